@@ -12,19 +12,268 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/milvus-io/milvus/internal/datacoord"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
+const usage = `usage:
+  binlog file1 file2 ...                                 print binlog files
+  binlog print [-minio] file1 file2 ...                  print binlog files
+  binlog export [-minio] [-format json|csv] [-o output] file
+                                                         export the payload of a binlog file
+  binlog verify [-minio] [-meta] file1 file2 ...         verify headers and timestamps of binlog files
+
+  -minio  read files from the minio bucket in milvus.yaml, the files are object keys
+  -meta   also verify against the segment meta of datacoord in etcd`
+
 func main() {
 	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Println(usage)
+		return
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
+
+	var err error
+	switch os.Args[1] {
+	case "print":
+		err = runPrint(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "verify":
+		err = runVerify(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Println(usage)
+		return
+	default:
+		err = storage.PrintBinlogFiles(os.Args[1:])
+		if err == nil {
+			fmt.Printf("print binlog complete.\n")
+		}
+	}
+	if err != nil {
 		fmt.Printf("error: %s\n", err.Error())
-	} else {
+		os.Exit(1)
+	}
+}
+
+func runPrint(args []string) error {
+	flags := flag.NewFlagSet("print", flag.ExitOnError)
+	useMinio := flags.Bool("minio", false, "read files from minio")
+	_ = flags.Parse(args)
+
+	if !*useMinio {
+		if err := storage.PrintBinlogFiles(flags.Args()); err != nil {
+			return err
+		}
 		fmt.Printf("print binlog complete.\n")
+		return nil
+	}
+	reader, err := newFileReader(true)
+	if err != nil {
+		return err
+	}
+	for _, file := range flags.Args() {
+		data, err := reader(file)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("file: %s\n", file)
+		if err := storage.PrintBinlog(data); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("print binlog complete.\n")
+	return nil
+}
+
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	useMinio := flags.Bool("minio", false, "read the file from minio")
+	format := flags.String("format", storage.ExportFormatJSON, "export format, json or csv")
+	output := flags.String("o", "", "output file, default to stdout")
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("export needs exactly one file, got %d", flags.NArg())
+	}
+	reader, err := newFileReader(*useMinio)
+	if err != nil {
+		return err
+	}
+	data, err := reader(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		fd, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer fd.Close()
+		w = fd
+	}
+	return storage.ExportBinlog(data, *format, w)
+}
+
+func runVerify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	useMinio := flags.Bool("minio", false, "read files from minio")
+	useMeta := flags.Bool("meta", false, "verify against the segment meta of datacoord")
+	_ = flags.Parse(args)
+
+	reader, err := newFileReader(*useMinio)
+	if err != nil {
+		return err
+	}
+	var metaKV *etcdkv.EtcdKV
+	if *useMeta {
+		metaKV, err = newMetaKV()
+		if err != nil {
+			return err
+		}
+		defer metaKV.Close()
+	}
+
+	failed := 0
+	for _, file := range flags.Args() {
+		data, err := reader(file)
+		if err != nil {
+			return err
+		}
+		var segment *datapb.SegmentInfo
+		if metaKV != nil {
+			segment, err = loadSegmentMeta(metaKV, data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+		}
+		errs := storage.VerifyBinlog(data, segment)
+		if len(errs) == 0 {
+			fmt.Printf("%s: ok\n", file)
+			continue
+		}
+		failed++
+		fmt.Printf("%s: %d problems found\n", file, len(errs))
+		for _, err := range errs {
+			fmt.Printf("\t%s\n", err.Error())
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed verification", failed, flags.NArg())
+	}
+	return nil
+}
+
+// newFileReader returns a function reading the whole content of a binlog file,
+// from the local disk or from the minio bucket configured in milvus.yaml
+func newFileReader(useMinio bool) (func(file string) ([]byte, error), error) {
+	if !useMinio {
+		return ioutil.ReadFile, nil
+	}
+	paramtable.Params.Init()
+	address, err := paramtable.Params.Load("_MinioAddress")
+	if err != nil {
+		return nil, err
+	}
+	accessKeyID, err := paramtable.Params.Load("minio.accessKeyID")
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := paramtable.Params.Load("minio.secretAccessKey")
+	if err != nil {
+		return nil, err
+	}
+	useSSL, err := paramtable.Params.Load("minio.useSSL")
+	if err != nil {
+		return nil, err
+	}
+	bucketName, err := paramtable.Params.Load("minio.bucketName")
+	if err != nil {
+		return nil, err
+	}
+	ssl, _ := strconv.ParseBool(useSSL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	kv, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           address,
+		AccessKeyID:       accessKeyID,
+		SecretAccessKeyID: secretAccessKey,
+		UseSSL:            ssl,
+		BucketName:        bucketName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(file string) ([]byte, error) {
+		if !kv.Exist(file) {
+			return nil, fmt.Errorf("%s not found in bucket %s", file, bucketName)
+		}
+		value, err := kv.Load(file)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(value), nil
+	}, nil
+}
+
+func newMetaKV() (*etcdkv.EtcdKV, error) {
+	paramtable.Params.Init()
+	endpoints, err := paramtable.Params.Load("_EtcdEndpoints")
+	if err != nil {
+		return nil, err
+	}
+	rootPath, err := paramtable.Params.Load("etcd.rootPath")
+	if err != nil {
+		return nil, err
+	}
+	subPath, err := paramtable.Params.Load("etcd.metaSubPath")
+	if err != nil {
+		return nil, err
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(endpoints, ","),
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return etcdkv.NewEtcdKV(client, path.Join(rootPath, subPath)), nil
+}
+
+func loadSegmentMeta(metaKV *etcdkv.EtcdKV, data []byte) (*datapb.SegmentInfo, error) {
+	r, err := storage.NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	value, err := metaKV.Load(datacoord.BuildSegmentPath(r.CollectionID, r.PartitionID, r.SegmentID))
+	if err != nil {
+		return nil, fmt.Errorf("load meta of segment %d failed: %w", r.SegmentID, err)
+	}
+	segment := &datapb.SegmentInfo{}
+	if err := proto.UnmarshalText(value, segment); err != nil {
+		return nil, err
 	}
+	return segment, nil
 }
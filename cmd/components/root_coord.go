@@ -17,14 +17,12 @@ import (
 
 	rc "github.com/milvus-io/milvus/internal/distributed/rootcoord"
 	"github.com/milvus-io/milvus/internal/msgstream"
-	"github.com/opentracing/opentracing-go"
 )
 
 type RootCoord struct {
 	ctx context.Context
 	svr *rc.Server
 
-	tracer opentracing.Tracer
 	closer io.Closer
 }
 
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/querynode"
 	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/util/embedetcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
@@ -42,6 +43,11 @@ func newMsgFactory(localMsg bool, rocksmqPath string) msgstream.Factory {
 	if localMsg {
 		return msgstream.NewRmsFactory(rocksmqPath)
 	}
+	paramtable.Params.Init()
+	kafkaBrokerList, _ := paramtable.Params.Load("_KafkaBrokerList")
+	if kafkaBrokerList != "" {
+		return msgstream.NewKmsFactory(strings.Split(kafkaBrokerList, ","))
+	}
 	return msgstream.NewPmsFactory()
 }
 
@@ -338,6 +344,16 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		cfg := mr.setLogConfigFilename("standalone.log")
 		logutil.SetupLogger(cfg)
 		defer log.Sync()
+
+		// the embedded etcd must be ready before any component connects to it,
+		// and it is stopped after all components are stopped
+		if paramtable.Params.UseEmbedEtcd {
+			etcdServer, err := embedetcd.Start(paramtable.Params.EtcdDataDir, paramtable.Params.EtcdEndpoints)
+			if err != nil {
+				panic(err)
+			}
+			defer etcdServer.Stop()
+		}
 	}
 
 	var rc *components.RootCoord
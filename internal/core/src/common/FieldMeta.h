@@ -41,6 +41,10 @@ datatype_sizeof(DataType data_type, int dim = 1) {
             Assert(dim % 8 == 0);
             return dim / 8;
         }
+        case DataType::ARRAY:
+        case DataType::JSON:
+            // json values and arrays encoded as json are kept in fixed-width slots, dim is the max length in bytes
+            return dim;
         default: {
             throw std::invalid_argument("unsupported data type");
             return 0;
@@ -71,6 +75,10 @@ datatype_name(DataType data_type) {
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
+        case DataType::ARRAY:
+            return "array";
+        case DataType::JSON:
+            return "json";
         default: {
             auto err_msg = "Unsupported DataType(" + std::to_string((int)data_type) + ")";
             PanicInfo(err_msg);
@@ -119,6 +127,20 @@ class FieldMeta {
 
     FieldMeta(const FieldName& name, FieldId id, DataType type) : name_(name), id_(id), type_(type) {
         Assert(!is_vector());
+        Assert(!is_json() && !is_array());
+    }
+
+    FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t max_length)
+        : name_(name), id_(id), type_(type), max_length_(max_length) {
+        Assert(is_json());
+        Assert(max_length > 0);
+    }
+
+    FieldMeta(const FieldName& name, FieldId id, DataType type, DataType element_type, int64_t max_length)
+        : name_(name), id_(id), type_(type), element_type_(element_type), max_length_(max_length) {
+        Assert(is_array());
+        Assert(!datatype_is_vector(element_type));
+        Assert(max_length > 0);
     }
 
     FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t dim, std::optional<MetricType> metric_type)
@@ -132,6 +154,32 @@ class FieldMeta {
         return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
     }
 
+    bool
+    is_json() const {
+        return type_ == DataType::JSON;
+    }
+
+    // arrays are encoded as json arrays, so they are stored and scanned the same way as json values
+    bool
+    is_array() const {
+        return type_ == DataType::ARRAY;
+    }
+
+    DataType
+    get_element_type() const {
+        Assert(is_array());
+        Assert(element_type_.has_value());
+        return element_type_.value();
+    }
+
+    // max length in bytes of a json value, the value is NUL-padded to it
+    int64_t
+    get_max_length() const {
+        Assert(is_json() || is_array());
+        Assert(max_length_.has_value());
+        return max_length_.value();
+    }
+
     int64_t
     get_dim() const {
         Assert(is_vector());
@@ -165,6 +213,8 @@ class FieldMeta {
     get_sizeof() const {
         if (is_vector()) {
             return datatype_sizeof(type_, get_dim());
+        } else if (is_json() || is_array()) {
+            return datatype_sizeof(type_, get_max_length());
         } else {
             return datatype_sizeof(type_, 1);
         }
@@ -179,6 +229,8 @@ class FieldMeta {
     FieldId id_;
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
+    std::optional<DataType> element_type_;
+    std::optional<int64_t> max_length_;
 };
 
 }  // namespace milvus
@@ -19,6 +19,8 @@ struct LoadIndexInfo {
     int64_t field_id;
     std::map<std::string, std::string> index_params;
     milvus::knowhere::VecIndexPtr index;
+    // binaries of scalar indexes, the index is created by the segment which knows the field type
+    milvus::knowhere::BinarySet scalar_index_binary;
 };
 
 // NOTE: field_id can be system field
@@ -63,6 +63,16 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
                 auto metric_type = GetMetricType(index_map.at("metric_type"));
                 schema->AddField(name, field_id, data_type, dim, metric_type);
             }
+        } else if (data_type == DataType::JSON || data_type == DataType::ARRAY) {
+            auto type_map = RepeatedKeyValToMap(child.type_params());
+            AssertInfo(type_map.count("max_length"), "max_length not found");
+            auto max_length = boost::lexical_cast<int64_t>(type_map.at("max_length"));
+            if (data_type == DataType::ARRAY) {
+                auto element_type = DataType(child.element_type());
+                schema->AddField(name, field_id, data_type, element_type, max_length);
+            } else {
+                schema->AddField(name, field_id, data_type, max_length);
+            }
         } else {
             schema->AddField(name, field_id, data_type);
         }
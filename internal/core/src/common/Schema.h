@@ -43,6 +43,26 @@ class Schema {
         return field_id;
     }
 
+    // auto gen field_id for convenience
+    FieldId
+    AddDebugField(const std::string& name, DataType data_type, int64_t max_length) {
+        static int64_t debug_id = 3001;
+        auto field_id = FieldId(debug_id);
+        debug_id += 2;
+        this->AddField(FieldName(name), field_id, data_type, max_length);
+        return field_id;
+    }
+
+    // auto gen field_id for convenience
+    FieldId
+    AddDebugField(const std::string& name, DataType data_type, DataType element_type, int64_t max_length) {
+        static int64_t debug_id = 4001;
+        auto field_id = FieldId(debug_id);
+        debug_id += 2;
+        this->AddField(FieldName(name), field_id, data_type, element_type, max_length);
+        return field_id;
+    }
+
     // scalar type
     void
     AddField(const FieldName& name, const FieldId id, DataType data_type) {
@@ -50,6 +70,20 @@ class Schema {
         this->AddField(std::move(field_meta));
     }
 
+    // json type
+    void
+    AddField(const FieldName& name, const FieldId id, DataType data_type, int64_t max_length) {
+        auto field_meta = FieldMeta(name, id, data_type, max_length);
+        this->AddField(std::move(field_meta));
+    }
+
+    // array type
+    void
+    AddField(const FieldName& name, const FieldId id, DataType data_type, DataType element_type, int64_t max_length) {
+        auto field_meta = FieldMeta(name, id, data_type, element_type, max_length);
+        this->AddField(std::move(field_meta));
+    }
+
     // vector type
     void
     AddField(const FieldName& name,
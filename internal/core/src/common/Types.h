@@ -78,6 +78,8 @@ struct SearchResult {
     std::vector<int64_t> internal_seg_offsets_;
     std::vector<int64_t> result_offsets_;
     std::vector<std::vector<char>> row_data_;
+    // values of the group by field of the hits, empty if not a group by search
+    std::vector<int64_t> group_by_values_;
 };
 
 using SearchResultPtr = std::shared_ptr<SearchResult>;
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <algorithm>
+#include <memory>
+#include <utility>
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted.h"
+
+namespace milvus {
+namespace knowhere::scalar {
+
+template <typename T>
+StructuredIndexInverted<T>::StructuredIndexInverted() : is_built_(false), row_count_(0) {
+}
+
+template <typename T>
+StructuredIndexInverted<T>::StructuredIndexInverted(const size_t n, const T* values)
+    : is_built_(false), row_count_(0) {
+    StructuredIndexInverted<T>::Build(n, values);
+}
+
+template <typename T>
+StructuredIndexInverted<T>::~StructuredIndexInverted() {
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::Build(const size_t n, const T* values) {
+    if (n == 0) {
+        KNOWHERE_THROW_MSG("StructuredIndexInverted cannot build null values!");
+    }
+    std::vector<IndexStructure<T>> data;
+    data.reserve(n);
+    for (size_t i = 0; i < n; ++i) {
+        data.emplace_back(IndexStructure<T>(values[i], i));
+    }
+    // keep row offsets ascending inside every posting list
+    std::stable_sort(data.begin(), data.end());
+
+    keys_.clear();
+    postings_.clear();
+    postings_.reserve(n);
+    for (size_t i = 0; i < n; ++i) {
+        if (i == 0 || data[i].a_ != data[i - 1].a_) {
+            keys_.emplace_back(IndexStructure<T>(data[i].a_, postings_.size()));
+        }
+        postings_.push_back(data[i].idx_);
+    }
+    row_count_ = n;
+    is_built_ = true;
+}
+
+template <typename T>
+BinarySet
+StructuredIndexInverted<T>::Serialize(const milvus::knowhere::Config& config) {
+    if (!is_built_) {
+        KNOWHERE_THROW_MSG("StructuredIndexInverted is not built yet!");
+    }
+
+    auto keys_size = keys_.size() * sizeof(IndexStructure<T>);
+    std::shared_ptr<uint8_t[]> index_keys(new uint8_t[keys_size]);
+    memcpy(index_keys.get(), keys_.data(), keys_size);
+
+    auto postings_size = postings_.size() * sizeof(size_t);
+    std::shared_ptr<uint8_t[]> index_postings(new uint8_t[postings_size]);
+    memcpy(index_postings.get(), postings_.data(), postings_size);
+
+    std::shared_ptr<uint8_t[]> index_length(new uint8_t[sizeof(size_t)]);
+    memcpy(index_length.get(), &row_count_, sizeof(size_t));
+
+    BinarySet res_set;
+    res_set.Append("index_keys", index_keys, keys_size);
+    res_set.Append("index_postings", index_postings, postings_size);
+    res_set.Append("index_length", index_length, sizeof(size_t));
+    return res_set;
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::Load(const milvus::knowhere::BinarySet& index_binary) {
+    try {
+        auto index_length = index_binary.GetByName("index_length");
+        memcpy(&row_count_, index_length->data.get(), sizeof(size_t));
+
+        auto index_keys = index_binary.GetByName("index_keys");
+        keys_.resize((size_t)index_keys->size / sizeof(IndexStructure<T>));
+        memcpy(keys_.data(), index_keys->data.get(), (size_t)index_keys->size);
+
+        auto index_postings = index_binary.GetByName("index_postings");
+        postings_.resize((size_t)index_postings->size / sizeof(size_t));
+        memcpy(postings_.data(), index_postings->data.get(), (size_t)index_postings->size);
+        is_built_ = true;
+    } catch (std::exception& e) {
+        KNOWHERE_THROW_MSG(std::string("StructuredIndexInverted Load failed: ") + e.what());
+    }
+}
+
+template <typename T>
+void
+StructuredIndexInverted<T>::set_postings(TargetBitmap& bitset,
+                                         typename std::vector<IndexStructure<T>>::const_iterator lb,
+                                         typename std::vector<IndexStructure<T>>::const_iterator ub) const {
+    if (lb >= ub) {
+        return;
+    }
+    auto begin = lb->idx_;
+    auto end = ub == keys_.end() ? postings_.size() : ub->idx_;
+    for (auto i = begin; i < end; ++i) {
+        bitset.set(postings_[i]);
+    }
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::In(const size_t n, const T* values) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(row_count_);
+    for (size_t i = 0; i < n; ++i) {
+        auto lb = std::lower_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(values[i]));
+        if (lb != keys_.cend() && lb->a_ == values[i]) {
+            set_postings(*bitset, lb, lb + 1);
+        }
+    }
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::NotIn(const size_t n, const T* values) {
+    auto bitset = In(n, values);
+    bitset->flip();
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::Range(const T value, const OperatorType op) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(row_count_);
+    auto lb = keys_.cbegin();
+    auto ub = keys_.cend();
+    switch (op) {
+        case OperatorType::LT:
+            ub = std::lower_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(value));
+            break;
+        case OperatorType::LE:
+            ub = std::upper_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(value));
+            break;
+        case OperatorType::GT:
+            lb = std::upper_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(value));
+            break;
+        case OperatorType::GE:
+            lb = std::lower_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(value));
+            break;
+        default:
+            KNOWHERE_THROW_MSG("Invalid OperatorType:" + std::to_string((int)op) + "!");
+    }
+    set_postings(*bitset, lb, ub);
+    return bitset;
+}
+
+template <typename T>
+const TargetBitmapPtr
+StructuredIndexInverted<T>::Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(row_count_);
+    if (lower_bound_value > upper_bound_value) {
+        std::swap(lower_bound_value, upper_bound_value);
+        std::swap(lb_inclusive, ub_inclusive);
+    }
+    auto lb = lb_inclusive ? std::lower_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(lower_bound_value))
+                           : std::upper_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(lower_bound_value));
+    auto ub = ub_inclusive ? std::upper_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(upper_bound_value))
+                           : std::lower_bound(keys_.cbegin(), keys_.cend(), IndexStructure<T>(upper_bound_value));
+    set_postings(*bitset, lb, ub);
+    return bitset;
+}
+
+}  // namespace knowhere::scalar
+}  // namespace milvus
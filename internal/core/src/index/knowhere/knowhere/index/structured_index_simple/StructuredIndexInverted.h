@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+#pragma once
+
+#include <algorithm>
+#include <memory>
+#include <utility>
+#include <vector>
+#include "knowhere/common/Exception.h"
+#include "knowhere/index/structured_index_simple/StructuredIndex.h"
+
+namespace milvus {
+namespace knowhere::scalar {
+
+// StructuredIndexInverted keeps a posting list of row offsets for every distinct value,
+// it's smaller and faster than StructuredIndexSort on fields with few distinct values.
+template <typename T>
+class StructuredIndexInverted : public StructuredIndex<T> {
+ public:
+    StructuredIndexInverted();
+    StructuredIndexInverted(const size_t n, const T* values);
+    ~StructuredIndexInverted();
+
+    BinarySet
+    Serialize(const Config& config = Config()) override;
+
+    void
+    Load(const BinarySet& index_binary) override;
+
+    void
+    Build(const size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    In(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    NotIn(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    Range(T value, OperatorType op) override;
+
+    const TargetBitmapPtr
+    Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) override;
+
+    int64_t
+    Size() override {
+        return (int64_t)row_count_;
+    }
+
+    bool
+    IsBuilt() const {
+        return is_built_;
+    }
+
+ private:
+    // the postings of keys_[lb, ub) are stored contiguously in postings_
+    void
+    set_postings(TargetBitmap& bitset, typename std::vector<IndexStructure<T>>::const_iterator lb,
+                 typename std::vector<IndexStructure<T>>::const_iterator ub) const;
+
+ private:
+    bool is_built_;
+    size_t row_count_;
+    // distinct values in ascending order, idx_ is the start of the value's postings
+    std::vector<IndexStructure<T>> keys_;
+    std::vector<size_t> postings_;
+};
+
+template <typename T>
+using StructuredIndexInvertedPtr = std::shared_ptr<StructuredIndexInverted<T>>;
+}  // namespace knowhere::scalar
+}  // namespace milvus
+
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted-inl.h"
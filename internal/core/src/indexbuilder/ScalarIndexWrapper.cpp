@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include "pb/index_cgo_msg.pb.h"
+#include "exceptions/EasyAssert.h"
+#include "common/FieldMeta.h"
+#include "query/ScalarIndex.h"
+#include "indexbuilder/ScalarIndexWrapper.h"
+
+namespace milvus {
+namespace indexbuilder {
+
+ScalarIndexWrapper::ScalarIndexWrapper(const char* index_type, DataType data_type)
+    : index_type_(index_type), data_type_(data_type) {
+    AssertInfo(query::is_scalar_index_type(index_type_), "unsupported scalar index type: " + index_type_);
+    AssertInfo(!datatype_is_vector(data_type_), "scalar index can't be built on vector field");
+}
+
+void
+ScalarIndexWrapper::Build(int64_t row_num, const void* values) {
+    auto span = SpanBase(values, row_num, datatype_sizeof(data_type_));
+    index_ = query::build_scalar_index(index_type_, span, data_type_);
+}
+
+std::unique_ptr<IndexWrapper::Binary>
+ScalarIndexWrapper::Serialize() {
+    Assert(index_ != nullptr);
+    auto binarySet = index_->Serialize(knowhere::Config());
+
+    namespace indexcgo = milvus::proto::indexcgo;
+    indexcgo::BinarySet ret;
+
+    for (auto [key, value] : binarySet.binary_map_) {
+        auto binary = ret.add_datas();
+        binary->set_key(key);
+        binary->set_value(value->data.get(), value->size);
+    }
+
+    std::string serialized_data;
+    auto ok = ret.SerializeToString(&serialized_data);
+    Assert(ok);
+
+    auto binary = std::make_unique<IndexWrapper::Binary>();
+    binary->data.resize(serialized_data.length());
+    memcpy(binary->data.data(), serialized_data.c_str(), serialized_data.length());
+
+    return binary;
+}
+
+void
+ScalarIndexWrapper::Load(const char* serialized_sliced_blob_buffer, int32_t size) {
+    namespace indexcgo = milvus::proto::indexcgo;
+    auto data = std::string(serialized_sliced_blob_buffer, size);
+    indexcgo::BinarySet blob_buffer;
+
+    auto ok = blob_buffer.ParseFromString(data);
+    Assert(ok);
+
+    milvus::knowhere::BinarySet binarySet;
+    for (auto i = 0; i < blob_buffer.datas_size(); i++) {
+        const auto& binary = blob_buffer.datas(i);
+        auto deleter = [&](uint8_t*) {};  // avoid repeated deconstruction
+        auto bptr = std::make_shared<milvus::knowhere::Binary>();
+        bptr->data = std::shared_ptr<uint8_t[]>((uint8_t*)binary.value().c_str(), deleter);
+        bptr->size = binary.value().length();
+        binarySet.Append(binary.key(), bptr);
+    }
+
+    index_ = query::create_scalar_index(index_type_, data_type_);
+    index_->Load(binarySet);
+}
+
+}  // namespace indexbuilder
+}  // namespace milvus
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+#include <memory>
+#include <string>
+#include "common/Types.h"
+#include "knowhere/index/Index.h"
+#include "indexbuilder/IndexWrapper.h"
+
+namespace milvus {
+namespace indexbuilder {
+
+// ScalarIndexWrapper builds sorted or inverted indexes on scalar fields,
+// the serialized binary has the same layout as the one of IndexWrapper.
+class ScalarIndexWrapper {
+ public:
+    explicit ScalarIndexWrapper(const char* index_type, DataType data_type);
+
+    void
+    Build(int64_t row_num, const void* values);
+
+    std::unique_ptr<IndexWrapper::Binary>
+    Serialize();
+
+    void
+    Load(const char* serialized_sliced_blob_buffer, int32_t size);
+
+ private:
+    std::string index_type_;
+    DataType data_type_;
+    std::unique_ptr<knowhere::Index> index_ = nullptr;
+};
+
+}  // namespace indexbuilder
+}  // namespace milvus
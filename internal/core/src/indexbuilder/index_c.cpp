@@ -12,6 +12,7 @@
 #include <string>
 #include "index/knowhere/knowhere/index/vector_index/adapter/VectorAdapter.h"
 #include "indexbuilder/IndexWrapper.h"
+#include "indexbuilder/ScalarIndexWrapper.h"
 #include "indexbuilder/index_c.h"
 
 class CGODebugUtils {
@@ -232,6 +233,74 @@ QueryOnBinaryVecIndexWithParam(CIndex index,
     return status;
 }
 
+CStatus
+CreateScalarIndex(const char* index_type, int32_t data_type, CIndex* res_index) {
+    auto status = CStatus();
+    try {
+        auto index =
+            std::make_unique<milvus::indexbuilder::ScalarIndexWrapper>(index_type, milvus::DataType(data_type));
+        *res_index = index.release();
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+void
+DeleteScalarIndex(CIndex index) {
+    auto cIndex = (milvus::indexbuilder::ScalarIndexWrapper*)index;
+    delete cIndex;
+}
+
+CStatus
+BuildScalarIndex(CIndex index, int64_t row_num, const void* values) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::ScalarIndexWrapper*)index;
+        cIndex->Build(row_num, values);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+CStatus
+SerializeScalarIndexToSlicedBuffer(CIndex index, CBinary* c_binary) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::ScalarIndexWrapper*)index;
+        auto binary = cIndex->Serialize();
+        *c_binary = binary.release();
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
+CStatus
+LoadScalarIndexFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size) {
+    auto status = CStatus();
+    try {
+        auto cIndex = (milvus::indexbuilder::ScalarIndexWrapper*)index;
+        cIndex->Load(serialized_sliced_blob_buffer, size);
+        status.error_code = Success;
+        status.error_msg = "";
+    } catch (std::exception& e) {
+        status.error_code = UnexpectedError;
+        status.error_msg = strdup(e.what());
+    }
+    return status;
+}
+
 CStatus
 CreateQueryResult(CIndexQueryResult* res) {
     auto status = CStatus();
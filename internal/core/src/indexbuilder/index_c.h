@@ -85,6 +85,22 @@ QueryOnBinaryVecIndexWithParam(CIndex index,
                                const char* serialized_search_params,
                                CIndexQueryResult* res);
 
+// scalar indexes, data_type is the value of schema.DataType
+CStatus
+CreateScalarIndex(const char* index_type, int32_t data_type, CIndex* res_index);
+
+void
+DeleteScalarIndex(CIndex index);
+
+CStatus
+BuildScalarIndex(CIndex index, int64_t row_num, const void* values);
+
+CStatus
+SerializeScalarIndexToSlicedBuffer(CIndex index, CBinary* c_binary);
+
+CStatus
+LoadScalarIndexFromSlicedBuffer(CIndex index, const char* serialized_sliced_blob_buffer, int32_t size);
+
 CStatus
 CreateQueryResult(CIndexQueryResult* res);
 
@@ -89,10 +89,41 @@ static const std::map<std::string, OpType> mapping_ = {
     {"eq", OpType::Equal},       {"ne", OpType::NotEqual},
 };
 
+enum class ArithOpType {
+    Unknown = 0,
+    Add = 1,
+    Sub = 2,
+    Mul = 3,
+    Div = 4,
+    Mod = 5,
+    ArrayLength = 6,
+};
+
+// field arith_op right_operand op value, e.g. price * 0.9 < 100
+// or array_length(field) op value for array fields, where right_operand is unused
+struct BinaryArithOpEvalRangeExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    ArithOpType arith_op_;
+    OpType op_type_;
+    // keys to walk into the value of a json field, empty for other types
+    std::vector<std::string> nested_path_;
+
+ protected:
+    // prevent accidential instantiation
+    BinaryArithOpEvalRangeExpr() = default;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct UnaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
     OpType op_type_;
+    // keys to walk into the value of a json field, empty for other types
+    std::vector<std::string> nested_path_;
 
  protected:
     // prevent accidential instantiation
@@ -118,6 +149,30 @@ struct BinaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+enum class ArrayOpType {
+    Invalid = 0,
+    Contains = 1,
+    ContainsAll = 2,
+    ContainsAny = 3,
+};
+
+// array_contains(field, x), array_contains_all(field, [x, y]) or array_contains_any(field, [x, y])
+struct ArrayContainsExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    ArrayOpType op_type_;
+    // keys to walk into the value of a json field, empty for array fields
+    std::vector<std::string> nested_path_;
+
+ protected:
+    // prevent accidential instantiation
+    ArrayContainsExpr() = default;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct CompareExpr : Expr {
     FieldOffset left_field_offset_;
     FieldOffset right_field_offset_;
@@ -14,6 +14,7 @@
 #include <tuple>
 #include <vector>
 #include <boost/container/vector.hpp>
+#include "utils/Json.h"
 
 namespace milvus::query {
 template <typename T>
@@ -21,14 +22,27 @@ struct TermExprImpl : TermExpr {
     boost::container::vector<T> terms_;
 };
 
+// T is json when comparing a value inside a json field
 template <typename T>
 struct UnaryRangeExprImpl : UnaryRangeExpr {
     T value_;
 };
 
+template <typename T>
+struct BinaryArithOpEvalRangeExprImpl : BinaryArithOpEvalRangeExpr {
+    T right_operand_;
+    T value_;
+};
+
 template <typename T>
 struct BinaryRangeExprImpl : BinaryRangeExpr {
     T lower_value_;
     T upper_value_;
 };
+
+// T is json, as the elements of arrays are stored as json
+template <typename T>
+struct ArrayContainsExprImpl : ArrayContainsExpr {
+    std::vector<T> elements_;
+};
 }  // namespace milvus::query
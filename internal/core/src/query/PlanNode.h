@@ -35,6 +35,14 @@ struct SearchInfo {
     FieldOffset field_offset_;
     MetricType metric_type_;
     nlohmann::json search_params_;
+    // estimated fraction of rows passing the predicate, 0 if unknown
+    double estimated_selectivity_ = 0;
+    // brute force is preferred once no more than this many rows pass the predicate, 0 disables it
+    int64_t brute_force_threshold_ = 0;
+    // set by the executor when the filtered rows should be searched by brute force
+    bool brute_force_ = false;
+    // only the best hit of each distinct value of this field is returned if set
+    std::optional<FieldOffset> group_by_field_offset_;
 };
 
 struct VectorPlanNode : PlanNode {
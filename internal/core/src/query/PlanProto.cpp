@@ -71,6 +71,39 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
     return result;
 }
 
+static json
+GenericValueToJson(const planpb::GenericValue& value_proto) {
+    switch (value_proto.val_case()) {
+        case planpb::GenericValue::kBoolVal: {
+            return value_proto.bool_val();
+        }
+        case planpb::GenericValue::kInt64Val: {
+            return value_proto.int64_val();
+        }
+        case planpb::GenericValue::kFloatVal: {
+            return value_proto.float_val();
+        }
+        case planpb::GenericValue::kStringVal: {
+            return value_proto.string_val();
+        }
+        default: {
+            PanicInfo("unsupported value type");
+        }
+    }
+}
+
+std::unique_ptr<UnaryRangeExprImpl<json>>
+ExtractJsonUnaryRangeExprImpl(FieldOffset field_offset, const planpb::UnaryRangeExpr& expr_proto) {
+    auto result = std::make_unique<UnaryRangeExprImpl<json>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = DataType::JSON;
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+    auto& nested_path = expr_proto.column_info().nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
+    result->value_ = GenericValueToJson(expr_proto.value());
+    return result;
+}
+
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
 ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
@@ -98,6 +131,71 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
     return result;
 }
 
+template <typename T>
+std::unique_ptr<BinaryArithOpEvalRangeExprImpl<T>>
+ExtractBinaryArithOpEvalRangeExprImpl(FieldOffset field_offset,
+                                      DataType data_type,
+                                      const planpb::BinaryArithOpEvalRangeExpr& expr_proto) {
+    static_assert(std::is_fundamental_v<T>);
+    auto result = std::make_unique<BinaryArithOpEvalRangeExprImpl<T>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->arith_op_ = static_cast<ArithOpType>(expr_proto.arith_op());
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+
+    auto setValue = [&](T& v, const auto& value_proto) {
+        if constexpr (std::is_integral_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
+            v = static_cast<T>(value_proto.int64_val());
+        } else if constexpr (std::is_floating_point_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+            v = static_cast<T>(value_proto.float_val());
+        } else {
+            static_assert(always_false<T>);
+        }
+    };
+    setValue(result->right_operand_, expr_proto.right_operand());
+    setValue(result->value_, expr_proto.value());
+    return result;
+}
+
+// array_length(field) op value, the right operand is unused
+std::unique_ptr<BinaryArithOpEvalRangeExprImpl<int64_t>>
+ExtractArrayLengthExprImpl(FieldOffset field_offset, const planpb::BinaryArithOpEvalRangeExpr& expr_proto) {
+    Assert(expr_proto.arith_op() == planpb::ArithOpType::ArrayLength);
+    Assert(expr_proto.value().val_case() == planpb::GenericValue::kInt64Val);
+    auto result = std::make_unique<BinaryArithOpEvalRangeExprImpl<int64_t>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = DataType::ARRAY;
+    result->arith_op_ = ArithOpType::ArrayLength;
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+    result->right_operand_ = 0;
+    result->value_ = expr_proto.value().int64_val();
+    return result;
+}
+
+// arithmetic or array_length on a value inside a json field, the right operand is unused for array_length
+std::unique_ptr<BinaryArithOpEvalRangeExprImpl<json>>
+ExtractJsonBinaryArithOpEvalRangeExprImpl(FieldOffset field_offset,
+                                          const planpb::BinaryArithOpEvalRangeExpr& expr_proto) {
+    auto result = std::make_unique<BinaryArithOpEvalRangeExprImpl<json>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = DataType::JSON;
+    result->arith_op_ = static_cast<ArithOpType>(expr_proto.arith_op());
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+    auto& nested_path = expr_proto.column_info().nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
+    if (result->arith_op_ == ArithOpType::ArrayLength) {
+        result->right_operand_ = 0;
+    } else {
+        result->right_operand_ = GenericValueToJson(expr_proto.right_operand());
+        Assert(result->right_operand_.is_number());
+    }
+    result->value_ = GenericValueToJson(expr_proto.value());
+    Assert(result->value_.is_number());
+    return result;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -121,6 +219,12 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.metric_type_ = GetMetricType(query_info_proto.metric_type());
     search_info.topk_ = query_info_proto.topk();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    search_info.estimated_selectivity_ = query_info_proto.estimated_selectivity();
+    search_info.brute_force_threshold_ = query_info_proto.brute_force_threshold();
+    if (query_info_proto.group_by_field_id() != 0) {
+        auto group_by_field_id = FieldId(query_info_proto.group_by_field_id());
+        search_info.group_by_field_offset_ = schema.get_offset(group_by_field_id);
+    }
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -188,6 +292,9 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                return ExtractJsonUnaryRangeExprImpl(field_offset, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -260,6 +367,69 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ExprPtr
+ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto result = [&]() -> ExprPtr {
+        switch (data_type) {
+            case DataType::INT8: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int8_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT16: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int16_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT32: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int32_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT64: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int64_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::FLOAT: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<float>(field_offset, data_type, expr_pb);
+            }
+            case DataType::DOUBLE: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<double>(field_offset, data_type, expr_pb);
+            }
+            case DataType::ARRAY: {
+                return ExtractArrayLengthExprImpl(field_offset, expr_pb);
+            }
+            case DataType::JSON: {
+                return ExtractJsonBinaryArithOpEvalRangeExprImpl(field_offset, expr_pb);
+            }
+            default: {
+                PanicInfo("unsupported data type");
+            }
+        }
+    }();
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    Assert(data_type == DataType::ARRAY || data_type == DataType::JSON);
+
+    auto result = std::make_unique<ArrayContainsExprImpl<json>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->op_type_ = static_cast<ArrayOpType>(expr_pb.op());
+    auto& nested_path = column_info.nested_path();
+    result->nested_path_.assign(nested_path.begin(), nested_path.end());
+    for (auto& element : expr_pb.elements()) {
+        result->elements_.emplace_back(GenericValueToJson(element));
+    }
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -345,6 +515,12 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kBinaryArithOpEvalRangeExpr: {
+            return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
+        }
+        case ppe::kArrayContainsExpr: {
+            return ParseArrayContainsExpr(expr_pb.array_contains_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -35,6 +35,12 @@ class ProtoParser {
     ExprPtr
     ParseCompareExpr(const proto::plan::CompareExpr& expr_pb);
 
+    ExprPtr
+    ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb);
+
+    ExprPtr
+    ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb);
+
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
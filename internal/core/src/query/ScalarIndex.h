@@ -11,9 +11,11 @@
 
 #pragma once
 #include "knowhere/index/structured_index_simple/StructuredIndexSort.h"
+#include "knowhere/index/structured_index_simple/StructuredIndexInverted.h"
 #include "common/Span.h"
 #include "common/FieldMeta.h"
 #include <memory>
+#include <string>
 
 namespace milvus::query {
 
@@ -48,4 +50,76 @@ generate_scalar_index(SpanBase data, DataType data_type) {
     }
 }
 
+// index types of scalar indexes built by index nodes
+constexpr const char* SCALAR_INDEX_SORTED = "SORTED";
+constexpr const char* SCALAR_INDEX_INVERTED = "INVERTED";
+
+inline bool
+is_scalar_index_type(const std::string& index_type) {
+    return index_type == SCALAR_INDEX_SORTED || index_type == SCALAR_INDEX_INVERTED;
+}
+
+template <typename T>
+inline std::unique_ptr<knowhere::scalar::StructuredIndex<T>>
+create_scalar_index(const std::string& index_type) {
+    if (index_type == SCALAR_INDEX_SORTED) {
+        return std::make_unique<knowhere::scalar::StructuredIndexSort<T>>();
+    } else if (index_type == SCALAR_INDEX_INVERTED) {
+        return std::make_unique<knowhere::scalar::StructuredIndexInverted<T>>();
+    }
+    PanicInfo("unsupported scalar index type: " + index_type);
+}
+
+inline std::unique_ptr<knowhere::Index>
+create_scalar_index(const std::string& index_type, DataType data_type) {
+    switch (data_type) {
+        case DataType::BOOL:
+            return create_scalar_index<bool>(index_type);
+        case DataType::INT8:
+            return create_scalar_index<int8_t>(index_type);
+        case DataType::INT16:
+            return create_scalar_index<int16_t>(index_type);
+        case DataType::INT32:
+            return create_scalar_index<int32_t>(index_type);
+        case DataType::INT64:
+            return create_scalar_index<int64_t>(index_type);
+        case DataType::FLOAT:
+            return create_scalar_index<float>(index_type);
+        case DataType::DOUBLE:
+            return create_scalar_index<double>(index_type);
+        default:
+            PanicInfo("unsupported type");
+    }
+}
+
+template <typename T>
+inline std::unique_ptr<knowhere::Index>
+build_scalar_index(const std::string& index_type, Span<T> data) {
+    auto indexing = create_scalar_index<T>(index_type);
+    indexing->Build(data.row_count(), data.data());
+    return indexing;
+}
+
+inline std::unique_ptr<knowhere::Index>
+build_scalar_index(const std::string& index_type, SpanBase data, DataType data_type) {
+    switch (data_type) {
+        case DataType::BOOL:
+            return build_scalar_index(index_type, Span<bool>(data));
+        case DataType::INT8:
+            return build_scalar_index(index_type, Span<int8_t>(data));
+        case DataType::INT16:
+            return build_scalar_index(index_type, Span<int16_t>(data));
+        case DataType::INT32:
+            return build_scalar_index(index_type, Span<int32_t>(data));
+        case DataType::INT64:
+            return build_scalar_index(index_type, Span<int64_t>(data));
+        case DataType::FLOAT:
+            return build_scalar_index(index_type, Span<float>(data));
+        case DataType::DOUBLE:
+            return build_scalar_index(index_type, Span<double>(data));
+        default:
+            PanicInfo("unsupported type");
+    }
+}
+
 }  // namespace milvus::query
@@ -60,7 +60,7 @@ FloatSearch(const segcore::SegmentGrowingImpl& segment,
 
     int current_chunk_id = 0;
 
-    if (indexing_record.is_in(vecfield_offset)) {
+    if (indexing_record.is_in(vecfield_offset) && !info.brute_force_) {
         auto max_indexed_id = indexing_record.get_finished_ack();
         const auto& field_indexing = indexing_record.get_vec_field_indexing(vecfield_offset);
         auto search_conf = field_indexing.get_search_params(topk);
@@ -17,6 +17,7 @@
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include <cstring>
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "ExprVisitor.h"
@@ -42,6 +43,12 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
  public:
     using RetType = boost::dynamic_bitset<>;
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
@@ -62,14 +69,35 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
 
+    template <typename T, typename ElementFunc>
+    auto
+    ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
     template <typename T>
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    auto
+    ExecJsonUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecArrayLengthVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJsonArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename T>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
@@ -45,4 +45,14 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+BinaryArithOpEvalRangeExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+ArrayContainsExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
@@ -36,5 +36,11 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(BinaryArithOpEvalRangeExpr&) = 0;
+
+    virtual void
+    visit(ArrayContainsExpr&) = 0;
 };
 }  // namespace milvus::query
@@ -36,6 +36,12 @@ class ExtractInfoExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
  public:
     explicit ExtractInfoExprVisitor(ExtractedPlanInfo& plan_info) : plan_info_(plan_info) {
     }
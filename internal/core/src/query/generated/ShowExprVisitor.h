@@ -37,6 +37,12 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
  public:
     using RetType = Json;
 
@@ -42,6 +42,12 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
+    void
+    visit(ArrayContainsExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
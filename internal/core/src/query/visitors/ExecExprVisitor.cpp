@@ -14,6 +14,9 @@
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include <cstring>
+#include <algorithm>
+#include <cmath>
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -44,14 +47,35 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
 
+    template <typename T, typename ElementFunc>
+    auto
+    ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
     template <typename T>
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    auto
+    ExecJsonUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecArrayLengthVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecJsonArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename T>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
@@ -175,6 +199,28 @@ ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc index_
     return final_result;
 }
 
+// unlike ExecRangeVisitorImpl, scan the raw data of every chunk, for exprs that indexes can't answer
+template <typename T, typename ElementFunc>
+auto
+ExecExprVisitor::ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<boost::dynamic_bitset<>> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto chunk = segment_.chunk_data<T>(field_offset, chunk_id);
+        const T* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(data[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    Assert(final_result.size() == row_count_);
+    return final_result;
+}
+
 #pragma clang diagnostic push
 #pragma ide diagnostic ignored "Simplify"
 template <typename T>
@@ -257,6 +303,228 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+// returns the value at the nested path of a json document, nullptr if the path doesn't exist
+static const json*
+JsonAtPath(const json& doc, const std::vector<std::string>& nested_path) {
+    const json* node = &doc;
+    for (auto& key : nested_path) {
+        if (!node->is_object()) {
+            return nullptr;
+        }
+        auto iter = node->find(key);
+        if (iter == node->end()) {
+            return nullptr;
+        }
+        node = &*iter;
+    }
+    return node;
+}
+
+template <typename T>
+static bool
+CompareByOp(OpType op_type, const T& x, const T& val) {
+    switch (op_type) {
+        case OpType::Equal:
+            return x == val;
+        case OpType::NotEqual:
+            return x != val;
+        case OpType::GreaterEqual:
+            return x >= val;
+        case OpType::GreaterThan:
+            return x > val;
+        case OpType::LessEqual:
+            return x <= val;
+        case OpType::LessThan:
+            return x < val;
+        default:
+            PanicInfo("unsupported range node");
+    }
+}
+
+// a row matches when the value at the nested path exists and compares true with the expr value,
+// values of different types never match
+auto
+ExecExprVisitor::ExecJsonUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<json>&>(expr_raw);
+    auto& val = expr.value_;
+
+    auto elem_func = [&](const json& doc) -> bool {
+        auto node = JsonAtPath(doc, expr.nested_path_);
+        if (node == nullptr || (!(node->is_number() && val.is_number()) && node->type() != val.type())) {
+            return false;
+        }
+        return CompareByOp(expr.op_type_, *node, val);
+    };
+    return ExecJsonVisitorImpl(expr.field_offset_, elem_func);
+}
+
+// array length is the size of the json array
+auto
+ExecExprVisitor::ExecArrayLengthVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<int64_t>&>(expr_raw);
+    Assert(expr.arith_op_ == ArithOpType::ArrayLength);
+    auto val = expr.value_;
+
+    auto elem_func = [&](const json& doc) -> bool {
+        auto length = static_cast<int64_t>(doc.size());
+        return CompareByOp(expr.op_type_, length, val);
+    };
+    return ExecJsonVisitorImpl(expr.field_offset_, elem_func);
+}
+
+// arithmetic on the number at the nested path of a json field, done on int64 when both operands are integers
+// and on double otherwise, rows without a number at the path never match
+auto
+ExecExprVisitor::ExecJsonArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<json>&>(expr_raw);
+    auto& right_operand = expr.right_operand_;
+    auto& val = expr.value_;
+
+    auto elem_func = [&](const json& doc) -> bool {
+        auto node = JsonAtPath(doc, expr.nested_path_);
+        if (node == nullptr) {
+            return false;
+        }
+        if (expr.arith_op_ == ArithOpType::ArrayLength) {
+            if (!node->is_array()) {
+                return false;
+            }
+            return CompareByOp(expr.op_type_, static_cast<double>(node->size()), val.get<double>());
+        }
+        if (!node->is_number()) {
+            return false;
+        }
+        if (node->is_number_integer() && right_operand.is_number_integer() && val.is_number_integer()) {
+            auto x = node->get<int64_t>();
+            auto operand = right_operand.get<int64_t>();
+            switch (expr.arith_op_) {
+                case ArithOpType::Add:
+                    return CompareByOp(expr.op_type_, x + operand, val.get<int64_t>());
+                case ArithOpType::Sub:
+                    return CompareByOp(expr.op_type_, x - operand, val.get<int64_t>());
+                case ArithOpType::Mul:
+                    return CompareByOp(expr.op_type_, x * operand, val.get<int64_t>());
+                case ArithOpType::Div:
+                    return operand != 0 && CompareByOp(expr.op_type_, x / operand, val.get<int64_t>());
+                case ArithOpType::Mod:
+                    return operand != 0 && CompareByOp(expr.op_type_, x % operand, val.get<int64_t>());
+                default:
+                    PanicInfo("unsupported arithmetic operation");
+            }
+        }
+        auto x = node->get<double>();
+        auto operand = right_operand.get<double>();
+        switch (expr.arith_op_) {
+            case ArithOpType::Add:
+                return CompareByOp(expr.op_type_, x + operand, val.get<double>());
+            case ArithOpType::Sub:
+                return CompareByOp(expr.op_type_, x - operand, val.get<double>());
+            case ArithOpType::Mul:
+                return CompareByOp(expr.op_type_, x * operand, val.get<double>());
+            case ArithOpType::Div:
+                return CompareByOp(expr.op_type_, x / operand, val.get<double>());
+            case ArithOpType::Mod:
+                return CompareByOp(expr.op_type_, std::fmod(x, operand), val.get<double>());
+            default:
+                PanicInfo("unsupported arithmetic operation");
+        }
+    };
+    return ExecJsonVisitorImpl(expr.field_offset_, elem_func);
+}
+
+// json and array values are NUL-padded fixed-width slots of json text, rows that fail to parse never match
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecJsonVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto& field_meta = segment_.get_schema()[field_offset];
+    auto max_length = field_meta.get_max_length();
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<boost::dynamic_bitset<>> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto chunk = segment_.chunk_data<BinaryVector>(field_offset, chunk_id);
+        auto data = reinterpret_cast<const char*>(chunk.data());
+        for (int index = 0; index < this_size; ++index) {
+            auto slot = data + index * max_length;
+            auto doc = json::parse(slot, slot + strnlen(slot, max_length), nullptr, false);
+            result[index] = !doc.is_discarded() && element_func(doc);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    Assert(final_result.size() == row_count_);
+    return final_result;
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<T>&>(expr_raw);
+    auto right_operand = expr.right_operand_;
+    auto val = expr.value_;
+
+    auto exec = [&](auto arith_func) -> RetType {
+        switch (expr.op_type_) {
+            case OpType::Equal: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) == val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            case OpType::NotEqual: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) != val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            case OpType::GreaterEqual: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) >= val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            case OpType::GreaterThan: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) > val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            case OpType::LessEqual: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) <= val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            case OpType::LessThan: {
+                auto elem_func = [=](T x) { return (arith_func(x, right_operand) < val); };
+                return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+            }
+            default: {
+                PanicInfo("unsupported range node");
+            }
+        }
+    };
+
+    switch (expr.arith_op_) {
+        case ArithOpType::Add: {
+            return exec(std::plus<>{});
+        }
+        case ArithOpType::Sub: {
+            return exec(std::minus<>{});
+        }
+        case ArithOpType::Mul: {
+            return exec(std::multiplies<>{});
+        }
+        case ArithOpType::Div: {
+            AssertInfo(right_operand != 0, "divide by zero");
+            return exec(std::divides<>{});
+        }
+        case ArithOpType::Mod: {
+            if constexpr (std::is_integral_v<T>) {
+                AssertInfo(right_operand != 0, "modulo by zero");
+                return exec(std::modulus<>{});
+            } else {
+                PanicInfo("modulo is only supported by integers");
+            }
+        }
+        default: {
+            PanicInfo("unsupported arith op");
+        }
+    }
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
@@ -291,6 +559,10 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::JSON: {
+            res = ExecJsonUnaryRangeVisitorDispatcher(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -339,6 +611,84 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
     ret_ = std::move(res);
 }
 
+void
+ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    Assert(expr.data_type_ == field_meta.get_data_type());
+    RetType res;
+    switch (expr.data_type_) {
+        case DataType::INT8: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int8_t>(expr);
+            break;
+        }
+        case DataType::INT16: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int16_t>(expr);
+            break;
+        }
+        case DataType::INT32: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int32_t>(expr);
+            break;
+        }
+        case DataType::INT64: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int64_t>(expr);
+            break;
+        }
+        case DataType::FLOAT: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<float>(expr);
+            break;
+        }
+        case DataType::DOUBLE: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<double>(expr);
+            break;
+        }
+        case DataType::ARRAY: {
+            res = ExecArrayLengthVisitorDispatcher(expr);
+            break;
+        }
+        case DataType::JSON: {
+            res = ExecJsonArithOpEvalRangeVisitorDispatcher(expr);
+            break;
+        }
+        default:
+            PanicInfo("unsupported");
+    }
+    Assert(res.size() == row_count_);
+    ret_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(ArrayContainsExpr& expr_raw) {
+    auto& expr = static_cast<ArrayContainsExprImpl<json>&>(expr_raw);
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    Assert(field_meta.is_array() || field_meta.is_json());
+    auto& elements = expr.elements_;
+
+    // for a json field the array is the value at the nested path, rows without an array there never match
+    auto contains = [](const json& array, const json& element) {
+        return std::find(array.begin(), array.end(), element) != array.end();
+    };
+    auto elem_func = [&](const json& doc) -> bool {
+        auto array = JsonAtPath(doc, expr.nested_path_);
+        if (array == nullptr || !array->is_array()) {
+            return false;
+        }
+        switch (expr.op_type_) {
+            case ArrayOpType::Contains:
+            case ArrayOpType::ContainsAny:
+                return std::any_of(elements.begin(), elements.end(),
+                                   [&](const json& element) { return contains(*array, element); });
+            case ArrayOpType::ContainsAll:
+                return std::all_of(elements.begin(), elements.end(),
+                                   [&](const json& element) { return contains(*array, element); });
+            default:
+                PanicInfo("unsupported array op");
+        }
+    };
+    auto res = ExecJsonVisitorImpl(expr.field_offset_, elem_func);
+    Assert(res.size() == row_count_);
+    ret_ = std::move(res);
+}
+
 template <typename Op>
 struct relational {
     template <typename T, typename U>
@@ -13,6 +13,7 @@
 #include "query/PlanImpl.h"
 #include "segcore/SegmentGrowing.h"
 #include <utility>
+#include <unordered_set>
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -73,6 +74,40 @@ empty_search_result(int64_t num_queries, int64_t topk, MetricType metric_type) {
     return final_result;
 }
 
+// the number of hits searched per group to return, so that enough distinct groups are likely found
+constexpr int64_t GROUP_BY_SEARCH_FACTOR = 10;
+
+// keep the best hit of each distinct group by value, at most topk hits for each query
+static SearchResult
+group_search_result(const segcore::SegmentInternalInterface& segment,
+                    FieldOffset group_by_field_offset,
+                    int64_t topk,
+                    MetricType metric_type,
+                    const SearchResult& result) {
+    auto num_queries = result.num_queries_;
+    auto searched_topk = result.topk_;
+    auto& seg_offsets = result.internal_seg_offsets_;
+    auto values = segment.get_group_by_values(group_by_field_offset, seg_offsets.data(), seg_offsets.size());
+
+    auto final_result = empty_search_result(num_queries, topk, metric_type);
+    final_result.group_by_values_.resize(num_queries * topk, 0);
+    for (int64_t q = 0; q < num_queries; ++q) {
+        std::unordered_set<int64_t> groups;
+        auto dst = q * topk;
+        for (auto src = q * searched_topk; src < (q + 1) * searched_topk && dst < (q + 1) * topk; ++src) {
+            // hits are sorted, so the first hit of a group is the best one
+            if (seg_offsets[src] == -1 || !groups.insert(values[src]).second) {
+                continue;
+            }
+            final_result.internal_seg_offsets_[dst] = seg_offsets[src];
+            final_result.result_distances_[dst] = result.result_distances_[src];
+            final_result.group_by_values_[dst] = values[src];
+            ++dst;
+        }
+    }
+    return final_result;
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -93,7 +128,11 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
 
     // skip all calculation
     if (active_count == 0) {
-        ret_ = empty_search_result(num_queries, node.search_info_.topk_, node.search_info_.metric_type_);
+        ret = empty_search_result(num_queries, node.search_info_.topk_, node.search_info_.metric_type_);
+        if (node.search_info_.group_by_field_offset_.has_value()) {
+            ret.group_by_values_.resize(ret.get_row_count(), 0);
+        }
+        ret_ = std::move(ret);
         return;
     }
 
@@ -104,13 +143,32 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
 
+    // with a selective predicate, scanning the few remaining rows beats searching the index
+    auto search_info = node.search_info_;
+    if (node.predicate_.has_value() && search_info.brute_force_threshold_ > 0) {
+        auto estimated_count = search_info.estimated_selectivity_ * active_count;
+        if (estimated_count <= search_info.brute_force_threshold_) {
+            auto filtered_count = static_cast<int64_t>(bitset_holder.count());
+            search_info.brute_force_ = filtered_count <= search_info.brute_force_threshold_;
+        }
+    }
+
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
         view = BitsetView((uint8_t*)boost_ext::get_data(bitset_holder), bitset_holder.size());
     }
 
-    segment->vector_search(active_count, node.search_info_, src_data, num_queries, MAX_TIMESTAMP, view, ret);
+    auto topk = search_info.topk_;
+    if (search_info.group_by_field_offset_.has_value()) {
+        search_info.topk_ = std::max(std::min(topk * GROUP_BY_SEARCH_FACTOR, active_count), topk);
+    }
 
+    segment->vector_search(active_count, search_info, src_data, num_queries, MAX_TIMESTAMP, view, ret);
+
+    if (search_info.group_by_field_offset_.has_value()) {
+        ret = group_search_result(*segment, search_info.group_by_field_offset_.value(), topk,
+                                  search_info.metric_type_, ret);
+    }
     ret_ = ret;
 }
 
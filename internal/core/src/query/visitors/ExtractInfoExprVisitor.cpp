@@ -60,4 +60,14 @@ ExtractInfoExprVisitor::visit(CompareExpr& expr) {
     plan_info_.add_involved_field(expr.right_field_offset_);
 }
 
+void
+ExtractInfoExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    plan_info_.add_involved_field(expr.field_offset_);
+}
+
+void
+ExtractInfoExprVisitor::visit(ArrayContainsExpr& expr) {
+    plan_info_.add_involved_field(expr.field_offset_);
+}
+
 }  // namespace milvus::query
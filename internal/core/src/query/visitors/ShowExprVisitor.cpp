@@ -152,6 +152,9 @@ UnaryRangeExtract(const UnaryRangeExpr& expr_raw) {
              {"data_type", datatype_name(expr->data_type_)},
              {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
              {"value", expr->value_}};
+    if (!expr->nested_path_.empty()) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -181,6 +184,9 @@ ShowExprVisitor::visit(UnaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = UnaryRangeExtract<float>(expr);
             return;
+        case DataType::JSON:
+            ret_ = UnaryRangeExtract<Json>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -248,4 +254,76 @@ ShowExprVisitor::visit(CompareExpr& expr) {
              {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
     ret_ = res;
 }
+
+template <typename T>
+static Json
+BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
+    using proto::plan::ArithOpType;
+    using proto::plan::ArithOpType_Name;
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    auto expr = dynamic_cast<const BinaryArithOpEvalRangeExprImpl<T>*>(&expr_raw);
+    Assert(expr);
+    Json res{{"expr_type", "BinaryArithOpEvalRange"},
+             {"field_offset", expr->field_offset_.get()},
+             {"data_type", datatype_name(expr->data_type_)},
+             {"arith_op", ArithOpType_Name(static_cast<ArithOpType>(expr->arith_op_))},
+             {"right_operand", expr->right_operand_},
+             {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
+             {"value", expr->value_}};
+    if (!expr->nested_path_.empty()) {
+        res["nested_path"] = expr->nested_path_;
+    }
+    return res;
+}
+
+void
+ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    Assert(!ret_.has_value());
+    Assert(datatype_is_vector(expr.data_type_) == false);
+    switch (expr.data_type_) {
+        case DataType::INT8:
+            ret_ = BinaryArithOpEvalRangeExtract<int8_t>(expr);
+            return;
+        case DataType::INT16:
+            ret_ = BinaryArithOpEvalRangeExtract<int16_t>(expr);
+            return;
+        case DataType::INT32:
+            ret_ = BinaryArithOpEvalRangeExtract<int32_t>(expr);
+            return;
+        case DataType::INT64:
+        case DataType::ARRAY:
+            ret_ = BinaryArithOpEvalRangeExtract<int64_t>(expr);
+            return;
+        case DataType::DOUBLE:
+            ret_ = BinaryArithOpEvalRangeExtract<double>(expr);
+            return;
+        case DataType::FLOAT:
+            ret_ = BinaryArithOpEvalRangeExtract<float>(expr);
+            return;
+        case DataType::JSON:
+            ret_ = BinaryArithOpEvalRangeExtract<Json>(expr);
+            return;
+        default:
+            PanicInfo("unsupported type");
+    }
+}
+
+void
+ShowExprVisitor::visit(ArrayContainsExpr& expr_raw) {
+    using proto::plan::ArrayContainsExpr_ArrayOp;
+    using proto::plan::ArrayContainsExpr_ArrayOp_Name;
+    Assert(!ret_.has_value());
+    auto expr = dynamic_cast<const ArrayContainsExprImpl<Json>*>(&expr_raw);
+    Assert(expr);
+    Json res{{"expr_type", "ArrayContains"},
+             {"field_offset", expr->field_offset_.get()},
+             {"data_type", datatype_name(expr->data_type_)},
+             {"op", ArrayContainsExpr_ArrayOp_Name(static_cast<ArrayContainsExpr_ArrayOp>(expr->op_type_))},
+             {"elements", expr->elements_}};
+    if (!expr->nested_path_.empty()) {
+        res["nested_path"] = expr->nested_path_;
+    }
+    ret_ = res;
+}
 }  // namespace milvus::query
@@ -42,4 +42,14 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArrayContainsExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
@@ -127,6 +127,11 @@ class IndexingRecord {
                 }
             }
 
+            // json and array fields are scanned by raw data only
+            if (field.is_json() || field.is_array()) {
+                continue;
+            }
+
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
         assert(offset_id == schema_.size());
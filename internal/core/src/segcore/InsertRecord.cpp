@@ -26,6 +26,11 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk) : uids_
                 PanicInfo("unsupported");
             }
         }
+        if (field.is_json() || field.is_array()) {
+            // json values are fixed-width byte slots, stored the same way as binary vectors
+            this->append_field_data<BinaryVector>(field.get_max_length() * 8, size_per_chunk);
+            continue;
+        }
         switch (field.get_data_type()) {
             case DataType::BOOL: {
                 this->append_field_data<bool>(size_per_chunk);
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <cmath>  // std::isnan
+#include <limits>
 #include <common/Types.h>
 #include "segcore/Reduce.h"
 
@@ -18,9 +19,15 @@ struct SearchResultPair {
     milvus::SearchResult* search_result_;
     int64_t offset_;
     int64_t index_;
+    // offset_ never reaches it, the hits of a query end here
+    int64_t offset_rb_;
 
-    SearchResultPair(float distance, milvus::SearchResult* search_result, int64_t offset, int64_t index)
-        : distance_(distance), search_result_(search_result), offset_(offset), index_(index) {
+    SearchResultPair(float distance,
+                     milvus::SearchResult* search_result,
+                     int64_t offset,
+                     int64_t index,
+                     int64_t offset_rb = std::numeric_limits<int64_t>::max())
+        : distance_(distance), search_result_(search_result), offset_(offset), index_(index), offset_rb_(offset_rb) {
     }
 
     bool
@@ -33,8 +40,17 @@ struct SearchResultPair {
         return std::isnan(pair.distance_) || (!std::isnan(distance_) && (distance_ > pair.distance_));
     }
 
+    bool
+    is_exhausted() const {
+        return offset_ >= offset_rb_;
+    }
+
     void
     reset_distance() {
+        if (is_exhausted()) {
+            distance_ = -std::numeric_limits<float>::infinity();
+            return;
+        }
         distance_ = search_result_->result_distances_[offset_];
     }
 };
@@ -272,7 +272,7 @@ SegmentGrowingImpl::vector_search(int64_t vec_count,
                                   const BitsetView& bitset,
                                   SearchResult& output) const {
     auto& sealed_indexing = this->get_sealed_indexing_record();
-    if (sealed_indexing.is_ready(search_info.field_offset_)) {
+    if (sealed_indexing.is_ready(search_info.field_offset_) && !search_info.brute_force_) {
         query::SearchOnSealed(this->get_schema(), sealed_indexing, search_info, query_data, query_count, bitset,
                               output);
     } else {
@@ -299,6 +299,11 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
         return;
     }
 
+    if (field_meta.is_json() || field_meta.is_array()) {
+        bulk_subscript_impl<BinaryVector>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
+        return;
+    }
+
     Assert(!field_meta.is_vector());
     switch (field_meta.get_data_type()) {
         case DataType::BOOL: {
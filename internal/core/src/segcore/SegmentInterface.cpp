@@ -11,6 +11,8 @@
 
 #include "segcore/SegmentInterface.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
+#include <cstring>
+#include "utils/Json.h"
 namespace milvus::segcore {
 class Naive;
 
@@ -82,6 +84,48 @@ SegmentInternalInterface::Search(const query::Plan* plan,
     return results;
 }
 
+std::vector<int64_t>
+SegmentInternalInterface::get_group_by_values(FieldOffset field_offset,
+                                              const int64_t* seg_offsets,
+                                              int64_t count) const {
+    auto& field_meta = get_schema()[field_offset];
+    aligned_vector<char> blob(count * field_meta.get_sizeof());
+    bulk_subscript(field_offset, seg_offsets, count, blob.data());
+
+    std::vector<int64_t> values(count);
+    auto widen = [&](auto data) {
+        for (int64_t i = 0; i < count; ++i) {
+            values[i] = static_cast<int64_t>(data[i]);
+        }
+    };
+    switch (field_meta.get_data_type()) {
+        case DataType::BOOL: {
+            widen(reinterpret_cast<const bool*>(blob.data()));
+            break;
+        }
+        case DataType::INT8: {
+            widen(reinterpret_cast<const int8_t*>(blob.data()));
+            break;
+        }
+        case DataType::INT16: {
+            widen(reinterpret_cast<const int16_t*>(blob.data()));
+            break;
+        }
+        case DataType::INT32: {
+            widen(reinterpret_cast<const int32_t*>(blob.data()));
+            break;
+        }
+        case DataType::INT64: {
+            widen(reinterpret_cast<const int64_t*>(blob.data()));
+            break;
+        }
+        default: {
+            PanicInfo("unsupported group by field type");
+        }
+    }
+    return values;
+}
+
 // Note: this is temporary solution.
 // modify bulk script implement to make process more clear
 static std::unique_ptr<ScalarArray>
@@ -137,13 +181,73 @@ CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
     return scalar_array;
 }
 
+// the elements of an array are stored as a json array
+static std::unique_ptr<ScalarArray>
+CreateScalarArrayFromJson(const json& elements, DataType element_type) {
+    auto scalar_array = std::make_unique<ScalarArray>();
+    for (auto& element : elements) {
+        switch (element_type) {
+            case DataType::BOOL: {
+                scalar_array->mutable_bool_data()->add_data(element.get<bool>());
+                break;
+            }
+            case DataType::INT8:
+            case DataType::INT16:
+            case DataType::INT32: {
+                scalar_array->mutable_int_data()->add_data(element.get<int32_t>());
+                break;
+            }
+            case DataType::INT64: {
+                scalar_array->mutable_long_data()->add_data(element.get<int64_t>());
+                break;
+            }
+            case DataType::FLOAT: {
+                scalar_array->mutable_float_data()->add_data(element.get<float>());
+                break;
+            }
+            case DataType::DOUBLE: {
+                scalar_array->mutable_double_data()->add_data(element.get<double>());
+                break;
+            }
+            case DataType::STRING: {
+                scalar_array->mutable_string_data()->add_data(element.get<std::string>());
+                break;
+            }
+            default: {
+                PanicInfo("unsupported element type");
+            }
+        }
+    }
+    return scalar_array;
+}
+
 static std::unique_ptr<DataArray>
 CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta) {
     auto data_type = field_meta.get_data_type();
     auto data_array = std::make_unique<DataArray>();
     data_array->set_field_id(field_meta.get_id().get());
 
-    if (!datatype_is_vector(data_type)) {
+    if (field_meta.is_json()) {
+        // strip the NUL padding of the fixed-width slots
+        auto max_length = field_meta.get_max_length();
+        auto data = reinterpret_cast<const char*>(data_raw);
+        auto obj = data_array->mutable_scalars()->mutable_json_data();
+        for (int64_t i = 0; i < count; ++i) {
+            auto value = data + i * max_length;
+            obj->add_data(value, strnlen(value, max_length));
+        }
+    } else if (field_meta.is_array()) {
+        auto max_length = field_meta.get_max_length();
+        auto element_type = field_meta.get_element_type();
+        auto data = reinterpret_cast<const char*>(data_raw);
+        auto obj = data_array->mutable_scalars()->mutable_array_data();
+        obj->set_element_type(static_cast<proto::schema::DataType>(element_type));
+        for (int64_t i = 0; i < count; ++i) {
+            auto value = data + i * max_length;
+            auto elements = json::parse(value, value + strnlen(value, max_length));
+            obj->mutable_data()->AddAllocated(CreateScalarArrayFromJson(elements, element_type).release());
+        }
+    } else if (!datatype_is_vector(data_type)) {
         auto scalar_array = CreateScalarArrayFrom(data_raw, count, data_type);
         data_array->set_allocated_scalars(scalar_array.release());
     } else {
@@ -123,6 +123,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual int64_t
     get_active_count(Timestamp ts) const = 0;
 
+    // values of an integral or bool field at seg_offsets, widened to int64 for grouping
+    std::vector<int64_t>
+    get_group_by_values(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count) const;
+
  protected:
     // internal API: return chunk_data in span
     virtual SpanBase
@@ -31,6 +31,10 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     // NOTE: lock only when data is ready to avoid starvation
     auto field_id = FieldId(info.field_id);
     auto field_offset = schema_->get_offset(field_id);
+    if (!schema_->operator[](field_offset).is_vector()) {
+        load_scalar_index(info);
+        return;
+    }
 
     Assert(info.index_params.count("metric_type"));
     auto metric_type_str = info.index_params.at("metric_type");
@@ -51,6 +55,22 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     lck.unlock();
 }
 
+void
+SegmentSealedImpl::load_scalar_index(const LoadIndexInfo& info) {
+    auto field_offset = schema_->get_offset(FieldId(info.field_id));
+    auto& field_meta = schema_->operator[](field_offset);
+    Assert(info.index_params.count("index_type"));
+    auto index = query::create_scalar_index(info.index_params.at("index_type"), field_meta.get_data_type());
+    index->Load(info.scalar_index_binary);
+    auto row_count = index->Size();
+    Assert(row_count > 0);
+
+    std::unique_lock lck(mutex_);
+    update_row_count(row_count);
+    // replace the index generated when loading raw data
+    scalar_indexings_[field_offset.get()] = std::move(index);
+}
+
 void
 SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     // NOTE: lock only when data is ready to avoid starvation
@@ -127,7 +147,7 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
 
         // generate scalar index
         std::unique_ptr<knowhere::Index> index;
-        if (!field_meta.is_vector()) {
+        if (!field_meta.is_vector() && !field_meta.is_json() && !field_meta.is_array()) {
             index = query::generate_scalar_index(span, field_meta.get_data_type());
         }
 
@@ -145,9 +165,11 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
             AssertInfo(!vecindexs_.is_ready(field_offset), "field data can't be loaded when indexing exists");
             field_datas_[field_offset.get()] = std::move(vec_data);
         } else {
-            AssertInfo(!scalar_indexings_[field_offset.get()], "scalar indexing not cleared");
             field_datas_[field_offset.get()] = std::move(vec_data);
-            scalar_indexings_[field_offset.get()] = std::move(index);
+            // keep the scalar index loaded from index files
+            if (!scalar_indexings_[field_offset.get()]) {
+                scalar_indexings_[field_offset.get()] = std::move(index);
+            }
         }
 
         if (schema_->get_primary_key_offset() == field_offset) {
@@ -224,7 +246,9 @@ SegmentSealedImpl::vector_search(int64_t vec_count,
     auto& field_meta = schema_->operator[](field_offset);
 
     Assert(field_meta.is_vector());
-    if (get_bit(vecindex_ready_bitset_, field_offset)) {
+    // brute force needs the raw vectors, which are not loaded for most indexed fields
+    auto brute_force = search_info.brute_force_ && get_bit(field_data_ready_bitset_, field_offset);
+    if (get_bit(vecindex_ready_bitset_, field_offset) && !brute_force) {
         Assert(vecindexs_.is_ready(field_offset));
         query::SearchOnSealed(*schema_, vecindexs_, search_info, query_data, query_count, bitset, output);
         return;
@@ -293,7 +317,18 @@ SegmentSealedImpl::DropIndex(const FieldId field_id) {
     Assert(!SystemProperty::Instance().IsSystem(field_id));
     auto field_offset = schema_->get_offset(field_id);
     auto& field_meta = schema_->operator[](field_offset);
-    Assert(field_meta.is_vector());
+    if (!field_meta.is_vector()) {
+        // fall back to the index generated from raw data
+        std::unique_lock lck(mutex_);
+        std::unique_ptr<knowhere::Index> index;
+        if (get_bit(field_data_ready_bitset_, field_offset) && !field_meta.is_json() && !field_meta.is_array()) {
+            auto span = SpanBase(field_datas_[field_offset.get()].data(), row_count_opt_.value(),
+                                 field_meta.get_sizeof());
+            index = query::generate_scalar_index(span, field_meta.get_data_type());
+        }
+        scalar_indexings_[field_offset.get()] = std::move(index);
+        return;
+    }
 
     std::unique_lock lck(mutex_);
     vecindexs_.drop_field_indexing(field_offset);
@@ -410,6 +445,8 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
             break;
         }
 
+        case DataType::ARRAY:
+        case DataType::JSON:
         case DataType::VECTOR_FLOAT:
         case DataType::VECTOR_BINARY: {
             bulk_subscript_impl(field_meta.get_sizeof(), src_vec, seg_offsets, count, output);
@@ -97,6 +97,9 @@ class SegmentSealedImpl : public SegmentSealed {
     bulk_subscript_impl(
         int64_t element_sizeof, const void* src_raw, const int64_t* seg_offsets, int64_t count, void* dst_raw);
 
+    void
+    load_scalar_index(const LoadIndexInfo& info);
+
     void
     update_row_count(int64_t row_count) {
         if (row_count_opt_.has_value()) {
@@ -13,6 +13,7 @@
 #include "index/knowhere/knowhere/index/vector_index/VecIndexFactory.h"
 #include "segcore/load_index_c.h"
 #include "common/LoadInfo.h"
+#include "query/ScalarIndex.h"
 #include "exceptions/EasyAssert.h"
 
 CStatus
@@ -85,6 +86,18 @@ AppendIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
         bool find_index_type = index_params.count("index_type") > 0 ? true : false;
         bool find_index_mode = index_params.count("index_mode") > 0 ? true : false;
         Assert(find_index_type == true);
+        if (milvus::query::is_scalar_index_type(index_params["index_type"])) {
+            // binary_set doesn't own the memory, copy it before it's released by the caller
+            for (auto& [key, binary] : binary_set->binary_map_) {
+                std::shared_ptr<uint8_t[]> data(new uint8_t[binary->size]);
+                memcpy(data.get(), binary->data.get(), binary->size);
+                load_index_info->scalar_index_binary.Append(key, data, binary->size);
+            }
+            auto status = CStatus();
+            status.error_code = Success;
+            status.error_msg = "";
+            return status;
+        }
         milvus::knowhere::IndexMode mode;
         if (find_index_mode) {
             mode = index_params["index_mode"] == "CPU" ? milvus::knowhere::IndexMode::MODE_CPU
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <vector>
+#include <unordered_set>
 #include <exceptions/EasyAssert.h>
 #include "segcore/reduce_c.h"
 
@@ -59,22 +60,36 @@ GetResultData(std::vector<std::vector<int64_t>>& search_records,
     auto num_segments = search_results.size();
     AssertInfo(num_segments > 0, "num segment must greater than 0");
     std::vector<SearchResultPair> result_pairs;
+    bool is_group_by = false;
     for (int j = 0; j < num_segments; ++j) {
         auto distance = search_results[j]->result_distances_[query_offset];
         auto search_result = search_results[j];
         AssertInfo(search_result != nullptr, "search result must not equal to nullptr");
-        result_pairs.push_back(SearchResultPair(distance, search_result, query_offset, j));
+        result_pairs.push_back(SearchResultPair(distance, search_result, query_offset, j, query_offset + topk));
+        is_group_by = is_group_by || !search_result->group_by_values_.empty();
     }
     int64_t loc_offset = query_offset;
     AssertInfo(topk > 0, "topk must greater than 0");
-    for (int i = 0; i < topk; ++i) {
+    // for group by, a hit is skipped if a better hit of the same group is selected from another segment
+    std::unordered_set<int64_t> selected_groups;
+    for (int i = 0; i < topk;) {
         result_pairs[0].reset_distance();
         std::sort(result_pairs.begin(), result_pairs.end(), std::greater<>());
         auto& result_pair = result_pairs[0];
+        AssertInfo(!result_pair.is_exhausted(), "no more hits to reduce");
+        auto search_result = result_pair.search_result_;
+        if (is_group_by && search_result->internal_seg_offsets_[result_pair.offset_] != -1) {
+            auto group_by_value = search_result->group_by_values_[result_pair.offset_];
+            if (!selected_groups.insert(group_by_value).second) {
+                result_pair.offset_++;
+                continue;
+            }
+        }
         auto index = result_pair.index_;
         is_selected[index] = true;
-        result_pair.search_result_->result_offsets_.push_back(loc_offset++);
+        search_result->result_offsets_.push_back(loc_offset++);
         search_records[index].push_back(result_pair.offset_++);
+        ++i;
     }
 }
 
@@ -93,6 +108,8 @@ ResetSearchResult(std::vector<std::vector<int64_t>>& search_records,
 
         std::vector<float> result_distances;
         std::vector<int64_t> internal_seg_offsets;
+        std::vector<int64_t> group_by_values;
+        auto is_group_by = !search_result->group_by_values_.empty();
 
         for (int j = 0; j < search_records[i].size(); j++) {
             auto& offset = search_records[i][j];
@@ -100,10 +117,14 @@ ResetSearchResult(std::vector<std::vector<int64_t>>& search_records,
             auto internal_seg_offset = search_result->internal_seg_offsets_[offset];
             result_distances.push_back(distance);
             internal_seg_offsets.push_back(internal_seg_offset);
+            if (is_group_by) {
+                group_by_values.push_back(search_result->group_by_values_[offset]);
+            }
         }
 
         search_result->result_distances_ = result_distances;
         search_result->internal_seg_offsets_ = internal_seg_offsets;
+        search_result->group_by_values_ = group_by_values;
     }
 }
 
@@ -158,6 +179,7 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits,
         std::vector<float> result_distances(total_num_queries * topk);
         std::vector<int64_t> result_ids(total_num_queries * topk);
         std::vector<std::vector<char>> row_datas(total_num_queries * topk);
+        std::vector<int64_t> group_by_values;
         std::vector<char> temp_ids;
 
         std::vector<int64_t> counts(num_segments);
@@ -168,12 +190,18 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits,
             auto search_result = (SearchResult*)c_search_results[i];
             AssertInfo(search_result != nullptr, "search result must not equal to nullptr");
             auto size = search_result->result_offsets_.size();
+            if (!search_result->group_by_values_.empty()) {
+                group_by_values.resize(total_num_queries * topk);
+            }
 #pragma omp parallel for
             for (int j = 0; j < size; j++) {
                 auto loc = search_result->result_offsets_[j];
                 result_distances[loc] = search_result->result_distances_[j];
                 row_datas[loc] = search_result->row_data_[j];
                 memcpy(&result_ids[loc], search_result->row_data_[j].data(), sizeof(int64_t));
+                if (!search_result->group_by_values_.empty()) {
+                    group_by_values[loc] = search_result->group_by_values_[j];
+                }
             }
             counts[i] = size;
         }
@@ -199,6 +227,9 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits,
                     hits[m].add_scores(result_distances[result_offset]);
                     auto& row_data = row_datas[result_offset];
                     hits[m].add_row_data(row_data.data(), row_data.size());
+                    if (!group_by_values.empty()) {
+                        hits[m].add_group_by_values(group_by_values[result_offset]);
+                    }
                 }
             }
             last_offset = last_offset + num_queries_peer_group[i] * topk;
@@ -259,6 +290,9 @@ ReorganizeSingleSearchResult(CMarshaledHits* c_marshaled_hits,
                     int64_t result_id;
                     memcpy(&result_id, row_data.data(), sizeof(int64_t));
                     hits[m].add_ids(result_id);
+                    if (!search_result->group_by_values_.empty()) {
+                        hits[m].add_group_by_values(search_result->group_by_values_[result_offset]);
+                    }
                 }
             }
             last_offset = last_offset + num_queries_peer_group[i] * topk;
@@ -23,6 +23,8 @@
 #include <regex>
 #include <boost/format.hpp>
 #include "segcore/SegmentGrowingImpl.h"
+#include "query/PlanProto.h"
+#include <google/protobuf/text_format.h>
 using namespace milvus;
 
 TEST(Expr, Naive) {
@@ -583,3 +585,406 @@ TEST(Expr, TestCompare) {
         }
     }
 }
+
+TEST(Expr, TestBinaryArithOpEvalRange) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    // field, arith_op, right_operand, op, value, reference
+    std::vector<std::tuple<std::string, std::string, std::string, std::string, std::string,
+                           std::function<bool(int, int64_t, double)>>>
+        testcases = {
+            {"age32", "Add", "int64_val: 10", "Equal", "int64_val: 42",
+             [](int a, int64_t b, double c) { return a + 10 == 42; }},
+            {"age32", "Sub", "int64_val: 10", "LessThan", "int64_val: 0",
+             [](int a, int64_t b, double c) { return a - 10 < 0; }},
+            {"age64", "Mul", "int64_val: 2", "GreaterEqual", "int64_val: 100",
+             [](int a, int64_t b, double c) { return b * 2 >= 100; }},
+            {"age64", "Div", "int64_val: 3", "NotEqual", "int64_val: 5",
+             [](int a, int64_t b, double c) { return b / 3 != 5; }},
+            {"age64", "Mod", "int64_val: 7", "Equal", "int64_val: 2",
+             [](int a, int64_t b, double c) { return b % 7 == 2; }},
+            {"price", "Mul", "float_val: 0.9", "LessThan", "float_val: 0.5",
+             [](int a, int64_t b, double c) { return c * 0.9 < 0.5; }},
+            {"price", "Div", "float_val: 2", "GreaterThan", "float_val: 0.1",
+             [](int a, int64_t b, double c) { return c / 2 > 0.1; }},
+        };
+
+    std::string proto_tpl = R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    binary_arith_op_eval_range_expr: <
+      column_info: <
+        field_id: %2%
+        data_type: %3%
+      >
+      arith_op: %4%
+      right_operand: <
+        %5%
+      >
+      op: %6%
+      value: <
+        %7%
+      >
+    >
+  >
+  query_info: <
+    topk: 10
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)";
+    auto schema = std::make_shared<Schema>();
+    auto vec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    std::map<std::string, std::pair<FieldId, std::string>> fields = {
+        {"age32", {schema->AddDebugField("age32", DataType::INT32), "Int32"}},
+        {"age64", {schema->AddDebugField("age64", DataType::INT64), "Int64"}},
+        {"price", {schema->AddDebugField("price", DataType::DOUBLE), "Double"}},
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 10000;
+    std::vector<int> age32_col;
+    std::vector<int64_t> age64_col;
+    std::vector<double> price_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age32_col = raw_data.get_col<int>(1);
+        auto new_age64_col = raw_data.get_col<int64_t>(2);
+        auto new_price_col = raw_data.get_col<double>(3);
+        age32_col.insert(age32_col.end(), new_age32_col.begin(), new_age32_col.end());
+        age64_col.insert(age64_col.end(), new_age64_col.begin(), new_age64_col.end());
+        price_col.insert(price_col.end(), new_price_col.begin(), new_price_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [field, arith_op, right_operand, op, value, ref_func] : testcases) {
+        auto [field_id, data_type] = fields.at(field);
+        auto proto_text = boost::str(boost::format(proto_tpl) % vec_id.get() % field_id.get() % data_type % arith_op %
+                                     right_operand % op % value);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto));
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ref = ref_func(age32_col[i], age64_col[i], price_col[i]);
+            ASSERT_EQ(final[i], ref) << field << " " << arith_op << " " << op << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestJsonUnaryRange) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    // nested_path, op, value, reference
+    std::vector<std::tuple<std::string, std::string, std::string, std::function<bool(const json&)>>> testcases = {
+        {"color", "Equal", R"(string_val: "red")", [](const json& v) { return v["color"] == "red"; }},
+        {"color", "NotEqual", R"(string_val: "red")", [](const json& v) { return v["color"] != "red"; }},
+        {"size", "GreaterThan", "int64_val: 3", [](const json& v) { return v["size"] > 3; }},
+        {"size", "LessEqual", "float_val: 4.5", [](const json& v) { return v["size"] <= 4.5; }},
+        // values of different types never match
+        {"color", "GreaterThan", "int64_val: 3", [](const json& v) { return false; }},
+        {"weight", "Equal", "int64_val: 3", [](const json& v) { return false; }},
+    };
+
+    std::string proto_tpl = R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    unary_range_expr: <
+      column_info: <
+        field_id: %2%
+        data_type: JSON
+        nested_path: "%3%"
+      >
+      op: %4%
+      value: <
+        %5%
+      >
+    >
+  >
+  query_info: <
+    topk: 10
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)";
+    int64_t max_length = 64;
+    auto schema = std::make_shared<Schema>();
+    auto vec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto meta_id = schema->AddDebugField("meta", DataType::JSON, max_length);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<json> meta_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_meta_col = raw_data.get_col<char>(1);
+        for (int i = 0; i < N; ++i) {
+            auto slot = new_meta_col.data() + i * max_length;
+            meta_col.push_back(json::parse(std::string(slot, strnlen(slot, max_length))));
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [nested_path, op, value, ref_func] : testcases) {
+        auto proto_text =
+            boost::str(boost::format(proto_tpl) % vec_id.get() % meta_id.get() % nested_path % op % value);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto));
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ref = ref_func(meta_col[i]);
+            ASSERT_EQ(final[i], ref) << nested_path << " " << op << " " << value << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestArrayContains) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    // expr, reference
+    std::vector<std::tuple<std::string, std::function<bool(const json&)>>> testcases = {
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      op: Contains
+      elements: < string_val: "tag1" >
+    >)",
+         [](const json& v) { return std::find(v.begin(), v.end(), "tag1") != v.end(); }},
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      op: ContainsAll
+      elements: < string_val: "tag1" >
+      elements: < string_val: "tag2" >
+    >)",
+         [](const json& v) {
+             return std::find(v.begin(), v.end(), "tag1") != v.end() &&
+                    std::find(v.begin(), v.end(), "tag2") != v.end();
+         }},
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      op: ContainsAny
+      elements: < string_val: "tag1" >
+      elements: < string_val: "tag2" >
+    >)",
+         [](const json& v) {
+             return std::find(v.begin(), v.end(), "tag1") != v.end() ||
+                    std::find(v.begin(), v.end(), "tag2") != v.end();
+         }},
+        // elements of different types never match
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      op: Contains
+      elements: < int64_val: 1 >
+    >)",
+         [](const json& v) { return false; }},
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      arith_op: ArrayLength
+      op: GreaterThan
+      value: < int64_val: 2 >
+    >)",
+         [](const json& v) { return v.size() > 2; }},
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: Array >
+      arith_op: ArrayLength
+      op: Equal
+      value: < int64_val: 0 >
+    >)",
+         [](const json& v) { return v.empty(); }},
+    };
+
+    std::string proto_tpl = R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    %3%
+  >
+  query_info: <
+    topk: 10
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)";
+    int64_t max_length = 64;
+    auto schema = std::make_shared<Schema>();
+    auto vec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto tags_id = schema->AddDebugField("tags", DataType::ARRAY, DataType::STRING, max_length);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<json> tags_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_tags_col = raw_data.get_col<char>(1);
+        for (int i = 0; i < N; ++i) {
+            auto slot = new_tags_col.data() + i * max_length;
+            tags_col.push_back(json::parse(std::string(slot, strnlen(slot, max_length))));
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [expr_tpl, ref_func] : testcases) {
+        auto expr_text = boost::str(boost::format(expr_tpl) % vec_id.get() % tags_id.get());
+        auto proto_text = boost::str(boost::format(proto_tpl) % vec_id.get() % tags_id.get() % expr_text);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto));
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ref = ref_func(tags_col[i]);
+            ASSERT_EQ(final[i], ref) << expr_text << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestJsonArithAndArrayContains) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    // expr, reference
+    std::vector<std::tuple<std::string, std::function<bool(const json&)>>> testcases = {
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "size" >
+      arith_op: Add
+      right_operand: < int64_val: 2 >
+      op: GreaterThan
+      value: < int64_val: 8 >
+    >)",
+         [](const json& v) { return v["size"].get<int64_t>() + 2 > 8; }},
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "size" >
+      arith_op: Mod
+      right_operand: < int64_val: 3 >
+      op: Equal
+      value: < int64_val: 1 >
+    >)",
+         [](const json& v) { return v["size"].get<int64_t>() % 3 == 1; }},
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "size" >
+      arith_op: Mul
+      right_operand: < float_val: 1.5 >
+      op: LessEqual
+      value: < float_val: 6 >
+    >)",
+         [](const json& v) { return v["size"].get<double>() * 1.5 <= 6; }},
+        // values that aren't numbers never match
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "color" >
+      arith_op: Add
+      right_operand: < int64_val: 1 >
+      op: NotEqual
+      value: < int64_val: 0 >
+    >)",
+         [](const json& v) { return false; }},
+        {R"(binary_arith_op_eval_range_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "tags" >
+      arith_op: ArrayLength
+      op: GreaterEqual
+      value: < int64_val: 2 >
+    >)",
+         [](const json& v) { return v["tags"].size() >= 2; }},
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "tags" >
+      op: Contains
+      elements: < string_val: "tag1" >
+    >)",
+         [](const json& v) {
+             auto& tags = v["tags"];
+             return std::find(tags.begin(), tags.end(), "tag1") != tags.end();
+         }},
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "tags" >
+      op: ContainsAll
+      elements: < string_val: "tag1" >
+      elements: < string_val: "tag2" >
+    >)",
+         [](const json& v) {
+             auto& tags = v["tags"];
+             return std::find(tags.begin(), tags.end(), "tag1") != tags.end() &&
+                    std::find(tags.begin(), tags.end(), "tag2") != tags.end();
+         }},
+        // values that aren't arrays never match
+        {R"(array_contains_expr: <
+      column_info: < field_id: %2% data_type: JSON nested_path: "color" >
+      op: Contains
+      elements: < string_val: "red" >
+    >)",
+         [](const json& v) { return false; }},
+    };
+
+    std::string proto_tpl = R"(
+vector_anns: <
+  field_id: %1%
+  predicates: <
+    %3%
+  >
+  query_info: <
+    topk: 10
+    metric_type: "L2"
+    search_params: "{\"nprobe\": 10}"
+  >
+  placeholder_tag: "$0"
+>
+)";
+    int64_t max_length = 64;
+    auto schema = std::make_shared<Schema>();
+    auto vec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto meta_id = schema->AddDebugField("meta", DataType::JSON, max_length);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<json> meta_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_meta_col = raw_data.get_col<char>(1);
+        for (int i = 0; i < N; ++i) {
+            auto slot = new_meta_col.data() + i * max_length;
+            meta_col.push_back(json::parse(std::string(slot, strnlen(slot, max_length))));
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [expr_tpl, ref_func] : testcases) {
+        auto expr_text = boost::str(boost::format(expr_tpl) % vec_id.get() % meta_id.get());
+        auto proto_text = boost::str(boost::format(proto_tpl) % vec_id.get() % meta_id.get() % expr_text);
+        proto::plan::PlanNode node_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &node_proto));
+        auto plan = ProtoParser(*schema).CreatePlan(node_proto);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ref = ref_func(meta_col[i]);
+            ASSERT_EQ(final[i], ref) << expr_text << "@" << i;
+        }
+    }
+}
@@ -11,6 +11,7 @@
 
 #include <gtest/gtest.h>
 #include "segcore/ReduceStructure.h"
+#include "segcore/reduce_c.h"
 
 TEST(SearchResultPair, Less) {
     auto pair1 = SearchResultPair(1.0, nullptr, 0, 0);
@@ -105,3 +106,45 @@ TEST(SearchResultPair, Greater) {
     ASSERT_EQ(pair1 > pair2, true);
     ASSERT_EQ(pair1.operator>(pair2), true);
 }
+
+TEST(SearchResultPair, Exhausted) {
+    milvus::SearchResult result(1, 2);
+    result.result_distances_ = {2.0, 1.0};
+    auto pair = SearchResultPair(2.0, &result, 0, 0, 2);
+    pair.offset_ = 1;
+    pair.reset_distance();
+    ASSERT_EQ(pair.is_exhausted(), false);
+    ASSERT_EQ(pair.distance_, 1.0);
+
+    pair.offset_ = 2;
+    pair.reset_distance();
+    ASSERT_EQ(pair.is_exhausted(), true);
+    ASSERT_EQ(pair.distance_, -std::numeric_limits<float>::infinity());
+}
+
+TEST(ReduceSearchResults, GroupBy) {
+    milvus::SearchResult result1(1, 3);
+    result1.result_distances_ = {-1.0, -2.0, -3.0};
+    result1.internal_seg_offsets_ = {0, 1, 2};
+    result1.group_by_values_ = {1, 2, 3};
+
+    milvus::SearchResult result2(1, 3);
+    result2.result_distances_ = {-1.5, -2.5, -std::numeric_limits<float>::max()};
+    result2.internal_seg_offsets_ = {0, 1, -1};
+    result2.group_by_values_ = {1, 4, 0};
+
+    CSearchResult results[] = {&result1, &result2};
+    bool is_selected[] = {false, false};
+    auto status = ReduceSearchResults(results, 2, is_selected);
+    ASSERT_EQ(status.error_code, Success);
+    ASSERT_TRUE(is_selected[0]);
+    ASSERT_TRUE(is_selected[1]);
+
+    // the hit of group 1 in result2 is worse than the one in result1
+    ASSERT_EQ(result1.result_offsets_, std::vector<int64_t>({0, 1}));
+    ASSERT_EQ(result1.internal_seg_offsets_, std::vector<int64_t>({0, 1}));
+    ASSERT_EQ(result1.group_by_values_, std::vector<int64_t>({1, 2}));
+    ASSERT_EQ(result2.result_offsets_, std::vector<int64_t>({2}));
+    ASSERT_EQ(result2.internal_seg_offsets_, std::vector<int64_t>({1}));
+    ASSERT_EQ(result2.group_by_values_, std::vector<int64_t>({4}));
+}
@@ -19,6 +19,9 @@
 #include <knowhere/index/vector_index/VecIndexFactory.h>
 #include <knowhere/index/vector_index/IndexIVF.h>
 #include "segcore/SegmentSealedImpl.h"
+#include "query/ScalarIndex.h"
+#include "query/PlanImpl.h"
+#include <set>
 
 using namespace milvus;
 using namespace milvus::segcore;
@@ -340,3 +343,136 @@ TEST(Sealed, LoadFieldData) {
 ])");
     ASSERT_EQ(std_json.dump(-2), json.dump(-2));
 }
+
+TEST(Sealed, LoadScalarIndex) {
+    auto dim = 16;
+    int64_t N = 1000;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+
+    auto dataset = DataGen(schema, N);
+    auto counter = dataset.get_col<int64_t>(1);
+
+    auto segment = CreateSealedSegment(schema);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "range": {
+                    "counter": {
+                        "GE": 100,
+                        "LT": 300
+                    }
+                }
+            },
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+
+    SealedLoader(dataset, *segment);
+    auto ref = SearchResultToJson(segment->Search(plan.get(), *ph_group, time));
+
+    for (auto index_type : {query::SCALAR_INDEX_SORTED, query::SCALAR_INDEX_INVERTED}) {
+        auto index = query::build_scalar_index(index_type, Span<int64_t>(counter.data(), N));
+
+        LoadIndexInfo info;
+        info.field_id = counter_id.get();
+        info.index_params["index_type"] = index_type;
+        info.scalar_index_binary = index->Serialize(knowhere::Config());
+        segment->LoadIndex(info);
+        auto json = SearchResultToJson(segment->Search(plan.get(), *ph_group, time));
+        ASSERT_EQ(ref.dump(-2), json.dump(-2));
+
+        segment->DropIndex(counter_id);
+        json = SearchResultToJson(segment->Search(plan.get(), *ph_group, time));
+        ASSERT_EQ(ref.dump(-2), json.dump(-2));
+    }
+}
+
+TEST(Sealed, GroupBy) {
+    auto dim = 16;
+    auto topK = 5;
+    int64_t N = 10000;
+    auto num_groups = 7;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto group_id = schema->AddDebugField("group", DataType::INT32);
+
+    auto dataset = DataGen(schema, N);
+    auto group = dataset.get_mutable_col<int32_t>(1);
+    for (int64_t i = 0; i < N; ++i) {
+        group[i] = i % num_groups;
+    }
+
+    auto segment = CreateSealedSegment(schema);
+    std::string dsl = R"({
+        "bool": {
+            "must": [
+            {
+                "vector": {
+                    "fakevec": {
+                        "metric_type": "L2",
+                        "params": {
+                            "nprobe": 10
+                        },
+                        "query": "$0",
+                        "topk": 5
+                    }
+                }
+            }
+            ]
+        }
+    })";
+
+    Timestamp time = 1000000;
+    auto plan = CreatePlan(*schema, dsl);
+    auto num_queries = 5;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, 16, 1024);
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+
+    SealedLoader(dataset, *segment);
+    auto ref = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_TRUE(ref.group_by_values_.empty());
+
+    plan->plan_node_->search_info_.group_by_field_offset_ = schema->get_offset(group_id);
+    auto sr = segment->Search(plan.get(), *ph_group, time);
+    ASSERT_EQ(sr.topk_, topK);
+    ASSERT_EQ(sr.group_by_values_.size(), num_queries * topK);
+    for (int q = 0; q < num_queries; ++q) {
+        std::set<int64_t> groups;
+        for (int k = 0; k < topK; ++k) {
+            auto offset = q * topK + k;
+            auto seg_offset = sr.internal_seg_offsets_[offset];
+            ASSERT_NE(seg_offset, -1);
+            ASSERT_EQ(sr.group_by_values_[offset], group[seg_offset]);
+            groups.insert(sr.group_by_values_[offset]);
+            if (k > 0) {
+                ASSERT_LE(sr.result_distances_[offset - 1], sr.result_distances_[offset]);
+            }
+        }
+        ASSERT_EQ(groups.size(), topK);
+        // the best hit is kept whatever its group is
+        ASSERT_EQ(sr.internal_seg_offsets_[q * topK], ref.internal_seg_offsets_[q * topK]);
+    }
+}
@@ -19,6 +19,7 @@
 #include "Constants.h"
 #include <boost/algorithm/string/predicate.hpp>
 #include "segcore/SegmentSealed.h"
+#include "utils/Json.h"
 
 #include <knowhere/index/vector_index/VecIndex.h>
 #include <knowhere/index/vector_index/adapter/VectorAdapter.h>
@@ -179,6 +180,47 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42) {
                 insert_cols(data);
                 break;
             }
+            case engine::DataType::JSON: {
+                // NUL-padded to the max length, like the rows built by proxy
+                auto max_length = field.get_max_length();
+                vector<char> data(max_length * N, 0);
+                const char* colors[] = {"red", "green", "blue"};
+                for (int n = 0; n < N; ++n) {
+                    auto tags = json::array();
+                    auto num_tags = er() % 4;
+                    for (int i = 0; i < num_tags; ++i) {
+                        tags.push_back("tag" + std::to_string(er() % 10));
+                    }
+                    auto value = json{{"color", colors[er() % 3]}, {"size", er() % 10}, {"tags", tags}}.dump();
+                    Assert(value.size() <= max_length);
+                    memcpy(data.data() + n * max_length, value.data(), value.size());
+                }
+                insert_cols(data);
+                break;
+            }
+            case engine::DataType::ARRAY: {
+                // arrays of up to 4 elements, encoded as json arrays
+                auto max_length = field.get_max_length();
+                vector<char> data(max_length * N, 0);
+                for (int n = 0; n < N; ++n) {
+                    auto elements = json::array();
+                    auto length = er() % 5;
+                    for (int i = 0; i < length; ++i) {
+                        if (field.get_element_type() == engine::DataType::STRING) {
+                            elements.push_back("tag" + std::to_string(er() % 10));
+                        } else if (datatype_is_interger(field.get_element_type())) {
+                            elements.push_back(er() % 10);
+                        } else {
+                            throw std::runtime_error("unimplemented");
+                        }
+                    }
+                    auto value = elements.dump();
+                    Assert(value.size() <= max_length);
+                    memcpy(data.data() + n * max_length, value.data(), value.size());
+                }
+                insert_cols(data);
+                break;
+            }
             default: {
                 throw std::runtime_error("unimplemented");
             }
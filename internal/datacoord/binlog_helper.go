@@ -56,6 +56,16 @@ func (s *Server) SaveBinLogMetaTxn(meta map[string]string) error {
 // prepareField2PathMeta parses fields2Paths ID2PathList
 //		into key-value for kv store
 func (s *Server) prepareField2PathMeta(segID UniqueID, field2Paths *datapb.ID2PathList) (result map[string]string, err error) {
+	return s.prepareField2PathMetaWithPrefix(Params.SegmentBinlogSubPath, segID, field2Paths)
+}
+
+// prepareField2StatslogMeta parses fields2Paths ID2PathList of stats binlogs
+//		into key-value for kv store
+func (s *Server) prepareField2StatslogMeta(segID UniqueID, field2Paths *datapb.ID2PathList) (result map[string]string, err error) {
+	return s.prepareField2PathMetaWithPrefix(Params.SegmentStatslogSubPath, segID, field2Paths)
+}
+
+func (s *Server) prepareField2PathMetaWithPrefix(metaPrefix string, segID UniqueID, field2Paths *datapb.ID2PathList) (result map[string]string, err error) {
 	if field2Paths == nil {
 		return nil, errNilID2Paths
 	}
@@ -72,7 +82,7 @@ func (s *Server) prepareField2PathMeta(segID UniqueID, field2Paths *datapb.ID2Pa
 			BinlogPath: p,
 		})
 
-		result[path.Join(Params.SegmentBinlogSubPath, key)] = binlogPath
+		result[path.Join(metaPrefix, key)] = binlogPath
 	}
 	return result, err
 }
@@ -105,13 +115,21 @@ func (s *Server) getFieldBinlogMeta(segmentID UniqueID,
 
 // getSegmentBinlogMeta querys segment bin log meta from kv store
 func (s *Server) getSegmentBinlogMeta(segmentID UniqueID) (metas []*datapb.SegmentFieldBinlogMeta, err error) {
+	return s.getSegmentMetaWithPrefix(Params.SegmentBinlogSubPath, segmentID)
+}
+
+// getSegmentStatslogMeta querys segment stats binlog meta from kv store
+func (s *Server) getSegmentStatslogMeta(segmentID UniqueID) (metas []*datapb.SegmentFieldBinlogMeta, err error) {
+	return s.getSegmentMetaWithPrefix(Params.SegmentStatslogSubPath, segmentID)
+}
 
+func (s *Server) getSegmentMetaWithPrefix(metaPrefix string, segmentID UniqueID) (metas []*datapb.SegmentFieldBinlogMeta, err error) {
 	prefix, err := s.genKey(false, segmentID)
 	if err != nil {
 		return nil, err
 	}
 
-	_, vs, err := s.kvClient.LoadWithPrefix(path.Join(Params.SegmentBinlogSubPath, prefix))
+	_, vs, err := s.kvClient.LoadWithPrefix(path.Join(metaPrefix, prefix))
 	if err != nil {
 		return nil, err
 	}
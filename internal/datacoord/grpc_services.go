@@ -326,6 +326,8 @@ func (s *Server) GetComponentStates(ctx context.Context) (*internalpb.ComponentS
 		resp.State.StateCode = internalpb.StateCode_Initializing
 	case ServerStateHealthy:
 		resp.State.StateCode = internalpb.StateCode_Healthy
+	case ServerStateStandBy:
+		resp.State.StateCode = internalpb.StateCode_StandBy
 	default:
 		resp.State.StateCode = internalpb.StateCode_Abnormal
 	}
@@ -349,6 +351,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	}
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2NumOfRows := make(map[UniqueID]int64)
+	segment2Statslogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
 		if segment == nil {
@@ -379,6 +383,24 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			}
 			segment2Binlogs[id] = append(segment2Binlogs[id], fieldBinlogs)
 		}
+		segment2NumOfRows[id] = segment.NumOfRows
+
+		statsMeta, err := s.getSegmentStatslogMeta(id)
+		if err != nil {
+			log.Error("get segment statslog meta failed", zap.Int64("segmentID", id))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		field2Statslog := make(map[UniqueID][]string)
+		for _, m := range statsMeta {
+			field2Statslog[m.FieldID] = append(field2Statslog[m.FieldID], m.BinlogPath)
+		}
+		for f, paths := range field2Statslog {
+			segment2Statslogs[id] = append(segment2Statslogs[id], &datapb.FieldBinlog{
+				FieldID: f,
+				Binlogs: paths,
+			})
+		}
 	}
 
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
@@ -386,6 +408,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		sbl := &datapb.SegmentBinlogs{
 			SegmentID:    segmentID,
 			FieldBinlogs: fieldBinlogs,
+			NumOfRows:    segment2NumOfRows[segmentID],
+			Statslogs:    segment2Statslogs[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
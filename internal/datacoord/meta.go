@@ -189,7 +189,7 @@ func (m *meta) SaveBinlogAndCheckPoints(segID UniqueID, flushed bool,
 	for _, id := range modSegments {
 		if segment := m.segments.GetSegment(id); segment != nil {
 			segBytes := proto.MarshalTextString(segment.SegmentInfo)
-			key := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+			key := BuildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 			kv[key] = segBytes
 		}
 	}
@@ -304,12 +304,12 @@ func (m *meta) SetCurrentRows(segmentID UniqueID, rows int64) {
 func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 	segBytes := proto.MarshalTextString(segment.SegmentInfo)
 
-	key := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	key := BuildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 	return m.client.Save(key, segBytes)
 }
 
 func (m *meta) removeSegmentInfo(segment *SegmentInfo) error {
-	key := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+	key := BuildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 	return m.client.Remove(key)
 }
 
@@ -317,7 +317,8 @@ func (m *meta) saveKvTxn(kv map[string]string) error {
 	return m.client.MultiSave(kv)
 }
 
-func buildSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
+// BuildSegmentPath returns the key of the segment meta, relative to the meta root path
+func BuildSegmentPath(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID) string {
 	return fmt.Sprintf("%s/%d/%d/%d", segmentPrefix, collectionID, partitionID, segmentID)
 }
 
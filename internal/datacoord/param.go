@@ -10,6 +10,7 @@
 package datacoord
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
@@ -33,6 +34,7 @@ type ParamTable struct {
 	MetaRootPath            string
 	KvRootPath              string
 	SegmentBinlogSubPath    string
+	SegmentStatslogSubPath  string
 	CollectionBinlogSubPath string
 
 	// --- Pulsar ---
@@ -44,7 +46,8 @@ type ParamTable struct {
 	FlushStreamPosSubPath string
 	StatsStreamPosSubPath string
 
-	// segment
+	// segment, SegmentMaxSize and SegmentSealProportion are refreshable, read them with the getters
+	refreshLock             sync.RWMutex
 	SegmentMaxSize          float64
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
@@ -55,6 +58,8 @@ type ParamTable struct {
 	SegmentInfoChannelName    string
 	DataCoordSubscriptionName string
 
+	EnableActiveStandby bool
+
 	Log log.Config
 }
 
@@ -75,6 +80,7 @@ func (p *ParamTable) Init() {
 		p.initMetaRootPath()
 		p.initKvRootPath()
 		p.initSegmentBinlogSubPath()
+		p.initSegmentStatslogSubPath()
 		p.initCollectionBinlogSubPath()
 
 		p.initPulsarAddress()
@@ -92,6 +98,10 @@ func (p *ParamTable) Init() {
 
 		p.initFlushStreamPosSubPath()
 		p.initStatsStreamPosSubPath()
+
+		p.initEnableActiveStandby()
+
+		p.initRefreshers()
 	})
 }
 
@@ -151,6 +161,14 @@ func (p *ParamTable) initSegmentBinlogSubPath() {
 	p.SegmentBinlogSubPath = subPath
 }
 
+func (p *ParamTable) initSegmentStatslogSubPath() {
+	subPath, err := p.Load("etcd.segmentStatslogSubPath")
+	if err != nil {
+		panic(err)
+	}
+	p.SegmentStatslogSubPath = subPath
+}
+
 func (p *ParamTable) initCollectionBinlogSubPath() {
 	subPath, err := p.Load("etcd.collectionBinlogSubPath")
 	if err != nil {
@@ -167,6 +185,50 @@ func (p *ParamTable) initSegmentSealProportion() {
 	p.SegmentSealProportion = p.ParseFloat("datacoord.segment.sealProportion")
 }
 
+// GetSegmentMaxSize returns the max size of segment in MB
+func (p *ParamTable) GetSegmentMaxSize() float64 {
+	p.refreshLock.RLock()
+	defer p.refreshLock.RUnlock()
+	return p.SegmentMaxSize
+}
+
+// GetSegmentSealProportion returns the proportion of max rows at which a segment is sealed
+func (p *ParamTable) GetSegmentSealProportion() float64 {
+	p.refreshLock.RLock()
+	defer p.refreshLock.RUnlock()
+	return p.SegmentSealProportion
+}
+
+// initRefreshers makes the segment seal policies pick up the configs changed in etcd
+func (p *ParamTable) initRefreshers() {
+	p.RegisterRefresher("datacoord.segment.maxSize", func(value string) error {
+		size, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if size <= 0 {
+			return fmt.Errorf("segment max size should be positive, but got %f", size)
+		}
+		p.refreshLock.Lock()
+		defer p.refreshLock.Unlock()
+		p.SegmentMaxSize = size
+		return nil
+	})
+	p.RegisterRefresher("datacoord.segment.sealProportion", func(value string) error {
+		proportion, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if proportion <= 0 || proportion > 1 {
+			return fmt.Errorf("segment seal proportion should be in (0, 1], but got %f", proportion)
+		}
+		p.refreshLock.Lock()
+		defer p.refreshLock.Unlock()
+		p.SegmentSealProportion = proportion
+		return nil
+	})
+}
+
 func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64("datacoord.segment.assignmentExpiration")
 }
@@ -252,3 +314,14 @@ func (p *ParamTable) initStatsStreamPosSubPath() {
 	}
 	p.StatsStreamPosSubPath = subPath
 }
+
+func (p *ParamTable) initEnableActiveStandby() {
+	enable, err := p.Load("dataCoord.enableActiveStandby")
+	if err != nil {
+		panic(err)
+	}
+	p.EnableActiveStandby, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -26,7 +26,7 @@ func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
 	if err != nil {
 		return -1, err
 	}
-	threshold := Params.SegmentMaxSize * 1024 * 1024
+	threshold := Params.GetSegmentMaxSize() * 1024 * 1024
 	return int(threshold / float64(sizePerRecord)), nil
 }
 
@@ -121,7 +121,7 @@ func sortSegmentsByLastExpires(segs []*SegmentInfo) {
 }
 
 func sealPolicyV1(maxCount, writtenCount, allocatedCount int64) bool {
-	return float64(writtenCount) >= Params.SegmentSealProportion*float64(maxCount)
+	return float64(writtenCount) >= Params.GetSegmentSealProportion()*float64(maxCount)
 }
 
 type flushPolicy func(segment *SegmentInfo, t Timestamp) bool
@@ -2,6 +2,7 @@ package datacoord
 
 import (
 	"github.com/gogo/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -46,10 +47,17 @@ func (s *SegmentsInfo) GetSegments() []*SegmentInfo {
 }
 
 func (s *SegmentsInfo) DropSegment(segmentID UniqueID) {
-	delete(s.segments, segmentID)
+	if segment, ok := s.segments[segmentID]; ok {
+		metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String()).Dec()
+		delete(s.segments, segmentID)
+	}
 }
 
 func (s *SegmentsInfo) SetSegment(segmentID UniqueID, segment *SegmentInfo) {
+	if old, ok := s.segments[segmentID]; ok {
+		metrics.DataCoordNumSegments.WithLabelValues(old.GetState().String()).Dec()
+	}
+	metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String()).Inc()
 	s.segments[segmentID] = segment
 }
 
@@ -67,6 +75,8 @@ func (s *SegmentsInfo) SetLasteExpiraTime(segmentID UniqueID, expireTs Timestamp
 
 func (s *SegmentsInfo) SetState(segmentID UniqueID, state commonpb.SegmentState) {
 	if segment, ok := s.segments[segmentID]; ok {
+		metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String()).Dec()
+		metrics.DataCoordNumSegments.WithLabelValues(state.String()).Inc()
 		s.segments[segmentID] = s.ShadowClone(segment, SetState(state))
 	}
 }
@@ -13,12 +13,14 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -138,7 +140,10 @@ func defaultSealPolicy() sealPolicy {
 }
 
 func defaultSegmentSealPolicy() segmentSealPolicy {
-	return getSegmentCapacityPolicy(Params.SegmentSealProportion)
+	// read the proportion every time since it is refreshable
+	return func(segment *SegmentInfo, ts Timestamp) bool {
+		return getSegmentCapacityPolicy(Params.GetSegmentSealProportion())(segment, ts)
+	}
 }
 
 func defaultFlushPolicy() flushPolicy {
@@ -205,9 +210,10 @@ func (s *SegmentManager) putAllocation(a *Allocation) {
 func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	metrics.DataCoordAssignSegmentCounter.WithLabelValues("total").Inc()
 
 	// filter segments
 	segments := make([]*SegmentInfo, 0)
@@ -257,6 +263,8 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	}
 
 	allocations := append(newSegmentAllocations, existedSegmentAllocations...)
+	metrics.DataCoordAssignSegmentCounter.WithLabelValues("success").Inc()
+	metrics.DataCoordAllocatedRowsCounter.WithLabelValues(strconv.FormatInt(collectionID, 10)).Add(float64(requestRows))
 	return allocations, nil
 }
 
@@ -273,7 +281,7 @@ func (s *SegmentManager) genExpireTs() (Timestamp, error) {
 
 func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	id, err := s.allocator.allocID()
 	if err != nil {
 		return nil, err
@@ -324,7 +332,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 
 func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	for i, id := range s.segments {
@@ -345,7 +353,7 @@ func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 
 func (s *SegmentManager) SealAllSegments(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	ret := make([]UniqueID, 0)
@@ -375,7 +383,7 @@ func (s *SegmentManager) GetFlushableSegments(ctx context.Context, channel strin
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	if err := s.tryToSealSegment(t, channel); err != nil {
 		return nil, err
 	}
@@ -456,7 +464,7 @@ func (s *SegmentManager) tryToSealSegment(ts Timestamp, channel string) error {
 // only for test
 func (s *SegmentManager) SealSegment(ctx context.Context, segmentID UniqueID) error {
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if err := s.meta.SetState(segmentID, commonpb.SegmentState_Sealed); err != nil {
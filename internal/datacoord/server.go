@@ -12,6 +12,7 @@ package datacoord
 import (
 	"context"
 	"math/rand"
+	"path"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -56,6 +58,8 @@ const (
 	ServerStateInitializing ServerState = 1
 	// ServerStateHealthy state stands for healthy `Server` instance
 	ServerStateHealthy ServerState = 2
+	// ServerStateStandBy state stands for a standby `Server` instance waiting for the active one to quit
+	ServerStateStandBy ServerState = 3
 )
 
 type dataNodeCreatorFunc func(ctx context.Context, addr string) (types.DataNode, error)
@@ -125,13 +129,28 @@ func defaultRootCoordCreatorFunc(ctx context.Context, metaRootPath string, etcdE
 // Register register data service at etcd
 func (s *Server) Register() error {
 	s.session = sessionutil.NewSession(s.ctx, Params.MetaRootPath, Params.EtcdEndpoints)
+	s.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	s.activeCh = s.session.Init(typeutil.DataCoordRole, Params.IP, true)
 	Params.NodeID = s.session.ServerID
 	return nil
 }
 
-// Init change server state to Initializing
+// EtcdClient returns the etcd client of the DataCoord session, nil before it registers
+func (s *Server) EtcdClient() *clientv3.Client {
+	return s.session.EtcdClient()
+}
+
+// Init change server state to Initializing,
+// a standby server blocks here until the active one quits
 func (s *Server) Init() error {
+	if Params.EnableActiveStandby {
+		atomic.StoreInt64(&s.isServing, ServerStateStandBy)
+		log.Debug("dataCoord enter standby mode", zap.Int64("node id", s.session.ServerID))
+		if err := s.session.ProcessActiveStandBy(nil); err != nil {
+			return err
+		}
+		log.Debug("dataCoord switch from standby to active", zap.Int64("node id", s.session.ServerID))
+	}
 	atomic.StoreInt64(&s.isServing, ServerStateInitializing)
 	return nil
 }
@@ -153,6 +172,10 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+	configRoot := path.Join(Params.MetaRootPath, paramtable.ConfigSubPath)
+	if err = Params.WatchEtcdConfig(s.ctx, Params.EtcdEndpoints, configRoot); err != nil {
+		return err
+	}
 	if err = s.initRootCoordClient(); err != nil {
 		return err
 	}
@@ -567,5 +590,15 @@ func (s *Server) prepareBinlog(req *datapb.SaveBinlogPathsRequest) (map[string]s
 		}
 	}
 
+	for _, fieldStats := range req.Field2StatslogPaths {
+		fieldMeta, err := s.prepareField2StatslogMeta(req.SegmentID, fieldStats)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fieldMeta {
+			meta[k] = v
+		}
+	}
+
 	return meta, nil
 }
@@ -795,6 +795,14 @@ func TestGetRecoveryInfo(t *testing.T) {
 					},
 				},
 			},
+			Field2StatslogPaths: []*datapb.ID2PathList{
+				{
+					ID: 1,
+					Paths: []string{
+						"/stats_log/file1",
+					},
+				},
+			},
 		}
 		meta, err := svr.prepareBinlog(binlogReq)
 		assert.Nil(t, err)
@@ -813,6 +821,9 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetFieldBinlogs()))
 		assert.EqualValues(t, 1, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetFieldID())
 		assert.ElementsMatch(t, []string{"/binlog/file1", "/binlog/file2"}, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetBinlogs())
+		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetStatslogs()))
+		assert.EqualValues(t, 1, resp.GetBinlogs()[0].GetStatslogs()[0].GetFieldID())
+		assert.ElementsMatch(t, []string{"/stats_log/file1"}, resp.GetBinlogs()[0].GetStatslogs()[0].GetBinlogs())
 	})
 }
 
@@ -20,17 +20,20 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"path"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -49,6 +52,9 @@ const (
 
 	// MetricRequestsSuccess used to count the num of successful requests
 	MetricRequestsSuccess = "success"
+
+	// MetricRequestsFailed used to count the num of failed requests
+	MetricRequestsFailed = "fail"
 )
 
 // DataNode communicates with outside services and unioun all
@@ -146,6 +152,11 @@ func (node *DataNode) Register() error {
 	return nil
 }
 
+// EtcdClient returns the etcd client of the data node session, nil before it registers
+func (node *DataNode) EtcdClient() *clientv3.Client {
+	return node.session.EtcdClient()
+}
+
 // Init function do nothing now.
 func (node *DataNode) Init() error {
 	log.Debug("DataNode Init",
@@ -153,6 +164,11 @@ func (node *DataNode) Init() error {
 		zap.String("TimeTickChannelName", Params.TimeTickChannelName),
 	)
 
+	configRoot := path.Join(Params.MetaRootPath, paramtable.ConfigSubPath)
+	if err := Params.WatchEtcdConfig(node.ctx, Params.EtcdEndpoints, configRoot); err != nil {
+		log.Warn("DataNode watch etcd config failed", zap.Error(err))
+		return err
+	}
 	return nil
 }
 
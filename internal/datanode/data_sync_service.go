@@ -106,10 +106,14 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 
 	saveBinlog := func(fu *segmentFlushUnit) error {
 		id2path := []*datapb.ID2PathList{}
+		id2stats := []*datapb.ID2PathList{}
 		checkPoints := []*datapb.CheckPoint{}
 		for k, v := range fu.field2Path {
 			id2path = append(id2path, &datapb.ID2PathList{ID: k, Paths: []string{v}})
 		}
+		for k, v := range fu.field2Stats {
+			id2stats = append(id2stats, &datapb.ID2PathList{ID: k, Paths: []string{v}})
+		}
 		for k, v := range fu.checkPoint {
 			v := v
 			checkPoints = append(checkPoints, &datapb.CheckPoint{
@@ -131,12 +135,13 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 				Timestamp: 0, //TODO time stamp
 				SourceID:  Params.NodeID,
 			},
-			SegmentID:         fu.segID,
-			CollectionID:      fu.collID,
-			Field2BinlogPaths: id2path,
-			CheckPoints:       checkPoints,
-			StartPositions:    fu.startPositions,
-			Flushed:           fu.flushed,
+			SegmentID:           fu.segID,
+			CollectionID:        fu.collID,
+			Field2BinlogPaths:   id2path,
+			CheckPoints:         checkPoints,
+			StartPositions:      fu.startPositions,
+			Flushed:             fu.flushed,
+			Field2StatslogPaths: id2stats,
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
 		if err != nil {
@@ -23,7 +23,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 )
 
 type ddNode struct {
@@ -61,7 +60,7 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// TODO: add error handling
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range msMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -111,7 +110,7 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	var res Msg = &iMsg
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	return []Msg{res}
@@ -148,7 +147,7 @@ func (ddn *ddNode) isFlushed(segmentID UniqueID) bool {
 
 func newDDNode(clearSignal chan<- UniqueID, collID UniqueID, vchanInfo *datapb.VchannelInfo) *ddNode {
 	baseNode := BaseNode{}
-	baseNode.SetMaxParallelism(Params.FlowGraphMaxQueueLength)
+	baseNode.SetMaxParallelism(Params.GetFlowGraphMaxQueueLength())
 
 	si := make(map[UniqueID]*datapb.SegmentInfo)
 	for _, us := range vchanInfo.GetUnflushedSegments() {
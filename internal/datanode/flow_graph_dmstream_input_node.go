@@ -21,7 +21,7 @@ import (
 )
 
 func newDmInputNode(ctx context.Context, factory msgstream.Factory, pchannelName string, seekPos *internalpb.MsgPosition) *flowgraph.InputNode {
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 	consumeSubName := Params.MsgChannelSubName
 	insertStream, _ := factory.NewTtMsgStream(ctx)
@@ -26,11 +26,13 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -76,6 +78,7 @@ type segmentFlushUnit struct {
 	collID         UniqueID
 	segID          UniqueID
 	field2Path     map[UniqueID]string
+	field2Stats    map[UniqueID]string
 	checkPoint     map[UniqueID]segmentCheckPoint
 	startPositions []*datapb.SegmentStartPosition
 	flushed        bool
@@ -157,7 +160,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		return []Msg{}
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range iMsg.insertMessages {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -475,6 +478,52 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 				pos += int(unsafe.Sizeof(*(&v)))
 				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
+			case schemapb.DataType_JSON:
+				maxLength, err := typeutil.GetJSONMaxLength(field)
+				if err != nil {
+					log.Error("invalid json field", zap.Error(err))
+					// TODO: add error handling
+				}
+
+				if _, ok := idata.Data[field.FieldID]; !ok {
+					idata.Data[field.FieldID] = &storage.JSONFieldData{
+						NumRows: make([]int64, 0, 1),
+						Data:    make([][]byte, 0),
+					}
+				}
+
+				fieldData := idata.Data[field.FieldID].(*storage.JSONFieldData)
+				for _, blob := range msg.RowData {
+					v := typeutil.DecodeJSONSlot(blob.GetValue()[pos : pos+maxLength])
+					fieldData.Data = append(fieldData.Data, append([]byte(nil), v...))
+				}
+
+				pos += maxLength
+				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
+			case schemapb.DataType_Array:
+				maxLength, err := typeutil.GetJSONMaxLength(field)
+				if err != nil {
+					log.Error("invalid array field", zap.Error(err))
+					// TODO: add error handling
+				}
+
+				if _, ok := idata.Data[field.FieldID]; !ok {
+					idata.Data[field.FieldID] = &storage.ArrayFieldData{
+						NumRows: make([]int64, 0, 1),
+						Data:    make([][]byte, 0),
+					}
+				}
+
+				fieldData := idata.Data[field.FieldID].(*storage.ArrayFieldData)
+				for _, blob := range msg.RowData {
+					v := typeutil.DecodeJSONSlot(blob.GetValue()[pos : pos+maxLength])
+					fieldData.Data = append(fieldData.Data, append([]byte(nil), v...))
+				}
+
+				pos += maxLength
+				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 			}
 		}
 
@@ -609,13 +658,19 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	default:
 	}
 
+	var bufferedRows int64
+	for segID := range ibNode.insertBuffer.insertData {
+		bufferedRows += ibNode.insertBuffer.size(segID)
+	}
+	metrics.DataNodeInsertBufferRows.WithLabelValues(strconv.FormatInt(Params.NodeID, 10), ibNode.channelName).Set(float64(bufferedRows))
+
 	// TODO write timetick
 	if err := ibNode.writeHardTimeTick(iMsg.timeRange.timestampMax); err != nil {
 		log.Error("send hard time tick into pulsar channel failed", zap.Error(err))
 	}
 
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 
 	return nil
@@ -635,10 +690,14 @@ func flushSegment(
 		defer wgFinish.Done()
 	}
 
+	tr := timerecord.NewTimeRecorder("flush segment")
 	clearFn := func(isSuccess bool) {
+		status := MetricRequestsSuccess
 		if !isSuccess {
+			status = MetricRequestsFailed
 			flushUnit <- segmentFlushUnit{field2Path: nil}
 		}
+		metrics.DataNodeFlushLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10), status).Observe(tr.ElapseSpan().Seconds())
 
 		log.Debug(".. Clearing flush Buffer ..")
 		insertData.Delete(segID)
@@ -695,6 +754,7 @@ func flushSegment(
 	}
 
 	// write stats binlog
+	field2Stats := make(map[UniqueID]string, len(statsBinlogs))
 	for _, blob := range statsBinlogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
@@ -710,6 +770,7 @@ func flushSegment(
 
 		key := path.Join(Params.StatsBinlogRootPath, k)
 		kvs[key] = string(blob.Value[:])
+		field2Stats[fieldID] = key
 	}
 	log.Debug("save binlog file to MinIO/S3")
 
@@ -721,9 +782,15 @@ func flushSegment(
 		return
 	}
 
+	var flushedBytes int
+	for _, value := range kvs {
+		flushedBytes += len(value)
+	}
+	metrics.DataNodeFlushedBytesCounter.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Add(float64(flushedBytes))
+
 	ibNode.replica.updateSegmentCheckPoint(segID)
 	startPos := ibNode.replica.listNewSegmentsStartPositions()
-	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, startPositions: startPos}
+	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, field2Stats: field2Stats, startPositions: startPos}
 	clearFn(true)
 }
 
@@ -822,7 +889,7 @@ func newInsertBufferNode(
 	channelName string,
 ) *insertBufferNode {
 
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := BaseNode{}
@@ -845,7 +912,7 @@ func newInsertBufferNode(
 		BucketName:        Params.MinioBucketName,
 	}
 
-	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	minIOKV, err := storage.NewObjectStorageKV(ctx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
 		panic(err)
 	}
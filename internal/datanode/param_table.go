@@ -12,10 +12,12 @@
 package datanode
 
 import (
+	"fmt"
 	"path"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -62,6 +64,8 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSL          bool
 	MinioBucketName      string
+
+	paramtable.StorageConfig
 }
 
 var Params ParamTable
@@ -109,6 +113,9 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSL()
 		p.initMinioBucketName()
+		p.InitStorageConfig(&p.BaseTable)
+
+		p.initRefreshers()
 	})
 }
 
@@ -118,6 +125,13 @@ func (p *ParamTable) initFlowGraphMaxQueueLength() {
 	p.FlowGraphMaxQueueLength = p.ParseInt32("dataNode.dataSync.flowGraph.maxQueueLength")
 }
 
+// GetFlowGraphMaxQueueLength returns the max queue length of flow graph nodes, it is refreshable
+// but only the flow graphs created after the change pick up the new value, the queues of the
+// running ones are buffered channels which can't be resized
+func (p *ParamTable) GetFlowGraphMaxQueueLength() int32 {
+	return atomic.LoadInt32(&p.FlowGraphMaxQueueLength)
+}
+
 func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("dataNode.dataSync.flowGraph.maxParallelism")
 }
@@ -274,3 +288,18 @@ func (p *ParamTable) initLogCfg() {
 		p.Log.File.Filename = ""
 	}
 }
+
+// initRefreshers makes the params pick up the configs changed in etcd
+func (p *ParamTable) initRefreshers() {
+	p.RegisterRefresher("dataNode.dataSync.flowGraph.maxQueueLength", func(value string) error {
+		length, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		if length <= 0 {
+			return fmt.Errorf("flow graph max queue length should be positive, but got %d", length)
+		}
+		atomic.StoreInt32(&p.FlowGraphMaxQueueLength, int32(length))
+		return nil
+	})
+}
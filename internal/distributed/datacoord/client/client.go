@@ -15,10 +15,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -38,6 +38,8 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// mu guards grpcClient, conn and addr, which are replaced on reconnecting
+	mu         sync.RWMutex
 	grpcClient datapb.DataCoordClient
 	conn       *grpc.ClientConn
 
@@ -82,15 +84,16 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
+	var addr string
+	var conn *grpc.ClientConn
 	connectDataCoordFn := func() error {
-		c.addr, err = getDataCoordAddress(c.sess)
+		addr, err = getDataCoordAddress(c.sess)
 		if err != nil {
 			log.Debug("DataCoordClient getDataCoordAddr failed", zap.Error(err))
 			return err
 		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("DataCoordClient try reconnect ", zap.String("address", c.addr))
-		conn, err := grpc.DialContext(c.ctx, c.addr,
+		log.Debug("DataCoordClient try reconnect ", zap.String("address", addr))
+		conn, err = grpc.DialContext(c.ctx, addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
@@ -101,21 +104,17 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
 					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
-		if err != nil {
-			return err
-		}
-		c.conn = conn
-		return nil
+		return err
 	}
 
 	err = retry.Do(c.ctx, connectDataCoordFn, retryOptions...)
@@ -123,10 +122,25 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		log.Debug("DataCoord try reconnect failed", zap.Error(err))
 		return err
 	}
-	c.grpcClient = datapb.NewDataCoordClient(c.conn)
+	c.mu.Lock()
+	oldConn := c.conn
+	c.addr = addr
+	c.conn = conn
+	c.grpcClient = datapb.NewDataCoordClient(conn)
+	c.mu.Unlock()
+	// the calls on the replaced connection fail and are recalled on the new one
+	if oldConn != nil {
+		oldConn.Close()
+	}
 	return nil
 }
 
+func (c *Client) getGrpcClient() datapb.DataCoordClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.grpcClient
+}
+
 func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
 	ret, err := caller()
 	if err == nil {
@@ -145,11 +159,29 @@ func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error)
 }
 
 func (c *Client) Start() error {
+	go c.sess.WatchActiveServer(c.ctx, typeutil.DataCoordRole, c.reconnect)
 	return nil
 }
 
+// reconnect connects to the datacoord which takes over the active one, the address is checked
+// since the client may have reconnected to it already when a call failed
+func (c *Client) reconnect(address string) {
+	c.mu.RLock()
+	connected := c.addr == address
+	c.mu.RUnlock()
+	if connected {
+		return
+	}
+	log.Debug("DataCoordClient datacoord changed, reconnect", zap.String("address", address))
+	if err := c.connect(); err != nil {
+		log.Debug("DataCoordClient reconnect failed", zap.Error(err))
+	}
+}
+
 func (c *Client) Stop() error {
 	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.conn.Close()
 }
 
@@ -160,95 +192,95 @@ func (c *Client) Register() error {
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+		return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 	})
 	return ret.(*internalpb.ComponentStates), err
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
+		return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
+		return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.Flush(ctx, req)
+		return c.getGrpcClient().Flush(ctx, req)
 	})
 	return ret.(*datapb.FlushResponse), err
 }
 
 func (c *Client) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AssignSegmentID(ctx, req)
+		return c.getGrpcClient().AssignSegmentID(ctx, req)
 	})
 	return ret.(*datapb.AssignSegmentIDResponse), err
 }
 
 func (c *Client) GetSegmentStates(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentStates(ctx, req)
+		return c.getGrpcClient().GetSegmentStates(ctx, req)
 	})
 	return ret.(*datapb.GetSegmentStatesResponse), err
 }
 
 func (c *Client) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetInsertBinlogPaths(ctx, req)
+		return c.getGrpcClient().GetInsertBinlogPaths(ctx, req)
 	})
 	return ret.(*datapb.GetInsertBinlogPathsResponse), err
 }
 
 func (c *Client) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetCollectionStatistics(ctx, req)
+		return c.getGrpcClient().GetCollectionStatistics(ctx, req)
 	})
 	return ret.(*datapb.GetCollectionStatisticsResponse), err
 }
 
 func (c *Client) GetPartitionStatistics(ctx context.Context, req *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetPartitionStatistics(ctx, req)
+		return c.getGrpcClient().GetPartitionStatistics(ctx, req)
 	})
 	return ret.(*datapb.GetPartitionStatisticsResponse), err
 }
 
 func (c *Client) GetSegmentInfoChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfoChannel(ctx, &datapb.GetSegmentInfoChannelRequest{})
+		return c.getGrpcClient().GetSegmentInfoChannel(ctx, &datapb.GetSegmentInfoChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfo(ctx, req)
+		return c.getGrpcClient().GetSegmentInfo(ctx, req)
 	})
 	return ret.(*datapb.GetSegmentInfoResponse), err
 }
 
 func (c *Client) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
-	return c.grpcClient.SaveBinlogPaths(ctx, req)
+	return c.getGrpcClient().SaveBinlogPaths(ctx, req)
 }
 
 func (c *Client) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetRecoveryInfo(ctx, req)
+		return c.getGrpcClient().GetRecoveryInfo(ctx, req)
 	})
 	return ret.(*datapb.GetRecoveryInfoResponse), err
 }
 
 func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetFlushedSegments(ctx, req)
+		return c.getGrpcClient().GetFlushedSegments(ctx, req)
 	})
 	return ret.(*datapb.GetFlushedSegmentsResponse), err
 }
@@ -23,8 +23,9 @@ import (
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/milvus-io/milvus/internal/datacoord"
 	"github.com/milvus-io/milvus/internal/log"
@@ -36,6 +37,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/healthz"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type Server struct {
@@ -48,6 +51,10 @@ type Server struct {
 	grpcErrChan chan error
 	grpcServer  *grpc.Server
 	closer      io.Closer
+
+	msFactory     msgstream.Factory
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
 }
 
 // NewServer new data service grpc server
@@ -59,6 +66,7 @@ func NewServer(ctx context.Context, factory msgstream.Factory, opts ...datacoord
 		ctx:         ctx1,
 		cancel:      cancel,
 		grpcErrChan: make(chan error),
+		msFactory:   factory,
 	}
 	s.dataCoord, err = datacoord.CreateServer(s.ctx, factory, opts...)
 	if err != nil {
@@ -83,6 +91,7 @@ func (s *Server) init() error {
 		log.Debug("DataCoord Register etcd failed", zap.Error(err))
 		return err
 	}
+	s.etcdCli.Set(s.dataCoord.EtcdClient())
 	log.Debug("DataCoord Register etcd success")
 
 	err = s.startGrpc()
@@ -121,16 +130,28 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	//grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor))
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
+	s.healthChecker = healthz.NewChecker(typeutil.DataCoordRole, s,
+		healthz.EtcdDependency(&s.etcdCli),
+		healthz.Dependency{
+			Name: "msgstream",
+			Check: func(ctx context.Context) error {
+				return msgstream.CheckReachable(ctx, s.msFactory)
+			},
+		},
+	)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 	grpc_prometheus.Register(s.grpcServer)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -143,6 +164,7 @@ func (s *Server) start() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	var err error
 	if s.closer != nil {
 		if err = s.closer.Close(); err != nil {
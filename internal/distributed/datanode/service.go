@@ -23,21 +23,26 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	dn "github.com/milvus-io/milvus/internal/datanode"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type Server struct {
@@ -56,6 +61,9 @@ type Server struct {
 	newRootCoordClient func(string, []string) (types.RootCoord, error)
 	newDataCoordClient func(string, []string) (types.DataCoord, error)
 
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -91,15 +99,30 @@ func (s *Server) startGrpc() error {
 func (s *Server) startGrpcLoop(listener net.Listener) {
 	defer s.wg.Done()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	datapb.RegisterDataNodeServer(s.grpcServer, s)
+	dependencies := []healthz.Dependency{healthz.EtcdDependency(&s.etcdCli)}
+	if dn.Params.StorageType != storage.LocalStorage {
+		dependencies = append(dependencies, healthz.MinioDependency(dn.Params.MinioAddress, dn.Params.MinioAccessKeyID, dn.Params.MinioSecretAccessKey,
+			dn.Params.MinioUseSSL, dn.Params.MinioBucketName))
+	}
+	dependencies = append(dependencies, healthz.Dependency{
+		Name: "msgstream",
+		Check: func(ctx context.Context) error {
+			return msgstream.CheckReachable(ctx, s.msFactory)
+		},
+	})
+	s.healthChecker = healthz.NewChecker(typeutil.DataNodeRole, s, dependencies...)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
@@ -134,6 +157,7 @@ func (s *Server) Run() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
 			return err
@@ -248,6 +272,7 @@ func (s *Server) start() error {
 		log.Debug("DataNode Register etcd failed", zap.Error(err))
 		return err
 	}
+	s.etcdCli.Set(s.datanode.EtcdClient())
 	return nil
 }
 
@@ -15,12 +15,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"google.golang.org/grpc"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -38,6 +38,8 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// mu guards grpcClient, conn and addr, which are replaced on reconnecting
+	mu         sync.RWMutex
 	grpcClient indexpb.IndexCoordClient
 	conn       *grpc.ClientConn
 
@@ -83,15 +85,16 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
+	var addr string
+	var conn *grpc.ClientConn
 	connectIndexCoordaddrFn := func() error {
-		c.addr, err = getIndexCoordAddr(c.sess)
+		addr, err = getIndexCoordAddr(c.sess)
 		if err != nil {
 			log.Debug("IndexCoordClient getIndexCoordAddress failed")
 			return err
 		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("IndexCoordClient try connect ", zap.String("address", c.addr))
-		conn, err := grpc.DialContext(c.ctx, c.addr,
+		log.Debug("IndexCoordClient try connect ", zap.String("address", addr))
+		conn, err = grpc.DialContext(c.ctx, addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
@@ -99,19 +102,15 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 			grpc.WithUnaryInterceptor(
 				grpc_middleware.ChainUnaryClient(
 					grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(3)),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
 					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3)),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
-		if err != nil {
-			return err
-		}
-		c.conn = conn
-		return nil
+		return err
 	}
 
 	err = retry.Do(c.ctx, connectIndexCoordaddrFn, retryOptions...)
@@ -120,10 +119,25 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("IndexCoordClient connect success")
-	c.grpcClient = indexpb.NewIndexCoordClient(c.conn)
+	c.mu.Lock()
+	oldConn := c.conn
+	c.addr = addr
+	c.conn = conn
+	c.grpcClient = indexpb.NewIndexCoordClient(conn)
+	c.mu.Unlock()
+	// the calls on the replaced connection fail and are recalled on the new one
+	if oldConn != nil {
+		oldConn.Close()
+	}
 	return nil
 }
 
+func (c *Client) getGrpcClient() indexpb.IndexCoordClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.grpcClient
+}
+
 func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
 	ret, err := caller()
 	if err == nil {
@@ -142,11 +156,29 @@ func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error)
 }
 
 func (c *Client) Start() error {
+	go c.sess.WatchActiveServer(c.ctx, typeutil.IndexCoordRole, c.reconnect)
 	return nil
 }
 
+// reconnect connects to the indexcoord which takes over the active one, the address is checked
+// since the client may have reconnected to it already when a call failed
+func (c *Client) reconnect(address string) {
+	c.mu.RLock()
+	connected := c.addr == address
+	c.mu.RUnlock()
+	if connected {
+		return
+	}
+	log.Debug("IndexCoordClient indexcoord changed, reconnect", zap.String("address", address))
+	if err := c.connect(); err != nil {
+		log.Debug("IndexCoordClient reconnect failed", zap.Error(err))
+	}
+}
+
 func (c *Client) Stop() error {
 	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.conn.Close()
 }
 
@@ -157,48 +189,48 @@ func (c *Client) Register() error {
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+		return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 	})
 	return ret.(*internalpb.ComponentStates), err
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
+		return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
+		return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequest) (*indexpb.BuildIndexResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.BuildIndex(ctx, req)
+		return c.getGrpcClient().BuildIndex(ctx, req)
 	})
 	return ret.(*indexpb.BuildIndexResponse), err
 }
 
 func (c *Client) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropIndex(ctx, req)
+		return c.getGrpcClient().DropIndex(ctx, req)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *Client) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetIndexStates(ctx, req)
+		return c.getGrpcClient().GetIndexStates(ctx, req)
 	})
 	return ret.(*indexpb.GetIndexStatesResponse), err
 }
 func (c *Client) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetIndexFilePaths(ctx, req)
+		return c.getGrpcClient().GetIndexFilePaths(ctx, req)
 	})
 	return ret.(*indexpb.GetIndexFilePathsResponse), err
 }
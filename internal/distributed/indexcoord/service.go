@@ -20,15 +20,19 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
-	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/milvus-io/milvus/internal/indexcoord"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -46,6 +50,9 @@ type Server struct {
 	loopCancel func()
 	loopWg     sync.WaitGroup
 
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -73,6 +80,7 @@ func (s *Server) init() error {
 	if err := s.indexcoord.Register(); err != nil {
 		return err
 	}
+	s.etcdCli.Set(s.indexcoord.EtcdClient())
 
 	s.loopWg.Add(1)
 	go s.startGrpcLoop(Params.ServicePort)
@@ -97,6 +105,7 @@ func (s *Server) start() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
 			return err
@@ -158,13 +167,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
+	dependencies := []healthz.Dependency{healthz.EtcdDependency(&s.etcdCli)}
+	if indexcoord.Params.StorageType != storage.LocalStorage {
+		dependencies = append(dependencies, healthz.MinioDependency(indexcoord.Params.MinIOAddress, indexcoord.Params.MinIOAccessKeyID,
+			indexcoord.Params.MinIOSecretAccessKey, indexcoord.Params.MinIOUseSSL, indexcoord.Params.MinioBucketName))
+	}
+	s.healthChecker = healthz.NewChecker(typeutil.IndexCoordRole, s, dependencies...)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -18,7 +18,6 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -62,7 +61,6 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
-		opts := trace.GetInterceptorOpts()
 		log.Debug("IndexNodeClient try connect ", zap.String("address", c.addr))
 		conn, err := grpc.DialContext(c.ctx, c.addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
@@ -75,7 +73,7 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
@@ -83,7 +81,7 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
 		if err != nil {
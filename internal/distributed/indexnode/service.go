@@ -21,18 +21,23 @@ import (
 
 	"go.uber.org/zap"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcindexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	"github.com/milvus-io/milvus/internal/indexnode"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type Server struct {
@@ -46,6 +51,9 @@ type Server struct {
 	loopCancel       func()
 	loopWg           sync.WaitGroup
 
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -76,13 +84,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	indexpb.RegisterIndexNodeServer(s.grpcServer, s)
+	dependencies := []healthz.Dependency{healthz.EtcdDependency(&s.etcdCli)}
+	if indexnode.Params.StorageType != storage.LocalStorage {
+		dependencies = append(dependencies, healthz.MinioDependency(indexnode.Params.MinIOAddress, indexnode.Params.MinIOAccessKeyID,
+			indexnode.Params.MinIOSecretAccessKey, indexnode.Params.MinIOUseSSL, indexnode.Params.MinioBucketName))
+	}
+	s.healthChecker = healthz.NewChecker(typeutil.IndexNodeRole, s, dependencies...)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
 		s.grpcErrChan <- err
@@ -124,6 +143,7 @@ func (s *Server) init() error {
 		log.Debug("IndexNode Register etcd failed", zap.Error(err))
 		return err
 	}
+	s.etcdCli.Set(s.indexnode.EtcdClient())
 	log.Debug("IndexNode Register etcd success")
 
 	s.loopWg.Add(1)
@@ -165,6 +185,7 @@ func (s *Server) start() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
 			return err
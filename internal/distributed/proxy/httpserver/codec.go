@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// extractFunc takes the fields which are not in the json format of the request out of the raw
+// json object, and sets them to the request in the way the proxy expects
+type extractFunc func(raw map[string]json.RawMessage) error
+
+// decodeRequest decodes the json body of r into req by the field names of the proto messages,
+// the request is decoded from the query parameters if the body is empty, such as a GET request
+func decodeRequest(r *http.Request, req proto.Message, extract extractFunc) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		if body, err = queryToJSON(r.URL.Query()); err != nil {
+			return err
+		}
+	}
+
+	if extract != nil {
+		raw := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return fmt.Errorf("invalid request body: %w", err)
+		}
+		if err := extract(raw); err != nil {
+			return err
+		}
+		if body, err = json.Marshal(raw); err != nil {
+			return err
+		}
+	}
+
+	if err := jsonpb.Unmarshal(bytes.NewReader(body), req); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+// queryToJSON converts the query parameters to a json object, the parameters given more than once are arrays
+func queryToJSON(query url.Values) ([]byte, error) {
+	obj := make(map[string]interface{}, len(query))
+	for key, values := range query {
+		if len(values) > 1 {
+			obj[key] = values
+			continue
+		}
+		switch values[0] {
+		case "true":
+			obj[key] = true
+		case "false":
+			obj[key] = false
+		default:
+			obj[key] = values[0]
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// extractSchema takes the collection schema in json out, and sets it to the request serialized
+func extractSchema(req *milvuspb.CreateCollectionRequest) extractFunc {
+	return func(raw map[string]json.RawMessage) error {
+		data, ok := raw["schema"]
+		if !ok {
+			return errors.New("schema is required")
+		}
+		delete(raw, "schema")
+
+		schema := &schemapb.CollectionSchema{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(data), schema); err != nil {
+			return fmt.Errorf("invalid schema: %w", err)
+		}
+		blob, err := proto.Marshal(schema)
+		if err != nil {
+			return err
+		}
+		req.Schema = blob
+		return nil
+	}
+}
+
+// extractKeyValuePairs allows the key value pairs to be given as a json object such as
+// {"metric_type": "L2", "params": {"nprobe": 10}}, the values which are not strings are
+// kept in json, the pairs in the format of the proto messages are left to decodeRequest
+func extractKeyValuePairs(key string, pairs *[]*commonpb.KeyValuePair) extractFunc {
+	return func(raw map[string]json.RawMessage) error {
+		data, ok := raw[key]
+		if !ok || !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+			return nil
+		}
+		delete(raw, key)
+
+		obj := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		for k, v := range obj {
+			var value string
+			if err := json.Unmarshal(v, &value); err != nil {
+				value = string(v)
+			}
+			*pairs = append(*pairs, &commonpb.KeyValuePair{Key: k, Value: value})
+		}
+		return nil
+	}
+}
+
+// extractPlaceholderGroup takes the target vectors out of "vectors" for float vectors, or out of
+// "binary_vectors" for binary vectors in base64, and sets them to the request as the placeholder group
+func extractPlaceholderGroup(req *milvuspb.SearchRequest) extractFunc {
+	return func(raw map[string]json.RawMessage) error {
+		placeholder := &milvuspb.PlaceholderValue{Tag: "$0"}
+		if data, ok := raw["vectors"]; ok {
+			delete(raw, "vectors")
+			var vectors [][]float32
+			if err := json.Unmarshal(data, &vectors); err != nil {
+				return fmt.Errorf("invalid vectors: %w", err)
+			}
+			placeholder.Type = milvuspb.PlaceholderType_FloatVector
+			for _, vector := range vectors {
+				value := make([]byte, 0, len(vector)*4)
+				for _, v := range vector {
+					value = append(value, typeutil.Float32ToByte(v)...)
+				}
+				placeholder.Values = append(placeholder.Values, value)
+			}
+		} else if data, ok := raw["binary_vectors"]; ok {
+			delete(raw, "binary_vectors")
+			if err := json.Unmarshal(data, &placeholder.Values); err != nil {
+				return fmt.Errorf("invalid binary_vectors: %w", err)
+			}
+			placeholder.Type = milvuspb.PlaceholderType_BinaryVector
+		} else {
+			return nil
+		}
+
+		blob, err := proto.Marshal(&milvuspb.PlaceholderGroup{
+			Placeholders: []*milvuspb.PlaceholderValue{placeholder},
+		})
+		if err != nil {
+			return err
+		}
+		req.PlaceholderGroup = blob
+		return nil
+	}
+}
+
+var marshaler = jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// writeResponse writes resp in json, with the http status code mapped from the status of resp,
+// err is the error returned by the proxy
+func writeResponse(w http.ResponseWriter, status *commonpb.Status, resp proto.Message, err error) {
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+		return
+	}
+	writeJSON(w, httpStatusOf(status), resp)
+}
+
+// writeError writes a status with the error code and reason
+func writeError(w http.ResponseWriter, httpStatus int, code commonpb.ErrorCode, reason string) {
+	writeJSON(w, httpStatus, &commonpb.Status{ErrorCode: code, Reason: reason})
+}
+
+func writeJSON(w http.ResponseWriter, httpStatus int, resp proto.Message) {
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, resp); err != nil {
+		httpStatus = http.StatusInternalServerError
+		buf.Reset()
+		_ = marshaler.Marshal(&buf, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// httpStatusOf maps the error code of the status returned by the proxy to the http status code
+func httpStatusOf(status *commonpb.Status) int {
+	switch status.GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+		return http.StatusOK
+	case commonpb.ErrorCode_PermissionDenied:
+		return http.StatusForbidden
+	case commonpb.ErrorCode_CollectionNotExists, commonpb.ErrorCode_IndexNotExist, commonpb.ErrorCode_FileNotFound:
+		return http.StatusNotFound
+	case commonpb.ErrorCode_IllegalArgument, commonpb.ErrorCode_IllegalDimension, commonpb.ErrorCode_IllegalIndexType,
+		commonpb.ErrorCode_IllegalCollectionName, commonpb.ErrorCode_IllegalTOPK, commonpb.ErrorCode_IllegalRowRecord,
+		commonpb.ErrorCode_IllegalVectorID, commonpb.ErrorCode_IllegalNLIST, commonpb.ErrorCode_IllegalMetricType:
+		return http.StatusBadRequest
+	case commonpb.ErrorCode_ConnectFailed:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
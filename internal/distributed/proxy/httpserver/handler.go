@@ -0,0 +1,280 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// APIPrefix is the prefix of all the routes of the RESTful API
+const APIPrefix = "/api/v1"
+
+// ProxyService is the client API of the proxy served by the RESTful API, proxy.Proxy implements it
+type ProxyService interface {
+	CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
+	LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	GetCollectionStatistics(ctx context.Context, request *milvuspb.GetCollectionStatisticsRequest) (*milvuspb.GetCollectionStatisticsResponse, error)
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+
+	CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+	DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error)
+
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+	Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error)
+}
+
+// Handlers translates the RESTful API to the client API of the proxy
+type Handlers struct {
+	proxy ProxyService
+}
+
+// NewHandlers returns the handlers of the RESTful API served by the proxy
+func NewHandlers(proxy ProxyService) *Handlers {
+	return &Handlers{proxy: proxy}
+}
+
+type route struct {
+	method  string
+	path    string
+	handler http.HandlerFunc
+}
+
+func (h *Handlers) routes() []route {
+	return []route{
+		{http.MethodPost, "/collection", h.handleCreateCollection},
+		{http.MethodDelete, "/collection", h.handleDropCollection},
+		{http.MethodGet, "/collection", h.handleDescribeCollection},
+		{http.MethodGet, "/collection/existence", h.handleHasCollection},
+		{http.MethodGet, "/collection/statistics", h.handleGetCollectionStatistics},
+		{http.MethodPost, "/collection/load", h.handleLoadCollection},
+		{http.MethodDelete, "/collection/load", h.handleReleaseCollection},
+		{http.MethodGet, "/collections", h.handleShowCollections},
+
+		{http.MethodPost, "/index", h.handleCreateIndex},
+		{http.MethodGet, "/index", h.handleDescribeIndex},
+		{http.MethodDelete, "/index", h.handleDropIndex},
+
+		{http.MethodPost, "/entities", h.handleInsert},
+		{http.MethodDelete, "/entities", h.handleDelete},
+		{http.MethodPost, "/search", h.handleSearch},
+		{http.MethodPost, "/query", h.handleQuery},
+		{http.MethodPost, "/persist", h.handleFlush},
+	}
+}
+
+// RegisterRoutesTo registers all the routes of the RESTful API to mux, under APIPrefix
+func (h *Handlers) RegisterRoutesTo(mux *http.ServeMux) {
+	paths := make(map[string]map[string]http.HandlerFunc)
+	for _, r := range h.routes() {
+		if _, ok := paths[r.path]; !ok {
+			paths[r.path] = make(map[string]http.HandlerFunc)
+		}
+		paths[r.path][r.method] = r.handler
+	}
+	for path, methods := range paths {
+		mux.Handle(APIPrefix+path, methodsHandler(methods))
+	}
+}
+
+// methodsHandler dispatches the requests of a path by their methods
+func methodsHandler(methods map[string]http.HandlerFunc) http.Handler {
+	allowed := make([]string, 0, len(methods))
+	for method := range methods {
+		allowed = append(allowed, method)
+	}
+	sort.Strings(allowed)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := methods[r.Method]
+		if !ok {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeError(w, http.StatusMethodNotAllowed, commonpb.ErrorCode_UnexpectedError, "method "+r.Method+" not allowed")
+			return
+		}
+		handler(w, r)
+	})
+}
+
+func (h *Handlers) handleCreateCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.CreateCollectionRequest{}
+	if err := decodeRequest(r, req, extractSchema(req)); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.CreateCollection(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleDropCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.DropCollectionRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.DropCollection(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleDescribeCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.DescribeCollectionRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.DescribeCollection(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleHasCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.HasCollectionRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.HasCollection(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleGetCollectionStatistics(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.GetCollectionStatisticsRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.GetCollectionStatistics(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleLoadCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.LoadCollectionRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.LoadCollection(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleReleaseCollection(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.ReleaseCollectionRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.ReleaseCollection(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleShowCollections(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.ShowCollectionsRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.ShowCollections(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleCreateIndex(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.CreateIndexRequest{}
+	if err := decodeRequest(r, req, extractKeyValuePairs("extra_params", &req.ExtraParams)); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.CreateIndex(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleDescribeIndex(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.DescribeIndexRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.DescribeIndex(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleDropIndex(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.DropIndexRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	status, err := h.proxy.DropIndex(r.Context(), req)
+	writeResponse(w, status, status, err)
+}
+
+func (h *Handlers) handleInsert(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.InsertRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.Insert(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+// handleDelete is reserved for deleting entities by primary keys, which the proxy does not support yet
+func (h *Handlers) handleDelete(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotImplemented, commonpb.ErrorCode_UnexpectedError, "delete entities is not supported yet")
+}
+
+func (h *Handlers) handleSearch(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.SearchRequest{}
+	extract := func(raw map[string]json.RawMessage) error {
+		if err := extractKeyValuePairs("search_params", &req.SearchParams)(raw); err != nil {
+			return err
+		}
+		return extractPlaceholderGroup(req)(raw)
+	}
+	if err := decodeRequest(r, req, extract); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.Search(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleQuery(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.QueryRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.Query(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
+
+func (h *Handlers) handleFlush(w http.ResponseWriter, r *http.Request) {
+	req := &milvuspb.FlushRequest{}
+	if err := decodeRequest(r, req, nil); err != nil {
+		writeError(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+		return
+	}
+	resp, err := h.proxy.Flush(r.Context(), req)
+	writeResponse(w, resp.GetStatus(), resp, err)
+}
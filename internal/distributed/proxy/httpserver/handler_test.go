@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type mockProxy struct {
+	status  *commonpb.Status
+	err     error
+	request proto.Message
+}
+
+func (m *mockProxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	m.request = request
+	return &milvuspb.BoolResponse{Status: m.status, Value: true}, m.err
+}
+
+func (m *mockProxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) ReleaseCollection(ctx context.Context, request *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	m.request = request
+	return &milvuspb.DescribeCollectionResponse{Status: m.status}, m.err
+}
+
+func (m *mockProxy) GetCollectionStatistics(ctx context.Context, request *milvuspb.GetCollectionStatisticsRequest) (*milvuspb.GetCollectionStatisticsResponse, error) {
+	m.request = request
+	return &milvuspb.GetCollectionStatisticsResponse{Status: m.status}, m.err
+}
+
+func (m *mockProxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	m.request = request
+	return &milvuspb.ShowCollectionsResponse{Status: m.status, CollectionNames: []string{"test"}}, m.err
+}
+
+func (m *mockProxy) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	m.request = request
+	return &milvuspb.DescribeIndexResponse{Status: m.status}, m.err
+}
+
+func (m *mockProxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
+	m.request = request
+	return m.status, m.err
+}
+
+func (m *mockProxy) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.request = request
+	return &milvuspb.MutationResult{Status: m.status}, m.err
+}
+
+func (m *mockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.request = request
+	return &milvuspb.SearchResults{Status: m.status}, m.err
+}
+
+func (m *mockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	m.request = request
+	return &milvuspb.QueryResults{Status: m.status}, m.err
+}
+
+func (m *mockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
+	m.request = request
+	return &milvuspb.FlushResponse{Status: m.status}, m.err
+}
+
+func newTestServer(proxy *mockProxy) *httptest.Server {
+	mux := http.NewServeMux()
+	NewHandlers(proxy).RegisterRoutesTo(mux)
+	return httptest.NewServer(mux)
+}
+
+func doRequest(t *testing.T, method, url, body string) (int, map[string]interface{}) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	result := make(map[string]interface{})
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func TestHandlers_CreateCollection(t *testing.T) {
+	proxy := &mockProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	server := newTestServer(proxy)
+	defer server.Close()
+
+	code, result := doRequest(t, http.MethodPost, server.URL+APIPrefix+"/collection", `{
+		"collection_name": "test",
+		"shards_num": 2,
+		"schema": {
+			"name": "test",
+			"fields": [
+				{"fieldID": 100, "name": "pk", "is_primary_key": true, "data_type": "Int64"},
+				{"fieldID": 101, "name": "vec", "data_type": "FloatVector", "type_params": [{"key": "dim", "value": "8"}]}
+			]
+		}
+	}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Success", result["error_code"])
+
+	req := proxy.request.(*milvuspb.CreateCollectionRequest)
+	assert.Equal(t, "test", req.CollectionName)
+	assert.Equal(t, int32(2), req.ShardsNum)
+	schema := &schemapb.CollectionSchema{}
+	assert.NoError(t, proto.Unmarshal(req.Schema, schema))
+	assert.Equal(t, 2, len(schema.Fields))
+	assert.True(t, schema.Fields[0].IsPrimaryKey)
+	assert.Equal(t, schemapb.DataType_FloatVector, schema.Fields[1].DataType)
+
+	code, _ = doRequest(t, http.MethodPost, server.URL+APIPrefix+"/collection", `{"collection_name": "test"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestHandlers_GetByQuery(t *testing.T) {
+	proxy := &mockProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	server := newTestServer(proxy)
+	defer server.Close()
+
+	code, result := doRequest(t, http.MethodGet, server.URL+APIPrefix+"/collection/existence?collection_name=test", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, true, result["value"])
+	assert.Equal(t, "test", proxy.request.(*milvuspb.HasCollectionRequest).CollectionName)
+
+	code, result = doRequest(t, http.MethodGet, server.URL+APIPrefix+"/collections", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []interface{}{"test"}, result["collection_names"])
+}
+
+func TestHandlers_Insert(t *testing.T) {
+	proxy := &mockProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	server := newTestServer(proxy)
+	defer server.Close()
+
+	code, _ := doRequest(t, http.MethodPost, server.URL+APIPrefix+"/entities", `{
+		"collection_name": "test",
+		"num_rows": 2,
+		"fields_data": [
+			{"field_name": "pk", "type": "Int64", "scalars": {"long_data": {"data": [1, 2]}}},
+			{"field_name": "vec", "type": "FloatVector", "vectors": {"dim": 2, "float_vector": {"data": [0.1, 0.2, 0.3, 0.4]}}}
+		]
+	}`)
+	assert.Equal(t, http.StatusOK, code)
+	req := proxy.request.(*milvuspb.InsertRequest)
+	assert.Equal(t, uint32(2), req.NumRows)
+	assert.Equal(t, []int64{1, 2}, req.FieldsData[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, req.FieldsData[1].GetVectors().GetFloatVector().GetData())
+
+	code, _ = doRequest(t, http.MethodDelete, server.URL+APIPrefix+"/entities", `{"collection_name": "test"}`)
+	assert.Equal(t, http.StatusNotImplemented, code)
+}
+
+func TestHandlers_Search(t *testing.T) {
+	proxy := &mockProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	server := newTestServer(proxy)
+	defer server.Close()
+
+	code, _ := doRequest(t, http.MethodPost, server.URL+APIPrefix+"/search", `{
+		"collection_name": "test",
+		"dsl": "pk > 0",
+		"dsl_type": "BoolExprV1",
+		"output_fields": ["pk"],
+		"search_params": {"anns_field": "vec", "topk": 10, "metric_type": "L2", "params": {"nprobe": 10}},
+		"vectors": [[1, 2], [3, 4]]
+	}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	req := proxy.request.(*milvuspb.SearchRequest)
+	assert.Equal(t, "pk > 0", req.Dsl)
+	assert.Equal(t, commonpb.DslType_BoolExprV1, req.DslType)
+	params := make(map[string]string)
+	for _, pair := range req.SearchParams {
+		params[pair.Key] = pair.Value
+	}
+	assert.Equal(t, map[string]string{"anns_field": "vec", "topk": "10", "metric_type": "L2", "params": `{"nprobe": 10}`}, params)
+
+	group := &milvuspb.PlaceholderGroup{}
+	assert.NoError(t, proto.Unmarshal(req.PlaceholderGroup, group))
+	assert.Equal(t, 1, len(group.Placeholders))
+	assert.Equal(t, milvuspb.PlaceholderType_FloatVector, group.Placeholders[0].Type)
+	assert.Equal(t, 2, len(group.Placeholders[0].Values))
+	assert.Equal(t, float32(3), typeutil.ByteToFloat32(group.Placeholders[0].Values[1][:4]))
+}
+
+func TestHandlers_ErrorMapping(t *testing.T) {
+	proxy := &mockProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "not found"}}
+	server := newTestServer(proxy)
+	defer server.Close()
+
+	code, result := doRequest(t, http.MethodPost, server.URL+APIPrefix+"/query", `{"collection_name": "test", "expr": "pk in [1]"}`)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "not found", result["status"].(map[string]interface{})["reason"])
+
+	proxy.status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+	code, _ = doRequest(t, http.MethodDelete, server.URL+APIPrefix+"/collection", `{"collection_name": "test"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	proxy.err = errors.New("mock error")
+	code, result = doRequest(t, http.MethodPost, server.URL+APIPrefix+"/collection/load", `{"collection_name": "test"}`)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "mock error", result["reason"])
+
+	code, _ = doRequest(t, http.MethodPost, server.URL+APIPrefix+"/query", `{"collection_name": `)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = doRequest(t, http.MethodPut, server.URL+APIPrefix+"/query", `{}`)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -12,6 +12,7 @@
 package grpcproxy
 
 import (
+	"strconv"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/distributed/grpcconfigs"
@@ -36,6 +37,9 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	HTTPEnabled bool
+	HTTPPort    int
 }
 
 var Params ParamTable
@@ -48,6 +52,9 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+
+		pt.initHTTPEnabled()
+		pt.initHTTPPort()
 	})
 }
 
@@ -129,3 +136,25 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("proxy.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initHTTPEnabled() {
+	enabled, err := pt.Load("proxy.http.enabled")
+	if err != nil {
+		pt.HTTPEnabled = false
+		log.Debug("proxy.http.enabled not set, disable the RESTful API")
+		return
+	}
+	pt.HTTPEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initHTTPPort() {
+	var err error
+	pt.HTTPPort, err = pt.ParseIntWithErr("proxy.http.port")
+	if err != nil {
+		pt.HTTPPort = DefaultHTTPPort
+		log.Debug("proxy.http.port not set, set to default")
+	}
+}
@@ -24,3 +24,10 @@ func TestParamTable(t *testing.T) {
 	log.Info("TestParamTable", zap.Int("ServerMaxSendSize", Params.ServerMaxSendSize))
 	log.Info("TestParamTable", zap.Int("ServerMaxRecvSize", Params.ServerMaxRecvSize))
 }
+
+func TestParamTable_HTTP(t *testing.T) {
+	Params.Init()
+
+	log.Info("TestParamTable", zap.Bool("HTTPEnabled", Params.HTTPEnabled))
+	log.Info("TestParamTable", zap.Int("HTTPPort", Params.HTTPPort))
+}
@@ -16,33 +16,43 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	grpcdatacoordclient "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	grpcindexcoordclient "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
+	grpcquerynodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
 	GRPCMaxMagSize = 2 << 30
+
+	// DefaultHTTPPort is the port of the RESTful API if proxy.http.port is not set
+	DefaultHTTPPort = 19121
 )
 
 type Server struct {
@@ -58,7 +68,11 @@ type Server struct {
 	queryCooedClient *grpcquerycoordclient.Client
 	indexCoordClient *grpcindexcoordclient.Client
 
-	tracer opentracing.Tracer
+	msFactory     msgstream.Factory
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+	httpServer    *http.Server
+
 	closer io.Closer
 }
 
@@ -68,6 +82,7 @@ func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error)
 	server := &Server{
 		ctx:         ctx,
 		grpcErrChan: make(chan error),
+		msFactory:   factory,
 	}
 
 	server.proxy, err = proxy.NewProxy(server.ctx, factory)
@@ -92,17 +107,29 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
+	s.healthChecker = healthz.NewChecker(typeutil.ProxyRole, s,
+		healthz.EtcdDependency(&s.etcdCli),
+		healthz.Dependency{
+			Name: "msgstream",
+			Check: func(ctx context.Context) error {
+				return msgstream.CheckReachable(ctx, s.msFactory)
+			},
+		},
+	)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -156,6 +183,7 @@ func (s *Server) init() error {
 		log.Debug("Proxy Register etcd failed ", zap.Error(err))
 		return err
 	}
+	s.etcdCli.Set(s.proxy.EtcdClient())
 
 	s.wg.Add(1)
 	go s.startGrpcLoop(Params.Port)
@@ -231,6 +259,20 @@ func (s *Server) init() error {
 	s.proxy.SetQueryCoordClient(s.queryCooedClient)
 	log.Debug("set query coordinator client ...")
 
+	s.proxy.SetQueryNodeCreator(func(ctx context.Context, addr string) (types.QueryNode, error) {
+		client, err := grpcquerynodeclient.NewClient(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if err = client.Init(); err != nil {
+			return nil, err
+		}
+		if err = client.Start(); err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+
 	s.proxy.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("proxy", zap.Any("state of proxy", internalpb.StateCode_Initializing))
 
@@ -243,10 +285,36 @@ func (s *Server) init() error {
 }
 
 func (s *Server) start() error {
-	return s.proxy.Start()
+	if err := s.proxy.Start(); err != nil {
+		return err
+	}
+	if Params.HTTPEnabled {
+		s.startHTTPServer(Params.HTTPPort)
+	}
+	return nil
+}
+
+// startHTTPServer serves the RESTful API, which calls the same methods of the proxy as the grpc server
+func (s *Server) startHTTPServer(port int) {
+	mux := http.NewServeMux()
+	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(mux)
+	s.httpServer = &http.Server{
+		Addr:    ":" + strconv.Itoa(port),
+		Handler: mux,
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		log.Debug("proxy RESTful API listening", zap.Int("port", port))
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("proxy RESTful API stopped", zap.Error(err))
+		}
+	}()
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	var err error
 	if s.closer != nil {
 		if err = s.closer.Close(); err != nil {
@@ -254,6 +322,12 @@ func (s *Server) Stop() error {
 		}
 	}
 
+	if s.httpServer != nil {
+		if err = s.httpServer.Shutdown(context.Background()); err != nil {
+			log.Warn("shutdown proxy RESTful API failed", zap.Error(err))
+		}
+	}
+
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
@@ -374,6 +448,10 @@ func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return s.proxy.HybridSearch(ctx, request)
+}
+
 func (s *Server) Retrieve(ctx context.Context, request *milvuspb.RetrieveRequest) (*milvuspb.RetrieveResults, error) {
 	return s.proxy.Retrieve(ctx, request)
 }
@@ -403,6 +481,14 @@ func (s *Server) GetQuerySegmentInfo(ctx context.Context, request *milvuspb.GetQ
 
 }
 
+func (s *Server) GetConfig(ctx context.Context, request *milvuspb.GetConfigRequest) (*milvuspb.GetConfigResponse, error) {
+	return s.proxy.GetConfig(ctx, request)
+}
+
+func (s *Server) SetConfig(ctx context.Context, request *milvuspb.SetConfigRequest) (*commonpb.Status, error) {
+	return s.proxy.SetConfig(ctx, request)
+}
+
 func (s *Server) Dummy(ctx context.Context, request *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	return s.proxy.Dummy(ctx, request)
 }
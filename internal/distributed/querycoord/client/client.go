@@ -15,10 +15,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -38,6 +38,8 @@ type Client struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// mu guards grpcClient, conn and addr, which are replaced on reconnecting
+	mu         sync.RWMutex
 	grpcClient querypb.QueryCoordClient
 	conn       *grpc.ClientConn
 
@@ -83,15 +85,16 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	var err error
+	var addr string
+	var conn *grpc.ClientConn
 	connectQueryCoordAddressFn := func() error {
-		c.addr, err = getQueryCoordAddress(c.sess)
+		addr, err = getQueryCoordAddress(c.sess)
 		if err != nil {
 			log.Debug("QueryCoordClient getQueryCoordAddress failed", zap.Error(err))
 			return err
 		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("QueryCoordClient try reconnect ", zap.String("address", c.addr))
-		conn, err := grpc.DialContext(c.ctx, c.addr,
+		log.Debug("QueryCoordClient try reconnect ", zap.String("address", addr))
+		conn, err = grpc.DialContext(c.ctx, addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
@@ -102,7 +105,7 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
@@ -110,14 +113,10 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
-		if err != nil {
-			return err
-		}
-		c.conn = conn
-		return nil
+		return err
 	}
 
 	err = retry.Do(c.ctx, connectQueryCoordAddressFn, retryOptions...)
@@ -126,10 +125,25 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("QueryCoordClient try reconnect success")
-	c.grpcClient = querypb.NewQueryCoordClient(c.conn)
+	c.mu.Lock()
+	oldConn := c.conn
+	c.addr = addr
+	c.conn = conn
+	c.grpcClient = querypb.NewQueryCoordClient(conn)
+	c.mu.Unlock()
+	// the calls on the replaced connection fail and are recalled on the new one
+	if oldConn != nil {
+		oldConn.Close()
+	}
 	return nil
 }
 
+func (c *Client) getGrpcClient() querypb.QueryCoordClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.grpcClient
+}
+
 func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error) {
 	ret, err := caller()
 	if err == nil {
@@ -148,11 +162,29 @@ func (c *Client) recall(caller func() (interface{}, error)) (interface{}, error)
 }
 
 func (c *Client) Start() error {
+	go c.sess.WatchActiveServer(c.ctx, typeutil.QueryCoordRole, c.reconnect)
 	return nil
 }
 
+// reconnect connects to the querycoord which takes over the active one, the address is checked
+// since the client may have reconnected to it already when a call failed
+func (c *Client) reconnect(address string) {
+	c.mu.RLock()
+	connected := c.addr == address
+	c.mu.RUnlock()
+	if connected {
+		return
+	}
+	log.Debug("QueryCoordClient querycoord changed, reconnect", zap.String("address", address))
+	if err := c.connect(); err != nil {
+		log.Debug("QueryCoordClient reconnect failed", zap.Error(err))
+	}
+}
+
 func (c *Client) Stop() error {
 	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.conn.Close()
 }
 
@@ -163,84 +195,91 @@ func (c *Client) Register() error {
 
 func (c *Client) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+		return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 	})
 	return ret.(*internalpb.ComponentStates), err
 }
 
 func (c *Client) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
+		return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
+		return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
 
 func (c *Client) ShowCollections(ctx context.Context, req *querypb.ShowCollectionsRequest) (*querypb.ShowCollectionsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowCollections(ctx, req)
+		return c.getGrpcClient().ShowCollections(ctx, req)
 	})
 	return ret.(*querypb.ShowCollectionsResponse), err
 }
 
 func (c *Client) LoadCollection(ctx context.Context, req *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.LoadCollection(ctx, req)
+		return c.getGrpcClient().LoadCollection(ctx, req)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *Client) ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleaseCollection(ctx, req)
+		return c.getGrpcClient().ReleaseCollection(ctx, req)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *Client) ShowPartitions(ctx context.Context, req *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowPartitions(ctx, req)
+		return c.getGrpcClient().ShowPartitions(ctx, req)
 	})
 	return ret.(*querypb.ShowPartitionsResponse), err
 }
 
 func (c *Client) LoadPartitions(ctx context.Context, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.LoadPartitions(ctx, req)
+		return c.getGrpcClient().LoadPartitions(ctx, req)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *Client) ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleasePartitions(ctx, req)
+		return c.getGrpcClient().ReleasePartitions(ctx, req)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *Client) CreateQueryChannel(ctx context.Context, req *querypb.CreateQueryChannelRequest) (*querypb.CreateQueryChannelResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateQueryChannel(ctx, req)
+		return c.getGrpcClient().CreateQueryChannel(ctx, req)
 	})
 	return ret.(*querypb.CreateQueryChannelResponse), err
 }
 
 func (c *Client) GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetPartitionStates(ctx, req)
+		return c.getGrpcClient().GetPartitionStates(ctx, req)
 	})
 	return ret.(*querypb.GetPartitionStatesResponse), err
 }
 
 func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetSegmentInfo(ctx, req)
+		return c.getGrpcClient().GetSegmentInfo(ctx, req)
 	})
 	return ret.(*querypb.GetSegmentInfoResponse), err
 }
+
+func (c *Client) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.getGrpcClient().GetShardLeaders(ctx, req)
+	})
+	return ret.(*querypb.GetShardLeadersResponse), err
+}
@@ -19,10 +19,11 @@ import (
 	"sync"
 	"time"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	qc "github.com/milvus-io/milvus/internal/querycoord"
 	"github.com/milvus-io/milvus/internal/types"
@@ -30,11 +31,15 @@ import (
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/healthz"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type Server struct {
@@ -52,6 +57,9 @@ type Server struct {
 	dataCoord *dsc.Client
 	rootCoord *rcc.GrpcClient
 
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -96,6 +104,7 @@ func (s *Server) init() error {
 	if err := s.queryCoord.Register(); err != nil {
 		return err
 	}
+	s.etcdCli.Set(s.queryCoord.EtcdClient())
 
 	s.wg.Add(1)
 	go s.startGrpcLoop(Params.Port)
@@ -184,15 +193,30 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
+	dependencies := []healthz.Dependency{healthz.EtcdDependency(&s.etcdCli)}
+	if qc.Params.StorageType != storage.LocalStorage {
+		dependencies = append(dependencies, healthz.MinioDependency(qc.Params.MinioEndPoint, qc.Params.MinioAccessKeyID, qc.Params.MinioSecretAccessKey,
+			qc.Params.MinioUseSSLStr, qc.Params.MinioBucketName))
+	}
+	dependencies = append(dependencies, healthz.Dependency{
+		Name: "msgstream",
+		Check: func(ctx context.Context) error {
+			return msgstream.CheckReachable(ctx, s.msFactory)
+		},
+	})
+	s.healthChecker = healthz.NewChecker(typeutil.QueryCoordRole, s, dependencies...)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -205,6 +229,7 @@ func (s *Server) start() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
 			return err
@@ -275,3 +300,7 @@ func (s *Server) CreateQueryChannel(ctx context.Context, req *querypb.CreateQuer
 func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return s.queryCoord.GetSegmentInfo(ctx, req)
 }
+
+func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return s.queryCoord.GetShardLeaders(ctx, req)
+}
@@ -20,7 +20,6 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -62,7 +61,6 @@ func (c *Client) Init() error {
 
 func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
-		opts := trace.GetInterceptorOpts()
 		log.Debug("QueryNodeClient try connect ", zap.String("address", c.addr))
 		conn, err := grpc.DialContext(c.ctx, c.addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
@@ -75,14 +73,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
 					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
 		if err != nil {
@@ -208,3 +206,24 @@ func (c *Client) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 	})
 	return ret.(*querypb.GetSegmentInfoResponse), err
 }
+
+func (c *Client) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.Search(ctx, req)
+	})
+	return ret.(*internalpb.SearchResults), err
+}
+
+func (c *Client) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.Query(ctx, req)
+	})
+	return ret.(*internalpb.RetrieveResults), err
+}
+
+func (c *Client) GetNodeResources(ctx context.Context, req *querypb.GetNodeResourcesRequest) (*querypb.GetNodeResourcesResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetNodeResources(ctx, req)
+	})
+	return ret.(*querypb.GetNodeResourcesResponse), err
+}
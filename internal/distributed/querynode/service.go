@@ -26,19 +26,23 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	qn "github.com/milvus-io/milvus/internal/querynode"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -58,6 +62,10 @@ type Server struct {
 	rootCoord  *rcc.GrpcClient
 	indexCoord *isc.Client
 
+	msFactory     msgstream.Factory
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -69,6 +77,7 @@ func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error)
 		cancel:      cancel,
 		querynode:   qn.NewQueryNode(ctx, factory),
 		grpcErrChan: make(chan error),
+		msFactory:   factory,
 	}
 	return s, nil
 }
@@ -168,6 +177,7 @@ func (s *Server) init() error {
 	if err := s.querynode.Register(); err != nil {
 		return err
 	}
+	s.etcdCli.Set(s.querynode.EtcdClient())
 	return nil
 }
 
@@ -197,15 +207,30 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		return
 	}
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	querypb.RegisterQueryNodeServer(s.grpcServer, s)
+	dependencies := []healthz.Dependency{healthz.EtcdDependency(&s.etcdCli)}
+	if qn.Params.StorageType != storage.LocalStorage {
+		dependencies = append(dependencies, healthz.MinioDependency(qn.Params.MinioEndPoint, qn.Params.MinioAccessKeyID, qn.Params.MinioSecretAccessKey,
+			qn.Params.MinioUseSSLStr, qn.Params.MinioBucketName))
+	}
+	dependencies = append(dependencies, healthz.Dependency{
+		Name: "msgstream",
+		Check: func(ctx context.Context) error {
+			return msgstream.CheckReachable(ctx, s.msFactory)
+		},
+	})
+	s.healthChecker = healthz.NewChecker(typeutil.QueryNodeRole, s, dependencies...)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
@@ -233,6 +258,7 @@ func (s *Server) Run() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
 			return err
@@ -311,3 +337,15 @@ func (s *Server) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmen
 func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return s.querynode.GetSegmentInfo(ctx, req)
 }
+
+func (s *Server) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	return s.querynode.Search(ctx, req)
+}
+
+func (s *Server) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	return s.querynode.Query(ctx, req)
+}
+
+func (s *Server) GetNodeResources(ctx context.Context, req *querypb.GetNodeResourcesRequest) (*querypb.GetNodeResourcesResponse, error) {
+	return s.querynode.GetNodeResources(ctx, req)
+}
@@ -15,10 +15,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -40,6 +40,8 @@ type GrpcClient struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// mu guards grpcClient, conn and addr, which are replaced on reconnecting
+	mu         sync.RWMutex
 	grpcClient rootcoordpb.RootCoordClient
 	conn       *grpc.ClientConn
 
@@ -91,15 +93,16 @@ func (c *GrpcClient) Init() error {
 
 func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 	var err error
+	var addr string
+	var conn *grpc.ClientConn
 	connectRootCoordAddrFn := func() error {
-		c.addr, err = getRootCoordAddr(c.sess)
+		addr, err = getRootCoordAddr(c.sess)
 		if err != nil {
 			log.Debug("RootCoordClient getRootCoordAddr failed", zap.Error(err))
 			return err
 		}
-		opts := trace.GetInterceptorOpts()
-		log.Debug("RootCoordClient try reconnect ", zap.String("address", c.addr))
-		conn, err := grpc.DialContext(c.ctx, c.addr,
+		log.Debug("RootCoordClient try reconnect ", zap.String("address", addr))
+		conn, err = grpc.DialContext(c.ctx, addr,
 			grpc.WithInsecure(), grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
@@ -110,21 +113,17 @@ func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 						grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.UnaryClientInterceptor(opts...),
+					trace.UnaryClientInterceptor(),
 				)),
 			grpc.WithStreamInterceptor(
 				grpc_middleware.ChainStreamClient(
 					grpc_retry.StreamClientInterceptor(grpc_retry.WithMax(3),
 						grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 					),
-					grpc_opentracing.StreamClientInterceptor(opts...),
+					trace.StreamClientInterceptor(),
 				)),
 		)
-		if err != nil {
-			return err
-		}
-		c.conn = conn
-		return nil
+		return err
 	}
 
 	err = retry.Do(c.ctx, connectRootCoordAddrFn, retryOptions...)
@@ -133,16 +132,49 @@ func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 		return err
 	}
 	log.Debug("RootCoordClient try reconnect success")
-	c.grpcClient = rootcoordpb.NewRootCoordClient(c.conn)
+	c.mu.Lock()
+	oldConn := c.conn
+	c.addr = addr
+	c.conn = conn
+	c.grpcClient = rootcoordpb.NewRootCoordClient(conn)
+	c.mu.Unlock()
+	// the calls on the replaced connection fail and are recalled on the new one
+	if oldConn != nil {
+		oldConn.Close()
+	}
 	return nil
 }
 
+func (c *GrpcClient) getGrpcClient() rootcoordpb.RootCoordClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.grpcClient
+}
+
 func (c *GrpcClient) Start() error {
+	go c.sess.WatchActiveServer(c.ctx, typeutil.RootCoordRole, c.reconnect)
 	return nil
 }
 
+// reconnect connects to the rootcoord which takes over the active one, the address is checked
+// since the client may have reconnected to it already when a call failed
+func (c *GrpcClient) reconnect(address string) {
+	c.mu.RLock()
+	connected := c.addr == address
+	c.mu.RUnlock()
+	if connected {
+		return
+	}
+	log.Debug("RootCoordClient rootcoord changed, reconnect", zap.String("address", address))
+	if err := c.connect(); err != nil {
+		log.Debug("RootCoordClient reconnect failed", zap.Error(err))
+	}
+}
+
 func (c *GrpcClient) Stop() error {
 	c.cancel()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.conn.Close()
 }
 
@@ -171,13 +203,13 @@ func (c *GrpcClient) recall(caller func() (interface{}, error)) (interface{}, er
 // GetComponentStates TODO: timeout need to be propagated through ctx
 func (c *GrpcClient) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+		return c.getGrpcClient().GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
 	})
 	return ret.(*internalpb.ComponentStates), err
 }
 func (c *GrpcClient) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
+		return c.getGrpcClient().GetTimeTickChannel(ctx, &internalpb.GetTimeTickChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
@@ -185,7 +217,7 @@ func (c *GrpcClient) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringRe
 // GetStatisticsChannel just define a channel, not used currently
 func (c *GrpcClient) GetStatisticsChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
+		return c.getGrpcClient().GetStatisticsChannel(ctx, &internalpb.GetStatisticsChannelRequest{})
 	})
 	return ret.(*milvuspb.StringResponse), err
 }
@@ -193,61 +225,61 @@ func (c *GrpcClient) GetStatisticsChannel(ctx context.Context) (*milvuspb.String
 //DDL request
 func (c *GrpcClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateCollection(ctx, in)
+		return c.getGrpcClient().CreateCollection(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropCollection(ctx, in)
+		return c.getGrpcClient().DropCollection(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.HasCollection(ctx, in)
+		return c.getGrpcClient().HasCollection(ctx, in)
 	})
 	return ret.(*milvuspb.BoolResponse), err
 }
 func (c *GrpcClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeCollection(ctx, in)
+		return c.getGrpcClient().DescribeCollection(ctx, in)
 	})
 	return ret.(*milvuspb.DescribeCollectionResponse), err
 }
 
 func (c *GrpcClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowCollections(ctx, in)
+		return c.getGrpcClient().ShowCollections(ctx, in)
 	})
 	return ret.(*milvuspb.ShowCollectionsResponse), err
 }
 func (c *GrpcClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreatePartition(ctx, in)
+		return c.getGrpcClient().CreatePartition(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropPartition(ctx, in)
+		return c.getGrpcClient().DropPartition(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.HasPartition(ctx, in)
+		return c.getGrpcClient().HasPartition(ctx, in)
 	})
 	return ret.(*milvuspb.BoolResponse), err
 }
 
 func (c *GrpcClient) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowPartitions(ctx, in)
+		return c.getGrpcClient().ShowPartitions(ctx, in)
 	})
 	return ret.(*milvuspb.ShowPartitionsResponse), err
 }
@@ -255,21 +287,21 @@ func (c *GrpcClient) ShowPartitions(ctx context.Context, in *milvuspb.ShowPartit
 // CreateIndex index builder service
 func (c *GrpcClient) CreateIndex(ctx context.Context, in *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.CreateIndex(ctx, in)
+		return c.getGrpcClient().CreateIndex(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) DropIndex(ctx context.Context, in *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DropIndex(ctx, in)
+		return c.getGrpcClient().DropIndex(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 
 func (c *GrpcClient) DescribeIndex(ctx context.Context, in *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeIndex(ctx, in)
+		return c.getGrpcClient().DescribeIndex(ctx, in)
 	})
 	return ret.(*milvuspb.DescribeIndexResponse), err
 }
@@ -277,14 +309,14 @@ func (c *GrpcClient) DescribeIndex(ctx context.Context, in *milvuspb.DescribeInd
 // AllocTimestamp global timestamp allocator
 func (c *GrpcClient) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AllocTimestamp(ctx, in)
+		return c.getGrpcClient().AllocTimestamp(ctx, in)
 	})
 	return ret.(*rootcoordpb.AllocTimestampResponse), err
 }
 
 func (c *GrpcClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.AllocID(ctx, in)
+		return c.getGrpcClient().AllocID(ctx, in)
 	})
 	return ret.(*rootcoordpb.AllocIDResponse), err
 }
@@ -292,7 +324,7 @@ func (c *GrpcClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest
 // UpdateChannelTimeTick used to handle ChannelTimeTickMsg
 func (c *GrpcClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.UpdateChannelTimeTick(ctx, in)
+		return c.getGrpcClient().UpdateChannelTimeTick(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
@@ -300,26 +332,26 @@ func (c *GrpcClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.C
 // DescribeSegment receiver time tick from proxy service, and put it into this channel
 func (c *GrpcClient) DescribeSegment(ctx context.Context, in *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.DescribeSegment(ctx, in)
+		return c.getGrpcClient().DescribeSegment(ctx, in)
 	})
 	return ret.(*milvuspb.DescribeSegmentResponse), err
 }
 
 func (c *GrpcClient) ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest) (*milvuspb.ShowSegmentsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ShowSegments(ctx, in)
+		return c.getGrpcClient().ShowSegments(ctx, in)
 	})
 	return ret.(*milvuspb.ShowSegmentsResponse), err
 }
 func (c *GrpcClient) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.ReleaseDQLMessageStream(ctx, in)
+		return c.getGrpcClient().ReleaseDQLMessageStream(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
 func (c *GrpcClient) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
-		return c.grpcClient.SegmentFlushCompleted(ctx, in)
+		return c.getGrpcClient().SegmentFlushCompleted(ctx, in)
 	})
 	return ret.(*commonpb.Status), err
 }
@@ -20,13 +20,15 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	pnc "github.com/milvus-io/milvus/internal/distributed/proxy/client"
 	qsc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -37,8 +39,10 @@ import (
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // Server grpc wrapper
@@ -60,6 +64,10 @@ type Server struct {
 	newDataCoordClient  func(string, []string) types.DataCoord
 	newQueryCoordClient func(string, []string) types.QueryCoord
 
+	msFactory     msgstream.Factory
+	healthChecker *healthz.Checker
+	etcdCli       healthz.EtcdClient
+
 	closer io.Closer
 }
 
@@ -69,6 +77,7 @@ func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error)
 		ctx:         ctx1,
 		cancel:      cancel,
 		grpcErrChan: make(chan error),
+		msFactory:   factory,
 	}
 	s.setClient()
 	var err error
@@ -130,6 +139,7 @@ func (s *Server) init() error {
 	if err != nil {
 		return err
 	}
+	s.etcdCli.Set(s.rootCoord.EtcdClient())
 
 	err = s.startGrpc()
 	if err != nil {
@@ -204,13 +214,27 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
-	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			trace.UnaryServerInterceptor(),
+			logutil.UnaryDebugServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			trace.StreamServerInterceptor(),
+			logutil.StreamDebugServerInterceptor())))
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
+	s.healthChecker = healthz.NewChecker(typeutil.RootCoordRole, s,
+		healthz.EtcdDependency(&s.etcdCli),
+		healthz.Dependency{
+			Name: "msgstream",
+			Check: func(ctx context.Context) error {
+				return msgstream.CheckReachable(ctx, s.msFactory)
+			},
+		},
+	)
+	grpc_health_v1.RegisterHealthServer(s.grpcServer, s.healthChecker)
+	healthz.Register(s.healthChecker)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -227,9 +251,10 @@ func (s *Server) start() error {
 }
 
 func (s *Server) Stop() error {
+	healthz.Unregister(s.healthChecker)
 	if s.closer != nil {
 		if err := s.closer.Close(); err != nil {
-			log.Error("close opentracing", zap.Error(err))
+			log.Error("close tracer", zap.Error(err))
 		}
 	}
 	if s.indexCoord != nil {
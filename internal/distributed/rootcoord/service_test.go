@@ -826,6 +826,10 @@ func (m *mockCore) Register() error {
 	return nil
 }
 
+func (m *mockCore) EtcdClient() *clientv3.Client {
+	return nil
+}
+
 func (m *mockCore) Init() error {
 	return nil
 }
@@ -23,9 +23,9 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/trace"
 
-	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/golang/protobuf/proto"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -96,12 +97,37 @@ func NewIndexCoord(ctx context.Context) (*IndexCoord, error) {
 // Register register index service at etcd
 func (i *IndexCoord) Register() error {
 	i.session = sessionutil.NewSession(i.loopCtx, Params.MetaRootPath, Params.EtcdEndpoints)
+	i.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	i.session.Init(typeutil.IndexCoordRole, Params.Address, true)
 	return nil
 }
 
+// EtcdClient returns the etcd client of the IndexCoord session, nil before it registers
+func (i *IndexCoord) EtcdClient() *clientv3.Client {
+	return i.session.EtcdClient()
+}
+
+// activeStandBy blocks a standby IndexCoord until the active one is down, then only moves the
+// state to Initializing. Init calls it before loading anything, so the meta table and id allocator
+// are loaded by the rest of Init once this IndexCoord is active.
+func (i *IndexCoord) activeStandBy() error {
+	i.UpdateStateCode(internalpb.StateCode_StandBy)
+	log.Debug("IndexCoord enter standby mode", zap.Int64("ServerID", i.session.ServerID))
+	return i.session.ProcessActiveStandBy(func() error {
+		log.Debug("IndexCoord switch from standby to active", zap.Int64("ServerID", i.session.ServerID))
+		i.UpdateStateCode(internalpb.StateCode_Initializing)
+		return nil
+	})
+}
+
 func (i *IndexCoord) Init() error {
 	log.Debug("IndexCoord", zap.Any("etcd endpoints", Params.EtcdEndpoints))
+	if Params.EnableActiveStandby {
+		if err := i.activeStandBy(); err != nil {
+			log.Debug("IndexCoord wait for active failed", zap.Error(err))
+			return err
+		}
+	}
 
 	connectEtcdFn := func() error {
 		etcdClient, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
@@ -165,12 +191,12 @@ func (i *IndexCoord) Init() error {
 		CreateBucket:      true,
 	}
 
-	i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+	i.kv, err = storage.NewObjectStorageKV(i.loopCtx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
-		log.Debug("IndexCoord new minio kv failed", zap.Error(err))
+		log.Debug("IndexCoord new object storage kv failed", zap.Error(err))
 		return err
 	}
-	log.Debug("IndexCoord new minio kv success")
+	log.Debug("IndexCoord new object storage kv success", zap.String("storage type", Params.StorageType))
 
 	i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.kv, i.metaTable)
 	if err != nil {
@@ -270,11 +296,12 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 		zap.Int64("IndexBuildID", req.IndexBuildID),
 		zap.String("IndexName = ", req.IndexName),
 		zap.Int64("IndexID = ", req.IndexID),
+		zap.Int64("FieldID = ", req.FieldID),
 		zap.Strings("DataPath = ", req.DataPaths),
 		zap.Any("TypeParams", req.TypeParams),
 		zap.Any("IndexParams", req.IndexParams))
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	hasIndex, indexBuildID := i.metaTable.HasSameReq(req)
 	if hasIndex {
 		log.Debug("IndexCoord", zap.Int64("hasIndex true", indexBuildID), zap.Strings("data paths", req.DataPaths))
@@ -335,7 +362,7 @@ func (i *IndexCoord) BuildIndex(ctx context.Context, req *indexpb.BuildIndexRequ
 
 func (i *IndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	var (
 		cntNone       = 0
 		cntUnissued   = 0
@@ -374,7 +401,7 @@ func (i *IndexCoord) GetIndexStates(ctx context.Context, req *indexpb.GetIndexSt
 func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	log.Debug("IndexCoord DropIndex", zap.Any("IndexID", req.IndexID))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	ret := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -402,7 +429,7 @@ func (i *IndexCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReques
 func (i *IndexCoord) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
 	log.Debug("IndexCoord GetIndexFilePaths", zap.Int64s("IndexBuildIds", req.IndexBuildIDs))
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "IndexCoord-BuildIndex")
-	defer sp.Finish()
+	defer sp.End()
 	var indexPaths []*indexpb.IndexFilePathInfo = nil
 
 	for _, indexID := range req.IndexBuildIDs {
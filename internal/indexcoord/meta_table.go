@@ -388,6 +388,9 @@ func (mt *metaTable) HasSameReq(req *indexpb.BuildIndexRequest) (bool, UniqueID)
 		if meta.indexMeta.Req.IndexID != req.IndexID {
 			continue
 		}
+		if meta.indexMeta.Req.FieldID != req.FieldID {
+			continue
+		}
 		if meta.indexMeta.Req.IndexName != req.IndexName {
 			continue
 		}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestMetaTable_HasSameReq(t *testing.T) {
+	newReq := func(fieldID UniqueID) *indexpb.BuildIndexRequest {
+		return &indexpb.BuildIndexRequest{
+			IndexBuildID: 1,
+			IndexName:    "_default_idx",
+			IndexID:      10,
+			FieldID:      fieldID,
+			DataPaths:    []string{"path1", "path2"},
+			TypeParams:   []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+			IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}},
+		}
+	}
+	mt := &metaTable{
+		indexBuildID2Meta: map[UniqueID]Meta{
+			1: {indexMeta: &indexpb.IndexMeta{IndexBuildID: 1, Req: newReq(100)}},
+		},
+	}
+
+	has, buildID := mt.HasSameReq(newReq(100))
+	assert.True(t, has)
+	assert.Equal(t, UniqueID(1), buildID)
+
+	// the same index on another vector field is built again
+	has, buildID = mt.HasSameReq(newReq(101))
+	assert.False(t, has)
+	assert.Equal(t, UniqueID(-1), buildID)
+
+	req := newReq(100)
+	req.DataPaths = []string{"path1"}
+	has, _ = mt.HasSameReq(req)
+	assert.False(t, has)
+
+	req = newReq(100)
+	req.IndexParams[0].Value = "HNSW"
+	has, _ = mt.HasSameReq(req)
+	assert.False(t, has)
+}
@@ -39,6 +39,10 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	paramtable.StorageConfig
+
+	EnableActiveStandby bool
+
 	Log log.Config
 }
 
@@ -58,6 +62,8 @@ func (pt *ParamTable) Init() {
 		pt.initMinIOSecretAccessKey()
 		pt.initMinIOUseSSL()
 		pt.initMinioBucketName()
+		pt.InitStorageConfig(&pt.BaseTable)
+		pt.initEnableActiveStandby()
 	})
 }
 
@@ -169,3 +175,14 @@ func (pt *ParamTable) initLogCfg() {
 		pt.Log.File.Filename = ""
 	}
 }
+
+func (pt *ParamTable) initEnableActiveStandby() {
+	enable, err := pt.Load("indexCoord.enableActiveStandby")
+	if err != nil {
+		panic(err)
+	}
+	pt.EnableActiveStandby, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
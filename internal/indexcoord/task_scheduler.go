@@ -23,8 +23,8 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type TaskQueue interface {
@@ -226,11 +226,10 @@ func (sched *TaskScheduler) scheduleIndexAddTask() task {
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.Ctx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-		})
-	defer span.Finish()
-	span.LogFields(oplog.String("scheduler process PreExecute", t.Name()))
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name())))
+	defer span.End()
+	span.AddEvent("scheduler process PreExecute", oteltrace.WithAttributes(attribute.String("Name", t.Name())))
 	err := t.PreExecute(ctx)
 
 	defer func() {
@@ -241,20 +240,20 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.String("scheduler process AddActiveTask", t.Name()))
+	span.AddEvent("scheduler process AddActiveTask", oteltrace.WithAttributes(attribute.String("Name", t.Name())))
 	q.AddActiveTask(t)
 	defer func() {
-		span.LogFields(oplog.String("scheduler process PopActiveTask", t.Name()))
+		span.AddEvent("scheduler process PopActiveTask", oteltrace.WithAttributes(attribute.String("Name", t.Name())))
 		q.PopActiveTask(t.ID())
 	}()
 
-	span.LogFields(oplog.String("scheduler process Execute", t.Name()))
+	span.AddEvent("scheduler process Execute", oteltrace.WithAttributes(attribute.String("Name", t.Name())))
 	err = t.Execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
 		return
 	}
-	span.LogFields(oplog.String("scheduler process PostExecute", t.Name()))
+	span.AddEvent("scheduler process PostExecute", oteltrace.WithAttributes(attribute.String("Name", t.Name())))
 	err = t.PostExecute(ctx)
 }
 
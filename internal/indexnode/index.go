@@ -64,6 +64,11 @@ func (index *CIndex) Serialize() ([]*Blob, error) {
 		return nil, fmt.Errorf("SerializeToSlicedBuffer failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
 	}
 
+	return getCBinaryBlobs(cBinary)
+}
+
+// getCBinaryBlobs unmarshals the serialized index binary set into blobs
+func getCBinaryBlobs(cBinary C.CBinary) ([]*Blob, error) {
 	binarySize := C.GetCBinarySize(cBinary)
 	binaryData := make([]byte, binarySize)
 	C.GetCBinaryData(cBinary, unsafe.Pointer(&binaryData[0]))
@@ -82,13 +87,17 @@ func (index *CIndex) Serialize() ([]*Blob, error) {
 	return ret, nil
 }
 
-func (index *CIndex) Load(blobs []*Blob) error {
+// marshalBlobs marshals blobs into the binary set format loaded by the index builder
+func marshalBlobs(blobs []*Blob) ([]byte, error) {
 	binarySet := &indexcgopb.BinarySet{Datas: make([]*indexcgopb.Binary, 0)}
 	for _, blob := range blobs {
 		binarySet.Datas = append(binarySet.Datas, &indexcgopb.Binary{Key: blob.Key, Value: blob.Value})
 	}
+	return proto.Marshal(binarySet)
+}
 
-	datas, err2 := proto.Marshal(binarySet)
+func (index *CIndex) Load(blobs []*Blob) error {
+	datas, err2 := marshalBlobs(blobs)
 	if err2 != nil {
 		return err2
 	}
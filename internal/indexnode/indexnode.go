@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -88,6 +89,11 @@ func (i *IndexNode) Register() error {
 	return nil
 }
 
+// EtcdClient returns the etcd client of the IndexNode session, nil before it registers
+func (i *IndexNode) EtcdClient() *clientv3.Client {
+	return i.session.EtcdClient()
+}
+
 func (i *IndexNode) Init() error {
 	connectEtcdFn := func() error {
 		etcdClient, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
@@ -109,12 +115,12 @@ func (i *IndexNode) Init() error {
 		BucketName:        Params.MinioBucketName,
 		CreateBucket:      true,
 	}
-	i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+	i.kv, err = storage.NewObjectStorageKV(i.loopCtx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
-		log.Debug("IndexNode NewMinIOKV failed", zap.Error(err))
+		log.Debug("IndexNode NewObjectStorageKV failed", zap.Error(err))
 		return err
 	}
-	log.Debug("IndexNode NewMinIOKV success")
+	log.Debug("IndexNode NewObjectStorageKV success", zap.String("storage type", Params.StorageType))
 	i.closer = trace.InitTracing("index_node")
 
 	i.UpdateStateCode(internalpb.StateCode_Healthy)
@@ -171,7 +177,7 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 		zap.Any("IndexParams", request.IndexParams))
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex")
-	defer sp.Finish()
+	defer sp.End()
 
 	t := &IndexBuildTask{
 		BaseTask: BaseTask{
@@ -47,6 +47,8 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	paramtable.StorageConfig
+
 	Log log.Config
 }
 
@@ -71,6 +73,7 @@ func (pt *ParamTable) initParams() {
 	pt.initMinIOSecretAccessKey()
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
+	pt.InitStorageConfig(&pt.BaseTable)
 	pt.initEtcdEndpoints()
 	pt.initMetaRootPath()
 }
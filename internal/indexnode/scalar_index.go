@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+/*
+
+#cgo CFLAGS: -I${SRCDIR}/../core/output/include
+
+#cgo LDFLAGS: -L${SRCDIR}/../core/output/lib -lmilvus_indexbuilder -Wl,-rpath=${SRCDIR}/../core/output/lib
+
+#include <stdlib.h>	// free
+#include "segcore/collection_c.h"
+#include "indexbuilder/index_c.h"
+
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// ScalarIndex is a sorted or inverted index built on a scalar field
+type ScalarIndex interface {
+	Serialize() ([]*Blob, error)
+	Load([]*Blob) error
+	Build(data storage.FieldData) error
+	Delete() error
+}
+
+type CScalarIndex struct {
+	indexPtr C.CIndex
+	dataType schemapb.DataType
+}
+
+func (index *CScalarIndex) Serialize() ([]*Blob, error) {
+	var cBinary C.CBinary
+
+	status := C.SerializeScalarIndexToSlicedBuffer(index.indexPtr, &cBinary)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return nil, fmt.Errorf("SerializeScalarIndexToSlicedBuffer failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	defer C.DeleteCBinary(cBinary)
+
+	return getCBinaryBlobs(cBinary)
+}
+
+func (index *CScalarIndex) Load(blobs []*Blob) error {
+	datas, err := marshalBlobs(blobs)
+	if err != nil {
+		return err
+	}
+
+	status := C.LoadScalarIndexFromSlicedBuffer(index.indexPtr, (*C.char)(unsafe.Pointer(&datas[0])), (C.int32_t)(len(datas)))
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("LoadScalarIndexFromSlicedBuffer failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
+func (index *CScalarIndex) Build(data storage.FieldData) error {
+	dataType, values, rowNum, err := getScalarFieldData(data)
+	if err != nil {
+		return err
+	}
+	if dataType != index.dataType {
+		return fmt.Errorf("can't build scalar index of %s on %s field data", index.dataType.String(), dataType.String())
+	}
+
+	/*
+		CStatus
+		BuildScalarIndex(CIndex index, int64_t row_num, const void* values);
+	*/
+	status := C.BuildScalarIndex(index.indexPtr, (C.int64_t)(rowNum), values)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return fmt.Errorf("BuildScalarIndex failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+	return nil
+}
+
+func (index *CScalarIndex) Delete() error {
+	C.DeleteScalarIndex(index.indexPtr)
+	return nil
+}
+
+func NewCScalarIndex(indexType string, dataType schemapb.DataType) (ScalarIndex, error) {
+	cIndexType := C.CString(indexType)
+	defer C.free(unsafe.Pointer(cIndexType))
+
+	/*
+		CStatus
+		CreateScalarIndex(const char* index_type, int32_t data_type, CIndex* res_index);
+	*/
+	var indexPtr C.CIndex
+	status := C.CreateScalarIndex(cIndexType, (C.int32_t)(dataType), &indexPtr)
+	errorCode := status.error_code
+	if errorCode != 0 {
+		errorMsg := C.GoString(status.error_msg)
+		defer C.free(unsafe.Pointer(status.error_msg))
+		return nil, fmt.Errorf("CreateScalarIndex failed, C runtime error detected, error code = %d, err msg = %s", errorCode, errorMsg)
+	}
+
+	return &CScalarIndex{
+		indexPtr: indexPtr,
+		dataType: dataType,
+	}, nil
+}
+
+// getScalarFieldData returns the data type, the address of the first value and the number of values of scalar field data
+func getScalarFieldData(data storage.FieldData) (schemapb.DataType, unsafe.Pointer, int, error) {
+	var dataType schemapb.DataType
+	var values unsafe.Pointer
+	var rowNum int
+	switch d := data.(type) {
+	case *storage.BoolFieldData:
+		dataType, rowNum = schemapb.DataType_Bool, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.Int8FieldData:
+		dataType, rowNum = schemapb.DataType_Int8, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.Int16FieldData:
+		dataType, rowNum = schemapb.DataType_Int16, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.Int32FieldData:
+		dataType, rowNum = schemapb.DataType_Int32, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.Int64FieldData:
+		dataType, rowNum = schemapb.DataType_Int64, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.FloatFieldData:
+		dataType, rowNum = schemapb.DataType_Float, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	case *storage.DoubleFieldData:
+		dataType, rowNum = schemapb.DataType_Double, len(d.Data)
+		if rowNum > 0 {
+			values = unsafe.Pointer(&d.Data[0])
+		}
+	default:
+		return schemapb.DataType_None, nil, 0, errors.New("we expect scalar field data to build scalar index")
+	}
+	if rowNum <= 0 {
+		return schemapb.DataType_None, nil, 0, errors.New("null field data to build scalar index")
+	}
+	return dataType, values, rowNum, nil
+}
+
+// buildScalarIndex builds a scalar index of indexType on field data and serializes it
+func buildScalarIndex(indexType string, data storage.FieldData) ([]*Blob, error) {
+	dataType, _, _, err := getScalarFieldData(data)
+	if err != nil {
+		return nil, err
+	}
+	index, err := NewCScalarIndex(indexType, dataType)
+	if err != nil {
+		return nil, err
+	}
+	defer index.Delete()
+
+	err = index.Build(data)
+	if err != nil {
+		return nil, err
+	}
+	return index.Serialize()
+}
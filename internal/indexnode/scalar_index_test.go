@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+)
+
+func generateScalarFieldData() map[schemapb.DataType]storage.FieldData {
+	return map[schemapb.DataType]storage.FieldData{
+		schemapb.DataType_Bool:   &storage.BoolFieldData{Data: []bool{true, false, true}},
+		schemapb.DataType_Int8:   &storage.Int8FieldData{Data: []int8{3, 1, 3}},
+		schemapb.DataType_Int16:  &storage.Int16FieldData{Data: []int16{3, 1, 3}},
+		schemapb.DataType_Int32:  &storage.Int32FieldData{Data: []int32{3, 1, 3}},
+		schemapb.DataType_Int64:  &storage.Int64FieldData{Data: []int64{3, 1, 3}},
+		schemapb.DataType_Float:  &storage.FloatFieldData{Data: []float32{3, 1, 3}},
+		schemapb.DataType_Double: &storage.DoubleFieldData{Data: []float64{3, 1, 3}},
+	}
+}
+
+func TestCScalarIndex_Codec(t *testing.T) {
+	for _, indexType := range []string{indexparamcheck.IndexSorted, indexparamcheck.IndexInverted} {
+		for dataType, data := range generateScalarFieldData() {
+			index, err := NewCScalarIndex(indexType, dataType)
+			assert.NoError(t, err)
+
+			err = index.Build(data)
+			assert.NoError(t, err)
+
+			blobs, err := index.Serialize()
+			assert.NoError(t, err)
+			assert.NotEqual(t, 0, len(blobs))
+
+			copyIndex, err := NewCScalarIndex(indexType, dataType)
+			assert.NoError(t, err)
+			err = copyIndex.Load(blobs)
+			assert.NoError(t, err)
+			copyBlobs, err := copyIndex.Serialize()
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, blobs, copyBlobs)
+
+			err = index.Delete()
+			assert.NoError(t, err)
+			err = copyIndex.Delete()
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestCScalarIndex_Build(t *testing.T) {
+	index, err := NewCScalarIndex(indexparamcheck.IndexSorted, schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	defer index.Delete()
+
+	err = index.Build(&storage.Int32FieldData{Data: []int32{1, 2}})
+	assert.Error(t, err)
+
+	err = index.Build(&storage.Int64FieldData{Data: []int64{}})
+	assert.Error(t, err)
+
+	err = index.Build(&storage.FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2})
+	assert.Error(t, err)
+}
+
+func TestNewCScalarIndex(t *testing.T) {
+	_, err := NewCScalarIndex(indexparamcheck.IndexFaissIvfFlat, schemapb.DataType_Int64)
+	assert.Error(t, err)
+
+	_, err = NewCScalarIndex(indexparamcheck.IndexSorted, schemapb.DataType_FloatVector)
+	assert.Error(t, err)
+}
+
+func TestBuildScalarIndex(t *testing.T) {
+	blobs, err := buildScalarIndex(indexparamcheck.IndexInverted, &storage.Int64FieldData{Data: []int64{3, 1, 3}})
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, len(blobs))
+
+	_, err = buildScalarIndex(indexparamcheck.IndexInverted, &storage.StringFieldData{Data: []string{"a"}})
+	assert.Error(t, err)
+}
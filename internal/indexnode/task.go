@@ -24,10 +24,12 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -173,14 +175,14 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 func (it *IndexBuildTask) PreExecute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask preExecute...")
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-PreExecute")
-	defer sp.Finish()
+	defer sp.End()
 	return it.checkIndexMeta(ctx, true)
 }
 
 func (it *IndexBuildTask) PostExecute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask PostExecute...")
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-PostExecute")
-	defer sp.Finish()
+	defer sp.End()
 
 	return it.checkIndexMeta(ctx, false)
 }
@@ -188,7 +190,7 @@ func (it *IndexBuildTask) PostExecute(ctx context.Context) error {
 func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	log.Debug("IndexNode IndexBuildTask Execute ...")
 	sp, _ := trace.StartSpanFromContextWithOperationName(ctx, "CreateIndex-Execute")
-	defer sp.Finish()
+	defer sp.End()
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("IndexBuildTask %d", it.req.IndexBuildID))
 	var err error
 
@@ -232,17 +234,21 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	it.index, err = NewCIndex(typeParams, indexParams)
-	if err != nil {
-		log.Error("IndexNode IndexBuildTask Execute NewCIndex failed", zap.Error(err))
-		return err
-	}
-	defer func() {
-		err = it.index.Delete()
+	indexType := indexParams["index_type"]
+	isScalarIndex := indexparamcheck.IsScalarIndexType(indexType)
+	if !isScalarIndex {
+		it.index, err = NewCIndex(typeParams, indexParams)
 		if err != nil {
-			log.Warn("IndexNode IndexBuildTask Execute CIndexDelete Failed", zap.Error(err))
+			log.Error("IndexNode IndexBuildTask Execute NewCIndex failed", zap.Error(err))
+			return err
 		}
-	}()
+		defer func() {
+			err = it.index.Delete()
+			if err != nil {
+				log.Warn("IndexNode IndexBuildTask Execute CIndexDelete Failed", zap.Error(err))
+			}
+		}()
+	}
 
 	getKeyByPathNaive := func(path string) string {
 		// splitElements := strings.Split(path, "/")
@@ -305,35 +311,45 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 	tr.Record("deserialize storage blobs done")
 
 	for _, value := range insertData.Data {
-		// TODO: BinaryVectorFieldData
-		floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
-		if fOk {
-			err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+		var indexBlobs []*Blob
+		if isScalarIndex {
+			indexBlobs, err = buildScalarIndex(indexType, value)
 			if err != nil {
-				log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+				log.Error("IndexNode build scalar index failed", zap.Error(err))
 				return err
 			}
-			tr.Record("build float vector index done")
-		}
+			tr.Record("build scalar index done")
+		} else {
+			// TODO: BinaryVectorFieldData
+			floatVectorFieldData, fOk := value.(*storage.FloatVectorFieldData)
+			if fOk {
+				err = it.index.BuildFloatVecIndexWithoutIds(floatVectorFieldData.Data)
+				if err != nil {
+					log.Error("IndexNode BuildFloatVecIndexWithoutIds failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build float vector index done")
+			}
 
-		binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
-		if bOk {
-			err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
-			if err != nil {
-				log.Error("IndexNode BuildBinaryVecIndexWithoutIds failed", zap.Error(err))
-				return err
+			binaryVectorFieldData, bOk := value.(*storage.BinaryVectorFieldData)
+			if bOk {
+				err = it.index.BuildBinaryVecIndexWithoutIds(binaryVectorFieldData.Data)
+				if err != nil {
+					log.Error("IndexNode BuildBinaryVecIndexWithoutIds failed", zap.Error(err))
+					return err
+				}
+				tr.Record("build binary vector index done")
 			}
-			tr.Record("build binary vector index done")
-		}
 
-		if !fOk && !bOk {
-			return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
-		}
+			if !fOk && !bOk {
+				return errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
+			}
 
-		indexBlobs, err := it.index.Serialize()
-		if err != nil {
-			log.Error("IndexNode index Serialize failed", zap.Error(err))
-			return err
+			indexBlobs, err = it.index.Serialize()
+			if err != nil {
+				log.Error("IndexNode index Serialize failed", zap.Error(err))
+				return err
+			}
 		}
 		tr.Record("serialize index done")
 
@@ -396,6 +412,6 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		tr.Record("save index file done")
 	}
 	log.Debug("IndexNode CreateIndex finished")
-	tr.Elapse("all done")
+	metrics.IndexNodeBuildIndexLatency.WithLabelValues(strconv.FormatInt(Params.NodeID, 10), indexParams["index_type"]).Observe(tr.Elapse("all done").Seconds())
 	return nil
 }
@@ -15,15 +15,17 @@ import (
 	"container/list"
 	"context"
 	"errors"
+	"strconv"
 	"sync"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type TaskQueue interface {
@@ -73,6 +75,7 @@ func (queue *BaseTaskQueue) addUnissuedTask(t task) error {
 		return errors.New("IndexNode task queue is full")
 	}
 	queue.unissuedTasks.PushBack(t)
+	metrics.IndexNodeTaskQueueLen.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Set(float64(queue.unissuedTasks.Len()))
 	queue.utBufChan <- 1
 	return nil
 }
@@ -100,6 +103,7 @@ func (queue *BaseTaskQueue) PopUnissuedTask() task {
 
 	ft := queue.unissuedTasks.Front()
 	queue.unissuedTasks.Remove(ft)
+	metrics.IndexNodeTaskQueueLen.WithLabelValues(strconv.FormatInt(Params.NodeID, 10)).Set(float64(queue.unissuedTasks.Len()))
 
 	return ft.Value.(task)
 }
@@ -221,18 +225,17 @@ func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.Ctx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-			"ID":   t.ID(),
-		})
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name()),
+			attribute.Int64("ID", t.ID())))
 
-	defer span.Finish()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	defer span.End()
+	span.AddEvent("scheduler process PreExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	err := t.PreExecute(ctx)
 	t.SetError(err)
 
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
+		span.AddEvent("scheduler process PostExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 		err := t.PostExecute(ctx)
 		t.SetError(err)
 	}()
@@ -242,17 +245,17 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
+	span.AddEvent("scheduler process AddActiveTask", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	q.AddActiveTask(t)
 
 	// log.Printf("task add to active list ...")
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
+		span.AddEvent("scheduler process PopActiveTask", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 		q.PopActiveTask(t.ID())
 		// log.Printf("pop from active list ...")
 	}()
 
-	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
+	span.AddEvent("scheduler process Execute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	err = t.Execute(ctx)
 	t.SetError(err)
 }
@@ -62,7 +62,7 @@ func InitLoggerWithWriteSyncer(cfg *Config, output zapcore.WriteSyncer, opts ...
 	if err != nil {
 		return nil, nil, fmt.Errorf("InitLoggerWithWriteSyncer UnmarshalText cfg.Level err:%w", err)
 	}
-	core := NewTextCore(newZapTextEncoder(cfg), output, level)
+	core := newLevelCore(NewTextCore(newZapTextEncoder(cfg), output, level), level)
 	opts = append(cfg.buildOptions(output), opts...)
 	lg := zap.New(core, opts...)
 	r := &ZapProperties{
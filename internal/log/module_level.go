@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package log
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// DebugFlagKey is the gRPC metadata key and the trace baggage key which asks
+// every component to log the request at debug level, whatever the log level is
+const DebugFlagKey = "milvus-debug"
+
+// moduleLevels keeps the log levels of modules, a module is a package directory
+// under internal, such as "querynode" or "util/rocksmq", which also covers its sub packages
+var moduleLevels = struct {
+	mu     sync.RWMutex
+	levels map[string]zapcore.Level
+	// count is read without the lock, so the logs are not slowed down if no module level is set
+	count int32
+	// minLevel is the lowest module level, only valid when count is positive
+	minLevel int32
+}{levels: make(map[string]zapcore.Level)}
+
+// SetModuleLevel sets the log level of the module, which overrides the global level
+func SetModuleLevel(module string, l zapcore.Level) {
+	module = strings.Trim(module, "/")
+	moduleLevels.mu.Lock()
+	defer moduleLevels.mu.Unlock()
+	moduleLevels.levels[module] = l
+	updateModuleLevels()
+}
+
+// RemoveModuleLevel makes the module use the global log level again
+func RemoveModuleLevel(module string) {
+	module = strings.Trim(module, "/")
+	moduleLevels.mu.Lock()
+	defer moduleLevels.mu.Unlock()
+	delete(moduleLevels.levels, module)
+	updateModuleLevels()
+}
+
+// GetModuleLevels returns the log levels of all modules which have their own level
+func GetModuleLevels() map[string]zapcore.Level {
+	moduleLevels.mu.RLock()
+	defer moduleLevels.mu.RUnlock()
+	ret := make(map[string]zapcore.Level, len(moduleLevels.levels))
+	for module, l := range moduleLevels.levels {
+		ret[module] = l
+	}
+	return ret
+}
+
+// updateModuleLevels must be called with moduleLevels.mu held
+func updateModuleLevels() {
+	minLevel := zapcore.FatalLevel
+	for _, l := range moduleLevels.levels {
+		if l < minLevel {
+			minLevel = l
+		}
+	}
+	atomic.StoreInt32(&moduleLevels.minLevel, int32(minLevel))
+	atomic.StoreInt32(&moduleLevels.count, int32(len(moduleLevels.levels)))
+}
+
+// moduleOf returns the module of the source file, which is its directory under internal
+func moduleOf(file string) string {
+	idx := strings.LastIndex(file, "/internal/")
+	if idx < 0 {
+		return ""
+	}
+	return path.Dir(file[idx+len("/internal/"):])
+}
+
+// moduleLevel returns the level of the longest module which contains the source file
+func moduleLevel(file string) (zapcore.Level, bool) {
+	dir := moduleOf(file)
+	if dir == "" {
+		return zapcore.InfoLevel, false
+	}
+	moduleLevels.mu.RLock()
+	defer moduleLevels.mu.RUnlock()
+	for {
+		if l, ok := moduleLevels.levels[dir]; ok {
+			return l, true
+		}
+		idx := strings.LastIndex(dir, "/")
+		if idx < 0 {
+			return zapcore.InfoLevel, false
+		}
+		dir = dir[:idx]
+	}
+}
+
+// levelCore filters the entries by the global level and the module levels,
+// the entries are always written if debug is set
+type levelCore struct {
+	zapcore.Core
+	level zap.AtomicLevel
+	debug bool
+}
+
+func newLevelCore(core zapcore.Core, level zap.AtomicLevel) *levelCore {
+	return &levelCore{
+		Core:  core,
+		level: level,
+	}
+}
+
+func (c *levelCore) Enabled(l zapcore.Level) bool {
+	if c.debug || c.level.Enabled(l) {
+		return true
+	}
+	return atomic.LoadInt32(&moduleLevels.count) > 0 && l >= zapcore.Level(atomic.LoadInt32(&moduleLevels.minLevel))
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{
+		Core:  c.Core.With(fields),
+		level: c.level,
+		debug: c.debug,
+	}
+}
+
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write filters the entry by the level of its module, the caller is only known here
+func (c *levelCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if !c.enabledEntry(ent) {
+		return nil
+	}
+	return c.Core.Write(ent, fields)
+}
+
+func (c *levelCore) enabledEntry(ent zapcore.Entry) bool {
+	if c.debug {
+		return true
+	}
+	if atomic.LoadInt32(&moduleLevels.count) == 0 || !ent.Caller.Defined {
+		return c.level.Enabled(ent.Level)
+	}
+	if l, ok := moduleLevel(ent.Caller.File); ok {
+		return l.Enabled(ent.Level)
+	}
+	return c.level.Enabled(ent.Level)
+}
+
+// debugCore returns a copy of the core which writes the entries at every level
+func (c *levelCore) debugCore() *levelCore {
+	return &levelCore{
+		Core:  c.Core,
+		level: c.level,
+		debug: true,
+	}
+}
+
+type debugCtxKey struct{}
+
+// WithDebug marks the request of ctx to be logged at debug level by Ctx
+func WithDebug(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugCtxKey{}, true)
+}
+
+// IsDebug returns whether the request of ctx asks for debug logs, either by
+// WithDebug or by the DebugFlagKey baggage propagated with the trace
+func IsDebug(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if debug, ok := ctx.Value(debugCtxKey{}).(bool); ok && debug {
+		return true
+	}
+	return baggage.FromContext(ctx).Member(DebugFlagKey).Value() == "true"
+}
+
+// Ctx returns the global logger, which writes the logs at every level if
+// the request of ctx asks for debug logs
+func Ctx(ctx context.Context) *zap.Logger {
+	if !IsDebug(ctx) {
+		return L()
+	}
+	core, ok := _globalP.Load().(*ZapProperties).Core.(*levelCore)
+	if !ok {
+		return L()
+	}
+	return L().WithOptions(zap.WrapCore(func(zapcore.Core) zapcore.Core {
+		return core.debugCore()
+	}))
+}
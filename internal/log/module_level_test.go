@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func newTestLevelCore(t *testing.T, level string) (*levelCore, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	_, props, err := InitLoggerWithWriteSyncer(&Config{Level: level}, zapcore.AddSync(buf))
+	assert.Nil(t, err)
+	return props.Core.(*levelCore), buf
+}
+
+func testEntry(l zapcore.Level, file string) zapcore.Entry {
+	return zapcore.Entry{
+		Level:   l,
+		Message: "test message",
+		Caller:  zapcore.NewEntryCaller(0, file, 1, true),
+	}
+}
+
+func TestModuleOf(t *testing.T) {
+	assert.Equal(t, "querynode", moduleOf("/go/src/milvus/internal/querynode/impl.go"))
+	assert.Equal(t, "util/rocksmq/server", moduleOf("/go/src/milvus/internal/util/rocksmq/server/rocksmq.go"))
+	assert.Equal(t, "", moduleOf("/go/src/milvus/cmd/main.go"))
+}
+
+func TestSetModuleLevel(t *testing.T) {
+	SetModuleLevel("/querynode/", zapcore.DebugLevel)
+	SetModuleLevel("util", zapcore.ErrorLevel)
+	defer RemoveModuleLevel("util")
+	assert.Equal(t, map[string]zapcore.Level{
+		"querynode": zapcore.DebugLevel,
+		"util":      zapcore.ErrorLevel,
+	}, GetModuleLevels())
+
+	// the longest module containing the file wins, and covers the sub packages
+	l, ok := moduleLevel("/milvus/internal/querynode/impl.go")
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.DebugLevel, l)
+	l, ok = moduleLevel("/milvus/internal/util/rocksmq/server/rocksmq.go")
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.ErrorLevel, l)
+	SetModuleLevel("util/rocksmq", zapcore.WarnLevel)
+	l, ok = moduleLevel("/milvus/internal/util/rocksmq/server/rocksmq.go")
+	assert.True(t, ok)
+	assert.Equal(t, zapcore.WarnLevel, l)
+	RemoveModuleLevel("util/rocksmq")
+
+	_, ok = moduleLevel("/milvus/internal/datanode/data_node.go")
+	assert.False(t, ok)
+
+	RemoveModuleLevel("querynode")
+	assert.Equal(t, map[string]zapcore.Level{"util": zapcore.ErrorLevel}, GetModuleLevels())
+	_, ok = moduleLevel("/milvus/internal/querynode/impl.go")
+	assert.False(t, ok)
+}
+
+func TestLevelCore(t *testing.T) {
+	core, buf := newTestLevelCore(t, "info")
+	queryNodeFile := "/milvus/internal/querynode/impl.go"
+	dataNodeFile := "/milvus/internal/datanode/data_node.go"
+
+	// only the global level without module levels
+	assert.False(t, core.Enabled(zapcore.DebugLevel))
+	assert.True(t, core.Enabled(zapcore.InfoLevel))
+	assert.Nil(t, core.Write(testEntry(zapcore.DebugLevel, queryNodeFile), nil))
+	assert.Equal(t, 0, buf.Len())
+	assert.Nil(t, core.Write(testEntry(zapcore.InfoLevel, queryNodeFile), nil))
+	assert.NotEqual(t, 0, buf.Len())
+	buf.Reset()
+
+	// a lower module level enables the level, then the entries are filtered by their module
+	SetModuleLevel("querynode", zapcore.DebugLevel)
+	SetModuleLevel("datanode", zapcore.ErrorLevel)
+	defer RemoveModuleLevel("querynode")
+	defer RemoveModuleLevel("datanode")
+	assert.True(t, core.Enabled(zapcore.DebugLevel))
+	assert.Nil(t, core.Write(testEntry(zapcore.DebugLevel, queryNodeFile), nil))
+	assert.NotEqual(t, 0, buf.Len())
+	buf.Reset()
+	assert.Nil(t, core.Write(testEntry(zapcore.WarnLevel, dataNodeFile), nil))
+	assert.Equal(t, 0, buf.Len())
+	assert.Nil(t, core.Write(testEntry(zapcore.ErrorLevel, dataNodeFile), nil))
+	assert.NotEqual(t, 0, buf.Len())
+	buf.Reset()
+	// the files out of any module use the global level
+	assert.Nil(t, core.Write(testEntry(zapcore.DebugLevel, "/milvus/internal/proxy/impl.go"), nil))
+	assert.Equal(t, 0, buf.Len())
+
+	// With keeps the filtering
+	withCore := core.With([]zapcore.Field{zap.String("key", "value")})
+	assert.Nil(t, withCore.Write(testEntry(zapcore.WarnLevel, dataNodeFile), nil))
+	assert.Equal(t, 0, buf.Len())
+
+	// the debug core writes every entry
+	debugCore := core.debugCore()
+	assert.True(t, debugCore.Enabled(zapcore.DebugLevel))
+	assert.Nil(t, debugCore.Write(testEntry(zapcore.DebugLevel, dataNodeFile), nil))
+	assert.NotEqual(t, 0, buf.Len())
+}
+
+func TestCtx(t *testing.T) {
+	assert.False(t, IsDebug(context.Background()))
+	assert.True(t, IsDebug(WithDebug(context.Background())))
+
+	member, err := baggage.NewMember(DebugFlagKey, "true")
+	assert.Nil(t, err)
+	bag, err := baggage.New(member)
+	assert.Nil(t, err)
+	assert.True(t, IsDebug(baggage.ContextWithBaggage(context.Background(), bag)))
+
+	conf := &Config{Level: "info"}
+	logger, props, err := InitLogger(conf)
+	assert.Nil(t, err)
+	oldLogger, oldProps := L(), _globalP.Load().(*ZapProperties)
+	ReplaceGlobals(logger, props)
+	defer ReplaceGlobals(oldLogger, oldProps)
+
+	assert.False(t, Ctx(context.Background()).Core().Enabled(zapcore.DebugLevel))
+	assert.True(t, Ctx(WithDebug(context.Background())).Core().Enabled(zapcore.DebugLevel))
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package logutil
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.opentelemetry.io/otel/baggage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryDebugServerInterceptor marks the request to be logged at debug level by log.Ctx
+// if its metadata carries log.DebugFlagKey, the flag is also put into the trace baggage,
+// so that it follows the request to the other components.
+func UnaryDebugServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withDebugFlag(ctx), req)
+	}
+}
+
+// StreamDebugServerInterceptor is the stream version of UnaryDebugServerInterceptor
+func StreamDebugServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withDebugFlag(ss.Context())
+		if ctx == ss.Context() {
+			return handler(srv, ss)
+		}
+		return handler(srv, &debugServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type debugServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *debugServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func withDebugFlag(ctx context.Context) context.Context {
+	if log.IsDebug(ctx) {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(log.DebugFlagKey)
+	if len(values) == 0 || values[0] != "true" {
+		return ctx
+	}
+	if member, err := baggage.NewMember(log.DebugFlagKey, "true"); err == nil {
+		if bag, err := baggage.FromContext(ctx).SetMember(member); err == nil {
+			ctx = baggage.ContextWithBaggage(ctx, bag)
+		}
+	}
+	return log.WithDebug(ctx)
+}
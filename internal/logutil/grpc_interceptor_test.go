@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package logutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func debugContext(debug string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(log.DebugFlagKey, debug))
+}
+
+func TestUnaryDebugServerInterceptor(t *testing.T) {
+	oldLevel := log.GetLevel()
+	defer log.SetLevel(oldLevel)
+	log.SetLevel(zapcore.InfoLevel)
+
+	interceptor := UnaryDebugServerInterceptor()
+	var handlerCtx context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCtx = ctx
+		return req, nil
+	}
+
+	// only the marked request is logged at debug level
+	resp, err := interceptor(debugContext("true"), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+	assert.True(t, log.IsDebug(handlerCtx))
+	assert.True(t, log.Ctx(handlerCtx).Core().Enabled(zapcore.DebugLevel))
+	// the flag follows the request to the other components by the trace baggage
+	assert.Equal(t, "true", baggage.FromContext(handlerCtx).Member(log.DebugFlagKey).Value())
+
+	unmarkedCtxs := []context.Context{
+		context.Background(),
+		debugContext("false"),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "true")),
+	}
+	for _, ctx := range unmarkedCtxs {
+		_, err = interceptor(ctx, "req", &grpc.UnaryServerInfo{}, handler)
+		assert.Nil(t, err)
+		assert.False(t, log.IsDebug(handlerCtx))
+		assert.False(t, log.Ctx(handlerCtx).Core().Enabled(zapcore.DebugLevel))
+	}
+	// the global level is never changed
+	assert.Equal(t, zapcore.InfoLevel, log.GetLevel())
+}
+
+func TestStreamDebugServerInterceptor(t *testing.T) {
+	interceptor := StreamDebugServerInterceptor()
+	var handlerCtx context.Context
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCtx = stream.Context()
+		return nil
+	}
+
+	err := interceptor(nil, &mockServerStream{ctx: debugContext("true")}, &grpc.StreamServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.True(t, log.IsDebug(handlerCtx))
+
+	err = interceptor(nil, &mockServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.False(t, log.IsDebug(handlerCtx))
+}
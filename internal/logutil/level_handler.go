@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package logutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogLevelRoute is the http route to get and set the log levels
+const LogLevelRoute = "/log/level"
+
+// logLevelResponse is the body of the responses of the log level handler
+type logLevelResponse struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// logLevelRequest is the body to set the log level, the global level is set if
+// Module is empty, otherwise the level of the module, and an empty Level of a module
+// makes it use the global level again
+type logLevelRequest struct {
+	Module string `json:"module"`
+	Level  string `json:"level"`
+}
+
+// LogLevelHandler returns the http handler which gets the log levels by GET,
+// and sets a log level by PUT or POST with a json body such as
+// {"module": "querynode", "level": "debug"}
+func LogLevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeLogLevels(w, http.StatusOK, nil)
+		case http.MethodPut, http.MethodPost:
+			var req logLevelRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeLogLevels(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+				return
+			}
+			if err := setLogLevel(req); err != nil {
+				writeLogLevels(w, http.StatusBadRequest, err)
+				return
+			}
+			log.Info("log level changed", zap.String("module", req.Module), zap.String("level", req.Level))
+			writeLogLevels(w, http.StatusOK, nil)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			writeLogLevels(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		}
+	})
+}
+
+func setLogLevel(req logLevelRequest) error {
+	if req.Module != "" && req.Level == "" {
+		log.RemoveModuleLevel(req.Module)
+		return nil
+	}
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(req.Level)); err != nil {
+		return fmt.Errorf("invalid log level %q", req.Level)
+	}
+	if req.Module == "" {
+		log.SetLevel(l)
+	} else {
+		log.SetModuleLevel(req.Module, l)
+	}
+	return nil
+}
+
+func writeLogLevels(w http.ResponseWriter, code int, err error) {
+	resp := logLevelResponse{
+		Level:   log.GetLevel().String(),
+		Modules: make(map[string]string),
+	}
+	for module, l := range log.GetModuleLevels() {
+		resp.Modules[module] = l.String()
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warn("failed to write log levels", zap.Error(err))
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package logutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func doLogLevelRequest(t *testing.T, method string, body string) (int, logLevelResponse) {
+	req := httptest.NewRequest(method, LogLevelRoute, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	LogLevelHandler().ServeHTTP(w, req)
+
+	var resp logLevelResponse
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	return w.Code, resp
+}
+
+func TestLogLevelHandler(t *testing.T) {
+	oldLevel := log.GetLevel()
+	defer log.SetLevel(oldLevel)
+	log.SetLevel(zapcore.InfoLevel)
+
+	code, resp := doLogLevelRequest(t, http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "info", resp.Level)
+	assert.Empty(t, resp.Modules)
+
+	// the global level
+	code, resp = doLogLevelRequest(t, http.MethodPut, `{"level": "warn"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "warn", resp.Level)
+	assert.Equal(t, zapcore.WarnLevel, log.GetLevel())
+
+	// the level of a module
+	code, resp = doLogLevelRequest(t, http.MethodPost, `{"module": "querynode", "level": "debug"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "warn", resp.Level)
+	assert.Equal(t, map[string]string{"querynode": "debug"}, resp.Modules)
+
+	code, resp = doLogLevelRequest(t, http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"querynode": "debug"}, resp.Modules)
+
+	// an empty level makes the module use the global level again
+	code, resp = doLogLevelRequest(t, http.MethodPut, `{"module": "querynode"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, resp.Modules)
+	assert.Empty(t, log.GetModuleLevels())
+
+	// bad requests don't change the levels
+	badBodies := []string{
+		`{"level": "verbose"}`,
+		`{"module": "querynode", "level": "verbose"}`,
+		`{"level": }`,
+		``,
+	}
+	for _, body := range badBodies {
+		code, resp = doLogLevelRequest(t, http.MethodPut, body)
+		assert.Equal(t, http.StatusBadRequest, code, body)
+		assert.NotEmpty(t, resp.Error, body)
+		assert.Equal(t, "warn", resp.Level, body)
+		assert.Empty(t, resp.Modules, body)
+	}
+
+	code, resp = doLogLevelRequest(t, http.MethodDelete, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	assert.NotEmpty(t, resp.Error)
+}
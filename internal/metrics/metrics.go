@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/util/healthz"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
@@ -16,6 +18,8 @@ const (
 	subSystemDataCoord = "dataCoord"
 	subSystemDataNode  = "dataNode"
 	subSystemProxy     = "proxy"
+	subSystemQueryNode = "queryNode"
+	subSystemIndexNode = "indexNode"
 )
 
 /*
@@ -588,9 +592,71 @@ func RegisterQueryCoord() {
 
 }
 
+var (
+	// QueryNodeNumSegments records the num of loaded segments
+	QueryNodeNumSegments = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "num_segments",
+			Help:      "Number of loaded segments",
+		}, []string{"node_id", "segment_type"})
+
+	// QueryNodeNumRows records the num of rows in the loaded segments
+	QueryNodeNumRows = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "num_rows",
+			Help:      "Number of rows in the loaded segments",
+		}, []string{"node_id", "segment_type"})
+
+	// QueryNodeSegmentMemorySize records the memory used by the loaded segments
+	QueryNodeSegmentMemorySize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "segment_memory_bytes",
+			Help:      "Memory size of the loaded segments in bytes",
+		}, []string{"node_id", "segment_type"})
+
+	// QueryNodeSearchLatency records the time spent in every stage of the searches
+	QueryNodeSearchLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "search_latency_seconds",
+			Help:      "Latency of every stage of the searches",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 16), // 0.5ms ~ 16s
+		}, []string{"node_id", "stage"})
+
+	// QueryNodeTSafeLag records how far the tSafe of every dml channel falls behind the wall clock
+	QueryNodeTSafeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "tsafe_lag_seconds",
+			Help:      "Lag of tSafe behind the wall clock",
+		}, []string{"node_id", "channel"})
+
+	// QueryNodeUnsolvedMsgLen records the num of query messages waiting for tSafe
+	QueryNodeUnsolvedMsgLen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemQueryNode,
+			Name:      "unsolved_msg_len",
+			Help:      "Length of the unsolved query message queue",
+		}, []string{"node_id", "collection_id"})
+)
+
 //RegisterQueryNode register QueryNode metrics
 func RegisterQueryNode() {
-
+	prometheus.MustRegister(QueryNodeNumSegments)
+	prometheus.MustRegister(QueryNodeNumRows)
+	prometheus.MustRegister(QueryNodeSegmentMemorySize)
+	prometheus.MustRegister(QueryNodeSearchLatency)
+	prometheus.MustRegister(QueryNodeTSafeLag)
+	prometheus.MustRegister(QueryNodeUnsolvedMsgLen)
 }
 
 var (
@@ -603,11 +669,41 @@ var (
 			Help:      "List of data nodes registered within etcd",
 		}, []string{"status"},
 	)
+
+	// DataCoordNumSegments records the num of segments in every state
+	DataCoordNumSegments = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "num_segments",
+			Help:      "Number of segments",
+		}, []string{"state"})
+
+	// DataCoordAllocatedRowsCounter counts the rows allocated to the segments, whose rate is the insert rate
+	DataCoordAllocatedRowsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "allocated_rows_total",
+			Help:      "Counter of rows allocated to segments",
+		}, []string{"collection_id"})
+
+	// DataCoordAssignSegmentCounter used to count the num of segment allocations
+	DataCoordAssignSegmentCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "assign_segment_total",
+			Help:      "Counter of segment allocations",
+		}, []string{"status"})
 )
 
 //RegisterDataCoord register DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.Register(DataCoordDataNodeList)
+	prometheus.Register(DataCoordNumSegments)
+	prometheus.Register(DataCoordAllocatedRowsCounter)
+	prometheus.Register(DataCoordAssignSegmentCounter)
 }
 
 var (
@@ -628,12 +724,43 @@ var (
 			Name:      "watch_dm_channels_total",
 			Help:      "Counter of watch dm channel",
 		}, []string{"type"})
+
+	// DataNodeInsertBufferRows records the num of rows buffered in the insert buffer of every dml channel
+	DataNodeInsertBufferRows = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "insert_buffer_rows",
+			Help:      "Number of rows in the insert buffer",
+		}, []string{"node_id", "channel"})
+
+	// DataNodeFlushLatency records the time spent in flushing a segment to the object storage
+	DataNodeFlushLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "flush_latency_seconds",
+			Help:      "Latency of flushing segments",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14), // 10ms ~ 80s
+		}, []string{"node_id", "status"})
+
+	// DataNodeFlushedBytesCounter counts the bytes of binlogs flushed to the object storage
+	DataNodeFlushedBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "flushed_bytes_total",
+			Help:      "Counter of flushed binlog bytes",
+		}, []string{"node_id"})
 )
 
 //RegisterDataNode register DataNode metrics
 func RegisterDataNode() {
 	prometheus.Register(DataNodeFlushSegmentsCounter)
 	prometheus.Register(DataNodeWatchDmChannelsCounter)
+	prometheus.Register(DataNodeInsertBufferRows)
+	prometheus.Register(DataNodeFlushLatency)
+	prometheus.Register(DataNodeFlushedBytesCounter)
 }
 
 //RegisterIndexCoord register IndexCoord metrics
@@ -641,9 +768,31 @@ func RegisterIndexCoord() {
 
 }
 
+var (
+	// IndexNodeBuildIndexLatency records the time spent in building and saving an index
+	IndexNodeBuildIndexLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemIndexNode,
+			Name:      "build_index_latency_seconds",
+			Help:      "Latency of building indexes",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14), // 100ms ~ 820s
+		}, []string{"node_id", "index_type"})
+
+	// IndexNodeTaskQueueLen records the num of index build tasks waiting to be scheduled
+	IndexNodeTaskQueueLen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemIndexNode,
+			Name:      "task_queue_len",
+			Help:      "Length of the index build task queue",
+		}, []string{"node_id"})
+)
+
 //RegisterIndexNode register IndexNode metrics
 func RegisterIndexNode() {
-
+	prometheus.MustRegister(IndexNodeBuildIndexLatency)
+	prometheus.MustRegister(IndexNodeTaskQueueLen)
 }
 
 //RegisterMsgStreamCoord register MsgStreamCoord metrics
@@ -651,9 +800,12 @@ func RegisterMsgStreamCoord() {
 
 }
 
-//ServeHTTP serve prometheus http service
+//ServeHTTP serve prometheus http service, and the log level handler
 func ServeHTTP() {
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle(logutil.LogLevelRoute, logutil.LogLevelHandler())
+	http.Handle(healthz.LivenessRoute, healthz.LivenessHandler())
+	http.Handle(healthz.ReadinessRoute, healthz.ReadinessHandler())
 	go func() {
 		if err := http.ListenAndServe(":9091", nil); err != nil {
 			log.Error("handle metrics failed", zap.Error(err))
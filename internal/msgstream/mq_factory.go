@@ -13,6 +13,10 @@ package msgstream
 
 import (
 	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/milvus-io/milvus/internal/log"
@@ -106,6 +110,53 @@ func (f *RmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
 	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.RmqBufSize, rmqClient, f.dispatcherFactory.NewUnmarshalDispatcher())
 }
 
+type KmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	brokers           []string
+	// the following members must be public, so that mapstructure.Decode() can access them
+	ReceiveBufSize int64
+	KafkaBufSize   int64
+}
+
+func (f *KmsFactory) SetParams(params map[string]interface{}) error {
+	err := mapstructure.Decode(params, f)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := mqclient.GetKafkaClientInstance(f.brokers)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := mqclient.GetKafkaClientInstance(f.brokers)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+func NewKmsFactory(brokers []string) Factory {
+	f := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		brokers:           brokers,
+		ReceiveBufSize:    64,
+		KafkaBufSize:      64,
+	}
+	log.Debug("KafkaBrokers=" + strings.Join(brokers, ","))
+	return f
+}
+
 func NewRmsFactory(rocksmqPath string) Factory {
 	f := &RmsFactory{
 		dispatcherFactory: ProtoUDFactory{},
@@ -117,3 +168,40 @@ func NewRmsFactory(rocksmqPath string) Factory {
 	rocksmqserver.InitRocksMQ(rocksmqPath)
 	return f
 }
+
+// CheckReachable checks whether the message queue behind the factory is reachable
+func CheckReachable(ctx context.Context, factory Factory) error {
+	switch f := factory.(type) {
+	case *PmsFactory:
+		u, err := url.Parse(f.PulsarAddress)
+		if err != nil {
+			return err
+		}
+		return dial(ctx, u.Host)
+	case *KmsFactory:
+		if len(f.brokers) == 0 {
+			return errors.New("no kafka brokers configured")
+		}
+		var err error
+		for _, broker := range f.brokers {
+			if err = dial(ctx, broker); err == nil {
+				return nil
+			}
+		}
+		return err
+	case *RmsFactory:
+		if rocksmqserver.Rmq == nil {
+			return errors.New("rocksmq is not initialized")
+		}
+	}
+	return nil
+}
+
+func dial(ctx context.Context, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
 
@@ -241,17 +240,17 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 
 			msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
-			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+			trace.InjectContextToMsgProperties(spanCtx, msg.Properties)
 
 			if err := ms.producers[channel].Send(
 				spanCtx,
 				msg,
 			); err != nil {
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return err
 			}
-			sp.Finish()
+			sp.End()
 		}
 	}
 	return nil
@@ -277,7 +276,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 
 		msg := &mqclient.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
-		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+		trace.InjectContextToMsgProperties(spanCtx, msg.Properties)
 
 		ms.producerLock.Lock()
 		for _, producer := range ms.producers {
@@ -286,12 +285,12 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 				msg,
 			); err != nil {
 				trace.LogError(sp, err)
-				sp.Finish()
+				sp.End()
 				return err
 			}
 		}
 		ms.producerLock.Unlock()
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
@@ -358,9 +357,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqclient.Consumer) {
 				Timestamp:   tsMsg.BeginTs(),
 			})
 
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
+			sp, ctx, ok := ExtractFromMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				tsMsg.SetTraceCtx(ctx)
 			}
 
 			msgPack := MsgPack{
@@ -370,7 +369,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqclient.Consumer) {
 			}
 			ms.receiveBuf <- &msgPack
 
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
@@ -651,9 +650,9 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqclient.Consumer) {
 				continue
 			}
 
-			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
+			sp, ctx, ok := ExtractFromMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				tsMsg.SetTraceCtx(ctx)
 			}
 
 			ms.chanMsgBufMutex.Lock()
@@ -664,10 +663,10 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqclient.Consumer) {
 				ms.chanTtMsgTimeMutex.Lock()
 				ms.chanTtMsgTime[consumer] = tsMsg.(*TimeTickMsg).Base.Timestamp
 				ms.chanTtMsgTimeMutex.Unlock()
-				sp.Finish()
+				sp.End()
 				return
 			}
-			sp.Finish()
+			sp.End()
 		}
 	}
 }
@@ -13,67 +13,44 @@ package msgstream
 
 import (
 	"context"
-	"errors"
-	"runtime"
+	"fmt"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
-	"github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
-func ExtractFromPulsarMsgProperties(msg TsMsg, properties map[string]string) (opentracing.Span, bool) {
+func msgAttributes(msg TsMsg) oteltrace.SpanStartOption {
+	return oteltrace.WithAttributes(
+		attribute.Int64("ID", msg.ID()),
+		attribute.String("Type", msg.Type().String()),
+		attribute.String("HashKeys", fmt.Sprint(msg.HashKeys())),
+		attribute.String("Position", fmt.Sprint(msg.Position())))
+}
+
+// ExtractFromMsgProperties starts the span to receive msg, which continues the trace written
+// into the message properties by the producer, false is returned if msg is not traced
+func ExtractFromMsgProperties(msg TsMsg, properties map[string]string) (trace.Span, context.Context, bool) {
 	if !allowTrace(msg) {
-		return trace.NoopSpan(), false
+		return trace.NoopSpan(), nil, false
 	}
-	tracer := opentracing.GlobalTracer()
-	sc, _ := tracer.Extract(opentracing.TextMap, trace.PropertiesReaderWriter{PpMap: properties})
-	name := "receive pulsar msg"
-	opts := []opentracing.StartSpanOption{
-		ext.RPCServerOption(sc),
-		opentracing.Tags{
-			"ID":       msg.ID(),
-			"Type":     msg.Type(),
-			"HashKeys": msg.HashKeys(),
-			"Position": msg.Position(),
-		}}
-	return opentracing.StartSpan(name, opts...), true
+	ctx := trace.ExtractContextFromMsgProperties(context.Background(), properties)
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "receive msg",
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer), msgAttributes(msg))
+	return sp, ctx, true
 }
 
-func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+// MsgSpanFromCtx starts the span to send msg, whose context should be injected into the message properties
+func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...oteltrace.SpanStartOption) (trace.Span, context.Context) {
 	if ctx == nil {
 		return trace.NoopSpan(), ctx
 	}
 	if !allowTrace(msg) {
 		return trace.NoopSpan(), ctx
 	}
-	operationName := "send pulsar msg"
-	opts = append(opts, opentracing.Tags{
-		"ID":       msg.ID(),
-		"Type":     msg.Type(),
-		"HashKeys": msg.HashKeys(),
-		"Position": msg.Position(),
-	})
-
-	var pcs [1]uintptr
-	n := runtime.Callers(2, pcs[:])
-	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
-		return span, ctx
-	}
-	file, line := runtime.FuncForPC(pcs[0]).FileLine(pcs[0])
-
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
-	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
-
-	span.LogFields(log.String("filename", file), log.Int("line", line))
-
-	return span, ctx
+	opts = append(opts, oteltrace.WithSpanKind(oteltrace.SpanKindProducer), msgAttributes(msg))
+	return trace.StartSpanFromContextWithOperationName(ctx, "send msg", opts...)
 }
 
 func allowTrace(in interface{}) bool {
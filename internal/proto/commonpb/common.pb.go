@@ -488,6 +488,70 @@ func (m *KeyValuePair) GetValue() string {
 	return ""
 }
 
+// StageCost is the time spent in one stage of a request on a node
+type StageCost struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Stage                string   `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	CostUs               int64    `protobuf:"varint,4,opt,name=cost_us,json=costUs,proto3" json:"cost_us,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StageCost) Reset()         { *m = StageCost{} }
+func (m *StageCost) String() string { return proto.CompactTextString(m) }
+func (*StageCost) ProtoMessage()    {}
+func (*StageCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{2}
+}
+
+func (m *StageCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StageCost.Unmarshal(m, b)
+}
+func (m *StageCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StageCost.Marshal(b, m, deterministic)
+}
+func (m *StageCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageCost.Merge(m, src)
+}
+func (m *StageCost) XXX_Size() int {
+	return xxx_messageInfo_StageCost.Size(m)
+}
+func (m *StageCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageCost proto.InternalMessageInfo
+
+func (m *StageCost) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *StageCost) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *StageCost) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *StageCost) GetCostUs() int64 {
+	if m != nil {
+		return m.CostUs
+	}
+	return 0
+}
+
 type Blob struct {
 	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -499,7 +563,7 @@ func (m *Blob) Reset()         { *m = Blob{} }
 func (m *Blob) String() string { return proto.CompactTextString(m) }
 func (*Blob) ProtoMessage()    {}
 func (*Blob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{2}
+	return fileDescriptor_555bd8c177793206, []int{3}
 }
 
 func (m *Blob) XXX_Unmarshal(b []byte) error {
@@ -539,7 +603,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{3}
+	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -588,7 +652,7 @@ func (m *MsgBase) Reset()         { *m = MsgBase{} }
 func (m *MsgBase) String() string { return proto.CompactTextString(m) }
 func (*MsgBase) ProtoMessage()    {}
 func (*MsgBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{4}
+	return fileDescriptor_555bd8c177793206, []int{5}
 }
 
 func (m *MsgBase) XXX_Unmarshal(b []byte) error {
@@ -649,7 +713,7 @@ func (m *MsgHeader) Reset()         { *m = MsgHeader{} }
 func (m *MsgHeader) String() string { return proto.CompactTextString(m) }
 func (*MsgHeader) ProtoMessage()    {}
 func (*MsgHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{5}
+	return fileDescriptor_555bd8c177793206, []int{6}
 }
 
 func (m *MsgHeader) XXX_Unmarshal(b []byte) error {
@@ -685,6 +749,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
+	proto.RegisterType((*StageCost)(nil), "milvus.proto.common.StageCost")
 	proto.RegisterType((*Blob)(nil), "milvus.proto.common.Blob")
 	proto.RegisterType((*Address)(nil), "milvus.proto.common.Address")
 	proto.RegisterType((*MsgBase)(nil), "milvus.proto.common.MsgBase")
@@ -694,86 +759,89 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0x44, 0xd9, 0x32, 0xc7, 0xb2, 0x3d, 0x1e, 0x2f, 0x71, 0xf2, 0x1b, 0x3f, 0x02, 0x5f,
-	0x05, 0x06, 0x62, 0xb7, 0x0d, 0xda, 0x5e, 0xe5, 0x22, 0x16, 0xbd, 0x08, 0x89, 0x97, 0x52, 0x4e,
-	0x5a, 0xf4, 0x26, 0x18, 0x93, 0x47, 0xd2, 0x34, 0xe4, 0x8c, 0x3a, 0x33, 0x74, 0xac, 0xfb, 0x3e,
-	0x40, 0x9b, 0xe7, 0x68, 0x8b, 0xee, 0x2d, 0xfa, 0x04, 0xdd, 0xaf, 0xfb, 0x08, 0x7d, 0x80, 0xae,
-	0x59, 0x8b, 0x33, 0xa4, 0x24, 0x06, 0x48, 0xef, 0x78, 0xbe, 0xb3, 0x7d, 0xf3, 0x9d, 0x39, 0x43,
-	0xd2, 0x88, 0x54, 0x9a, 0x2a, 0xb9, 0xd9, 0xd7, 0xca, 0x2a, 0xb6, 0x98, 0x8a, 0xe4, 0x2c, 0x33,
-	0xb9, 0xb5, 0x99, 0xbb, 0xd6, 0xef, 0x92, 0xa9, 0xb6, 0xe5, 0x36, 0x33, 0xec, 0x3a, 0x21, 0xa0,
-	0xb5, 0xd2, 0x77, 0x23, 0x15, 0xc3, 0x6a, 0xe5, 0x72, 0xe5, 0xca, 0xdc, 0x2b, 0xff, 0xdf, 0x7c,
-	0x41, 0xce, 0xe6, 0x0e, 0x86, 0x35, 0x55, 0x0c, 0xa1, 0x0f, 0xc3, 0x4f, 0xb6, 0x42, 0xa6, 0x34,
-	0x70, 0xa3, 0xe4, 0x6a, 0xf5, 0x72, 0xe5, 0x8a, 0x1f, 0x16, 0xd6, 0xfa, 0x6b, 0xa4, 0x71, 0x13,
-	0x06, 0x77, 0x78, 0x92, 0xc1, 0x31, 0x17, 0x9a, 0x51, 0xe2, 0xdd, 0x83, 0x81, 0xab, 0xef, 0x87,
-	0xf8, 0xc9, 0x96, 0xc8, 0xe4, 0x19, 0xba, 0x8b, 0xc4, 0xdc, 0x58, 0x5f, 0x23, 0xb5, 0xed, 0x44,
-	0x9d, 0x8e, 0xbd, 0x98, 0xd1, 0x18, 0x7a, 0xaf, 0x92, 0xfa, 0x8d, 0x38, 0xd6, 0x60, 0x0c, 0x9b,
-	0x23, 0x55, 0xd1, 0x2f, 0xea, 0x55, 0x45, 0x9f, 0x31, 0x52, 0xeb, 0x2b, 0x6d, 0x5d, 0x35, 0x2f,
-	0x74, 0xdf, 0xeb, 0x0f, 0x2a, 0xa4, 0x7e, 0x60, 0xba, 0xdb, 0xdc, 0x00, 0x7b, 0x9d, 0x4c, 0xa7,
-	0xa6, 0x7b, 0xd7, 0x0e, 0xfa, 0xc3, 0x53, 0xae, 0xbd, 0xf0, 0x94, 0x07, 0xa6, 0x7b, 0x32, 0xe8,
-	0x43, 0x58, 0x4f, 0xf3, 0x0f, 0x64, 0x92, 0x9a, 0x6e, 0x2b, 0x28, 0x2a, 0xe7, 0x06, 0x5b, 0x23,
-	0xbe, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xfe, 0xaa, 0x77, 0xb9, 0x72, 0xa5, 0x16, 0x8e, 0x01, 0x76,
-	0x89, 0x4c, 0x1b, 0x95, 0xe9, 0x08, 0x5a, 0xc1, 0x6a, 0xcd, 0xa5, 0x8d, 0xec, 0xf5, 0xeb, 0xc4,
-	0x3f, 0x30, 0xdd, 0x7d, 0xe0, 0x31, 0x68, 0xf6, 0x12, 0xa9, 0x9d, 0x72, 0x93, 0x33, 0x9a, 0xf9,
-	0x6f, 0x46, 0x78, 0x82, 0xd0, 0x45, 0x6e, 0x7c, 0x5b, 0x23, 0xfe, 0x68, 0x12, 0x6c, 0x86, 0xd4,
-	0xdb, 0x59, 0x14, 0x81, 0x31, 0x74, 0x82, 0x2d, 0x92, 0xf9, 0xdb, 0x12, 0xce, 0xfb, 0x10, 0x59,
-	0x88, 0x5d, 0x0c, 0xad, 0xb0, 0x05, 0x32, 0xdb, 0x54, 0x52, 0x42, 0x64, 0x77, 0xb9, 0x48, 0x20,
-	0xa6, 0x55, 0xb6, 0x44, 0xe8, 0x31, 0xe8, 0x54, 0x18, 0x23, 0x94, 0x0c, 0x40, 0x0a, 0x88, 0xa9,
-	0xc7, 0x2e, 0x90, 0xc5, 0xa6, 0x4a, 0x12, 0x88, 0xac, 0x50, 0xf2, 0x50, 0xd9, 0x9d, 0x73, 0x61,
-	0xac, 0xa1, 0x35, 0x2c, 0xdb, 0x4a, 0x12, 0xe8, 0xf2, 0xe4, 0x86, 0xee, 0x66, 0x29, 0x48, 0x4b,
-	0x27, 0xb1, 0x46, 0x01, 0x06, 0x22, 0x05, 0x89, 0x95, 0x68, 0xbd, 0x84, 0xb6, 0x64, 0x0c, 0xe7,
-	0xa8, 0x1f, 0x9d, 0x66, 0x17, 0xc9, 0x72, 0x81, 0x96, 0x1a, 0xf0, 0x14, 0xa8, 0xcf, 0xe6, 0xc9,
-	0x4c, 0xe1, 0x3a, 0x39, 0x3a, 0xbe, 0x49, 0x49, 0xa9, 0x42, 0xa8, 0xee, 0x87, 0x10, 0x29, 0x1d,
-	0xd3, 0x99, 0x12, 0x85, 0x3b, 0x10, 0x59, 0xa5, 0x5b, 0x01, 0x6d, 0x20, 0xe1, 0x02, 0x6c, 0x03,
-	0xd7, 0x51, 0x2f, 0x04, 0x93, 0x25, 0x96, 0xce, 0x32, 0x4a, 0x1a, 0xbb, 0x22, 0x81, 0x43, 0x65,
-	0x77, 0x55, 0x26, 0x63, 0x3a, 0xc7, 0xe6, 0x08, 0x39, 0x00, 0xcb, 0x0b, 0x05, 0xe6, 0xb1, 0x6d,
-	0x93, 0x47, 0x3d, 0x28, 0x00, 0xca, 0x56, 0x08, 0x6b, 0x72, 0x29, 0x95, 0x6d, 0x6a, 0xe0, 0x16,
-	0x76, 0x55, 0x12, 0x83, 0xa6, 0x0b, 0x48, 0xe7, 0x39, 0x5c, 0x24, 0x40, 0xd9, 0x38, 0x3a, 0x80,
-	0x04, 0x46, 0xd1, 0x8b, 0xe3, 0xe8, 0x02, 0xc7, 0xe8, 0x25, 0x24, 0xbf, 0x9d, 0x89, 0x24, 0x76,
-	0x92, 0xe4, 0x63, 0x59, 0x46, 0x8e, 0x05, 0xf9, 0xc3, 0x5b, 0xad, 0xf6, 0x09, 0x5d, 0x61, 0xcb,
-	0x64, 0xa1, 0x40, 0x0e, 0xc0, 0x6a, 0x11, 0x39, 0xf1, 0x2e, 0x20, 0xd5, 0xa3, 0xcc, 0x1e, 0x75,
-	0x0e, 0x20, 0x55, 0x7a, 0x40, 0x57, 0x71, 0xa0, 0xae, 0xd2, 0x70, 0x44, 0xf4, 0x22, 0x76, 0xd8,
-	0x49, 0xfb, 0x76, 0x30, 0x96, 0x97, 0x5e, 0x62, 0x8c, 0xcc, 0x06, 0x41, 0x08, 0xef, 0x66, 0x60,
-	0x6c, 0xc8, 0x23, 0xa0, 0xbf, 0xd5, 0x37, 0xde, 0x22, 0xc4, 0xe5, 0xe2, 0xee, 0x03, 0x63, 0x64,
-	0x6e, 0x6c, 0x1d, 0x2a, 0x09, 0x74, 0x82, 0x35, 0xc8, 0xf4, 0x6d, 0x29, 0x8c, 0xc9, 0x20, 0xa6,
-	0x15, 0xd4, 0xad, 0x25, 0x8f, 0xb5, 0xea, 0xe2, 0xca, 0xd1, 0x2a, 0x7a, 0x77, 0x85, 0x14, 0xa6,
-	0xe7, 0x6e, 0x0c, 0x21, 0x53, 0x85, 0x80, 0xb5, 0x8d, 0x0e, 0x69, 0xb4, 0xa1, 0x8b, 0x97, 0x23,
-	0xaf, 0xbd, 0x44, 0x68, 0xd9, 0x1e, 0x57, 0x1f, 0xd1, 0xae, 0xe0, 0xe5, 0xdd, 0xd3, 0xea, 0xbe,
-	0x90, 0x5d, 0x5a, 0xc5, 0x62, 0x6d, 0xe0, 0x89, 0x2b, 0x3c, 0x43, 0xea, 0xbb, 0x49, 0xe6, 0xba,
-	0xd4, 0x5c, 0x4f, 0x34, 0x30, 0x6c, 0x72, 0xe3, 0xbd, 0x69, 0xb7, 0xd2, 0x6e, 0x33, 0x67, 0x89,
-	0x7f, 0x5b, 0xc6, 0xd0, 0x11, 0x12, 0x62, 0x3a, 0xe1, 0xd4, 0x77, 0x53, 0x2a, 0xc9, 0x10, 0xe3,
-	0x21, 0x03, 0xad, 0xfa, 0x25, 0x0c, 0x50, 0xc2, 0x7d, 0x6e, 0x4a, 0x50, 0x07, 0x47, 0x1a, 0x80,
-	0x89, 0xb4, 0x38, 0x2d, 0xa7, 0x77, 0x51, 0xda, 0x76, 0x4f, 0xdd, 0x1f, 0x63, 0x86, 0xf6, 0xb0,
-	0xd3, 0x1e, 0xd8, 0xf6, 0xc0, 0x58, 0x48, 0x9b, 0x4a, 0x76, 0x44, 0xd7, 0x50, 0x81, 0x9d, 0x6e,
-	0x29, 0x1e, 0x97, 0xd2, 0xdf, 0xc1, 0xa1, 0x86, 0x90, 0x00, 0x37, 0xe5, 0xaa, 0xf7, 0xd8, 0x12,
-	0x99, 0xcf, 0xa9, 0x1e, 0x73, 0x6d, 0x85, 0x03, 0xbf, 0xab, 0xb8, 0x89, 0x69, 0xd5, 0x1f, 0x63,
-	0xdf, 0xe3, 0xfa, 0x36, 0xf6, 0xb9, 0x19, 0x43, 0x3f, 0x54, 0xd8, 0x0a, 0x59, 0x18, 0x52, 0x1d,
-	0xe3, 0x3f, 0x56, 0xd8, 0x22, 0x99, 0x43, 0xaa, 0x23, 0xcc, 0xd0, 0x9f, 0x1c, 0x88, 0xa4, 0x4a,
-	0xe0, 0xcf, 0xae, 0x42, 0xc1, 0xaa, 0x84, 0xff, 0xe2, 0x9a, 0x61, 0x85, 0x62, 0x70, 0x86, 0x3e,
-	0xac, 0x20, 0xd3, 0x61, 0xb3, 0x02, 0xa6, 0x8f, 0x5c, 0x20, 0x56, 0x1d, 0x05, 0x3e, 0x76, 0x81,
-	0x45, 0xcd, 0x11, 0xfa, 0xc4, 0xa1, 0xfb, 0x5c, 0xc6, 0xaa, 0xd3, 0x19, 0xa1, 0x4f, 0x2b, 0x6c,
-	0x95, 0x2c, 0x62, 0xfa, 0x36, 0x4f, 0xb8, 0x8c, 0xc6, 0xf1, 0xcf, 0x2a, 0x8c, 0x92, 0x99, 0x5c,
-	0x18, 0x77, 0x31, 0xe9, 0x87, 0x55, 0x27, 0x4a, 0x41, 0x20, 0xc7, 0x3e, 0xaa, 0xb2, 0x39, 0xe2,
-	0xa3, 0x50, 0xb9, 0xfd, 0x71, 0x95, 0xcd, 0x90, 0xa9, 0x96, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x2f,
-	0xcf, 0x54, 0xbe, 0x7e, 0xf4, 0x03, 0xbc, 0xa2, 0x93, 0xee, 0xf2, 0xd0, 0x07, 0xce, 0x91, 0x3f,
-	0x14, 0xf4, 0x77, 0xcf, 0x1d, 0xb5, 0xfc, 0x6a, 0xfc, 0xe1, 0x61, 0xa7, 0x3d, 0xb0, 0xe3, 0x8d,
-	0xa0, 0x7f, 0x7a, 0xec, 0x12, 0x59, 0x1e, 0x62, 0x6e, 0x87, 0x47, 0xbb, 0xf0, 0x97, 0xc7, 0xd6,
-	0xc8, 0x85, 0x3d, 0xb0, 0xe3, 0xb9, 0x62, 0x92, 0x30, 0x56, 0x44, 0x86, 0xfe, 0xed, 0xb1, 0xff,
-	0x91, 0x95, 0x3d, 0xb0, 0x23, 0x7d, 0x4b, 0xce, 0x7f, 0x3c, 0x36, 0x4b, 0xa6, 0x43, 0x5c, 0x72,
-	0x38, 0x03, 0xfa, 0xd0, 0xc3, 0x21, 0x0d, 0xcd, 0x82, 0xce, 0x23, 0x0f, 0xa5, 0x7b, 0x93, 0xdb,
-	0xa8, 0x17, 0xa4, 0xcd, 0x1e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0x7b, 0x6c, 0x99, 0xd0, 0x10, 0x52,
-	0x75, 0x06, 0x25, 0xf8, 0x09, 0x3e, 0xde, 0xcc, 0x05, 0xbf, 0x91, 0x81, 0x1e, 0x8c, 0x1c, 0x4f,
-	0x3d, 0x94, 0x3a, 0x8f, 0x7f, 0xde, 0xf3, 0xcc, 0x43, 0xa9, 0x0b, 0xe5, 0x5b, 0xb2, 0xa3, 0xe8,
-	0xaf, 0x35, 0x64, 0x75, 0x22, 0x52, 0x38, 0x11, 0xd1, 0x3d, 0xfa, 0x89, 0x8f, 0xac, 0x5c, 0xd2,
-	0xa1, 0x8a, 0x01, 0xe9, 0x1b, 0xfa, 0xa9, 0x8f, 0xd2, 0xe3, 0xe8, 0x72, 0xe9, 0x3f, 0x73, 0x76,
-	0xf1, 0xc6, 0xb4, 0x02, 0xfa, 0x39, 0x3e, 0xe8, 0xa4, 0xb0, 0x4f, 0xda, 0x47, 0xf4, 0x0b, 0x1f,
-	0x8f, 0x71, 0x23, 0x49, 0x54, 0xc4, 0xed, 0xe8, 0x02, 0x7d, 0xe9, 0xe3, 0x0d, 0x2c, 0x3d, 0x0f,
-	0x85, 0x30, 0x5f, 0xf9, 0x78, 0xbc, 0x02, 0x77, 0x63, 0x0b, 0xf0, 0xd9, 0xf8, 0xda, 0x55, 0x0d,
-	0xb8, 0xe5, 0xc8, 0xe4, 0xc4, 0xd2, 0x6f, 0xfc, 0x8d, 0x75, 0x52, 0x0f, 0x4c, 0xe2, 0x5e, 0x81,
-	0x3a, 0xf1, 0x02, 0x93, 0xd0, 0x09, 0x7c, 0xac, 0xb6, 0x95, 0x4a, 0x76, 0xce, 0xfb, 0xfa, 0xce,
-	0xcb, 0xb4, 0xb2, 0xfd, 0xea, 0xdb, 0xd7, 0xba, 0xc2, 0xf6, 0xb2, 0x53, 0xfc, 0x91, 0x6e, 0xe5,
-	0x7f, 0xd6, 0xab, 0x42, 0x15, 0x5f, 0x5b, 0x42, 0x5a, 0xd0, 0x92, 0x27, 0x5b, 0xee, 0x67, 0xbb,
-	0x95, 0xff, 0x6c, 0xfb, 0xa7, 0xa7, 0x53, 0xce, 0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x24, 0x61, 0xe5, 0x46, 0x09, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x72, 0x1b, 0x37,
+	0x16, 0x15, 0xd9, 0x94, 0xa8, 0xbe, 0xa2, 0x24, 0x08, 0x5a, 0xed, 0x51, 0x4d, 0xb9, 0xf4, 0xe4,
+	0x52, 0x95, 0xa5, 0x99, 0x71, 0xcd, 0xcc, 0x93, 0x1f, 0x2c, 0x52, 0x0b, 0xcb, 0xd6, 0x32, 0xa4,
+	0xe4, 0x99, 0x9a, 0x17, 0x15, 0xd4, 0x7d, 0x49, 0x62, 0xdc, 0x0d, 0x70, 0x1a, 0x68, 0x59, 0x7c,
+	0xcf, 0x07, 0x24, 0xfe, 0x8e, 0x24, 0x95, 0x3d, 0xa9, 0x7c, 0x41, 0xf6, 0xe7, 0x7c, 0x42, 0x3e,
+	0x20, 0xab, 0xd7, 0xd4, 0x45, 0x37, 0xc9, 0x76, 0x95, 0xf3, 0x86, 0x7b, 0x70, 0x97, 0x83, 0x73,
+	0x81, 0x0b, 0xa8, 0x05, 0x3a, 0x8e, 0xb5, 0xda, 0xea, 0x27, 0xda, 0x6a, 0xbe, 0x18, 0xcb, 0xe8,
+	0x32, 0x35, 0x99, 0xb5, 0x95, 0x6d, 0x6d, 0x9c, 0xc3, 0x54, 0xdb, 0x0a, 0x9b, 0x1a, 0x7e, 0x07,
+	0x00, 0x93, 0x44, 0x27, 0xe7, 0x81, 0x0e, 0x71, 0xad, 0x74, 0xa3, 0x74, 0x73, 0xee, 0x6f, 0x7f,
+	0xde, 0x7a, 0x4d, 0xcc, 0xd6, 0x2e, 0xb9, 0xd5, 0x75, 0x88, 0x2d, 0x1f, 0x87, 0x4b, 0xbe, 0x02,
+	0x53, 0x09, 0x0a, 0xa3, 0xd5, 0x5a, 0xf9, 0x46, 0xe9, 0xa6, 0xdf, 0xca, 0xad, 0x8d, 0x7f, 0x40,
+	0xed, 0x1e, 0x0e, 0x1e, 0x88, 0x28, 0xc5, 0x13, 0x21, 0x13, 0xce, 0xc0, 0x7b, 0x88, 0x03, 0x97,
+	0xdf, 0x6f, 0xd1, 0x92, 0x2f, 0xc1, 0xe4, 0x25, 0x6d, 0xe7, 0x81, 0x99, 0xb1, 0xd1, 0x01, 0xbf,
+	0x6d, 0x45, 0x17, 0xeb, 0xda, 0x58, 0xce, 0xa1, 0x92, 0xe8, 0x08, 0xf3, 0x28, 0xb7, 0xa6, 0x82,
+	0x4a, 0x87, 0xd8, 0x6c, 0xb8, 0x38, 0xaf, 0x95, 0x5b, 0x94, 0xce, 0x50, 0xe0, 0x9a, 0x97, 0xa5,
+	0x73, 0x06, 0x5f, 0x85, 0x6a, 0xa0, 0x8d, 0x3d, 0x4f, 0xcd, 0x5a, 0x25, 0x73, 0x27, 0xf3, 0xcc,
+	0x6c, 0xac, 0x43, 0x65, 0x27, 0xd2, 0x17, 0x63, 0x16, 0x54, 0xa3, 0x36, 0x64, 0x71, 0x0b, 0xaa,
+	0x77, 0xc3, 0x30, 0x41, 0x63, 0xf8, 0x1c, 0x94, 0x65, 0x3f, 0x67, 0x50, 0x96, 0x7d, 0xe2, 0xd4,
+	0xd7, 0x89, 0xcd, 0xab, 0xbb, 0xf5, 0xc6, 0xe3, 0x12, 0x54, 0x0f, 0x4d, 0x77, 0x47, 0x18, 0xe4,
+	0xff, 0x84, 0xe9, 0xd8, 0x74, 0xcf, 0xed, 0xa0, 0x3f, 0x54, 0x73, 0xfd, 0xb5, 0x6a, 0x1e, 0x9a,
+	0xee, 0xe9, 0xa0, 0x8f, 0xad, 0x6a, 0x9c, 0x2d, 0x88, 0x49, 0x6c, 0xba, 0xa3, 0x73, 0x65, 0x06,
+	0x5f, 0x07, 0xdf, 0xca, 0x18, 0x8d, 0x15, 0x71, 0xdf, 0x1d, 0xad, 0xd2, 0x1a, 0x03, 0xfc, 0x3a,
+	0x4c, 0x1b, 0x9d, 0x26, 0x01, 0xc9, 0x91, 0x9d, 0x6f, 0x64, 0x6f, 0xdc, 0x01, 0xff, 0xd0, 0x74,
+	0x0f, 0x50, 0x84, 0x98, 0xf0, 0xbf, 0x40, 0xe5, 0x42, 0x98, 0x8c, 0xd1, 0xcc, 0x1f, 0x33, 0xa2,
+	0x13, 0xb4, 0x9c, 0xe7, 0xe6, 0xe7, 0x15, 0xf0, 0x47, 0x1d, 0xe7, 0x33, 0x50, 0x6d, 0xa7, 0x41,
+	0x80, 0xc6, 0xb0, 0x09, 0xbe, 0x08, 0xf3, 0x67, 0x0a, 0xaf, 0xfa, 0x18, 0x58, 0x0c, 0x9d, 0x0f,
+	0x2b, 0xf1, 0x05, 0x98, 0xad, 0x6b, 0xa5, 0x30, 0xb0, 0x7b, 0x42, 0x46, 0x18, 0xb2, 0x32, 0x5f,
+	0x02, 0x76, 0x82, 0x49, 0x2c, 0x8d, 0x91, 0x5a, 0x35, 0x50, 0x49, 0x0c, 0x99, 0xc7, 0x57, 0x61,
+	0xb1, 0xae, 0xa3, 0x08, 0x03, 0x2b, 0xb5, 0x3a, 0xd2, 0x76, 0xf7, 0x4a, 0x1a, 0x6b, 0x58, 0x85,
+	0xd2, 0x36, 0xa3, 0x08, 0xbb, 0x22, 0xba, 0x9b, 0x74, 0xd3, 0x18, 0x95, 0x65, 0x93, 0x94, 0x23,
+	0x07, 0x1b, 0x32, 0x46, 0x45, 0x99, 0x58, 0xb5, 0x80, 0x36, 0x55, 0x88, 0x57, 0xa4, 0x1f, 0x9b,
+	0xe6, 0xd7, 0x60, 0x39, 0x47, 0x0b, 0x05, 0x44, 0x8c, 0xcc, 0xe7, 0xf3, 0x30, 0x93, 0x6f, 0x9d,
+	0x1e, 0x9f, 0xdc, 0x63, 0x50, 0xc8, 0xd0, 0xd2, 0x8f, 0x5a, 0x18, 0xe8, 0x24, 0x64, 0x33, 0x05,
+	0x0a, 0x0f, 0x30, 0xb0, 0x3a, 0x69, 0x36, 0x58, 0x8d, 0x08, 0xe7, 0x60, 0x1b, 0x45, 0x12, 0xf4,
+	0x5a, 0x68, 0xd2, 0xc8, 0xb2, 0x59, 0xce, 0xa0, 0xb6, 0x27, 0x23, 0x3c, 0xd2, 0x76, 0x4f, 0xa7,
+	0x2a, 0x64, 0x73, 0x7c, 0x0e, 0xe0, 0x10, 0xad, 0xc8, 0x15, 0x98, 0xa7, 0xb2, 0x75, 0x11, 0xf4,
+	0x30, 0x07, 0x18, 0x5f, 0x01, 0x5e, 0x17, 0x4a, 0x69, 0x5b, 0x4f, 0x50, 0x58, 0xdc, 0xd3, 0x51,
+	0x88, 0x09, 0x5b, 0x20, 0x3a, 0xaf, 0xe0, 0x32, 0x42, 0xc6, 0xc7, 0xde, 0x0d, 0x8c, 0x70, 0xe4,
+	0xbd, 0x38, 0xf6, 0xce, 0x71, 0xf2, 0x5e, 0x22, 0xf2, 0x3b, 0xa9, 0x8c, 0x42, 0x27, 0x49, 0xd6,
+	0x96, 0x65, 0xe2, 0x98, 0x93, 0x3f, 0xba, 0xdf, 0x6c, 0x9f, 0xb2, 0x15, 0xbe, 0x0c, 0x0b, 0x39,
+	0x72, 0x88, 0x36, 0x91, 0x81, 0x13, 0x6f, 0x95, 0xa8, 0x1e, 0xa7, 0xf6, 0xb8, 0x73, 0x88, 0xb1,
+	0x4e, 0x06, 0x6c, 0x8d, 0x1a, 0xea, 0x32, 0x0d, 0x5b, 0xc4, 0xae, 0x51, 0x85, 0xdd, 0xb8, 0x6f,
+	0x07, 0x63, 0x79, 0xd9, 0x75, 0xce, 0x61, 0xb6, 0xd1, 0x68, 0xe1, 0xff, 0x53, 0x34, 0xb6, 0x25,
+	0x02, 0x64, 0x3f, 0x54, 0x37, 0xff, 0x03, 0xe0, 0x62, 0x69, 0xc6, 0x20, 0xe7, 0x30, 0x37, 0xb6,
+	0x8e, 0xb4, 0x42, 0x36, 0xc1, 0x6b, 0x30, 0x7d, 0xa6, 0xa4, 0x31, 0x29, 0x86, 0xac, 0x44, 0xba,
+	0x35, 0xd5, 0x49, 0xa2, 0xbb, 0xf4, 0xe4, 0x58, 0x99, 0x76, 0xf7, 0xa4, 0x92, 0xa6, 0xe7, 0x6e,
+	0x0c, 0xc0, 0x54, 0x2e, 0x60, 0x65, 0xb3, 0x03, 0xb5, 0x36, 0x76, 0xe9, 0x72, 0x64, 0xb9, 0x97,
+	0x80, 0x15, 0xed, 0x71, 0xf6, 0x11, 0xed, 0x12, 0x5d, 0xde, 0xfd, 0x44, 0x3f, 0x92, 0xaa, 0xcb,
+	0xca, 0x94, 0xac, 0x8d, 0x22, 0x72, 0x89, 0x67, 0xa0, 0xba, 0x17, 0xa5, 0xae, 0x4a, 0xc5, 0xd5,
+	0x24, 0x83, 0xdc, 0x26, 0x37, 0xdf, 0x98, 0x76, 0x4f, 0xda, 0xbd, 0xcc, 0x59, 0xf0, 0xcf, 0x54,
+	0x88, 0x1d, 0xa9, 0x30, 0x64, 0x13, 0x4e, 0x7d, 0xd7, 0xa5, 0x82, 0x0c, 0x21, 0x1d, 0xb2, 0x91,
+	0xe8, 0x7e, 0x01, 0x43, 0x92, 0xf0, 0x40, 0x98, 0x02, 0xd4, 0xa1, 0x96, 0x36, 0xd0, 0x04, 0x89,
+	0xbc, 0x28, 0x86, 0x77, 0x49, 0xda, 0x76, 0x4f, 0x3f, 0x1a, 0x63, 0x86, 0xf5, 0xa8, 0xd2, 0x3e,
+	0xda, 0xf6, 0xc0, 0x58, 0x8c, 0xeb, 0x5a, 0x75, 0x64, 0xd7, 0x30, 0x49, 0x95, 0xee, 0x6b, 0x11,
+	0x16, 0xc2, 0xff, 0x47, 0x4d, 0x6d, 0x61, 0x84, 0xc2, 0x14, 0xb3, 0x3e, 0xe4, 0x4b, 0x30, 0x9f,
+	0x51, 0x3d, 0x11, 0x89, 0x95, 0x0e, 0xfc, 0xa2, 0xe4, 0x3a, 0x96, 0xe8, 0xfe, 0x18, 0xfb, 0x92,
+	0x9e, 0x6f, 0xed, 0x40, 0x98, 0x31, 0xf4, 0x55, 0x89, 0xaf, 0xc0, 0xc2, 0x90, 0xea, 0x18, 0xff,
+	0xba, 0xc4, 0x17, 0x61, 0x8e, 0xa8, 0x8e, 0x30, 0xc3, 0xbe, 0x71, 0x20, 0x91, 0x2a, 0x80, 0xdf,
+	0xba, 0x0c, 0x39, 0xab, 0x02, 0xfe, 0x9d, 0x2b, 0x46, 0x19, 0xf2, 0xc6, 0x19, 0xf6, 0xa4, 0x44,
+	0x4c, 0x87, 0xc5, 0x72, 0x98, 0x3d, 0x75, 0x8e, 0x94, 0x75, 0xe4, 0xf8, 0xcc, 0x39, 0xe6, 0x39,
+	0x47, 0xe8, 0x73, 0x87, 0x1e, 0x08, 0x15, 0xea, 0x4e, 0x67, 0x84, 0xbe, 0x28, 0xf1, 0x35, 0x58,
+	0xa4, 0xf0, 0x1d, 0x11, 0x09, 0x15, 0x8c, 0xfd, 0x5f, 0x96, 0x38, 0x83, 0x99, 0x4c, 0x18, 0x77,
+	0x31, 0xd9, 0xdb, 0x65, 0x27, 0x4a, 0x4e, 0x20, 0xc3, 0xde, 0x29, 0xf3, 0x39, 0xf0, 0x49, 0xa8,
+	0xcc, 0x7e, 0xb7, 0xcc, 0x67, 0x60, 0xaa, 0xa9, 0x0c, 0x26, 0x96, 0xbd, 0x49, 0x97, 0x67, 0x2a,
+	0x7b, 0x7e, 0xec, 0x2d, 0xba, 0xa2, 0x93, 0xee, 0xf2, 0xb0, 0xc7, 0x6e, 0x23, 0x1b, 0x14, 0xec,
+	0x47, 0xcf, 0x1d, 0xb5, 0x38, 0x35, 0x7e, 0xf2, 0xa8, 0xd2, 0x3e, 0xda, 0xf1, 0x8b, 0x60, 0x3f,
+	0x7b, 0xfc, 0x3a, 0x2c, 0x0f, 0x31, 0xf7, 0x86, 0x47, 0x6f, 0xe1, 0x17, 0x8f, 0xaf, 0xc3, 0xea,
+	0x3e, 0xda, 0x71, 0x5f, 0x29, 0x48, 0x1a, 0x2b, 0x03, 0xc3, 0x7e, 0xf5, 0xf8, 0x9f, 0x60, 0x65,
+	0x1f, 0xed, 0x48, 0xdf, 0xc2, 0xe6, 0x6f, 0x1e, 0x9f, 0x85, 0xe9, 0x16, 0x3d, 0x72, 0xbc, 0x44,
+	0xf6, 0xc4, 0xa3, 0x26, 0x0d, 0xcd, 0x9c, 0xce, 0x53, 0x8f, 0xa4, 0xfb, 0xb7, 0xb0, 0x41, 0xaf,
+	0x11, 0xd7, 0x7b, 0x42, 0x29, 0x8c, 0x0c, 0x7b, 0xe6, 0xf1, 0x65, 0x60, 0x2d, 0x8c, 0xf5, 0x25,
+	0x16, 0xe0, 0xe7, 0x34, 0xbc, 0xb9, 0x73, 0xfe, 0x57, 0x8a, 0xc9, 0x60, 0xb4, 0xf1, 0xc2, 0x23,
+	0xa9, 0x33, 0xff, 0x57, 0x77, 0x5e, 0x7a, 0x24, 0x75, 0xae, 0x7c, 0x53, 0x75, 0x34, 0xfb, 0xbe,
+	0x42, 0xac, 0x4e, 0x65, 0x8c, 0xa7, 0x32, 0x78, 0xc8, 0xde, 0xf3, 0x89, 0x95, 0x0b, 0x3a, 0xd2,
+	0x21, 0x12, 0x7d, 0xc3, 0xde, 0xf7, 0x49, 0x7a, 0x6a, 0x5d, 0x26, 0xfd, 0x07, 0xce, 0xce, 0x67,
+	0x4c, 0xb3, 0xc1, 0x3e, 0xa4, 0x81, 0x0e, 0xb9, 0x7d, 0xda, 0x3e, 0x66, 0x1f, 0xf9, 0x74, 0x8c,
+	0xbb, 0x51, 0xa4, 0x03, 0x61, 0x47, 0x17, 0xe8, 0x63, 0x9f, 0x6e, 0x60, 0x61, 0x3c, 0xe4, 0xc2,
+	0x7c, 0xe2, 0xd3, 0xf1, 0x72, 0xdc, 0xb5, 0xad, 0x41, 0x63, 0xe3, 0x53, 0x97, 0xb5, 0x21, 0xac,
+	0x20, 0x26, 0xa7, 0x96, 0x7d, 0xe6, 0x6f, 0x6e, 0x40, 0xb5, 0x61, 0x22, 0x37, 0x05, 0xaa, 0xe0,
+	0x35, 0x4c, 0xc4, 0x26, 0x68, 0x58, 0xed, 0x68, 0x1d, 0xed, 0x5e, 0xf5, 0x93, 0x07, 0x7f, 0x65,
+	0xa5, 0x9d, 0xbf, 0xff, 0xf7, 0x76, 0x57, 0xda, 0x5e, 0x7a, 0x41, 0x1f, 0xe9, 0x76, 0xf6, 0xb3,
+	0xde, 0x92, 0x3a, 0x5f, 0x6d, 0x4b, 0x65, 0x31, 0x51, 0x22, 0xda, 0x76, 0x9f, 0xed, 0x76, 0xf6,
+	0xd9, 0xf6, 0x2f, 0x2e, 0xa6, 0x9c, 0x7d, 0xfb, 0xf7, 0x01, 0x00, 0x07, 0x3f, 0xdb, 0x8b, 0xae,
+	0x09, 0x00, 0x00,
 }
@@ -1768,6 +1768,7 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths  []*ID2PathList          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1847,6 +1848,13 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetField2StatslogPaths() []*ID2PathList {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -2070,6 +2078,8 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 type SegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2114,6 +2124,20 @@ func (m *SegmentBinlogs) GetFieldBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentBinlogs) GetStatslogs() []*FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2470,134 +2494,136 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x5b, 0x6f, 0x1b, 0x59,
-	0xb9, 0xe3, 0x89, 0x13, 0xfb, 0xf3, 0xd8, 0x49, 0x0f, 0x21, 0x6b, 0xdc, 0x36, 0x4d, 0x87, 0xdd,
-	0x6e, 0xb6, 0xb0, 0xc9, 0xd6, 0x45, 0xe2, 0x52, 0x16, 0xb4, 0x8d, 0xdb, 0xc8, 0x22, 0x29, 0xe1,
-	0xa4, 0xbb, 0x2b, 0xb1, 0x42, 0xd6, 0xc4, 0x73, 0xe2, 0x0c, 0x9d, 0x8b, 0x77, 0xce, 0x38, 0x4d,
-	0x9f, 0x76, 0xb5, 0x48, 0x20, 0x10, 0xe2, 0x22, 0xc4, 0x1b, 0x0f, 0x80, 0x84, 0x84, 0xc4, 0x0b,
-	0xff, 0x80, 0x57, 0x7e, 0x16, 0x9a, 0x73, 0xce, 0x9c, 0xb9, 0xda, 0x9e, 0x3a, 0x74, 0xf3, 0x96,
-	0x73, 0xe6, 0xbb, 0x9d, 0xef, 0xfe, 0x7d, 0x0e, 0xac, 0x99, 0x46, 0x60, 0x0c, 0x86, 0x9e, 0xe7,
-	0x9b, 0x3b, 0x63, 0xdf, 0x0b, 0x3c, 0x74, 0xdd, 0xb1, 0xec, 0xf3, 0x09, 0xe5, 0xa7, 0x9d, 0xf0,
-	0x73, 0x47, 0x1b, 0x7a, 0x8e, 0xe3, 0xb9, 0xfc, 0xaa, 0xd3, 0xb2, 0xdc, 0x80, 0xf8, 0xae, 0x61,
-	0x8b, 0xb3, 0x96, 0x44, 0xe8, 0x68, 0x74, 0x78, 0x46, 0x1c, 0x83, 0x9f, 0xf4, 0x0b, 0xd0, 0x9e,
-	0xd8, 0x13, 0x7a, 0x86, 0xc9, 0xa7, 0x13, 0x42, 0x03, 0xf4, 0x1e, 0x2c, 0x9d, 0x18, 0x94, 0xb4,
-	0x95, 0x2d, 0x65, 0xbb, 0xd1, 0xbd, 0xb9, 0x93, 0xe2, 0x25, 0xb8, 0x1c, 0xd2, 0xd1, 0x23, 0x83,
-	0x12, 0xcc, 0x20, 0x11, 0x82, 0x25, 0xf3, 0xa4, 0xdf, 0x6b, 0x57, 0xb6, 0x94, 0x6d, 0x15, 0xb3,
-	0xbf, 0x91, 0x0e, 0xda, 0xd0, 0xb3, 0x6d, 0x32, 0x0c, 0x2c, 0xcf, 0xed, 0xf7, 0xda, 0x4b, 0xec,
-	0x5b, 0xea, 0x4e, 0xff, 0x8b, 0x02, 0x4d, 0xc1, 0x9a, 0x8e, 0x3d, 0x97, 0x12, 0xf4, 0x00, 0x96,
-	0x69, 0x60, 0x04, 0x13, 0x2a, 0xb8, 0xdf, 0x28, 0xe4, 0x7e, 0xcc, 0x40, 0xb0, 0x00, 0x2d, 0xc5,
-	0x5e, 0xcd, 0xb3, 0x47, 0x9b, 0x00, 0x94, 0x8c, 0x1c, 0xe2, 0x06, 0xfd, 0x1e, 0x6d, 0x2f, 0x6d,
-	0xa9, 0xdb, 0x2a, 0x4e, 0xdc, 0xe8, 0x7f, 0x54, 0x60, 0xed, 0x38, 0x3a, 0x46, 0xda, 0x59, 0x87,
-	0xea, 0xd0, 0x9b, 0xb8, 0x01, 0x13, 0xb0, 0x89, 0xf9, 0x01, 0xdd, 0x01, 0x6d, 0x78, 0x66, 0xb8,
-	0x2e, 0xb1, 0x07, 0xae, 0xe1, 0x10, 0x26, 0x4a, 0x1d, 0x37, 0xc4, 0xdd, 0x53, 0xc3, 0x21, 0xa5,
-	0x24, 0xda, 0x82, 0xc6, 0xd8, 0xf0, 0x03, 0x2b, 0xa5, 0xb3, 0xe4, 0x95, 0xfe, 0x57, 0x05, 0x36,
-	0x3e, 0xa0, 0xd4, 0x1a, 0xb9, 0x39, 0xc9, 0x36, 0x60, 0xd9, 0xf5, 0x4c, 0xd2, 0xef, 0x31, 0xd1,
-	0x54, 0x2c, 0x4e, 0xe8, 0x06, 0xd4, 0xc7, 0x84, 0xf8, 0x03, 0xdf, 0xb3, 0x23, 0xc1, 0x6a, 0xe1,
-	0x05, 0xf6, 0x6c, 0x82, 0x7e, 0x02, 0xd7, 0x69, 0x86, 0x10, 0x6d, 0xab, 0x5b, 0xea, 0x76, 0xa3,
-	0xfb, 0xf5, 0x9d, 0x9c, 0x97, 0xed, 0x64, 0x99, 0xe2, 0x3c, 0xb6, 0xfe, 0x79, 0x05, 0xbe, 0x22,
-	0xe1, 0xb8, 0xac, 0xe1, 0xdf, 0xa1, 0xe6, 0x28, 0x19, 0x49, 0xf1, 0xf8, 0xa1, 0x8c, 0xe6, 0xa4,
-	0xca, 0xd5, 0xa4, 0xca, 0x4b, 0x38, 0x58, 0x56, 0x9f, 0xd5, 0x9c, 0x3e, 0xd1, 0x6d, 0x68, 0x90,
-	0x8b, 0xb1, 0xe5, 0x93, 0x41, 0x60, 0x39, 0xa4, 0xbd, 0xbc, 0xa5, 0x6c, 0x2f, 0x61, 0xe0, 0x57,
-	0xcf, 0x2c, 0x27, 0xe9, 0x91, 0x2b, 0xa5, 0x3d, 0x52, 0xff, 0xbb, 0x02, 0x6f, 0xe4, 0xac, 0x24,
-	0x5c, 0x1c, 0xc3, 0x1a, 0x7b, 0x79, 0xac, 0x99, 0xd0, 0xd9, 0x43, 0x85, 0xdf, 0x9d, 0xa5, 0xf0,
-	0x18, 0x1c, 0xe7, 0xf0, 0x13, 0x42, 0x56, 0xca, 0x0b, 0xf9, 0x1c, 0xde, 0xd8, 0x27, 0x81, 0x60,
-	0x10, 0x7e, 0x23, 0x74, 0xf1, 0x14, 0x90, 0x8e, 0xa5, 0x4a, 0x2e, 0x96, 0xfe, 0x5d, 0x91, 0xb1,
-	0xc4, 0x58, 0xf5, 0xdd, 0x53, 0x0f, 0xdd, 0x84, 0xba, 0x04, 0x11, 0x5e, 0x11, 0x5f, 0xa0, 0x6f,
-	0x43, 0x35, 0x94, 0x94, 0xbb, 0x44, 0xab, 0x7b, 0xa7, 0xf8, 0x4d, 0x09, 0x9a, 0x98, 0xc3, 0xa3,
-	0x3e, 0xb4, 0x68, 0x60, 0xf8, 0xc1, 0x60, 0xec, 0x51, 0x66, 0x67, 0xe6, 0x38, 0x8d, 0xae, 0x9e,
-	0xa6, 0x20, 0x53, 0xe4, 0x21, 0x1d, 0x1d, 0x09, 0x48, 0xdc, 0x64, 0x98, 0xd1, 0x11, 0x3d, 0x06,
-	0x8d, 0xb8, 0x66, 0x4c, 0x68, 0xa9, 0x34, 0xa1, 0x06, 0x71, 0x4d, 0x49, 0x26, 0xb6, 0x4f, 0xb5,
-	0xbc, 0x7d, 0x7e, 0xab, 0x40, 0x3b, 0x6f, 0xa0, 0xcb, 0x24, 0xca, 0x87, 0x1c, 0x89, 0x70, 0x03,
-	0xcd, 0x8c, 0x70, 0x69, 0x24, 0x2c, 0x50, 0x74, 0x0b, 0xbe, 0x1a, 0x4b, 0xc3, 0xbe, 0xbc, 0x36,
-	0x67, 0xf9, 0x85, 0x02, 0x1b, 0x59, 0x5e, 0x97, 0x79, 0xf7, 0xb7, 0xa0, 0x6a, 0xb9, 0xa7, 0x5e,
-	0xf4, 0xec, 0xcd, 0x19, 0x71, 0x16, 0xf2, 0xe2, 0xc0, 0xba, 0x03, 0x37, 0xf6, 0x49, 0xd0, 0x77,
-	0x29, 0xf1, 0x83, 0x47, 0x96, 0x6b, 0x7b, 0xa3, 0x23, 0x23, 0x38, 0xbb, 0x44, 0x8c, 0xa4, 0xdc,
-	0xbd, 0x92, 0x71, 0x77, 0xfd, 0x9f, 0x0a, 0xdc, 0x2c, 0xe6, 0x27, 0x9e, 0xde, 0x81, 0xda, 0xa9,
-	0x45, 0x6c, 0x33, 0xd4, 0x99, 0xc2, 0x74, 0x26, 0xcf, 0x61, 0xac, 0x8c, 0x43, 0x60, 0xf1, 0xc2,
-	0x3b, 0x53, 0x1c, 0xf4, 0x38, 0xf0, 0x2d, 0x77, 0x74, 0x60, 0xd1, 0x00, 0x73, 0xf8, 0x84, 0x3e,
-	0xd5, 0xf2, 0x9e, 0xf9, 0x1b, 0x05, 0x36, 0xf7, 0x49, 0xb0, 0x27, 0x53, 0x6d, 0xf8, 0xdd, 0xa2,
-	0x81, 0x35, 0xa4, 0xaf, 0xb7, 0x89, 0x28, 0xa8, 0x99, 0xfa, 0xef, 0x15, 0xb8, 0x3d, 0x55, 0x18,
-	0xa1, 0x3a, 0x91, 0x4a, 0xa2, 0x44, 0x5b, 0x9c, 0x4a, 0x7e, 0x44, 0x5e, 0x7e, 0x64, 0xd8, 0x13,
-	0x72, 0x64, 0x58, 0x3e, 0x4f, 0x25, 0x0b, 0x26, 0xd6, 0x7f, 0x29, 0x70, 0x6b, 0x9f, 0x04, 0x47,
-	0x51, 0x99, 0xb9, 0x42, 0xed, 0x94, 0xe8, 0x28, 0x7e, 0xc7, 0x8d, 0x59, 0x28, 0xed, 0x95, 0xa8,
-	0x6f, 0x93, 0xc5, 0x41, 0x22, 0x20, 0xf7, 0x78, 0x2f, 0x20, 0x94, 0xa7, 0xff, 0xb9, 0x02, 0xda,
-	0x47, 0xa2, 0x3f, 0x60, 0x65, 0x24, 0xab, 0x07, 0xa5, 0x58, 0x0f, 0x89, 0x96, 0xa2, 0xa8, 0xcb,
-	0xd8, 0x87, 0x26, 0x25, 0xe4, 0xf9, 0x22, 0x45, 0x43, 0x0b, 0x11, 0x65, 0xb2, 0x3f, 0x80, 0xeb,
-	0x13, 0xf7, 0x34, 0x6c, 0x6b, 0x89, 0x29, 0x5e, 0xc1, 0xbb, 0xcb, 0xf9, 0x99, 0x27, 0x8f, 0x88,
-	0xb6, 0x61, 0x35, 0x4b, 0xab, 0xca, 0x82, 0x3f, 0x7b, 0xad, 0xff, 0x5a, 0x81, 0x8d, 0x8f, 0x8d,
-	0x60, 0x78, 0xd6, 0x73, 0x84, 0xc6, 0x2e, 0xe1, 0x6f, 0xef, 0x43, 0xfd, 0x5c, 0x68, 0x27, 0x4a,
-	0x2a, 0xb7, 0x0b, 0x84, 0x4f, 0xda, 0x01, 0xc7, 0x18, 0x61, 0x9b, 0xba, 0xce, 0x3a, 0xfb, 0x48,
-	0xba, 0x2f, 0xdf, 0xf3, 0xe7, 0x75, 0xf7, 0x17, 0x00, 0x42, 0xb8, 0x43, 0x3a, 0x5a, 0x40, 0xae,
-	0xef, 0xc0, 0x8a, 0xa0, 0x26, 0x9c, 0x7b, 0x9e, 0x71, 0x23, 0x70, 0xfd, 0x18, 0x36, 0xc4, 0xfd,
-	0x93, 0x30, 0x7f, 0xf3, 0x5c, 0x7f, 0x48, 0x02, 0x03, 0xb5, 0x61, 0x45, 0xa4, 0x74, 0xe1, 0xc4,
-	0xd1, 0x31, 0xec, 0x53, 0x4f, 0x18, 0xdc, 0x20, 0xcc, 0xdb, 0xc2, 0x7f, 0xe1, 0x44, 0x96, 0x09,
-	0xfd, 0x67, 0xd0, 0xec, 0xf5, 0x0e, 0x12, 0xb4, 0xee, 0xc2, 0xaa, 0x69, 0xda, 0x83, 0x24, 0x96,
-	0xc2, 0xb0, 0x9a, 0xa6, 0x69, 0xc7, 0xf5, 0x05, 0xbd, 0x09, 0xad, 0x80, 0x0e, 0xf2, 0xc4, 0xb5,
-	0x80, 0xc6, 0x50, 0xfa, 0x21, 0xb4, 0x98, 0xb0, 0xcc, 0xa8, 0x73, 0x64, 0xbd, 0x03, 0x5a, 0x82,
-	0x1c, 0x77, 0x9f, 0x3a, 0x6e, 0xc4, 0xc2, 0xb2, 0x0a, 0x12, 0xb5, 0x83, 0x31, 0xc5, 0xd9, 0xed,
-	0xe0, 0x2d, 0x00, 0x8b, 0x0e, 0x84, 0xd3, 0x33, 0x19, 0x6b, 0xb8, 0x6e, 0xd1, 0x27, 0xfc, 0x02,
-	0x7d, 0x17, 0x96, 0x19, 0x7f, 0x1e, 0x1e, 0xb9, 0x24, 0xc5, 0xac, 0x91, 0x7e, 0x01, 0x16, 0x08,
-	0xfa, 0x87, 0xa0, 0xf5, 0x7a, 0x07, 0xb1, 0x1c, 0x65, 0xf2, 0x49, 0x89, 0x37, 0x7e, 0x06, 0xad,
-	0xb8, 0x28, 0xb1, 0x44, 0xd5, 0x82, 0x8a, 0x24, 0x57, 0xe9, 0xf7, 0xd0, 0xfb, 0xb0, 0xcc, 0x27,
-	0x71, 0xe1, 0x41, 0x6f, 0xa5, 0x65, 0x16, 0x53, 0x7a, 0xa2, 0xb2, 0xb1, 0x0b, 0x2c, 0x90, 0x42,
-	0x0f, 0x97, 0x89, 0x9c, 0x0f, 0x6d, 0x2a, 0x4e, 0xdc, 0xe8, 0xff, 0x51, 0xa1, 0x91, 0x70, 0xc0,
-	0x1c, 0xfb, 0xec, 0x3b, 0x2b, 0xf3, 0xeb, 0x87, 0x9a, 0x9f, 0xa0, 0xde, 0x82, 0x96, 0xc5, 0x7a,
-	0x96, 0x81, 0x88, 0x7e, 0x56, 0x64, 0xea, 0xb8, 0xc9, 0x6f, 0x45, 0x2a, 0x42, 0x9b, 0xd0, 0x70,
-	0x27, 0xce, 0xc0, 0x3b, 0x1d, 0xf8, 0xde, 0x0b, 0x2a, 0x46, 0xb1, 0xba, 0x3b, 0x71, 0x7e, 0x7c,
-	0x8a, 0xbd, 0x17, 0x34, 0xee, 0xf6, 0x97, 0x5f, 0xb1, 0xdb, 0x7f, 0x0c, 0x9a, 0xe9, 0xd8, 0x71,
-	0xda, 0x5e, 0x29, 0xdf, 0xa2, 0x9b, 0x8e, 0x2d, 0xb3, 0xf6, 0x26, 0x34, 0x1c, 0xe3, 0x22, 0x14,
-	0x6e, 0xe0, 0x4e, 0x9c, 0x76, 0x8d, 0xcb, 0xe7, 0x18, 0x17, 0xd8, 0x7b, 0xf1, 0x74, 0xe2, 0xa0,
-	0x6d, 0x58, 0xb3, 0x0d, 0x1a, 0x0c, 0x92, 0xd3, 0x62, 0x9d, 0x4d, 0x8b, 0xad, 0xf0, 0xfe, 0x71,
-	0x3c, 0x31, 0xe6, 0xc7, 0x0f, 0x58, 0x70, 0xfc, 0xd0, 0x1f, 0x40, 0xa3, 0xdf, 0xeb, 0x86, 0xee,
-	0x14, 0xf6, 0x6c, 0x39, 0x03, 0xae, 0x43, 0xf5, 0x28, 0xe1, 0x7d, 0xd5, 0xc8, 0xef, 0xd6, 0x63,
-	0x3d, 0x25, 0x66, 0x99, 0xbc, 0x5c, 0xca, 0xa2, 0x63, 0xd1, 0xec, 0x4e, 0xf6, 0x57, 0x2a, 0x6c,
-	0x1c, 0x1b, 0xe7, 0xe4, 0xf5, 0x37, 0xcd, 0xa5, 0x0a, 0xc1, 0x01, 0x5c, 0x67, 0x81, 0xde, 0x4d,
-	0xc8, 0x33, 0xa3, 0x1e, 0x27, 0x14, 0x8e, 0xf3, 0x88, 0xe8, 0x87, 0x61, 0x23, 0x41, 0x86, 0xcf,
-	0x8f, 0x3c, 0x2b, 0xaa, 0xc5, 0x8d, 0xee, 0xad, 0x02, 0x3a, 0x7b, 0x12, 0x0a, 0x27, 0x31, 0xd0,
-	0x11, 0xac, 0xa6, 0xcd, 0x40, 0xdb, 0xcb, 0x8c, 0xc8, 0xdb, 0x33, 0xa7, 0xb1, 0x58, 0xfb, 0xb8,
-	0x95, 0x32, 0x06, 0x65, 0x99, 0x58, 0xa4, 0xc5, 0x15, 0x96, 0x16, 0xa3, 0x63, 0x98, 0x66, 0x21,
-	0x96, 0x63, 0x4e, 0x82, 0xfd, 0x01, 0xd4, 0xa4, 0x67, 0x54, 0x4a, 0x7b, 0x86, 0xc4, 0xc9, 0x46,
-	0xb8, 0x9a, 0x89, 0x70, 0xfd, 0x0b, 0x05, 0x9a, 0x3d, 0x23, 0x30, 0x9e, 0x7a, 0x26, 0x79, 0xb6,
-	0x60, 0xd1, 0x2d, 0xb1, 0x2d, 0xba, 0x09, 0xf5, 0x30, 0x38, 0x69, 0x60, 0x38, 0x63, 0x26, 0xc4,
-	0x12, 0x8e, 0x2f, 0xc2, 0xd1, 0xb2, 0x29, 0x52, 0xd2, 0xb1, 0xdc, 0x1e, 0x32, 0x52, 0xbc, 0x38,
-	0xb2, 0xbf, 0xd1, 0xf7, 0xd2, 0xab, 0x87, 0x37, 0x0b, 0xcd, 0xcb, 0x88, 0xb0, 0x86, 0x2b, 0x95,
-	0x8f, 0xca, 0xcc, 0x2c, 0x9f, 0x2b, 0xa0, 0x45, 0xaa, 0x60, 0xa9, 0xb9, 0x0d, 0x2b, 0x86, 0x69,
-	0xfa, 0x84, 0x52, 0x21, 0x47, 0x74, 0x0c, 0xbf, 0x9c, 0x13, 0x9f, 0x46, 0x46, 0x51, 0x71, 0x74,
-	0x44, 0xdf, 0x87, 0x9a, 0xec, 0xd0, 0xf8, 0xc6, 0x6e, 0x6b, 0xba, 0x9c, 0xa2, 0xc7, 0x96, 0x18,
-	0xba, 0x0f, 0x2d, 0xe1, 0x5c, 0xdc, 0xbb, 0xe9, 0x1c, 0xef, 0x78, 0x04, 0xda, 0x69, 0xdc, 0xad,
-	0xcc, 0x1a, 0xa5, 0x13, 0x4d, 0x0d, 0x4e, 0xe1, 0xe8, 0x1f, 0x40, 0x23, 0xf1, 0x71, 0x46, 0x07,
-	0xd1, 0x86, 0x95, 0x93, 0x04, 0x9f, 0x3a, 0x8e, 0x8e, 0xfa, 0x7f, 0x15, 0xb6, 0xb5, 0xc2, 0x64,
-	0xe8, 0x9d, 0x13, 0xff, 0xe5, 0xe5, 0x77, 0x03, 0x0f, 0x13, 0x5a, 0x2c, 0xd9, 0xe7, 0x4a, 0x04,
-	0xf4, 0x30, 0x96, 0x53, 0x9d, 0xda, 0x75, 0xa4, 0xd5, 0x1c, 0x3f, 0xe5, 0x0f, 0x7c, 0xcb, 0x91,
-	0x7e, 0xca, 0xa2, 0x69, 0xf2, 0xff, 0x52, 0xcb, 0xf5, 0x3f, 0x29, 0xf0, 0xb5, 0x7d, 0x12, 0x3c,
-	0x49, 0x4f, 0x16, 0x57, 0x2d, 0x95, 0x03, 0x9d, 0x22, 0xa1, 0x2e, 0x63, 0xf5, 0x0e, 0xd4, 0x68,
-	0x34, 0x4e, 0xf1, 0xfd, 0x93, 0x3c, 0xeb, 0xbf, 0x54, 0xa0, 0x9d, 0xec, 0x4d, 0xf7, 0x3c, 0x67,
-	0x6c, 0x93, 0x80, 0x98, 0x5f, 0xf2, 0x9c, 0x70, 0xef, 0x3e, 0x5c, 0xcf, 0x65, 0x19, 0xd4, 0x02,
-	0xf8, 0xd0, 0x1d, 0x0a, 0x91, 0xd6, 0xae, 0x21, 0x0d, 0x6a, 0x91, 0x80, 0x6b, 0x4a, 0xf7, 0x6f,
-	0x1a, 0xd4, 0xc3, 0xc4, 0xb2, 0xe7, 0x79, 0xbe, 0x89, 0xc6, 0x80, 0xd8, 0x66, 0xc4, 0x19, 0x7b,
-	0xae, 0x5c, 0x21, 0xa2, 0xf7, 0xa6, 0x64, 0xf5, 0x3c, 0xa8, 0x30, 0x7c, 0xe7, 0xee, 0x14, 0x8c,
-	0x0c, 0xb8, 0x7e, 0x0d, 0x39, 0x8c, 0x63, 0xd8, 0x06, 0x3d, 0xb3, 0x86, 0xcf, 0xa3, 0xde, 0x6f,
-	0x06, 0xc7, 0x0c, 0x68, 0xc4, 0x31, 0xb3, 0x99, 0x14, 0x07, 0xbe, 0xbe, 0x8a, 0x2c, 0xaf, 0x5f,
-	0x43, 0x9f, 0xc2, 0xfa, 0x3e, 0x09, 0xe2, 0x8d, 0x45, 0xc4, 0xb0, 0x3b, 0x9d, 0x61, 0x0e, 0xf8,
-	0x15, 0x59, 0x1e, 0x40, 0x95, 0x79, 0x05, 0x2a, 0x4a, 0x13, 0xc9, 0xdf, 0xd1, 0x3a, 0x5b, 0xd3,
-	0x01, 0x24, 0xb5, 0x9f, 0xc3, 0x6a, 0xe6, 0x77, 0x02, 0xf4, 0x4e, 0x01, 0x5a, 0xf1, 0x2f, 0x3e,
-	0x9d, 0x7b, 0x65, 0x40, 0x25, 0xaf, 0x11, 0xb4, 0xd2, 0x7b, 0x15, 0xb4, 0x5d, 0x80, 0x5f, 0xb8,
-	0xe3, 0xed, 0xbc, 0x53, 0x02, 0x52, 0x32, 0x72, 0x60, 0x2d, 0xbb, 0xb7, 0x46, 0xf7, 0x66, 0x12,
-	0x48, 0xbb, 0xdb, 0x37, 0x4a, 0xc1, 0x4a, 0x76, 0x2f, 0x99, 0x13, 0xe4, 0xf6, 0xa6, 0x68, 0xa7,
-	0x98, 0xcc, 0xb4, 0x85, 0x6e, 0x67, 0xb7, 0x34, 0xbc, 0x64, 0xfd, 0x05, 0xaf, 0x46, 0x45, 0xbb,
-	0x47, 0x74, 0xbf, 0x98, 0xdc, 0x8c, 0xa5, 0x69, 0xa7, 0xfb, 0x2a, 0x28, 0x52, 0x88, 0xcf, 0x58,
-	0x19, 0x29, 0xd8, 0xdf, 0x65, 0xe3, 0x2e, 0xa2, 0x37, 0x7d, 0x31, 0xd9, 0xb9, 0xff, 0x0a, 0x18,
-	0x52, 0x00, 0x2f, 0xfb, 0xcb, 0x40, 0x14, 0x86, 0xbb, 0x73, 0xbd, 0x66, 0xb1, 0x18, 0xfc, 0x04,
-	0x56, 0x33, 0xf3, 0x45, 0x61, 0xd4, 0x14, 0xcf, 0x20, 0x9d, 0x59, 0x05, 0x82, 0x87, 0x64, 0xa6,
-	0x2a, 0xa3, 0x29, 0xde, 0x5f, 0x50, 0xb9, 0x3b, 0xf7, 0xca, 0x80, 0xca, 0x87, 0x50, 0x96, 0x2e,
-	0x33, 0x95, 0x0d, 0x7d, 0xb3, 0x98, 0x46, 0x71, 0x55, 0xee, 0xbc, 0x5b, 0x12, 0x3a, 0x62, 0xda,
-	0xfd, 0x87, 0x0a, 0xb5, 0xa8, 0xf9, 0xbc, 0x82, 0x12, 0x71, 0x05, 0x39, 0xfb, 0x13, 0x58, 0xcd,
-	0x2c, 0x46, 0x0b, 0x4d, 0x5a, 0xbc, 0x3c, 0x9d, 0xe7, 0x2f, 0x1f, 0x8b, 0xff, 0x61, 0x90, 0xe6,
-	0x7b, 0x7b, 0x5a, 0xde, 0xcf, 0x5a, 0x6e, 0x36, 0xe1, 0x47, 0x0f, 0x7e, 0x7a, 0x7f, 0x64, 0x05,
-	0x67, 0x93, 0x93, 0xf0, 0xcb, 0x2e, 0x07, 0x7d, 0xd7, 0xf2, 0xc4, 0x5f, 0xbb, 0x91, 0x82, 0x76,
-	0x19, 0xf6, 0x6e, 0xc8, 0x66, 0x7c, 0x72, 0xb2, 0xcc, 0x4e, 0x0f, 0xfe, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x9c, 0xc7, 0x52, 0x4b, 0x34, 0x22, 0x00, 0x00,
+	// 2055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x4b, 0x6f, 0x1b, 0xc7,
+	0xd9, 0xcb, 0x15, 0x25, 0xf2, 0xe3, 0x92, 0x92, 0x26, 0xaa, 0xc2, 0xd2, 0xb6, 0x2c, 0x6f, 0x13,
+	0x47, 0x71, 0x1b, 0xc9, 0xa6, 0x0b, 0xf4, 0xe1, 0xa4, 0x45, 0x2c, 0xda, 0x02, 0x51, 0xc9, 0x55,
+	0x47, 0x4e, 0x02, 0x34, 0x28, 0x88, 0x15, 0x77, 0x44, 0x6d, 0xbd, 0x0f, 0x86, 0xb3, 0x94, 0xe5,
+	0x53, 0x82, 0x14, 0x28, 0xd0, 0xa2, 0xe8, 0x03, 0x45, 0x6f, 0x3d, 0xb4, 0x05, 0x0a, 0x14, 0xe8,
+	0xa5, 0xff, 0xa0, 0xd7, 0x1e, 0x7b, 0xed, 0xbf, 0x29, 0xe6, 0xb1, 0xef, 0x25, 0xb9, 0xa2, 0x62,
+	0xeb, 0xc6, 0x99, 0xf9, 0x5e, 0xf3, 0xbd, 0xe7, 0x5b, 0xc2, 0x8a, 0x69, 0xf8, 0x46, 0xaf, 0xef,
+	0x79, 0x23, 0x73, 0x7b, 0x38, 0xf2, 0x7c, 0x0f, 0xad, 0x3a, 0x96, 0x7d, 0x36, 0xa6, 0x62, 0xb5,
+	0xcd, 0x8e, 0x5b, 0x5a, 0xdf, 0x73, 0x1c, 0xcf, 0x15, 0x5b, 0xad, 0x86, 0xe5, 0xfa, 0x64, 0xe4,
+	0x1a, 0xb6, 0x5c, 0x6b, 0x71, 0x84, 0x96, 0x46, 0xfb, 0xa7, 0xc4, 0x31, 0xc4, 0x4a, 0x3f, 0x07,
+	0xed, 0x89, 0x3d, 0xa6, 0xa7, 0x98, 0x7c, 0x36, 0x26, 0xd4, 0x47, 0xf7, 0x60, 0xe1, 0xd8, 0xa0,
+	0xa4, 0xa9, 0x6c, 0x2a, 0x5b, 0xb5, 0xf6, 0x8d, 0xed, 0x04, 0x2f, 0xc9, 0xe5, 0x80, 0x0e, 0x1e,
+	0x19, 0x94, 0x60, 0x0e, 0x89, 0x10, 0x2c, 0x98, 0xc7, 0xdd, 0x4e, 0xb3, 0xb4, 0xa9, 0x6c, 0xa9,
+	0x98, 0xff, 0x46, 0x3a, 0x68, 0x7d, 0xcf, 0xb6, 0x49, 0xdf, 0xb7, 0x3c, 0xb7, 0xdb, 0x69, 0x2e,
+	0xf0, 0xb3, 0xc4, 0x9e, 0xfe, 0x67, 0x05, 0xea, 0x92, 0x35, 0x1d, 0x7a, 0x2e, 0x25, 0xe8, 0x01,
+	0x2c, 0x52, 0xdf, 0xf0, 0xc7, 0x54, 0x72, 0xbf, 0x9e, 0xcb, 0xfd, 0x88, 0x83, 0x60, 0x09, 0x5a,
+	0x88, 0xbd, 0x9a, 0x65, 0x8f, 0x36, 0x00, 0x28, 0x19, 0x38, 0xc4, 0xf5, 0xbb, 0x1d, 0xda, 0x5c,
+	0xd8, 0x54, 0xb7, 0x54, 0x1c, 0xdb, 0xd1, 0xff, 0xa0, 0xc0, 0xca, 0x51, 0xb0, 0x0c, 0xb4, 0xb3,
+	0x06, 0xe5, 0xbe, 0x37, 0x76, 0x7d, 0x2e, 0x60, 0x1d, 0x8b, 0x05, 0xba, 0x0d, 0x5a, 0xff, 0xd4,
+	0x70, 0x5d, 0x62, 0xf7, 0x5c, 0xc3, 0x21, 0x5c, 0x94, 0x2a, 0xae, 0xc9, 0xbd, 0xa7, 0x86, 0x43,
+	0x0a, 0x49, 0xb4, 0x09, 0xb5, 0xa1, 0x31, 0xf2, 0xad, 0x84, 0xce, 0xe2, 0x5b, 0xfa, 0x5f, 0x14,
+	0x58, 0xff, 0x90, 0x52, 0x6b, 0xe0, 0x66, 0x24, 0x5b, 0x87, 0x45, 0xd7, 0x33, 0x49, 0xb7, 0xc3,
+	0x45, 0x53, 0xb1, 0x5c, 0xa1, 0xeb, 0x50, 0x1d, 0x12, 0x32, 0xea, 0x8d, 0x3c, 0x3b, 0x10, 0xac,
+	0xc2, 0x36, 0xb0, 0x67, 0x13, 0xf4, 0x13, 0x58, 0xa5, 0x29, 0x42, 0xb4, 0xa9, 0x6e, 0xaa, 0x5b,
+	0xb5, 0xf6, 0x37, 0xb6, 0x33, 0x5e, 0xb6, 0x9d, 0x66, 0x8a, 0xb3, 0xd8, 0xfa, 0x17, 0x25, 0x78,
+	0x23, 0x84, 0x13, 0xb2, 0xb2, 0xdf, 0x4c, 0x73, 0x94, 0x0c, 0x42, 0xf1, 0xc4, 0xa2, 0x88, 0xe6,
+	0x42, 0x95, 0xab, 0x71, 0x95, 0x17, 0x70, 0xb0, 0xb4, 0x3e, 0xcb, 0x19, 0x7d, 0xa2, 0x5b, 0x50,
+	0x23, 0xe7, 0x43, 0x6b, 0x44, 0x7a, 0xbe, 0xe5, 0x90, 0xe6, 0xe2, 0xa6, 0xb2, 0xb5, 0x80, 0x41,
+	0x6c, 0x3d, 0xb3, 0x9c, 0xb8, 0x47, 0x2e, 0x15, 0xf6, 0x48, 0xfd, 0x6f, 0x0a, 0xbc, 0x99, 0xb1,
+	0x92, 0x74, 0x71, 0x0c, 0x2b, 0xfc, 0xe6, 0x91, 0x66, 0x98, 0xb3, 0x33, 0x85, 0xdf, 0x99, 0xa6,
+	0xf0, 0x08, 0x1c, 0x67, 0xf0, 0x63, 0x42, 0x96, 0x8a, 0x0b, 0xf9, 0x1c, 0xde, 0xdc, 0x23, 0xbe,
+	0x64, 0xc0, 0xce, 0x08, 0x9d, 0x3f, 0x05, 0x24, 0x63, 0xa9, 0x94, 0x89, 0xa5, 0x7f, 0x95, 0x60,
+	0x25, 0xce, 0xaa, 0xeb, 0x9e, 0x78, 0xe8, 0x06, 0x54, 0x43, 0x10, 0xe9, 0x15, 0xd1, 0x06, 0xfa,
+	0x0e, 0x94, 0x99, 0xa4, 0xc2, 0x25, 0x1a, 0xed, 0xdb, 0xf9, 0x77, 0x8a, 0xd1, 0xc4, 0x02, 0x1e,
+	0x75, 0xa1, 0x41, 0x7d, 0x63, 0xe4, 0xf7, 0x86, 0x1e, 0xe5, 0x76, 0xe6, 0x8e, 0x53, 0x6b, 0xeb,
+	0x49, 0x0a, 0x61, 0x8a, 0x3c, 0xa0, 0x83, 0x43, 0x09, 0x89, 0xeb, 0x1c, 0x33, 0x58, 0xa2, 0xc7,
+	0xa0, 0x11, 0xd7, 0x8c, 0x08, 0x2d, 0x14, 0x26, 0x54, 0x23, 0xae, 0x19, 0x92, 0x89, 0xec, 0x53,
+	0x2e, 0x6e, 0x9f, 0xdf, 0x28, 0xd0, 0xcc, 0x1a, 0xe8, 0x32, 0x89, 0xf2, 0xa1, 0x40, 0x22, 0xc2,
+	0x40, 0x53, 0x23, 0x3c, 0x34, 0x12, 0x96, 0x28, 0xba, 0x05, 0x5f, 0x8b, 0xa4, 0xe1, 0x27, 0xaf,
+	0xcc, 0x59, 0x7e, 0xa1, 0xc0, 0x7a, 0x9a, 0xd7, 0x65, 0xee, 0xfd, 0x6d, 0x28, 0x5b, 0xee, 0x89,
+	0x17, 0x5c, 0x7b, 0x63, 0x4a, 0x9c, 0x31, 0x5e, 0x02, 0x58, 0x77, 0xe0, 0xfa, 0x1e, 0xf1, 0xbb,
+	0x2e, 0x25, 0x23, 0xff, 0x91, 0xe5, 0xda, 0xde, 0xe0, 0xd0, 0xf0, 0x4f, 0x2f, 0x11, 0x23, 0x09,
+	0x77, 0x2f, 0xa5, 0xdc, 0x5d, 0xff, 0x87, 0x02, 0x37, 0xf2, 0xf9, 0xc9, 0xab, 0xb7, 0xa0, 0x72,
+	0x62, 0x11, 0xdb, 0xec, 0x76, 0x44, 0xc2, 0x50, 0x71, 0xb8, 0x66, 0xb1, 0x32, 0x64, 0xc0, 0xf2,
+	0x86, 0xb7, 0x27, 0x38, 0xe8, 0x91, 0x3f, 0xb2, 0xdc, 0xc1, 0xbe, 0x45, 0x7d, 0x2c, 0xe0, 0x63,
+	0xfa, 0x54, 0x8b, 0x7b, 0xe6, 0xaf, 0x15, 0xd8, 0xd8, 0x23, 0xfe, 0x6e, 0x98, 0x6a, 0xd9, 0xb9,
+	0x45, 0x7d, 0xab, 0x4f, 0x5f, 0x6d, 0x13, 0x91, 0x53, 0x33, 0xf5, 0xdf, 0x29, 0x70, 0x6b, 0xa2,
+	0x30, 0x52, 0x75, 0x32, 0x95, 0x04, 0x89, 0x36, 0x3f, 0x95, 0xfc, 0x88, 0xbc, 0xfc, 0xd8, 0xb0,
+	0xc7, 0xe4, 0xd0, 0xb0, 0x46, 0x22, 0x95, 0xcc, 0x99, 0x58, 0xff, 0xa9, 0xc0, 0xcd, 0x3d, 0xe2,
+	0x1f, 0x06, 0x65, 0xe6, 0x0a, 0xb5, 0x53, 0xa0, 0xa3, 0xf8, 0xad, 0x30, 0x66, 0xae, 0xb4, 0x57,
+	0xa2, 0xbe, 0x0d, 0x1e, 0x07, 0xb1, 0x80, 0xdc, 0x15, 0xbd, 0x80, 0x54, 0x9e, 0xfe, 0xa7, 0x12,
+	0x68, 0x1f, 0xcb, 0xfe, 0x80, 0x1d, 0x67, 0xf4, 0xa0, 0xe4, 0xeb, 0x21, 0xd6, 0x52, 0xe4, 0x75,
+	0x19, 0x7b, 0x50, 0xa7, 0x84, 0x3c, 0x9f, 0xa7, 0x68, 0x68, 0x0c, 0x31, 0x58, 0xa1, 0x7d, 0x58,
+	0x1d, 0xbb, 0x27, 0xac, 0xad, 0x25, 0xa6, 0xbc, 0x85, 0xe8, 0x2e, 0x67, 0x67, 0x9e, 0x2c, 0x22,
+	0xda, 0x82, 0xe5, 0x34, 0xad, 0x32, 0x0f, 0xfe, 0xf4, 0xb6, 0xfe, 0x2b, 0x05, 0xd6, 0x3f, 0x31,
+	0xfc, 0xfe, 0x69, 0xc7, 0x91, 0x1a, 0xbb, 0x84, 0xbf, 0x7d, 0x00, 0xd5, 0x33, 0xa9, 0x9d, 0x20,
+	0xa9, 0xdc, 0xca, 0x11, 0x3e, 0x6e, 0x07, 0x1c, 0x61, 0xb0, 0x36, 0x75, 0x8d, 0x77, 0xf6, 0x81,
+	0x74, 0xaf, 0xdf, 0xf3, 0x67, 0x75, 0xf7, 0xe7, 0x00, 0x52, 0xb8, 0x03, 0x3a, 0x98, 0x43, 0xae,
+	0xef, 0xc2, 0x92, 0xa4, 0x26, 0x9d, 0x7b, 0x96, 0x71, 0x03, 0x70, 0xfd, 0x08, 0xd6, 0xe5, 0xfe,
+	0x13, 0x96, 0xbf, 0x45, 0xae, 0x3f, 0x20, 0xbe, 0x81, 0x9a, 0xb0, 0x24, 0x53, 0xba, 0x74, 0xe2,
+	0x60, 0xc9, 0xfa, 0xd4, 0x63, 0x0e, 0xd7, 0x63, 0x79, 0x5b, 0xfa, 0x2f, 0x1c, 0x87, 0x65, 0x42,
+	0xff, 0x19, 0xd4, 0x3b, 0x9d, 0xfd, 0x18, 0xad, 0x3b, 0xb0, 0x6c, 0x9a, 0x76, 0x2f, 0x8e, 0xa5,
+	0x70, 0xac, 0xba, 0x69, 0xda, 0x51, 0x7d, 0x41, 0x6f, 0x41, 0xc3, 0xa7, 0xbd, 0x2c, 0x71, 0xcd,
+	0xa7, 0x11, 0x94, 0x7e, 0x00, 0x0d, 0x2e, 0x2c, 0x37, 0xea, 0x0c, 0x59, 0x6f, 0x83, 0x16, 0x23,
+	0x27, 0xdc, 0xa7, 0x8a, 0x6b, 0x91, 0xb0, 0xbc, 0x82, 0x04, 0xed, 0x60, 0x44, 0x71, 0x7a, 0x3b,
+	0x78, 0x13, 0xc0, 0xa2, 0x3d, 0xe9, 0xf4, 0x5c, 0xc6, 0x0a, 0xae, 0x5a, 0xf4, 0x89, 0xd8, 0x40,
+	0xdf, 0x83, 0x45, 0xce, 0x5f, 0x84, 0x47, 0x26, 0x49, 0x71, 0x6b, 0x24, 0x6f, 0x80, 0x25, 0x82,
+	0xfe, 0x11, 0x68, 0x9d, 0xce, 0x7e, 0x24, 0x47, 0x91, 0x7c, 0x52, 0xe0, 0x8e, 0x9f, 0x43, 0x23,
+	0x2a, 0x4a, 0x3c, 0x51, 0x35, 0xa0, 0x14, 0x92, 0x2b, 0x75, 0x3b, 0xe8, 0x03, 0x58, 0x14, 0x2f,
+	0x71, 0xe9, 0x41, 0x6f, 0x27, 0x65, 0x16, 0x67, 0xdb, 0xb1, 0xca, 0xc6, 0x37, 0xb0, 0x44, 0x62,
+	0x1e, 0x1e, 0x26, 0x72, 0xf1, 0x68, 0x53, 0x71, 0x6c, 0x47, 0xff, 0xb7, 0x0a, 0xb5, 0x98, 0x03,
+	0x66, 0xd8, 0xa7, 0xef, 0x59, 0x9a, 0x5d, 0x3f, 0xd4, 0xec, 0x0b, 0xea, 0x6d, 0x68, 0x58, 0xbc,
+	0x67, 0xe9, 0xc9, 0xe8, 0xe7, 0x45, 0xa6, 0x8a, 0xeb, 0x62, 0x57, 0xa6, 0x22, 0xb4, 0x01, 0x35,
+	0x77, 0xec, 0xf4, 0xbc, 0x93, 0xde, 0xc8, 0x7b, 0x41, 0xe5, 0x53, 0xac, 0xea, 0x8e, 0x9d, 0x1f,
+	0x9f, 0x60, 0xef, 0x05, 0x8d, 0xba, 0xfd, 0xc5, 0x0b, 0x76, 0xfb, 0x8f, 0x41, 0x33, 0x1d, 0x3b,
+	0x4a, 0xdb, 0x4b, 0xc5, 0x5b, 0x74, 0xd3, 0xb1, 0x83, 0x05, 0x93, 0xcf, 0x31, 0xce, 0x99, 0x70,
+	0x3d, 0x77, 0xec, 0x34, 0x2b, 0x42, 0x3e, 0xc7, 0x38, 0xc7, 0xde, 0x8b, 0xa7, 0x63, 0x07, 0x6d,
+	0xc1, 0x8a, 0x6d, 0x50, 0xbf, 0x17, 0x7f, 0x2d, 0x56, 0xf9, 0x6b, 0xb1, 0xc1, 0xf6, 0x1f, 0x47,
+	0x2f, 0xc6, 0xec, 0xf3, 0x03, 0xe6, 0x7c, 0x7e, 0xe8, 0x0f, 0xa0, 0xd6, 0xed, 0xb4, 0x99, 0x3b,
+	0xb1, 0x9e, 0x2d, 0x63, 0xc0, 0x35, 0x28, 0x1f, 0xc6, 0xbc, 0xaf, 0x1c, 0xf8, 0xdd, 0x5a, 0xa4,
+	0xa7, 0x88, 0x58, 0x8e, 0x5c, 0xca, 0xbc, 0xcf, 0xa2, 0xe9, 0x9d, 0xec, 0xff, 0x54, 0x58, 0x3f,
+	0x32, 0xce, 0xc8, 0xab, 0x6f, 0x9a, 0x0b, 0x15, 0x82, 0x7d, 0x58, 0xe5, 0x81, 0xde, 0x8e, 0xc9,
+	0x33, 0xa5, 0x1e, 0xc7, 0x14, 0x8e, 0xb3, 0x88, 0xe8, 0x87, 0xac, 0x91, 0x20, 0xfd, 0xe7, 0x87,
+	0x9e, 0x15, 0xd4, 0xe2, 0x5a, 0xfb, 0x66, 0x0e, 0x9d, 0xdd, 0x10, 0x0a, 0xc7, 0x31, 0xd0, 0x21,
+	0x2c, 0x27, 0xcd, 0x40, 0x9b, 0x8b, 0x9c, 0xc8, 0x3b, 0x53, 0x5f, 0x63, 0x91, 0xf6, 0x71, 0x23,
+	0x61, 0x0c, 0xca, 0x33, 0xb1, 0x4c, 0x8b, 0x4b, 0x3c, 0x2d, 0x06, 0x4b, 0x74, 0x08, 0x6f, 0x88,
+	0x1b, 0xb0, 0x88, 0xa1, 0xe1, 0xe5, 0x2b, 0x85, 0x2e, 0x9f, 0x87, 0xca, 0x12, 0x37, 0x44, 0x37,
+	0x9b, 0x91, 0xb2, 0x7f, 0x00, 0x95, 0xd0, 0xd7, 0x4a, 0x85, 0x7d, 0xad, 0x32, 0x8c, 0xc5, 0x64,
+	0x3c, 0x67, 0xa8, 0xa9, 0x9c, 0xa1, 0x7f, 0xa9, 0x40, 0xbd, 0x63, 0xf8, 0xc6, 0x53, 0xcf, 0x24,
+	0xcf, 0xe6, 0x2c, 0xe3, 0x05, 0xe6, 0x4f, 0x37, 0xa0, 0xca, 0xc2, 0x9d, 0xfa, 0x86, 0x33, 0xe4,
+	0x42, 0x2c, 0xe0, 0x68, 0x83, 0x3d, 0x56, 0xeb, 0x32, 0xc9, 0x1d, 0x85, 0xf3, 0x48, 0x4e, 0x4a,
+	0x94, 0x5b, 0xfe, 0x1b, 0x7d, 0x3f, 0x39, 0xcc, 0x78, 0x2b, 0xd7, 0x61, 0x38, 0x11, 0xde, 0xc2,
+	0x25, 0x32, 0x5c, 0x91, 0x57, 0xd0, 0x17, 0x0a, 0x68, 0x81, 0x2a, 0x78, 0xb2, 0x6f, 0xc2, 0x92,
+	0x61, 0x9a, 0x23, 0x42, 0xa9, 0x94, 0x23, 0x58, 0xb2, 0x93, 0x33, 0x32, 0xa2, 0x81, 0x51, 0x54,
+	0x1c, 0x2c, 0xd1, 0xfb, 0x50, 0x09, 0x7b, 0x3e, 0x31, 0x03, 0xdc, 0x9c, 0x2c, 0xa7, 0xec, 0xda,
+	0x43, 0x0c, 0xfd, 0xbf, 0x0a, 0x34, 0xa4, 0xbf, 0x8a, 0x80, 0xa1, 0x33, 0xdc, 0xe3, 0x11, 0x68,
+	0x27, 0x51, 0x03, 0x34, 0xed, 0x75, 0x1e, 0xeb, 0x93, 0x70, 0x02, 0x67, 0x96, 0x8b, 0xa0, 0xf7,
+	0xa1, 0x4a, 0xa5, 0x03, 0x4f, 0x0b, 0xfa, 0x38, 0x83, 0x08, 0x41, 0xff, 0x10, 0x6a, 0xb1, 0x93,
+	0x29, 0x2d, 0x4f, 0x13, 0x96, 0x8e, 0x63, 0xb7, 0xa8, 0xe2, 0x60, 0xa9, 0xff, 0x47, 0xe1, 0x63,
+	0x36, 0x4c, 0xfa, 0xde, 0x19, 0x19, 0xbd, 0xbc, 0xfc, 0x30, 0xe3, 0x61, 0xcc, 0x48, 0x05, 0x1b,
+	0xf3, 0x10, 0x01, 0x3d, 0x8c, 0xe4, 0x54, 0x27, 0xb6, 0x49, 0x49, 0x23, 0x46, 0x57, 0xf9, 0xbd,
+	0x18, 0xcb, 0x24, 0xaf, 0x32, 0x6f, 0x5e, 0xff, 0x4a, 0x9a, 0x0f, 0xfd, 0x8f, 0x0a, 0x7c, 0x7d,
+	0x8f, 0xf8, 0x4f, 0x92, 0x4f, 0xa1, 0xab, 0x96, 0xca, 0x81, 0x56, 0x9e, 0x50, 0x97, 0xb1, 0x7a,
+	0x0b, 0x2a, 0x34, 0x78, 0xff, 0x89, 0x81, 0x59, 0xb8, 0xd6, 0x7f, 0xa9, 0x40, 0x33, 0xde, 0x4c,
+	0xef, 0x7a, 0xce, 0xd0, 0x26, 0x3e, 0x31, 0x5f, 0xf3, 0xc3, 0xe6, 0xee, 0x7d, 0x58, 0xcd, 0x24,
+	0x31, 0xd4, 0x00, 0xf8, 0xc8, 0xed, 0x4b, 0x91, 0x56, 0xae, 0x21, 0x0d, 0x2a, 0x81, 0x80, 0x2b,
+	0x4a, 0xfb, 0xaf, 0x1a, 0x54, 0x59, 0xde, 0xda, 0x65, 0x5f, 0xb7, 0xd0, 0x10, 0x10, 0x1f, 0xe5,
+	0x38, 0x43, 0xcf, 0x0d, 0x67, 0x9e, 0xe8, 0xde, 0x84, 0xa2, 0x91, 0x05, 0x95, 0x86, 0x6f, 0xdd,
+	0x99, 0x80, 0x91, 0x02, 0xd7, 0xaf, 0x21, 0x87, 0x73, 0x64, 0x7d, 0xdb, 0x33, 0xab, 0xff, 0x3c,
+	0x68, 0x56, 0xa7, 0x70, 0x4c, 0x81, 0x06, 0x1c, 0x53, 0xa3, 0x54, 0xb9, 0x10, 0xf3, 0xb6, 0xc0,
+	0xf2, 0xfa, 0x35, 0xf4, 0x19, 0xac, 0xb1, 0xd9, 0x46, 0x38, 0x62, 0x09, 0x18, 0xb6, 0x27, 0x33,
+	0xcc, 0x00, 0x5f, 0x90, 0xe5, 0x3e, 0x94, 0xb9, 0x57, 0xa0, 0xbc, 0x34, 0x11, 0xff, 0xf0, 0xd7,
+	0xda, 0x9c, 0x0c, 0x10, 0x52, 0xfb, 0x39, 0x2c, 0xa7, 0x3e, 0x6c, 0xa0, 0x77, 0x73, 0xd0, 0xf2,
+	0x3f, 0x51, 0xb5, 0xee, 0x16, 0x01, 0x0d, 0x79, 0x0d, 0xa0, 0x91, 0x1c, 0x04, 0xa1, 0xad, 0x1c,
+	0xfc, 0xdc, 0xa1, 0x74, 0xeb, 0xdd, 0x02, 0x90, 0x21, 0x23, 0x07, 0x56, 0xd2, 0x83, 0x76, 0x74,
+	0x77, 0x2a, 0x81, 0xa4, 0xbb, 0x7d, 0xb3, 0x10, 0x6c, 0xc8, 0xee, 0x25, 0xac, 0xe5, 0x0d, 0x7a,
+	0xd1, 0x76, 0x3e, 0x99, 0x49, 0x13, 0xe8, 0xd6, 0x4e, 0x61, 0xf8, 0x90, 0xf5, 0x97, 0xa2, 0x1a,
+	0xe5, 0x0d, 0x4b, 0xd1, 0xfd, 0x7c, 0x72, 0x53, 0xa6, 0xbc, 0xad, 0xf6, 0x45, 0x50, 0x42, 0x21,
+	0x3e, 0x87, 0xf5, 0xfc, 0x81, 0x23, 0xba, 0x97, 0x4f, 0x6f, 0xf2, 0x24, 0xb5, 0x75, 0xff, 0x02,
+	0x18, 0xa1, 0x00, 0x5e, 0xfa, 0x53, 0x46, 0x10, 0x86, 0x3b, 0x33, 0xbd, 0x66, 0xbe, 0x18, 0xfc,
+	0x14, 0x96, 0x53, 0x0f, 0xa2, 0xdc, 0xa8, 0xc9, 0x7f, 0x34, 0xb5, 0xa6, 0x15, 0x08, 0x11, 0x92,
+	0xa9, 0xaa, 0x8c, 0x26, 0x78, 0x7f, 0x4e, 0xe5, 0x6e, 0xdd, 0x2d, 0x02, 0x1a, 0x5e, 0x84, 0xf2,
+	0x74, 0x99, 0xaa, 0x6c, 0xe8, 0x5b, 0xf9, 0x34, 0xf2, 0xab, 0x72, 0xeb, 0xbd, 0x82, 0xd0, 0x01,
+	0xd3, 0xf6, 0xdf, 0x55, 0xa8, 0x04, 0xbd, 0xed, 0x15, 0x94, 0x88, 0x2b, 0xc8, 0xd9, 0x9f, 0xc2,
+	0x72, 0x6a, 0x92, 0x9b, 0x6b, 0xd2, 0xfc, 0x69, 0xef, 0x2c, 0x7f, 0xf9, 0x44, 0xfe, 0xe9, 0x22,
+	0x34, 0xdf, 0x3b, 0x93, 0xf2, 0x7e, 0xda, 0x72, 0xd3, 0x09, 0x3f, 0x7a, 0xf0, 0xd3, 0xfb, 0x03,
+	0xcb, 0x3f, 0x1d, 0x1f, 0xb3, 0x93, 0x1d, 0x01, 0xfa, 0x9e, 0xe5, 0xc9, 0x5f, 0x3b, 0x81, 0x82,
+	0x76, 0x38, 0xf6, 0x0e, 0x63, 0x33, 0x3c, 0x3e, 0x5e, 0xe4, 0xab, 0x07, 0xff, 0x1f, 0x00, 0x3e,
+	0xf4, 0xcd, 0xa6, 0xe5, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -388,6 +388,7 @@ type BuildIndexRequest struct {
 	DataPaths            []string                 `protobuf:"bytes,5,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	FieldID              int64                    `protobuf:"varint,8,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -460,6 +461,13 @@ func (m *BuildIndexRequest) GetIndexParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *BuildIndexRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type BuildIndexResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
@@ -809,67 +817,68 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 952 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x1c, 0x45,
-	0x10, 0xf6, 0xec, 0x64, 0xff, 0x6a, 0x8d, 0x15, 0x37, 0x21, 0x1a, 0x36, 0x44, 0x59, 0x0f, 0x01,
-	0x2d, 0x28, 0x59, 0x47, 0x1b, 0x02, 0x27, 0x24, 0xb0, 0x57, 0x58, 0x2b, 0x94, 0xc8, 0xea, 0x58,
-	0x1c, 0x90, 0xd0, 0xaa, 0xbd, 0x53, 0xb6, 0x5b, 0x99, 0x3f, 0x4f, 0xf7, 0x46, 0xf8, 0xce, 0x9d,
-	0x1b, 0x88, 0x07, 0x41, 0x3c, 0x07, 0x67, 0x5e, 0x82, 0x47, 0x40, 0xdd, 0xd3, 0x33, 0x99, 0x99,
-	0x9d, 0x75, 0xd6, 0x98, 0x70, 0xca, 0x6d, 0xaa, 0xba, 0xaa, 0xbf, 0xae, 0xaf, 0x6a, 0xbe, 0x6e,
-	0xd8, 0xe6, 0xa1, 0x87, 0x3f, 0xce, 0xe6, 0x51, 0x94, 0x78, 0xa3, 0x38, 0x89, 0x64, 0x44, 0x48,
-	0xc0, 0xfd, 0x97, 0x0b, 0x91, 0x5a, 0x23, 0xbd, 0xde, 0xdf, 0x9c, 0x47, 0x41, 0x10, 0x85, 0xa9,
-	0xaf, 0xbf, 0xc5, 0x43, 0x89, 0x49, 0xc8, 0x7c, 0x63, 0x6f, 0x16, 0x33, 0xdc, 0x5f, 0x2d, 0x78,
-	0x97, 0xe2, 0x29, 0x17, 0x12, 0x93, 0x67, 0x91, 0x87, 0x14, 0xcf, 0x17, 0x28, 0x24, 0x79, 0x04,
-	0x37, 0x8e, 0x99, 0x40, 0xc7, 0x1a, 0x58, 0xc3, 0xde, 0xf8, 0x83, 0x51, 0x09, 0xc6, 0xec, 0xff,
-	0x54, 0x9c, 0xee, 0x31, 0x81, 0x54, 0x47, 0x92, 0xcf, 0xa1, 0xcd, 0x3c, 0x2f, 0x41, 0x21, 0x9c,
-	0xc6, 0x25, 0x49, 0x5f, 0xa7, 0x31, 0x34, 0x0b, 0x26, 0xb7, 0xa1, 0x15, 0x46, 0x1e, 0x4e, 0x27,
-	0x8e, 0x3d, 0xb0, 0x86, 0x36, 0x35, 0x96, 0xfb, 0xb3, 0x05, 0xb7, 0xca, 0x27, 0x13, 0x71, 0x14,
-	0x0a, 0x24, 0x8f, 0xa1, 0x25, 0x24, 0x93, 0x0b, 0x61, 0x0e, 0x77, 0xa7, 0x16, 0xe7, 0xb9, 0x0e,
-	0xa1, 0x26, 0x94, 0xec, 0x41, 0x8f, 0x87, 0x5c, 0xce, 0x62, 0x96, 0xb0, 0x20, 0x3b, 0xe1, 0xce,
-	0xa8, 0xc2, 0x9e, 0x21, 0x6a, 0x1a, 0x72, 0x79, 0xa8, 0x03, 0x29, 0xf0, 0xfc, 0xdb, 0xfd, 0x12,
-	0xde, 0x3b, 0x40, 0x39, 0x55, 0x1c, 0xab, 0xdd, 0x51, 0x64, 0x64, 0xdd, 0x87, 0x77, 0x34, 0xf3,
-	0x7b, 0x0b, 0xee, 0x7b, 0xd3, 0x89, 0x3a, 0x98, 0x3d, 0xb4, 0x69, 0xd9, 0xe9, 0xfe, 0x61, 0x41,
-	0x57, 0x27, 0x4f, 0xc3, 0x93, 0x88, 0x3c, 0x81, 0xa6, 0x3a, 0x5a, 0xca, 0xf0, 0xd6, 0xf8, 0x5e,
-	0x6d, 0x11, 0xaf, 0xb0, 0x68, 0x1a, 0x4d, 0x5c, 0xd8, 0x2c, 0xee, 0xaa, 0x0b, 0xb1, 0x69, 0xc9,
-	0x47, 0x1c, 0x68, 0x6b, 0x3b, 0xa7, 0x34, 0x33, 0xc9, 0x5d, 0x80, 0x74, 0x84, 0x42, 0x16, 0xa0,
-	0x73, 0x63, 0x60, 0x0d, 0xbb, 0xb4, 0xab, 0x3d, 0xcf, 0x58, 0x80, 0xaa, 0x15, 0x09, 0x32, 0x11,
-	0x85, 0x4e, 0x53, 0x2f, 0x19, 0xcb, 0xfd, 0xc9, 0x82, 0xdb, 0xd5, 0xca, 0xaf, 0xd3, 0x8c, 0x27,
-	0x69, 0x12, 0xaa, 0x3e, 0xd8, 0xc3, 0xde, 0xf8, 0xee, 0x68, 0x79, 0x8a, 0x47, 0x39, 0x55, 0xd4,
-	0x04, 0xbb, 0x7f, 0x36, 0x80, 0xec, 0x27, 0xc8, 0x24, 0xea, 0xb5, 0x8c, 0xfd, 0x2a, 0x25, 0x56,
-	0x0d, 0x25, 0xe5, 0xc2, 0x1b, 0xd5, 0xc2, 0x57, 0x33, 0xe6, 0x40, 0xfb, 0x25, 0x26, 0x82, 0x47,
-	0xa1, 0xa6, 0xcb, 0xa6, 0x99, 0x49, 0xee, 0x40, 0x37, 0x40, 0xc9, 0x66, 0x31, 0x93, 0x67, 0x86,
-	0xaf, 0x8e, 0x72, 0x1c, 0x32, 0x79, 0xa6, 0xf0, 0x3c, 0x66, 0x16, 0x85, 0xd3, 0x1a, 0xd8, 0x0a,
-	0x4f, 0x79, 0xd4, 0xaa, 0x9e, 0x46, 0x79, 0x11, 0x63, 0x36, 0x8d, 0x6d, 0xcd, 0xc2, 0x4e, 0x2d,
-	0x75, 0xdf, 0xe2, 0xc5, 0x77, 0xcc, 0x5f, 0xe0, 0x21, 0xe3, 0x09, 0x05, 0x95, 0x95, 0x4e, 0x23,
-	0x99, 0x98, 0xb2, 0xb3, 0x4d, 0x3a, 0xeb, 0x6e, 0xd2, 0xd3, 0x69, 0x66, 0xa6, 0x7f, 0x6b, 0xc0,
-	0x76, 0x4a, 0xd2, 0xff, 0x46, 0x69, 0x99, 0x9b, 0xe6, 0x6b, 0xb8, 0x69, 0xfd, 0x17, 0xdc, 0xb4,
-	0xff, 0x15, 0x37, 0x01, 0x90, 0x22, 0x35, 0xd7, 0x99, 0xf8, 0x35, 0x7e, 0x5b, 0xf7, 0x2b, 0x70,
-	0xb2, 0x9f, 0xec, 0x1b, 0xee, 0xa3, 0x66, 0xe3, 0x6a, 0x0a, 0xf3, 0x8b, 0x05, 0xdb, 0xa5, 0x7c,
-	0xad, 0x34, 0x6f, 0xea, 0xc0, 0x64, 0x08, 0x37, 0x53, 0x96, 0x4f, 0xb8, 0x8f, 0xa6, 0x9d, 0xb6,
-	0x6e, 0xe7, 0x16, 0x2f, 0x55, 0xa1, 0x0e, 0xf6, 0x7e, 0x4d, 0x6d, 0xd7, 0x61, 0x74, 0x02, 0x50,
-	0x80, 0x4d, 0x75, 0xe4, 0xa3, 0x95, 0x3a, 0x52, 0x24, 0x84, 0x76, 0x4f, 0xf2, 0x83, 0xfd, 0xd5,
-	0x30, 0x9a, 0xfc, 0x14, 0x25, 0x5b, 0x6b, 0xec, 0x73, 0xdd, 0x6e, 0x5c, 0x49, 0xb7, 0xef, 0x41,
-	0xef, 0x84, 0x71, 0x7f, 0x66, 0xf4, 0xd5, 0xd6, 0xbf, 0x0b, 0x28, 0x17, 0xd5, 0x1e, 0xf2, 0x05,
-	0xd8, 0x09, 0x9e, 0x6b, 0x91, 0x59, 0x51, 0xc8, 0xd2, 0x6f, 0x4a, 0x55, 0x46, 0x6d, 0x17, 0x9a,
-	0x75, 0x5d, 0x20, 0x3b, 0xb0, 0x19, 0xb0, 0xe4, 0xc5, 0xcc, 0x43, 0x1f, 0x25, 0x7a, 0x4e, 0x6b,
-	0x60, 0x0d, 0x3b, 0xb4, 0xa7, 0x7c, 0x93, 0xd4, 0x55, 0xb8, 0x8c, 0xdb, 0xc5, 0xcb, 0xb8, 0x28,
-	0x83, 0x9d, 0xb2, 0x0c, 0xf6, 0xa1, 0x93, 0xe0, 0xfc, 0x62, 0xee, 0xa3, 0xe7, 0x74, 0xf5, 0x86,
-	0xb9, 0xed, 0x3e, 0x80, 0x9b, 0x93, 0x24, 0x8a, 0x4b, 0xd2, 0x52, 0xd0, 0x05, 0xab, 0xa4, 0x0b,
-	0xe3, 0xbf, 0x9b, 0x00, 0x3a, 0x74, 0x5f, 0xbd, 0x6f, 0x48, 0x0c, 0xe4, 0x00, 0xe5, 0x7e, 0x14,
-	0xc4, 0x51, 0x88, 0xa1, 0x4c, 0xef, 0x1d, 0xf2, 0x68, 0xc5, 0x95, 0xbd, 0x1c, 0x6a, 0x00, 0xfb,
-	0x1f, 0xaf, 0xc8, 0xa8, 0x84, 0xbb, 0x1b, 0x24, 0xd0, 0x88, 0x47, 0x3c, 0xc0, 0x23, 0x3e, 0x7f,
-	0xb1, 0x7f, 0xc6, 0xc2, 0x10, 0xfd, 0xcb, 0x10, 0x2b, 0xa1, 0x19, 0xe2, 0x87, 0xe5, 0x0c, 0x63,
-	0x3c, 0x97, 0x09, 0x0f, 0x4f, 0xb3, 0xa1, 0x77, 0x37, 0xc8, 0x39, 0xdc, 0x3a, 0x40, 0x8d, 0xce,
-	0x85, 0xe4, 0x73, 0x91, 0x01, 0x8e, 0x57, 0x03, 0x2e, 0x05, 0x5f, 0x11, 0xf2, 0x07, 0x80, 0x57,
-	0x53, 0x44, 0xd6, 0x9b, 0xb2, 0x65, 0x02, 0xab, 0x61, 0xf9, 0xf6, 0x1c, 0xb6, 0xca, 0xcf, 0x04,
-	0xf2, 0x49, 0x5d, 0x6e, 0xed, 0x23, 0xaa, 0xff, 0xe9, 0x3a, 0xa1, 0x39, 0x54, 0x02, 0xdb, 0x4b,
-	0x82, 0x42, 0x1e, 0x5c, 0xb6, 0x45, 0x55, 0x53, 0xfb, 0x0f, 0xd7, 0x8c, 0xce, 0x31, 0x0f, 0xa1,
-	0x9b, 0x8f, 0x33, 0xb9, 0x5f, 0x97, 0x5d, 0x9d, 0xf6, 0xfe, 0x65, 0x52, 0xe6, 0x6e, 0x8c, 0x7f,
-	0xb7, 0x8d, 0xfc, 0xa8, 0x07, 0xee, 0xdb, 0x89, 0x7f, 0x03, 0x13, 0x7f, 0x04, 0xbd, 0xc2, 0x93,
-	0x91, 0xd4, 0xce, 0xf2, 0xf2, 0x9b, 0xf2, 0x35, 0x7d, 0xdb, 0xfb, 0xec, 0xfb, 0xf1, 0x29, 0x97,
-	0x67, 0x8b, 0x63, 0xb5, 0xb2, 0x9b, 0x86, 0x3e, 0xe4, 0x91, 0xf9, 0xda, 0xcd, 0x0a, 0xd8, 0xd5,
-	0xd9, 0xbb, 0x1a, 0x25, 0x3e, 0x3e, 0x6e, 0x69, 0xf3, 0xf1, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xfc, 0x30, 0x24, 0x6f, 0xc7, 0x0d, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x7a, 0xeb, 0xbf, 0xe3, 0x10, 0x35, 0x43, 0xa9, 0x16, 0x97, 0xaa, 0xee, 0x52, 0x2a,
+	0x83, 0x5a, 0xa7, 0x72, 0x29, 0x5c, 0x21, 0x41, 0x62, 0x11, 0x59, 0xa8, 0x55, 0x34, 0x8d, 0xb8,
+	0x40, 0x42, 0xd6, 0xc4, 0x7b, 0x9c, 0x8c, 0xba, 0x7f, 0xd9, 0x19, 0x57, 0xe4, 0x9e, 0x7b, 0xee,
+	0xe0, 0x41, 0x10, 0xe2, 0x39, 0xb8, 0xe6, 0x25, 0x78, 0x04, 0x34, 0xb3, 0xb3, 0xdb, 0x5d, 0x7b,
+	0xed, 0x38, 0x84, 0x72, 0xc5, 0xdd, 0x9e, 0x99, 0xef, 0xcc, 0x37, 0xe7, 0x9b, 0x33, 0xdf, 0x0e,
+	0xec, 0xf2, 0xd0, 0xc3, 0x1f, 0x26, 0xd3, 0x28, 0x4a, 0xbc, 0x41, 0x9c, 0x44, 0x32, 0x22, 0x24,
+	0xe0, 0xfe, 0xeb, 0xb9, 0x48, 0xa3, 0x81, 0x9e, 0xef, 0x6e, 0x4f, 0xa3, 0x20, 0x88, 0xc2, 0x74,
+	0xac, 0xbb, 0xc3, 0x43, 0x89, 0x49, 0xc8, 0x7c, 0x13, 0x6f, 0x17, 0x33, 0xdc, 0x5f, 0x2c, 0x78,
+	0x97, 0xe2, 0x29, 0x17, 0x12, 0x93, 0x17, 0x91, 0x87, 0x14, 0xcf, 0xe7, 0x28, 0x24, 0x79, 0x02,
+	0x37, 0x4e, 0x98, 0x40, 0xc7, 0xea, 0x59, 0xfd, 0xce, 0xf0, 0x83, 0x41, 0x89, 0xc6, 0xac, 0xff,
+	0x5c, 0x9c, 0xee, 0x33, 0x81, 0x54, 0x23, 0xc9, 0x67, 0xd0, 0x64, 0x9e, 0x97, 0xa0, 0x10, 0x4e,
+	0x6d, 0x4d, 0xd2, 0x57, 0x29, 0x86, 0x66, 0x60, 0x72, 0x1b, 0x1a, 0x61, 0xe4, 0xe1, 0x78, 0xe4,
+	0xd8, 0x3d, 0xab, 0x6f, 0x53, 0x13, 0xb9, 0x3f, 0x59, 0x70, 0xab, 0xbc, 0x33, 0x11, 0x47, 0xa1,
+	0x40, 0xf2, 0x14, 0x1a, 0x42, 0x32, 0x39, 0x17, 0x66, 0x73, 0x77, 0x2a, 0x79, 0x5e, 0x6a, 0x08,
+	0x35, 0x50, 0xb2, 0x0f, 0x1d, 0x1e, 0x72, 0x39, 0x89, 0x59, 0xc2, 0x82, 0x6c, 0x87, 0xf7, 0x07,
+	0x0b, 0xea, 0x19, 0xa1, 0xc6, 0x21, 0x97, 0x47, 0x1a, 0x48, 0x81, 0xe7, 0xdf, 0xee, 0x17, 0xf0,
+	0xde, 0x21, 0xca, 0xb1, 0xd2, 0x58, 0xad, 0x8e, 0x22, 0x13, 0xeb, 0x01, 0xbc, 0xa3, 0x95, 0xdf,
+	0x9f, 0x73, 0xdf, 0x1b, 0x8f, 0xd4, 0xc6, 0xec, 0xbe, 0x4d, 0xcb, 0x83, 0xee, 0xef, 0x16, 0xb4,
+	0x75, 0xf2, 0x38, 0x9c, 0x45, 0xe4, 0x19, 0xd4, 0xd5, 0xd6, 0x52, 0x85, 0x77, 0x86, 0xf7, 0x2a,
+	0x8b, 0x78, 0xc3, 0x45, 0x53, 0x34, 0x71, 0x61, 0xbb, 0xb8, 0xaa, 0x2e, 0xc4, 0xa6, 0xa5, 0x31,
+	0xe2, 0x40, 0x53, 0xc7, 0xb9, 0xa4, 0x59, 0x48, 0xee, 0x02, 0xa4, 0x2d, 0x14, 0xb2, 0x00, 0x9d,
+	0x1b, 0x3d, 0xab, 0xdf, 0xa6, 0x6d, 0x3d, 0xf2, 0x82, 0x05, 0xa8, 0x8e, 0x22, 0x41, 0x26, 0xa2,
+	0xd0, 0xa9, 0xeb, 0x29, 0x13, 0xb9, 0x3f, 0x5a, 0x70, 0x7b, 0xb1, 0xf2, 0xeb, 0x1c, 0xc6, 0xb3,
+	0x34, 0x09, 0xd5, 0x39, 0xd8, 0xfd, 0xce, 0xf0, 0xee, 0x60, 0xb9, 0x8b, 0x07, 0xb9, 0x54, 0xd4,
+	0x80, 0xdd, 0x3f, 0x6a, 0x40, 0x0e, 0x12, 0x64, 0x12, 0xf5, 0x5c, 0xa6, 0xfe, 0xa2, 0x24, 0x56,
+	0x85, 0x24, 0xe5, 0xc2, 0x6b, 0x8b, 0x85, 0xaf, 0x56, 0xcc, 0x81, 0xe6, 0x6b, 0x4c, 0x04, 0x8f,
+	0x42, 0x2d, 0x97, 0x4d, 0xb3, 0x90, 0xdc, 0x81, 0x76, 0x80, 0x92, 0x4d, 0x62, 0x26, 0xcf, 0x8c,
+	0x5e, 0x2d, 0x35, 0x70, 0xc4, 0xe4, 0x99, 0xe2, 0xf3, 0x98, 0x99, 0x14, 0x4e, 0xa3, 0x67, 0x2b,
+	0x3e, 0x8f, 0xa5, 0xb3, 0xba, 0x1b, 0xe5, 0x45, 0x8c, 0x59, 0x37, 0x36, 0x7b, 0xf6, 0x72, 0x37,
+	0x1a, 0xe9, 0xbe, 0xc1, 0x8b, 0x6f, 0x99, 0x3f, 0xc7, 0x23, 0xc6, 0x13, 0x0a, 0x2a, 0x2b, 0xed,
+	0x46, 0x32, 0x32, 0x65, 0x67, 0x8b, 0xb4, 0x36, 0x5d, 0xa4, 0xa3, 0xd3, 0x4c, 0x4f, 0xff, 0x5a,
+	0x83, 0xdd, 0x54, 0xa4, 0xff, 0x4c, 0xd2, 0xb2, 0x36, 0xf5, 0x4b, 0xb4, 0x69, 0xfc, 0x1b, 0xda,
+	0x34, 0xff, 0x89, 0x36, 0xaa, 0x84, 0x19, 0x47, 0x2d, 0x40, 0x2b, 0x2d, 0xc1, 0x84, 0x6e, 0x00,
+	0xa4, 0x28, 0xda, 0x75, 0xee, 0xc2, 0x06, 0x17, 0xda, 0xfd, 0x12, 0x9c, 0xec, 0xfa, 0x7d, 0xcd,
+	0x7d, 0xd4, 0x3a, 0x5d, 0xcd, 0x7b, 0x7e, 0xb6, 0x60, 0xb7, 0x94, 0xaf, 0x3d, 0xe8, 0x6d, 0x6d,
+	0x98, 0xf4, 0xe1, 0x66, 0xaa, 0xff, 0x8c, 0xfb, 0x68, 0x0e, 0xda, 0xd6, 0x07, 0xbd, 0xc3, 0x4b,
+	0x55, 0xa8, 0x8d, 0xbd, 0x5f, 0x51, 0xdb, 0x75, 0x14, 0x1d, 0x01, 0x14, 0x68, 0x53, 0x87, 0xf9,
+	0x68, 0xa5, 0xc3, 0x14, 0x05, 0xa1, 0xed, 0x59, 0xbe, 0xb1, 0x3f, 0x6b, 0xc6, 0xad, 0x9f, 0xa3,
+	0x64, 0x1b, 0x5d, 0x88, 0xdc, 0xd1, 0x6b, 0x57, 0x72, 0xf4, 0x7b, 0xd0, 0x99, 0x31, 0xee, 0x4f,
+	0x8c, 0xf3, 0xda, 0xfa, 0x22, 0x81, 0x1a, 0xa2, 0x7a, 0x84, 0x7c, 0x0e, 0x76, 0x82, 0xe7, 0xda,
+	0x7e, 0x56, 0x14, 0xb2, 0x74, 0x81, 0xa9, 0xca, 0xa8, 0x3c, 0x85, 0x7a, 0xd5, 0x29, 0x90, 0xfb,
+	0xb0, 0x1d, 0xb0, 0xe4, 0xd5, 0xc4, 0x43, 0x1f, 0x25, 0x7a, 0x4e, 0xa3, 0x67, 0xf5, 0x5b, 0xb4,
+	0xa3, 0xc6, 0x46, 0xe9, 0x50, 0xe1, 0x37, 0xdd, 0x2c, 0xfe, 0xa6, 0x8b, 0x06, 0xd9, 0x2a, 0x1b,
+	0x64, 0x17, 0x5a, 0x09, 0x4e, 0x2f, 0xa6, 0x3e, 0x7a, 0x4e, 0x5b, 0x2f, 0x98, 0xc7, 0xee, 0x23,
+	0xb8, 0x39, 0x4a, 0xa2, 0xb8, 0x64, 0x3a, 0x05, 0xc7, 0xb0, 0x4a, 0x8e, 0x31, 0xfc, 0xab, 0x0e,
+	0xa0, 0xa1, 0x07, 0xea, 0xe5, 0x43, 0x62, 0x20, 0x87, 0x28, 0x0f, 0xa2, 0x20, 0x8e, 0x42, 0x0c,
+	0x65, 0xfa, 0x47, 0x22, 0x4f, 0x56, 0xfc, 0xcc, 0x97, 0xa1, 0x86, 0xb0, 0xfb, 0x70, 0x45, 0xc6,
+	0x02, 0xdc, 0xdd, 0x22, 0x81, 0x66, 0x3c, 0xe6, 0x01, 0x1e, 0xf3, 0xe9, 0xab, 0x83, 0x33, 0x16,
+	0x86, 0xe8, 0xaf, 0x63, 0x5c, 0x80, 0x66, 0x8c, 0x1f, 0x96, 0x33, 0x4c, 0xf0, 0x52, 0x26, 0x3c,
+	0x3c, 0xcd, 0x9a, 0xde, 0xdd, 0x22, 0xe7, 0x70, 0xeb, 0x10, 0x35, 0x3b, 0x17, 0x92, 0x4f, 0x45,
+	0x46, 0x38, 0x5c, 0x4d, 0xb8, 0x04, 0xbe, 0x22, 0xe5, 0xf7, 0x00, 0x6f, 0xba, 0x88, 0x6c, 0xd6,
+	0x65, 0xdd, 0x87, 0x97, 0xc1, 0xf2, 0xe5, 0x39, 0xec, 0x94, 0x1f, 0x10, 0xe4, 0xe3, 0xaa, 0xdc,
+	0xca, 0xe7, 0x55, 0xf7, 0x93, 0x4d, 0xa0, 0x39, 0x55, 0x02, 0xbb, 0x4b, 0x86, 0x42, 0x1e, 0xad,
+	0x5b, 0x62, 0xd1, 0x53, 0xbb, 0x8f, 0x37, 0x44, 0xe7, 0x9c, 0x47, 0xd0, 0xce, 0xdb, 0x99, 0x3c,
+	0xa8, 0xca, 0x5e, 0xec, 0xf6, 0xee, 0x3a, 0x2b, 0x73, 0xb7, 0x86, 0xbf, 0xd9, 0xc6, 0x7e, 0xd4,
+	0xd3, 0xf7, 0xff, 0x8e, 0x7f, 0x0b, 0x1d, 0x7f, 0x0c, 0x9d, 0xc2, 0x63, 0x92, 0x54, 0xf6, 0xf2,
+	0xf2, 0x6b, 0xf3, 0x92, 0x73, 0xdb, 0xff, 0xf4, 0xbb, 0xe1, 0x29, 0x97, 0x67, 0xf3, 0x13, 0x35,
+	0xb3, 0x97, 0x42, 0x1f, 0xf3, 0xc8, 0x7c, 0xed, 0x65, 0x05, 0xec, 0xe9, 0xec, 0x3d, 0xcd, 0x12,
+	0x9f, 0x9c, 0x34, 0x74, 0xf8, 0xf4, 0xef, 0x01, 0x00, 0x19, 0x24, 0x2e, 0x04, 0xe1, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,18 +28,21 @@ const (
 	StateCode_Initializing StateCode = 0
 	StateCode_Healthy      StateCode = 1
 	StateCode_Abnormal     StateCode = 2
+	StateCode_StandBy      StateCode = 3
 )
 
 var StateCode_name = map[int32]string{
 	0: "Initializing",
 	1: "Healthy",
 	2: "Abnormal",
+	3: "StandBy",
 }
 
 var StateCode_value = map[string]int32{
 	"Initializing": 0,
 	"Healthy":      1,
 	"Abnormal":     2,
+	"StandBy":      3,
 }
 
 func (x StateCode) String() string {
@@ -1186,15 +1189,16 @@ type SearchResults struct {
 	MetricType      string            `protobuf:"bytes,4,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
 	Hits            [][]byte          `protobuf:"bytes,5,rep,name=hits,proto3" json:"hits,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob               []byte   `protobuf:"bytes,9,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount           int64    `protobuf:"varint,10,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset             int64    `protobuf:"varint,11,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	SealedSegmentIDsSearched []int64  `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_searched,json=sealedSegmentIDsSearched,proto3" json:"sealed_segmentIDs_searched,omitempty"`
-	ChannelIDsSearched       []string `protobuf:"bytes,7,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
-	GlobalSealedSegmentIDs   []int64  `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	SlicedBlob               []byte                `protobuf:"bytes,9,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount           int64                 `protobuf:"varint,10,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset             int64                 `protobuf:"varint,11,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	SealedSegmentIDsSearched []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_searched,json=sealedSegmentIDsSearched,proto3" json:"sealed_segmentIDs_searched,omitempty"`
+	ChannelIDsSearched       []string              `protobuf:"bytes,7,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
+	GlobalSealedSegmentIDs   []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	StageCosts               []*commonpb.StageCost `protobuf:"bytes,12,rep,name=stage_costs,json=stageCosts,proto3" json:"stage_costs,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}              `json:"-"`
+	XXX_unrecognized         []byte                `json:"-"`
+	XXX_sizecache            int32                 `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
@@ -1299,6 +1303,13 @@ func (m *SearchResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *SearchResults) GetStageCosts() []*commonpb.StageCost {
+	if m != nil {
+		return m.StageCosts
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
@@ -2212,126 +2223,128 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5b, 0x73, 0x23, 0x47,
-	0xf5, 0xff, 0x8f, 0x46, 0xb6, 0xa4, 0x23, 0xd9, 0xd6, 0xf6, 0x7a, 0x37, 0x63, 0xef, 0x66, 0xe3,
-	0x4c, 0xf2, 0x07, 0x93, 0x2d, 0xd6, 0x8b, 0x03, 0x24, 0x45, 0x51, 0x6c, 0x62, 0x2b, 0x2c, 0xaa,
-	0x8d, 0x8d, 0x69, 0x6f, 0x52, 0x05, 0x2f, 0x53, 0xad, 0x99, 0xb6, 0x3c, 0x64, 0x6e, 0x4c, 0xb7,
-	0xbc, 0xab, 0x3c, 0xf1, 0xc0, 0x13, 0x14, 0x3c, 0x50, 0xc5, 0xd7, 0xe0, 0x95, 0x27, 0x2e, 0xc5,
-	0x13, 0x55, 0x7c, 0x02, 0x3e, 0x05, 0xaf, 0x14, 0xc5, 0x03, 0xd5, 0xa7, 0x7b, 0x2e, 0x92, 0x65,
-	0xe3, 0xf5, 0x16, 0x10, 0x0a, 0xde, 0xa6, 0x7f, 0xe7, 0xf4, 0xe5, 0xfc, 0xce, 0xa5, 0x8f, 0x5a,
-	0xb0, 0x1a, 0x26, 0x92, 0xe7, 0x09, 0x8b, 0x1e, 0x64, 0x79, 0x2a, 0x53, 0x72, 0x2b, 0x0e, 0xa3,
-	0xb3, 0x89, 0xd0, 0xa3, 0x07, 0x85, 0x70, 0xb3, 0xe7, 0xa7, 0x71, 0x9c, 0x26, 0x1a, 0xde, 0xec,
-	0x09, 0xff, 0x94, 0xc7, 0x4c, 0x8f, 0xdc, 0xdf, 0x58, 0xb0, 0xb2, 0x9f, 0xc6, 0x59, 0x9a, 0xf0,
-	0x44, 0x0e, 0x93, 0x93, 0x94, 0xdc, 0x86, 0xe5, 0x24, 0x0d, 0xf8, 0x70, 0xe0, 0x58, 0x5b, 0xd6,
-	0xb6, 0x4d, 0xcd, 0x88, 0x10, 0x68, 0xe6, 0x69, 0xc4, 0x9d, 0xc6, 0x96, 0xb5, 0xdd, 0xa1, 0xf8,
-	0x4d, 0x1e, 0x01, 0x08, 0xc9, 0x24, 0xf7, 0xfc, 0x34, 0xe0, 0x8e, 0xbd, 0x65, 0x6d, 0xaf, 0xee,
-	0x6e, 0x3d, 0x58, 0x78, 0x8a, 0x07, 0xc7, 0x4a, 0x71, 0x3f, 0x0d, 0x38, 0xed, 0x88, 0xe2, 0x93,
-	0xbc, 0x07, 0xc0, 0x9f, 0xcb, 0x9c, 0x79, 0x61, 0x72, 0x92, 0x3a, 0xcd, 0x2d, 0x7b, 0xbb, 0xbb,
-	0xfb, 0xfa, 0xec, 0x02, 0xe6, 0xf0, 0x4f, 0xf8, 0xf4, 0x63, 0x16, 0x4d, 0xf8, 0x11, 0x0b, 0x73,
-	0xda, 0xc1, 0x49, 0xea, 0xb8, 0xee, 0x9f, 0x2c, 0x58, 0x2b, 0x0d, 0xc0, 0x3d, 0x04, 0xf9, 0x1a,
-	0x2c, 0xe1, 0x16, 0x68, 0x41, 0x77, 0xf7, 0xcd, 0x0b, 0x4e, 0x34, 0x63, 0x37, 0xd5, 0x53, 0xc8,
-	0x47, 0x70, 0x53, 0x4c, 0x46, 0x7e, 0x21, 0xf2, 0x10, 0x15, 0x4e, 0x03, 0x8f, 0x76, 0xb5, 0x95,
-	0x48, 0x7d, 0x01, 0x73, 0xa4, 0xb7, 0x61, 0x59, 0xad, 0x34, 0x11, 0xc8, 0x52, 0x77, 0xf7, 0xce,
-	0x42, 0x23, 0x8f, 0x51, 0x85, 0x1a, 0x55, 0xf7, 0x0e, 0x6c, 0x3c, 0xe6, 0x72, 0xce, 0x3a, 0xca,
-	0x7f, 0x30, 0xe1, 0x42, 0x1a, 0xe1, 0xd3, 0x30, 0xe6, 0x4f, 0x43, 0xff, 0x93, 0xfd, 0x53, 0x96,
-	0x24, 0x3c, 0x2a, 0x84, 0xaf, 0xc2, 0x9d, 0xc7, 0x1c, 0x27, 0x84, 0x42, 0x86, 0xbe, 0x98, 0x13,
-	0xdf, 0x82, 0x9b, 0x8f, 0xb9, 0x1c, 0x04, 0x73, 0xf0, 0xc7, 0xd0, 0x3e, 0x54, 0xce, 0x56, 0x61,
-	0xf0, 0x55, 0x68, 0xb1, 0x20, 0xc8, 0xb9, 0x10, 0x86, 0xc5, 0xbb, 0x0b, 0x4f, 0xfc, 0xbe, 0xd6,
-	0xa1, 0x85, 0xf2, 0xa2, 0x30, 0x71, 0xbf, 0x0f, 0x30, 0x4c, 0x42, 0x79, 0xc4, 0x72, 0x16, 0x8b,
-	0x0b, 0x03, 0x6c, 0x00, 0x3d, 0x21, 0x59, 0x2e, 0xbd, 0x0c, 0xf5, 0x0c, 0xe5, 0x57, 0x88, 0x86,
-	0x2e, 0x4e, 0xd3, 0xab, 0xbb, 0xdf, 0x05, 0x38, 0x96, 0x79, 0x98, 0x8c, 0x3f, 0x0c, 0x85, 0x54,
-	0x7b, 0x9d, 0x29, 0x3d, 0x65, 0x84, 0xbd, 0xdd, 0xa1, 0x66, 0x54, 0x73, 0x47, 0xe3, 0xea, 0xee,
-	0x78, 0x04, 0xdd, 0x82, 0xee, 0x03, 0x31, 0x26, 0x0f, 0xa1, 0x39, 0x62, 0x82, 0x5f, 0x4a, 0xcf,
-	0x81, 0x18, 0xef, 0x31, 0xc1, 0x29, 0x6a, 0xba, 0x3f, 0xb6, 0xe1, 0x95, 0xfd, 0x9c, 0x63, 0xf0,
-	0x47, 0x11, 0xf7, 0x65, 0x98, 0x26, 0x86, 0xfb, 0x17, 0x5f, 0x8d, 0xbc, 0x02, 0xad, 0x60, 0xe4,
-	0x25, 0x2c, 0x2e, 0xc8, 0x5e, 0x0e, 0x46, 0x87, 0x2c, 0xe6, 0xe4, 0x73, 0xb0, 0xea, 0x97, 0xeb,
-	0x2b, 0x04, 0x63, 0xae, 0x43, 0xe7, 0x50, 0xf2, 0x26, 0xac, 0x64, 0x2c, 0x97, 0x61, 0xa9, 0xd6,
-	0x44, 0xb5, 0x59, 0x50, 0x39, 0x34, 0x18, 0x0d, 0x07, 0xce, 0x12, 0x3a, 0x0b, 0xbf, 0x89, 0x0b,
-	0xbd, 0x6a, 0xad, 0xe1, 0xc0, 0x59, 0x46, 0xd9, 0x0c, 0x46, 0xb6, 0xa0, 0x5b, 0x2e, 0x34, 0x1c,
-	0x38, 0x2d, 0x54, 0xa9, 0x43, 0xca, 0x39, 0xba, 0x16, 0x39, 0xed, 0x2d, 0x6b, 0xbb, 0x47, 0xcd,
-	0x88, 0x3c, 0x84, 0x9b, 0x67, 0x61, 0x2e, 0x27, 0x2c, 0x32, 0xf1, 0xa9, 0xce, 0x21, 0x9c, 0x0e,
-	0x7a, 0x70, 0x91, 0x88, 0xec, 0xc2, 0x7a, 0x76, 0x3a, 0x15, 0xa1, 0x3f, 0x37, 0x05, 0x70, 0xca,
-	0x42, 0x99, 0xfb, 0x7b, 0x0b, 0x6e, 0x0d, 0xf2, 0x34, 0xfb, 0x4c, 0xb8, 0xa2, 0x20, 0xb9, 0x79,
-	0x09, 0xc9, 0x4b, 0xe7, 0x49, 0x76, 0x7f, 0xda, 0x80, 0xdb, 0x3a, 0xa2, 0x8e, 0x0a, 0x62, 0xff,
-	0x09, 0x56, 0x7c, 0x1e, 0xd6, 0xaa, 0x5d, 0xb5, 0xc2, 0x62, 0x33, 0xfe, 0x1f, 0x56, 0x4b, 0x07,
-	0x6b, 0xbd, 0x7f, 0x6d, 0x48, 0xb9, 0x3f, 0x69, 0xc0, 0xba, 0x72, 0xea, 0xff, 0xd8, 0x50, 0x6c,
-	0xfc, 0xb6, 0x01, 0x44, 0x47, 0xc7, 0x30, 0x09, 0xf8, 0xf3, 0x7f, 0x27, 0x17, 0xaf, 0x02, 0x9c,
-	0x84, 0x3c, 0x0a, 0xea, 0x3c, 0x74, 0x10, 0x79, 0x29, 0x0e, 0x1c, 0x68, 0xe1, 0x22, 0xa5, 0xfd,
-	0xc5, 0x50, 0xdd, 0x26, 0xba, 0xb3, 0x30, 0xb7, 0x49, 0xfb, 0xca, 0xb7, 0x09, 0x4e, 0x33, 0xb7,
-	0xc9, 0x2f, 0x6d, 0x58, 0x19, 0x26, 0x82, 0xe7, 0xf2, 0xbf, 0x39, 0x90, 0xc8, 0x5d, 0xe8, 0x08,
-	0x3e, 0x8e, 0x55, 0x83, 0x33, 0xc0, 0x62, 0x6d, 0xd3, 0x0a, 0x50, 0x52, 0x5f, 0x57, 0xd6, 0xe1,
-	0xc0, 0xe9, 0x68, 0xd7, 0x96, 0x00, 0xb9, 0x07, 0x20, 0xc3, 0x98, 0x0b, 0xc9, 0xe2, 0x4c, 0x57,
-	0xe4, 0x26, 0xad, 0x21, 0xea, 0x16, 0xc8, 0xd3, 0x67, 0xc3, 0x81, 0x70, 0xba, 0x5b, 0xb6, 0x6a,
-	0x07, 0xf4, 0x88, 0x7c, 0x19, 0xda, 0x79, 0xfa, 0xcc, 0x0b, 0x98, 0x64, 0x4e, 0x0f, 0x9d, 0xb7,
-	0xb1, 0x90, 0xec, 0xbd, 0x28, 0x1d, 0xd1, 0x56, 0x9e, 0x3e, 0x1b, 0x30, 0xc9, 0xdc, 0xbf, 0xd8,
-	0xb0, 0x72, 0xcc, 0x59, 0xee, 0x9f, 0x5e, 0xdf, 0x61, 0x5f, 0x80, 0x7e, 0xce, 0xc5, 0x24, 0x92,
-	0x5e, 0x65, 0x96, 0xf6, 0xdc, 0x9a, 0xc6, 0xf7, 0x4b, 0xe3, 0x0a, 0xca, 0xed, 0x4b, 0x28, 0x6f,
-	0x2e, 0xa0, 0xdc, 0x85, 0x5e, 0x8d, 0x5f, 0xe1, 0x2c, 0xa1, 0xe9, 0x33, 0x18, 0xe9, 0x83, 0x1d,
-	0x88, 0x08, 0x3d, 0xd6, 0xa1, 0xea, 0x93, 0xdc, 0x87, 0x1b, 0x59, 0xc4, 0x7c, 0x7e, 0x9a, 0x46,
-	0x01, 0xcf, 0xbd, 0x71, 0x9e, 0x4e, 0x32, 0x74, 0x57, 0x8f, 0xf6, 0x6b, 0x82, 0xc7, 0x0a, 0x27,
-	0xef, 0x40, 0x3b, 0x10, 0x91, 0x27, 0xa7, 0x19, 0x47, 0x97, 0xad, 0x5e, 0x60, 0xfb, 0x40, 0x44,
-	0x4f, 0xa7, 0x19, 0xa7, 0xad, 0x40, 0x7f, 0x90, 0x87, 0xb0, 0x2e, 0x78, 0x1e, 0xb2, 0x28, 0xfc,
-	0x94, 0x07, 0x1e, 0x7f, 0x9e, 0xe5, 0x5e, 0x16, 0xb1, 0x04, 0x3d, 0xdb, 0xa3, 0xa4, 0x92, 0x7d,
-	0xf0, 0x3c, 0xcb, 0x8f, 0x22, 0x96, 0x90, 0x6d, 0xe8, 0xa7, 0x13, 0x99, 0x4d, 0xa4, 0x87, 0xd9,
-	0x27, 0xbc, 0x30, 0x40, 0x47, 0xdb, 0x74, 0x55, 0xe3, 0xdf, 0x44, 0x78, 0x18, 0x28, 0x6a, 0x65,
-	0xce, 0xce, 0x78, 0xe4, 0x95, 0x11, 0xe0, 0x74, 0xb7, 0xac, 0xed, 0x26, 0x5d, 0xd3, 0xf8, 0xd3,
-	0x02, 0x26, 0x3b, 0x70, 0x73, 0x3c, 0x61, 0x39, 0x4b, 0x24, 0xe7, 0x35, 0xed, 0x1e, 0x6a, 0x93,
-	0x52, 0x54, 0x4e, 0x70, 0xff, 0x5c, 0x73, 0xbd, 0xf2, 0x92, 0xb8, 0x86, 0xeb, 0xaf, 0xd3, 0x17,
-	0x2e, 0x8c, 0x17, 0x7b, 0x71, 0xbc, 0xbc, 0x06, 0xdd, 0x98, 0xcb, 0x3c, 0xf4, 0xb5, 0x5f, 0x74,
-	0x1a, 0x83, 0x86, 0x90, 0x7c, 0x02, 0xcd, 0xd3, 0x50, 0xea, 0x80, 0xe8, 0x51, 0xfc, 0x56, 0x93,
-	0x44, 0x14, 0xfa, 0x3c, 0xf0, 0x46, 0x51, 0x3a, 0x32, 0x7e, 0x00, 0x0d, 0xa9, 0xe8, 0x57, 0xfc,
-	0x1b, 0x85, 0x64, 0x12, 0x7b, 0x7e, 0x3a, 0x49, 0xa4, 0x03, 0x18, 0x75, 0xab, 0x1a, 0x3f, 0x9c,
-	0xc4, 0xfb, 0x0a, 0x25, 0x6f, 0xc0, 0x8a, 0xd1, 0x4c, 0x4f, 0x4e, 0x04, 0x97, 0x48, 0xbe, 0x4d,
-	0x7b, 0x1a, 0xfc, 0x36, 0x62, 0xe4, 0xeb, 0xb0, 0x29, 0x38, 0x8b, 0x78, 0xe0, 0x95, 0x39, 0x2e,
-	0x3c, 0x81, 0xcc, 0xf2, 0xc0, 0x59, 0x46, 0xc7, 0x3a, 0x5a, 0xe3, 0xb8, 0x54, 0x38, 0x36, 0x72,
-	0xe5, 0xb7, 0x92, 0x86, 0xda, 0xb4, 0x16, 0xb6, 0x62, 0xa4, 0x12, 0x95, 0x13, 0xde, 0x05, 0x67,
-	0x1c, 0xa5, 0x23, 0x16, 0x79, 0xe7, 0x76, 0xc5, 0xaa, 0x6d, 0xd3, 0xdb, 0x5a, 0x7e, 0x3c, 0xb7,
-	0xa5, 0xfb, 0xb7, 0x06, 0xac, 0x51, 0xc5, 0x1d, 0x3f, 0xe3, 0xff, 0xf1, 0xe9, 0xfe, 0x16, 0xd8,
-	0x61, 0x20, 0x30, 0xdd, 0xbb, 0xbb, 0xce, 0xec, 0xb9, 0xcd, 0x4f, 0xf6, 0xe1, 0x40, 0x50, 0xa5,
-	0xb4, 0x30, 0xe1, 0x5a, 0x57, 0x4e, 0xb8, 0xf6, 0x0b, 0x25, 0x5c, 0xe7, 0xc2, 0x84, 0xfb, 0xb5,
-	0x5d, 0xa7, 0xff, 0xb3, 0x9a, 0x72, 0x86, 0xd7, 0xe6, 0x55, 0x78, 0x7d, 0x04, 0x5d, 0x43, 0x28,
-	0x5e, 0x3b, 0x4b, 0x78, 0xed, 0xdc, 0x5b, 0x38, 0x07, 0x19, 0x56, 0x57, 0x0e, 0xd5, 0x8d, 0x8d,
-	0x50, 0xdf, 0xe4, 0x1b, 0x70, 0xe7, 0x7c, 0xea, 0xe4, 0x86, 0xa3, 0x22, 0x77, 0x36, 0xe6, 0x73,
-	0xa7, 0x20, 0x31, 0x20, 0x5f, 0x82, 0xf5, 0x5a, 0xf2, 0x54, 0x13, 0x75, 0xf6, 0xd4, 0x12, 0xab,
-	0x9a, 0x72, 0xfd, 0xf4, 0xf9, 0xa3, 0x05, 0x2b, 0x03, 0x1e, 0x71, 0xf9, 0x12, 0xc9, 0xb3, 0xa0,
-	0x87, 0x69, 0x2c, 0xec, 0x61, 0x66, 0x9a, 0x04, 0xfb, 0xf2, 0x26, 0xa1, 0x79, 0xae, 0x49, 0x78,
-	0x1d, 0x7a, 0x59, 0x1e, 0xc6, 0x2c, 0x9f, 0x7a, 0x9f, 0xf0, 0x69, 0x91, 0x40, 0x5d, 0x83, 0x3d,
-	0xe1, 0x53, 0xe1, 0x26, 0xb0, 0xf9, 0x61, 0xca, 0x82, 0x3d, 0x16, 0xb1, 0xc4, 0xe7, 0xc6, 0x4c,
-	0x71, 0x7d, 0xcb, 0xee, 0x01, 0xd4, 0x98, 0x6c, 0xe0, 0x86, 0x35, 0xc4, 0xfd, 0xab, 0x05, 0x1d,
-	0xb5, 0x21, 0xb6, 0xd6, 0xd7, 0x58, 0x7f, 0xa6, 0xa7, 0x6a, 0x2c, 0xe8, 0xa9, 0xca, 0xee, 0xb8,
-	0xa0, 0xab, 0x6a, 0x97, 0x6b, 0x6d, 0x6f, 0x73, 0xb6, 0xed, 0x7d, 0x0d, 0xba, 0xa1, 0x3a, 0x90,
-	0x97, 0x31, 0x79, 0xaa, 0x79, 0xea, 0x50, 0x40, 0xe8, 0x48, 0x21, 0xaa, 0x2f, 0x2e, 0x14, 0xb0,
-	0x2f, 0x5e, 0xbe, 0x72, 0x5f, 0x6c, 0x16, 0xc1, 0xbe, 0xf8, 0x77, 0x0d, 0x70, 0x0c, 0xc5, 0xd5,
-	0x23, 0xd3, 0x47, 0x59, 0x80, 0x6f, 0x5d, 0x77, 0xa1, 0x53, 0x46, 0x99, 0x79, 0xe3, 0xa9, 0x00,
-	0xc5, 0xeb, 0x01, 0x8f, 0xd3, 0x7c, 0x7a, 0x1c, 0x7e, 0xca, 0x8d, 0xe1, 0x35, 0x44, 0xd9, 0x76,
-	0x38, 0x89, 0x69, 0xfa, 0x4c, 0x98, 0x32, 0x5b, 0x0c, 0x95, 0x6d, 0x3e, 0xfe, 0x9a, 0xc1, 0xea,
-	0x84, 0x96, 0x37, 0x29, 0x68, 0x48, 0x55, 0x25, 0xb2, 0x01, 0x6d, 0x9e, 0x04, 0x5a, 0xba, 0x84,
-	0xd2, 0x16, 0x4f, 0x02, 0x14, 0x0d, 0x61, 0xd5, 0x3c, 0x2e, 0xa5, 0x02, 0x4b, 0xae, 0x29, 0xb4,
-	0xee, 0x05, 0x2f, 0x7a, 0x07, 0x62, 0x7c, 0x64, 0x34, 0xe9, 0x8a, 0x7e, 0x5f, 0x32, 0x43, 0xf2,
-	0x01, 0xf4, 0xd4, 0x2e, 0xe5, 0x42, 0xad, 0x2b, 0x2f, 0xd4, 0xe5, 0x49, 0x50, 0x0c, 0xdc, 0x9f,
-	0x5b, 0x70, 0xe3, 0x1c, 0x85, 0xd7, 0x88, 0xa3, 0x27, 0xd0, 0x3e, 0xe6, 0x63, 0xb5, 0x44, 0xf1,
-	0x64, 0xb6, 0x73, 0xd1, 0x0b, 0xec, 0x05, 0x0e, 0xa3, 0xe5, 0x02, 0xee, 0x8f, 0x2c, 0x00, 0x0c,
-	0x68, 0x1c, 0x9e, 0x0b, 0x16, 0xeb, 0x3a, 0xc1, 0xa2, 0x1a, 0x4a, 0xd5, 0x97, 0xe4, 0x3c, 0x62,
-	0xb2, 0xaa, 0x4f, 0xc2, 0xf8, 0x9e, 0x24, 0x93, 0x98, 0x6a, 0x51, 0x91, 0xb4, 0xee, 0xcf, 0x2c,
-	0x00, 0x2c, 0xb0, 0xfa, 0x18, 0xf3, 0x57, 0xac, 0x75, 0xf9, 0x2f, 0xc1, 0xc6, 0x6c, 0x4a, 0xec,
-	0x15, 0x29, 0x21, 0x90, 0x23, 0x7b, 0x91, 0x0d, 0x25, 0x47, 0x95, 0xf1, 0x26, 0x6b, 0x34, 0x2f,
-	0xbf, 0xb0, 0xa0, 0x57, 0xa3, 0x4f, 0xcc, 0x66, 0xaf, 0x35, 0x9f, 0xbd, 0xd8, 0xe6, 0xa9, 0x88,
-	0xf6, 0x44, 0x2d, 0xc8, 0xe3, 0x2a, 0xc8, 0x37, 0xa0, 0x8d, 0x94, 0xd4, 0xa2, 0x3c, 0x31, 0x51,
-	0x7e, 0x1f, 0x6e, 0xe4, 0xdc, 0xe7, 0x89, 0x8c, 0xa6, 0x5e, 0x9c, 0x06, 0xe1, 0x49, 0xc8, 0x03,
-	0x8c, 0xf5, 0x36, 0xed, 0x17, 0x82, 0x03, 0x83, 0xbb, 0x7f, 0xb0, 0x60, 0xf5, 0x3b, 0x13, 0x9e,
-	0x4f, 0x0f, 0xd3, 0x80, 0xeb, 0x93, 0xbd, 0x78, 0x04, 0xbd, 0x87, 0xb6, 0x18, 0x7a, 0x74, 0x08,
-	0xbd, 0xf1, 0x8f, 0x43, 0x48, 0xd0, 0xb6, 0x30, 0x61, 0xa3, 0x28, 0xd6, 0xbf, 0xee, 0xaf, 0x42,
-	0x71, 0xe5, 0x58, 0x73, 0x75, 0x6a, 0x8a, 0x7f, 0x68, 0x41, 0xb7, 0x96, 0x2c, 0xaa, 0xe4, 0x9b,
-	0xfb, 0x41, 0x5f, 0x2b, 0x16, 0x16, 0xc1, 0xae, 0x5f, 0xbd, 0xe1, 0x91, 0x75, 0x58, 0x8a, 0xc5,
-	0xd8, 0x78, 0xbc, 0x47, 0xf5, 0x80, 0x6c, 0x42, 0x3b, 0x16, 0x63, 0xfc, 0x11, 0x64, 0x2a, 0x67,
-	0x39, 0x56, 0x6e, 0xab, 0x3a, 0x1b, 0x5d, 0x40, 0x2a, 0xc0, 0xfd, 0x95, 0x05, 0xc4, 0x34, 0x0e,
-	0x2f, 0xf5, 0xd0, 0x8b, 0x01, 0x5b, 0x7f, 0x87, 0x6c, 0x60, 0x19, 0x9e, 0xc1, 0xe6, 0xae, 0x3c,
-	0xfb, 0xdc, 0x95, 0x77, 0x1f, 0x6e, 0x04, 0xfc, 0x84, 0xa9, 0x1e, 0x67, 0xfe, 0xc8, 0x7d, 0x23,
-	0x28, 0x5b, 0xb1, 0xb7, 0xde, 0x85, 0x4e, 0xf9, 0xff, 0x0a, 0xe9, 0x43, 0x6f, 0x98, 0x84, 0x12,
-	0x7f, 0xa5, 0x85, 0xc9, 0xb8, 0xff, 0x7f, 0xa4, 0x0b, 0xad, 0x6f, 0x71, 0x16, 0xc9, 0xd3, 0x69,
-	0xdf, 0x22, 0x3d, 0x68, 0xbf, 0x3f, 0x4a, 0xd2, 0x3c, 0x66, 0x51, 0xbf, 0xb1, 0xf7, 0xce, 0xf7,
-	0xbe, 0x32, 0x0e, 0xe5, 0xe9, 0x64, 0xa4, 0x2c, 0xd9, 0xd1, 0xa6, 0x7d, 0x31, 0x4c, 0xcd, 0xd7,
-	0x4e, 0xe1, 0xb5, 0x1d, 0xb4, 0xb6, 0x1c, 0x66, 0xa3, 0xd1, 0x32, 0x22, 0x6f, 0xff, 0x3d, 0x00,
-	0x00, 0xff, 0xff, 0x7d, 0x5a, 0x47, 0x9f, 0x85, 0x1a, 0x00, 0x00,
+	// 1959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0xa7, 0xdd, 0x4e, 0x6c, 0x3f, 0x3b, 0x89, 0xa7, 0x26, 0x3b, 0xdb, 0xc9, 0xcc, 0xce, 0x7a,
+	0x7b, 0x17, 0x08, 0x3b, 0x62, 0x32, 0x64, 0x81, 0x45, 0x08, 0x31, 0xbb, 0x89, 0x87, 0xc1, 0x9a,
+	0xcd, 0x10, 0xca, 0xb3, 0x2b, 0xc1, 0xa5, 0x55, 0xee, 0xae, 0x38, 0xcd, 0xf6, 0x17, 0x5d, 0xe5,
+	0x4c, 0xbc, 0x27, 0x0e, 0x9c, 0x40, 0x70, 0x40, 0xe2, 0xdf, 0x40, 0xdc, 0x38, 0xf1, 0x21, 0x4e,
+	0x48, 0xfc, 0x05, 0xfc, 0x27, 0x08, 0x71, 0x40, 0xf5, 0xaa, 0xba, 0xdd, 0x76, 0x9c, 0x90, 0xc9,
+	0x08, 0x58, 0x04, 0xb7, 0xae, 0xdf, 0x7b, 0xf5, 0xf1, 0x7e, 0xef, 0xa3, 0x9e, 0xcb, 0xb0, 0x1e,
+	0x26, 0x92, 0xe7, 0x09, 0x8b, 0xee, 0x67, 0x79, 0x2a, 0x53, 0xf2, 0x4a, 0x1c, 0x46, 0xa7, 0x13,
+	0xa1, 0x47, 0xf7, 0x0b, 0xe1, 0x76, 0xc7, 0x4f, 0xe3, 0x38, 0x4d, 0x34, 0xbc, 0xdd, 0x11, 0xfe,
+	0x09, 0x8f, 0x99, 0x1e, 0xb9, 0xbf, 0xb3, 0x60, 0xed, 0x20, 0x8d, 0xb3, 0x34, 0xe1, 0x89, 0x1c,
+	0x24, 0xc7, 0x29, 0xb9, 0x05, 0xab, 0x49, 0x1a, 0xf0, 0x41, 0xdf, 0xb1, 0x7a, 0xd6, 0x8e, 0x4d,
+	0xcd, 0x88, 0x10, 0xa8, 0xe7, 0x69, 0xc4, 0x9d, 0x5a, 0xcf, 0xda, 0x69, 0x51, 0xfc, 0x26, 0x0f,
+	0x01, 0x84, 0x64, 0x92, 0x7b, 0x7e, 0x1a, 0x70, 0xc7, 0xee, 0x59, 0x3b, 0xeb, 0x7b, 0xbd, 0xfb,
+	0x4b, 0x4f, 0x71, 0x7f, 0xa8, 0x14, 0x0f, 0xd2, 0x80, 0xd3, 0x96, 0x28, 0x3e, 0xc9, 0x7b, 0x00,
+	0xfc, 0x4c, 0xe6, 0xcc, 0x0b, 0x93, 0xe3, 0xd4, 0xa9, 0xf7, 0xec, 0x9d, 0xf6, 0xde, 0x1b, 0xf3,
+	0x0b, 0x98, 0xc3, 0x3f, 0xe1, 0xd3, 0x8f, 0x58, 0x34, 0xe1, 0x47, 0x2c, 0xcc, 0x69, 0x0b, 0x27,
+	0xa9, 0xe3, 0xba, 0x7f, 0xb1, 0x60, 0xa3, 0x34, 0x00, 0xf7, 0x10, 0xe4, 0xeb, 0xb0, 0x82, 0x5b,
+	0xa0, 0x05, 0xed, 0xbd, 0xb7, 0x2e, 0x38, 0xd1, 0x9c, 0xdd, 0x54, 0x4f, 0x21, 0x1f, 0xc2, 0x4d,
+	0x31, 0x19, 0xf9, 0x85, 0xc8, 0x43, 0x54, 0x38, 0xb5, 0x9e, 0x7d, 0xe5, 0x95, 0x48, 0x75, 0x01,
+	0x73, 0xa4, 0x77, 0x60, 0x55, 0xad, 0x34, 0x11, 0xc8, 0x52, 0x7b, 0xef, 0xf6, 0x52, 0x23, 0x87,
+	0xa8, 0x42, 0x8d, 0xaa, 0x7b, 0x1b, 0xb6, 0x1e, 0x73, 0xb9, 0x60, 0x1d, 0xe5, 0x3f, 0x9c, 0x70,
+	0x21, 0x8d, 0xf0, 0x59, 0x18, 0xf3, 0x67, 0xa1, 0xff, 0xf1, 0xc1, 0x09, 0x4b, 0x12, 0x1e, 0x15,
+	0xc2, 0xd7, 0xe0, 0xf6, 0x63, 0x8e, 0x13, 0x42, 0x21, 0x43, 0x5f, 0x2c, 0x88, 0x5f, 0x81, 0x9b,
+	0x8f, 0xb9, 0xec, 0x07, 0x0b, 0xf0, 0x47, 0xd0, 0x7c, 0xaa, 0x9c, 0xad, 0xc2, 0xe0, 0xab, 0xd0,
+	0x60, 0x41, 0x90, 0x73, 0x21, 0x0c, 0x8b, 0x77, 0x96, 0x9e, 0xf8, 0x7d, 0xad, 0x43, 0x0b, 0xe5,
+	0x65, 0x61, 0xe2, 0xfe, 0x00, 0x60, 0x90, 0x84, 0xf2, 0x88, 0xe5, 0x2c, 0x16, 0x17, 0x06, 0x58,
+	0x1f, 0x3a, 0x42, 0xb2, 0x5c, 0x7a, 0x19, 0xea, 0x39, 0xb5, 0xab, 0x46, 0x43, 0x1b, 0xa7, 0xe9,
+	0xd5, 0xdd, 0xef, 0x01, 0x0c, 0x65, 0x1e, 0x26, 0xe3, 0x0f, 0x42, 0x21, 0xd5, 0x5e, 0xa7, 0x4a,
+	0x4f, 0x19, 0x61, 0xef, 0xb4, 0xa8, 0x19, 0x55, 0xdc, 0x51, 0xbb, 0xba, 0x3b, 0x1e, 0x42, 0xbb,
+	0xa0, 0xfb, 0x50, 0x8c, 0xc9, 0x03, 0xa8, 0x8f, 0x98, 0xe0, 0x97, 0xd2, 0x73, 0x28, 0xc6, 0xfb,
+	0x4c, 0x70, 0x8a, 0x9a, 0xee, 0x4f, 0x6c, 0x78, 0xf5, 0x20, 0xe7, 0x18, 0xfc, 0x51, 0xc4, 0x7d,
+	0x19, 0xa6, 0x89, 0xe1, 0xfe, 0xc5, 0x57, 0x23, 0xaf, 0x42, 0x23, 0x18, 0x79, 0x09, 0x8b, 0x0b,
+	0xb2, 0x57, 0x83, 0xd1, 0x53, 0x16, 0x73, 0xf2, 0x39, 0x58, 0xf7, 0xcb, 0xf5, 0x15, 0x82, 0x31,
+	0xd7, 0xa2, 0x0b, 0x28, 0x79, 0x0b, 0xd6, 0x32, 0x96, 0xcb, 0xb0, 0x54, 0xab, 0xa3, 0xda, 0x3c,
+	0xa8, 0x1c, 0x1a, 0x8c, 0x06, 0x7d, 0x67, 0x05, 0x9d, 0x85, 0xdf, 0xc4, 0x85, 0xce, 0x6c, 0xad,
+	0x41, 0xdf, 0x59, 0x45, 0xd9, 0x1c, 0x46, 0x7a, 0xd0, 0x2e, 0x17, 0x1a, 0xf4, 0x9d, 0x06, 0xaa,
+	0x54, 0x21, 0xe5, 0x1c, 0x5d, 0x8b, 0x9c, 0x66, 0xcf, 0xda, 0xe9, 0x50, 0x33, 0x22, 0x0f, 0xe0,
+	0xe6, 0x69, 0x98, 0xcb, 0x09, 0x8b, 0x4c, 0x7c, 0xaa, 0x73, 0x08, 0xa7, 0x85, 0x1e, 0x5c, 0x26,
+	0x22, 0x7b, 0xb0, 0x99, 0x9d, 0x4c, 0x45, 0xe8, 0x2f, 0x4c, 0x01, 0x9c, 0xb2, 0x54, 0xe6, 0xfe,
+	0xd1, 0x82, 0x57, 0xfa, 0x79, 0x9a, 0x7d, 0x2a, 0x5c, 0x51, 0x90, 0x5c, 0xbf, 0x84, 0xe4, 0x95,
+	0xf3, 0x24, 0xbb, 0x3f, 0xab, 0xc1, 0x2d, 0x1d, 0x51, 0x47, 0x05, 0xb1, 0xff, 0x02, 0x2b, 0x3e,
+	0x0f, 0x1b, 0xb3, 0x5d, 0xbd, 0xe4, 0x62, 0x33, 0x3e, 0x0b, 0xeb, 0xa5, 0x83, 0xb5, 0xde, 0xbf,
+	0x37, 0xa4, 0xdc, 0x9f, 0xd6, 0x60, 0x53, 0x39, 0xf5, 0xff, 0x6c, 0x28, 0x36, 0x7e, 0x5f, 0x03,
+	0xa2, 0xa3, 0x63, 0x90, 0x04, 0xfc, 0xec, 0x3f, 0xc9, 0xc5, 0x6b, 0x00, 0xc7, 0x21, 0x8f, 0x82,
+	0x2a, 0x0f, 0x2d, 0x44, 0x5e, 0x8a, 0x03, 0x07, 0x1a, 0xb8, 0x48, 0x69, 0x7f, 0x31, 0x54, 0xb7,
+	0x89, 0xee, 0x2c, 0xcc, 0x6d, 0xd2, 0xbc, 0xf2, 0x6d, 0x82, 0xd3, 0xcc, 0x6d, 0xf2, 0x2b, 0x1b,
+	0xd6, 0x06, 0x89, 0xe0, 0xb9, 0xfc, 0x5f, 0x0e, 0x24, 0x72, 0x07, 0x5a, 0x82, 0x8f, 0x63, 0xd5,
+	0xe0, 0xf4, 0xb1, 0x58, 0xdb, 0x74, 0x06, 0x28, 0xa9, 0xaf, 0x2b, 0xeb, 0xa0, 0xef, 0xb4, 0xb4,
+	0x6b, 0x4b, 0x80, 0xdc, 0x05, 0x90, 0x61, 0xcc, 0x85, 0x64, 0x71, 0xa6, 0x2b, 0x72, 0x9d, 0x56,
+	0x10, 0x75, 0x0b, 0xe4, 0xe9, 0xf3, 0x41, 0x5f, 0x38, 0xed, 0x9e, 0xad, 0xda, 0x01, 0x3d, 0x22,
+	0x5f, 0x86, 0x66, 0x9e, 0x3e, 0xf7, 0x02, 0x26, 0x99, 0xd3, 0x41, 0xe7, 0x6d, 0x2d, 0x25, 0x7b,
+	0x3f, 0x4a, 0x47, 0xb4, 0x91, 0xa7, 0xcf, 0xfb, 0x4c, 0x32, 0xf7, 0xaf, 0x36, 0xac, 0x0d, 0x39,
+	0xcb, 0xfd, 0x93, 0xeb, 0x3b, 0xec, 0x0b, 0xd0, 0xcd, 0xb9, 0x98, 0x44, 0xd2, 0x9b, 0x99, 0xa5,
+	0x3d, 0xb7, 0xa1, 0xf1, 0x83, 0xd2, 0xb8, 0x82, 0x72, 0xfb, 0x12, 0xca, 0xeb, 0x4b, 0x28, 0x77,
+	0xa1, 0x53, 0xe1, 0x57, 0x38, 0x2b, 0x68, 0xfa, 0x1c, 0x46, 0xba, 0x60, 0x07, 0x22, 0x42, 0x8f,
+	0xb5, 0xa8, 0xfa, 0x24, 0xf7, 0xe0, 0x46, 0x16, 0x31, 0x9f, 0x9f, 0xa4, 0x51, 0xc0, 0x73, 0x6f,
+	0x9c, 0xa7, 0x93, 0x0c, 0xdd, 0xd5, 0xa1, 0xdd, 0x8a, 0xe0, 0xb1, 0xc2, 0xc9, 0xbb, 0xd0, 0x0c,
+	0x44, 0xe4, 0xc9, 0x69, 0xc6, 0xd1, 0x65, 0xeb, 0x17, 0xd8, 0xde, 0x17, 0xd1, 0xb3, 0x69, 0xc6,
+	0x69, 0x23, 0xd0, 0x1f, 0xe4, 0x01, 0x6c, 0x0a, 0x9e, 0x87, 0x2c, 0x0a, 0x3f, 0xe1, 0x81, 0xc7,
+	0xcf, 0xb2, 0xdc, 0xcb, 0x22, 0x96, 0xa0, 0x67, 0x3b, 0x94, 0xcc, 0x64, 0x8f, 0xce, 0xb2, 0xfc,
+	0x28, 0x62, 0x09, 0xd9, 0x81, 0x6e, 0x3a, 0x91, 0xd9, 0x44, 0x7a, 0x98, 0x7d, 0xc2, 0x0b, 0x03,
+	0x74, 0xb4, 0x4d, 0xd7, 0x35, 0xfe, 0x2d, 0x84, 0x07, 0x81, 0xa2, 0x56, 0xe6, 0xec, 0x94, 0x47,
+	0x5e, 0x19, 0x01, 0x4e, 0xbb, 0x67, 0xed, 0xd4, 0xe9, 0x86, 0xc6, 0x9f, 0x15, 0x30, 0xd9, 0x85,
+	0x9b, 0xe3, 0x09, 0xcb, 0x59, 0x22, 0x39, 0xaf, 0x68, 0x77, 0x50, 0x9b, 0x94, 0xa2, 0x72, 0x82,
+	0xfb, 0xeb, 0xfa, 0xcc, 0xf5, 0xca, 0x4b, 0xe2, 0x1a, 0xae, 0xbf, 0x4e, 0x5f, 0xb8, 0x34, 0x5e,
+	0xec, 0xe5, 0xf1, 0xf2, 0x3a, 0xb4, 0x63, 0x2e, 0xf3, 0xd0, 0xd7, 0x7e, 0xd1, 0x69, 0x0c, 0x1a,
+	0x42, 0xf2, 0x09, 0xd4, 0x4f, 0x42, 0xa9, 0x03, 0xa2, 0x43, 0xf1, 0x5b, 0x4d, 0x12, 0x51, 0xe8,
+	0xf3, 0xc0, 0x1b, 0x45, 0xe9, 0xc8, 0xf8, 0x01, 0x34, 0xa4, 0xa2, 0x5f, 0xf1, 0x6f, 0x14, 0x92,
+	0x49, 0xec, 0xf9, 0xe9, 0x24, 0x91, 0x0e, 0x60, 0xd4, 0xad, 0x6b, 0xfc, 0xe9, 0x24, 0x3e, 0x50,
+	0x28, 0x79, 0x13, 0xd6, 0x8c, 0x66, 0x7a, 0x7c, 0x2c, 0xb8, 0x44, 0xf2, 0x6d, 0xda, 0xd1, 0xe0,
+	0x77, 0x10, 0x23, 0xdf, 0x80, 0x6d, 0xc1, 0x59, 0xc4, 0x03, 0xaf, 0xcc, 0x71, 0xe1, 0x09, 0x64,
+	0x96, 0x07, 0xce, 0x2a, 0x3a, 0xd6, 0xd1, 0x1a, 0xc3, 0x52, 0x61, 0x68, 0xe4, 0xca, 0x6f, 0x25,
+	0x0d, 0x95, 0x69, 0x0d, 0x6c, 0xc5, 0xc8, 0x4c, 0x54, 0x4e, 0xf8, 0x1a, 0x38, 0xe3, 0x28, 0x1d,
+	0xb1, 0xc8, 0x3b, 0xb7, 0x2b, 0x56, 0x6d, 0x9b, 0xde, 0xd2, 0xf2, 0xe1, 0xc2, 0x96, 0xe4, 0x21,
+	0xa8, 0xd6, 0x7f, 0xac, 0x7e, 0x7e, 0x0a, 0x29, 0x4c, 0x95, 0xb8, 0x7b, 0x91, 0xcb, 0xc6, 0xfc,
+	0x20, 0x15, 0x92, 0x82, 0x28, 0x3e, 0x85, 0xfb, 0xf7, 0x1a, 0x6c, 0x50, 0x45, 0x3e, 0x3f, 0xe5,
+	0xff, 0xf5, 0xf5, 0xe2, 0x6d, 0xb0, 0xc3, 0x40, 0x60, 0xbd, 0x68, 0xef, 0x39, 0xf3, 0xe7, 0x36,
+	0xbf, 0xf9, 0x07, 0x7d, 0x41, 0x95, 0xd2, 0xd2, 0x8c, 0x6d, 0x5c, 0x39, 0x63, 0x9b, 0x2f, 0x94,
+	0xb1, 0xad, 0x0b, 0x33, 0xf6, 0xb7, 0x76, 0x95, 0xfe, 0x4f, 0x6b, 0xce, 0x1a, 0x5e, 0xeb, 0x57,
+	0xe1, 0xf5, 0x21, 0xb4, 0x0d, 0xa1, 0x78, 0x6f, 0xad, 0x2c, 0x8b, 0x48, 0x33, 0x07, 0x19, 0x56,
+	0x77, 0x16, 0xd5, 0x9d, 0x91, 0x50, 0xdf, 0xe4, 0x9b, 0x70, 0xfb, 0x7c, 0xee, 0xe5, 0x86, 0xa3,
+	0x22, 0xf9, 0xb6, 0x16, 0x93, 0xaf, 0x20, 0x31, 0x20, 0x5f, 0x82, 0xcd, 0x4a, 0xf6, 0xcd, 0x26,
+	0xea, 0xf4, 0xab, 0x64, 0xe6, 0x6c, 0xca, 0xb5, 0xf3, 0xcf, 0xfd, 0xb3, 0x05, 0x6b, 0x7d, 0x1e,
+	0x71, 0xf9, 0x12, 0xc9, 0xb3, 0xa4, 0x09, 0xaa, 0x2d, 0x6d, 0x82, 0xe6, 0xba, 0x0c, 0xfb, 0xf2,
+	0x2e, 0xa3, 0x7e, 0xae, 0xcb, 0x78, 0x03, 0x3a, 0x59, 0x1e, 0xc6, 0x2c, 0x9f, 0x7a, 0x1f, 0xf3,
+	0x69, 0x91, 0x40, 0x6d, 0x83, 0x3d, 0xe1, 0x53, 0xe1, 0x26, 0xb0, 0xfd, 0x41, 0xca, 0x82, 0x7d,
+	0x16, 0xb1, 0xc4, 0xe7, 0xc6, 0x4c, 0x71, 0x7d, 0xcb, 0xee, 0x02, 0x54, 0x98, 0xac, 0xe1, 0x86,
+	0x15, 0xc4, 0xfd, 0x9b, 0x05, 0x2d, 0xb5, 0x21, 0xf6, 0xe6, 0xd7, 0x58, 0x7f, 0xae, 0x29, 0xab,
+	0x2d, 0x69, 0xca, 0xca, 0xf6, 0xba, 0xa0, 0xab, 0x04, 0xaa, 0x7d, 0x73, 0x7d, 0xbe, 0x6f, 0x7e,
+	0x1d, 0xda, 0xa1, 0x3a, 0x90, 0x97, 0x31, 0x79, 0xa2, 0x79, 0x6a, 0x51, 0x40, 0xe8, 0x48, 0x21,
+	0xaa, 0xb1, 0x2e, 0x14, 0xb0, 0xb1, 0x5e, 0xbd, 0x72, 0x63, 0x6d, 0x16, 0xc1, 0xc6, 0xfa, 0x0f,
+	0x35, 0x70, 0x0c, 0xc5, 0xb3, 0x57, 0xaa, 0x0f, 0xb3, 0x00, 0x1f, 0xcb, 0xee, 0x40, 0xab, 0x8c,
+	0x32, 0xf3, 0x48, 0x34, 0x03, 0x14, 0xaf, 0x87, 0x3c, 0x4e, 0xf3, 0xe9, 0x30, 0xfc, 0x84, 0x1b,
+	0xc3, 0x2b, 0x88, 0xb2, 0xed, 0xe9, 0x24, 0xa6, 0xe9, 0x73, 0x61, 0xca, 0x6c, 0x31, 0x54, 0xb6,
+	0xf9, 0xf8, 0x73, 0x08, 0xab, 0x13, 0x5a, 0x5e, 0xa7, 0xa0, 0x21, 0x55, 0x95, 0xc8, 0x16, 0x34,
+	0x79, 0x12, 0x68, 0xe9, 0x0a, 0x4a, 0x1b, 0x3c, 0x09, 0x50, 0x34, 0x80, 0x75, 0xf3, 0x3a, 0x95,
+	0x0a, 0x2c, 0xb9, 0xa6, 0xd0, 0xba, 0x17, 0x3c, 0x09, 0x1e, 0x8a, 0xf1, 0x91, 0xd1, 0xa4, 0x6b,
+	0xfa, 0x81, 0xca, 0x0c, 0xc9, 0x23, 0xe8, 0xa8, 0x5d, 0xca, 0x85, 0x1a, 0x57, 0x5e, 0xa8, 0xcd,
+	0x93, 0xa0, 0x18, 0xb8, 0xbf, 0xb0, 0xe0, 0xc6, 0x39, 0x0a, 0xaf, 0x11, 0x47, 0x4f, 0xa0, 0x39,
+	0xe4, 0x63, 0xb5, 0x44, 0xf1, 0xe6, 0xb6, 0x7b, 0xd1, 0x13, 0xee, 0x05, 0x0e, 0xa3, 0xe5, 0x02,
+	0xee, 0x8f, 0x2d, 0xf5, 0xd6, 0x17, 0xf0, 0x33, 0x1c, 0x9e, 0x0b, 0x16, 0xeb, 0x3a, 0xc1, 0xa2,
+	0x3a, 0x52, 0xd5, 0xd8, 0xe4, 0x3c, 0x62, 0x72, 0x56, 0x9f, 0x84, 0xf1, 0x3d, 0x49, 0x26, 0x31,
+	0xd5, 0xa2, 0x22, 0x69, 0xdd, 0x9f, 0x5b, 0x00, 0x58, 0x60, 0xf5, 0x31, 0x16, 0xaf, 0x58, 0xeb,
+	0xf2, 0x9f, 0x92, 0xb5, 0xf9, 0x94, 0xd8, 0x2f, 0x52, 0x42, 0x20, 0x47, 0xf6, 0x32, 0x1b, 0x4a,
+	0x8e, 0x66, 0xc6, 0x9b, 0xac, 0xd1, 0xbc, 0xfc, 0xd2, 0x82, 0x4e, 0x85, 0x3e, 0x31, 0x9f, 0xbd,
+	0xd6, 0x62, 0xf6, 0x62, 0x9f, 0xa8, 0x22, 0xda, 0x13, 0x95, 0x20, 0x8f, 0x67, 0x41, 0xbe, 0x05,
+	0x4d, 0xa4, 0xa4, 0x12, 0xe5, 0x89, 0x89, 0xf2, 0x7b, 0x70, 0x23, 0xe7, 0x3e, 0x4f, 0x64, 0x34,
+	0xf5, 0xe2, 0x34, 0x08, 0x8f, 0x43, 0x1e, 0x60, 0xac, 0x37, 0x69, 0xb7, 0x10, 0x1c, 0x1a, 0xdc,
+	0xfd, 0x93, 0x05, 0xeb, 0xdf, 0x9d, 0xf0, 0x7c, 0xaa, 0x1e, 0x7e, 0xf5, 0xc9, 0x5e, 0x3c, 0x82,
+	0xde, 0x43, 0x5b, 0x3c, 0x51, 0x09, 0xa1, 0x37, 0xff, 0x79, 0x08, 0x09, 0xda, 0x14, 0x26, 0x6c,
+	0x14, 0xc5, 0xfa, 0x79, 0xe0, 0x2a, 0x14, 0xcf, 0x1c, 0x6b, 0xae, 0x4e, 0x4d, 0xf1, 0x8f, 0x2c,
+	0x68, 0x57, 0x92, 0x45, 0x95, 0x7c, 0x73, 0x3f, 0xe8, 0x6b, 0xc5, 0xc2, 0x22, 0xd8, 0xf6, 0x67,
+	0x8f, 0x80, 0x64, 0x13, 0x56, 0x62, 0x31, 0x36, 0x1e, 0xef, 0x50, 0x3d, 0x20, 0xdb, 0xd0, 0x8c,
+	0xc5, 0x18, 0x7f, 0x45, 0x99, 0xca, 0x59, 0x8e, 0x95, 0xdb, 0x66, 0x9d, 0x8d, 0x2e, 0x20, 0x33,
+	0xc0, 0xfd, 0x8d, 0x05, 0xc4, 0x34, 0x0e, 0x2f, 0xf5, 0x52, 0x8c, 0x01, 0x5b, 0x7d, 0xc8, 0xac,
+	0x61, 0x19, 0x9e, 0xc3, 0x16, 0xae, 0x3c, 0xfb, 0xdc, 0x95, 0x77, 0x0f, 0x6e, 0x04, 0xfc, 0x98,
+	0xa9, 0x1e, 0x67, 0xf1, 0xc8, 0x5d, 0x23, 0x28, 0x5b, 0xb1, 0xb7, 0x1f, 0x41, 0xab, 0xfc, 0x83,
+	0x86, 0x74, 0xa1, 0xa3, 0xde, 0xeb, 0xf1, 0x67, 0x5e, 0x98, 0x8c, 0xbb, 0x9f, 0x21, 0x6d, 0x68,
+	0x7c, 0x9b, 0xb3, 0x48, 0x9e, 0x4c, 0xbb, 0x16, 0xe9, 0x40, 0xf3, 0xfd, 0x51, 0x92, 0xe6, 0x31,
+	0x8b, 0xba, 0x35, 0x25, 0x1a, 0x4a, 0x96, 0x04, 0xfb, 0xd3, 0xae, 0xbd, 0xff, 0xee, 0xf7, 0xbf,
+	0x32, 0x0e, 0xe5, 0xc9, 0x64, 0xa4, 0xcc, 0xda, 0xd5, 0x76, 0x7e, 0x31, 0x4c, 0xcd, 0xd7, 0x6e,
+	0xe1, 0xc2, 0x5d, 0x34, 0xbd, 0x1c, 0x66, 0xa3, 0xd1, 0x2a, 0x22, 0xef, 0xfc, 0x63, 0x00, 0xb6,
+	0xe5, 0x03, 0x30, 0xd3, 0x1a, 0x00, 0x00,
 }
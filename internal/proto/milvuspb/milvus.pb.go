@@ -1470,6 +1470,7 @@ type DescribeSegmentRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldID              int64             `protobuf:"varint,4,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1521,14 +1522,22 @@ func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	return 0
 }
 
+func (m *DescribeSegmentRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type DescribeSegmentResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexID              int64                `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64                `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	EnableIndex          bool                 `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	FieldIndexes         []*SegmentFieldIndex `protobuf:"bytes,5,rep,name=field_indexes,json=fieldIndexes,proto3" json:"field_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
@@ -1584,6 +1593,76 @@ func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	return false
 }
 
+func (m *DescribeSegmentResponse) GetFieldIndexes() []*SegmentFieldIndex {
+	if m != nil {
+		return m.FieldIndexes
+	}
+	return nil
+}
+
+type SegmentFieldIndex struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	IndexID              int64    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	EnableIndex          bool     `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentFieldIndex) Reset()         { *m = SegmentFieldIndex{} }
+func (m *SegmentFieldIndex) String() string { return proto.CompactTextString(m) }
+func (*SegmentFieldIndex) ProtoMessage()    {}
+func (*SegmentFieldIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
+}
+
+func (m *SegmentFieldIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentFieldIndex.Unmarshal(m, b)
+}
+func (m *SegmentFieldIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentFieldIndex.Marshal(b, m, deterministic)
+}
+func (m *SegmentFieldIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentFieldIndex.Merge(m, src)
+}
+func (m *SegmentFieldIndex) XXX_Size() int {
+	return xxx_messageInfo_SegmentFieldIndex.Size(m)
+}
+func (m *SegmentFieldIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentFieldIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentFieldIndex proto.InternalMessageInfo
+
+func (m *SegmentFieldIndex) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *SegmentFieldIndex) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *SegmentFieldIndex) GetBuildID() int64 {
+	if m != nil {
+		return m.BuildID
+	}
+	return 0
+}
+
+func (m *SegmentFieldIndex) GetEnableIndex() bool {
+	if m != nil {
+		return m.EnableIndex
+	}
+	return false
+}
+
 type ShowSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1597,7 +1676,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +1730,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +1780,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1772,7 +1851,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1842,7 +1921,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -1903,7 +1982,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -1953,7 +2032,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +2101,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +2158,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2227,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2205,7 +2284,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2278,7 +2357,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2367,7 +2446,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2465,7 +2544,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2518,7 +2597,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -2559,6 +2638,7 @@ type SearchRequest struct {
 	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
 	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Profile              bool                     `protobuf:"varint,12,opt,name=profile,proto3" json:"profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -2568,7 +2648,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -2666,6 +2746,118 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// ann search on one vector field per request, collection, partitions, output fields and timestamps are ignored
+	Requests []*SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// strategy and params to fuse the results of requests, and the topk of the fused results
+	RankParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
+}
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
+}
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
+
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRequests() []*SearchRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.RankParams
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
 type RetrieveRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2684,7 +2876,7 @@ func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -2774,7 +2966,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -2820,6 +3012,7 @@ type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
 	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	GroupByValues        []int64   `protobuf:"varint,4,rep,packed,name=group_by_values,json=groupByValues,proto3" json:"group_by_values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -2829,7 +3022,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -2871,9 +3064,17 @@ func (m *Hits) GetScores() []float32 {
 	return nil
 }
 
+func (m *Hits) GetGroupByValues() []int64 {
+	if m != nil {
+		return m.GroupByValues
+	}
+	return nil
+}
+
 type SearchResults struct {
 	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	Profile              []*commonpb.StageCost      `protobuf:"bytes,3,rep,name=profile,proto3" json:"profile,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -2883,7 +3084,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -2918,6 +3119,13 @@ func (m *SearchResults) GetResults() *schemapb.SearchResultData {
 	return nil
 }
 
+func (m *SearchResults) GetProfile() []*commonpb.StageCost {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2931,7 +3139,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -2986,7 +3194,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3044,7 +3252,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3119,7 +3327,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3168,7 +3376,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3231,7 +3439,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3311,7 +3519,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3377,7 +3585,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3465,7 +3673,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3534,7 +3742,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3588,7 +3796,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3640,7 +3848,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3723,7 +3931,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3777,7 +3985,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3812,6 +4020,155 @@ func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
 	return nil
 }
 
+type GetConfigRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	KeyPrefix            string            `protobuf:"bytes,2,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetConfigRequest) Reset()         { *m = GetConfigRequest{} }
+func (m *GetConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConfigRequest) ProtoMessage()    {}
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
+}
+
+func (m *GetConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConfigRequest.Unmarshal(m, b)
+}
+func (m *GetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConfigRequest.Merge(m, src)
+}
+func (m *GetConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConfigRequest.Size(m)
+}
+func (m *GetConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConfigRequest proto.InternalMessageInfo
+
+func (m *GetConfigRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetConfigRequest) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+type GetConfigResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Configs              []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetConfigResponse) Reset()         { *m = GetConfigResponse{} }
+func (m *GetConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetConfigResponse) ProtoMessage()    {}
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
+}
+
+func (m *GetConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConfigResponse.Unmarshal(m, b)
+}
+func (m *GetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *GetConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConfigResponse.Merge(m, src)
+}
+func (m *GetConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetConfigResponse.Size(m)
+}
+func (m *GetConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConfigResponse proto.InternalMessageInfo
+
+func (m *GetConfigResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetConfigResponse) GetConfigs() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+type SetConfigRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string            `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetConfigRequest) Reset()         { *m = SetConfigRequest{} }
+func (m *SetConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetConfigRequest) ProtoMessage()    {}
+func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
+}
+
+func (m *SetConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetConfigRequest.Unmarshal(m, b)
+}
+func (m *SetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *SetConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConfigRequest.Merge(m, src)
+}
+func (m *SetConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetConfigRequest.Size(m)
+}
+func (m *SetConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetConfigRequest proto.InternalMessageInfo
+
+func (m *SetConfigRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetConfigRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetConfigRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type DummyRequest struct {
 	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3823,7 +4180,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -3862,7 +4219,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -3900,7 +4257,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -3933,7 +4290,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -3995,6 +4352,7 @@ func init() {
 	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
 	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
 	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
+	proto.RegisterType((*SegmentFieldIndex)(nil), "milvus.proto.milvus.SegmentFieldIndex")
 	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
 	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
@@ -4011,6 +4369,7 @@ func init() {
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
 	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.milvus.HybridSearchRequest")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.milvus.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.milvus.RetrieveResults")
 	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
@@ -4030,6 +4389,9 @@ func init() {
 	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
 	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
 	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "milvus.proto.milvus.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "milvus.proto.milvus.GetConfigResponse")
+	proto.RegisterType((*SetConfigRequest)(nil), "milvus.proto.milvus.SetConfigRequest")
 	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
 	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
 	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
@@ -4039,194 +4401,210 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 2989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x5d, 0x6f, 0x24, 0x47,
-	0xd1, 0xb3, 0xeb, 0xfd, 0xaa, 0x9d, 0xb5, 0xf7, 0xda, 0x1f, 0xe7, 0x6c, 0xee, 0x72, 0xf6, 0x24,
-	0x47, 0x7c, 0x77, 0xc9, 0x5d, 0xce, 0x97, 0x90, 0x90, 0x00, 0xc9, 0xdd, 0x99, 0xdc, 0x59, 0xb9,
-	0x0b, 0xce, 0x38, 0x89, 0x08, 0x51, 0xb4, 0x1a, 0xef, 0xb4, 0x77, 0x47, 0x9e, 0x9d, 0x59, 0xa6,
-	0x7b, 0xed, 0xdb, 0x3c, 0x21, 0x25, 0x20, 0xa1, 0x40, 0x22, 0x04, 0x02, 0xf1, 0x0a, 0xe4, 0x81,
-	0x37, 0x42, 0x90, 0x40, 0x3c, 0xe7, 0x81, 0x07, 0x24, 0x3e, 0x5e, 0x79, 0xe1, 0x01, 0x1e, 0xf3,
-	0x07, 0x10, 0x12, 0xa8, 0x3f, 0x66, 0x76, 0x66, 0xb7, 0x67, 0xbd, 0xbe, 0x4d, 0xb0, 0xfd, 0x36,
-	0x53, 0x5d, 0xd5, 0x5d, 0x55, 0x5d, 0x5d, 0xd5, 0x5d, 0x55, 0xa0, 0xb7, 0x1d, 0x77, 0xaf, 0x4b,
-	0x2e, 0x77, 0x02, 0x9f, 0xfa, 0x68, 0x2e, 0xfe, 0x77, 0x59, 0xfc, 0xd4, 0xf4, 0x86, 0xdf, 0x6e,
-	0xfb, 0x9e, 0x00, 0xd6, 0x74, 0xd2, 0x68, 0xe1, 0xb6, 0x25, 0xfe, 0x8c, 0x4f, 0x34, 0x38, 0x7d,
-	0x33, 0xc0, 0x16, 0xc5, 0x37, 0x7d, 0xd7, 0xc5, 0x0d, 0xea, 0xf8, 0x9e, 0x89, 0xbf, 0xd5, 0xc5,
-	0x84, 0xa2, 0x27, 0x60, 0x7a, 0xdb, 0x22, 0x78, 0x49, 0x5b, 0xd6, 0x56, 0xcb, 0x6b, 0x67, 0x2e,
-	0x27, 0xe6, 0x96, 0x73, 0xde, 0x25, 0xcd, 0x1b, 0x16, 0xc1, 0x26, 0xc7, 0x44, 0xa7, 0xa1, 0x60,
-	0x6f, 0xd7, 0x3d, 0xab, 0x8d, 0x97, 0x32, 0xcb, 0xda, 0x6a, 0xc9, 0xcc, 0xdb, 0xdb, 0x2f, 0x5b,
-	0x6d, 0x8c, 0x1e, 0x85, 0xd9, 0x46, 0x34, 0xbf, 0x40, 0xc8, 0x72, 0x84, 0x99, 0x3e, 0x98, 0x23,
-	0x2e, 0x42, 0x5e, 0xf0, 0xb7, 0x34, 0xbd, 0xac, 0xad, 0xea, 0xa6, 0xfc, 0x43, 0x67, 0x01, 0x48,
-	0xcb, 0x0a, 0x6c, 0x52, 0xf7, 0xba, 0xed, 0xa5, 0xdc, 0xb2, 0xb6, 0x9a, 0x33, 0x4b, 0x02, 0xf2,
-	0x72, 0xb7, 0x6d, 0xbc, 0xa7, 0xc1, 0xc2, 0x7a, 0xe0, 0x77, 0x8e, 0x85, 0x10, 0xc6, 0xaf, 0x34,
-	0x98, 0xbf, 0x6d, 0x91, 0xe3, 0xa1, 0xd1, 0xb3, 0x00, 0xd4, 0x69, 0xe3, 0x3a, 0xa1, 0x56, 0xbb,
-	0xc3, 0xb5, 0x3a, 0x6d, 0x96, 0x18, 0x64, 0x8b, 0x01, 0x8c, 0x37, 0x40, 0xbf, 0xe1, 0xfb, 0xae,
-	0x89, 0x49, 0xc7, 0xf7, 0x08, 0x46, 0xd7, 0x20, 0x4f, 0xa8, 0x45, 0xbb, 0x44, 0x32, 0xf9, 0xa0,
-	0x92, 0xc9, 0x2d, 0x8e, 0x62, 0x4a, 0x54, 0x34, 0x0f, 0xb9, 0x3d, 0xcb, 0xed, 0x0a, 0x1e, 0x8b,
-	0xa6, 0xf8, 0x31, 0xde, 0x84, 0x99, 0x2d, 0x1a, 0x38, 0x5e, 0xf3, 0x33, 0x9c, 0xbc, 0x14, 0x4e,
-	0xfe, 0x37, 0x0d, 0x1e, 0x58, 0xc7, 0xa4, 0x11, 0x38, 0xdb, 0xc7, 0xc4, 0x74, 0x0d, 0xd0, 0xfb,
-	0x90, 0x8d, 0x75, 0xae, 0xea, 0xac, 0x99, 0x80, 0x0d, 0x6c, 0x46, 0x6e, 0x70, 0x33, 0xfe, 0x9b,
-	0x81, 0x9a, 0x4a, 0xa8, 0x49, 0xd4, 0xf7, 0x95, 0xe8, 0x44, 0x65, 0x38, 0xd1, 0xf9, 0x24, 0x91,
-	0xf4, 0x06, 0xfd, 0xd5, 0xb6, 0x38, 0x20, 0x3a, 0x78, 0x83, 0x52, 0x65, 0x15, 0x52, 0xad, 0xc1,
-	0xc2, 0x9e, 0x13, 0xd0, 0xae, 0xe5, 0xd6, 0x1b, 0x2d, 0xcb, 0xf3, 0xb0, 0xcb, 0xf5, 0x44, 0x96,
-	0xa6, 0x97, 0xb3, 0xab, 0x25, 0x73, 0x4e, 0x0e, 0xde, 0x14, 0x63, 0x4c, 0x59, 0x04, 0x3d, 0x09,
-	0x8b, 0x9d, 0x56, 0x8f, 0x38, 0x8d, 0x21, 0xa2, 0x1c, 0x27, 0x9a, 0x0f, 0x47, 0x13, 0x54, 0x97,
-	0xe0, 0x54, 0x83, 0x7b, 0x2b, 0xbb, 0xce, 0xb4, 0x26, 0xd4, 0x98, 0xe7, 0x6a, 0xac, 0xca, 0x81,
-	0x57, 0x43, 0x38, 0x63, 0x2b, 0x44, 0xee, 0xd2, 0x46, 0x8c, 0xa0, 0xc0, 0x09, 0xe6, 0xe4, 0xe0,
-	0x6b, 0xb4, 0x11, 0xd1, 0x70, 0x47, 0x72, 0xc7, 0xb7, 0xec, 0xe3, 0xe1, 0x48, 0xde, 0xd7, 0x60,
-	0xc9, 0xc4, 0x2e, 0xb6, 0xc8, 0xf1, 0xb0, 0x71, 0xe3, 0xc7, 0x1a, 0x3c, 0x74, 0x0b, 0xd3, 0x98,
-	0xb5, 0x50, 0x8b, 0x3a, 0x84, 0x3a, 0x0d, 0x72, 0x94, 0x6c, 0x7d, 0xa0, 0xc1, 0xb9, 0x54, 0xb6,
-	0x26, 0x39, 0x3c, 0x4f, 0x43, 0x8e, 0x7d, 0x91, 0xa5, 0xcc, 0x72, 0x76, 0xb5, 0xbc, 0xb6, 0xa2,
-	0xa4, 0x79, 0x09, 0xf7, 0x5e, 0x67, 0x3e, 0x69, 0xd3, 0x72, 0x02, 0x53, 0xe0, 0x1b, 0xff, 0xd0,
-	0x60, 0x71, 0xab, 0xe5, 0xef, 0xf7, 0x59, 0xfa, 0x3c, 0x14, 0x94, 0x74, 0x27, 0xd9, 0x01, 0x77,
-	0x82, 0xae, 0xc2, 0x34, 0xed, 0x75, 0x30, 0xf7, 0x44, 0x33, 0x6b, 0x67, 0x2f, 0x2b, 0x2e, 0x07,
-	0x97, 0x19, 0x93, 0xaf, 0xf6, 0x3a, 0xd8, 0xe4, 0xa8, 0xe8, 0x02, 0x54, 0x07, 0x54, 0x1e, 0x1e,
-	0xc8, 0xd9, 0xa4, 0xce, 0x89, 0xf1, 0xfb, 0x0c, 0x9c, 0x1e, 0x12, 0x71, 0x12, 0x65, 0xab, 0xd6,
-	0xce, 0x28, 0xd7, 0x46, 0xe7, 0x21, 0x66, 0x02, 0x75, 0xc7, 0x26, 0x4b, 0xd9, 0xe5, 0xec, 0x6a,
-	0xd6, 0xac, 0xc4, 0xfc, 0x92, 0x4d, 0xd0, 0xe3, 0x80, 0x86, 0xdc, 0x85, 0xf0, 0x4a, 0xd3, 0xe6,
-	0xa9, 0x41, 0x7f, 0xc1, 0x7d, 0x92, 0xd2, 0x61, 0x08, 0x15, 0x4c, 0x9b, 0xf3, 0x0a, 0x8f, 0x41,
-	0xd0, 0x55, 0x98, 0x77, 0xbc, 0xbb, 0xb8, 0xed, 0x07, 0xbd, 0x7a, 0x07, 0x07, 0x0d, 0xec, 0x51,
-	0xab, 0x89, 0xc9, 0x52, 0x9e, 0x73, 0x34, 0x17, 0x8e, 0x6d, 0xf6, 0x87, 0x8c, 0x8f, 0x35, 0x58,
-	0x14, 0xb7, 0xae, 0x4d, 0x2b, 0xa0, 0xce, 0x51, 0x47, 0xae, 0xf3, 0x30, 0xd3, 0x09, 0xf9, 0x10,
-	0x78, 0xd3, 0x1c, 0xaf, 0x12, 0x41, 0xf9, 0x29, 0xfb, 0x48, 0x83, 0x79, 0x76, 0xc9, 0x3a, 0x49,
-	0x3c, 0xff, 0x5a, 0x83, 0xb9, 0xdb, 0x16, 0x39, 0x49, 0x2c, 0xff, 0x56, 0x86, 0xa0, 0x88, 0xe7,
-	0xa3, 0x74, 0xad, 0x0c, 0x31, 0xc9, 0x74, 0x18, 0xd5, 0x67, 0x12, 0x5c, 0x13, 0xe3, 0x77, 0xfd,
-	0x58, 0x75, 0xc2, 0x38, 0xff, 0x83, 0x06, 0x67, 0x6f, 0x61, 0x1a, 0x71, 0x7d, 0x2c, 0x62, 0xda,
-	0xb8, 0xd6, 0xf2, 0xbe, 0x88, 0xc8, 0x4a, 0xe6, 0x8f, 0x24, 0xf2, 0xbd, 0x97, 0x81, 0x05, 0x16,
-	0x16, 0x8e, 0x87, 0x11, 0x8c, 0x73, 0x29, 0x57, 0x18, 0x4a, 0x4e, 0x65, 0x28, 0x51, 0x3c, 0xcd,
-	0x8f, 0x1d, 0x4f, 0x8d, 0xdf, 0x64, 0xc4, 0x3d, 0x20, 0xae, 0x8d, 0x49, 0xb6, 0x45, 0xc1, 0x6b,
-	0x46, 0xc9, 0xab, 0x01, 0x7a, 0x04, 0xd9, 0x58, 0x0f, 0xe3, 0x63, 0x02, 0x76, 0x6c, 0xc3, 0xe3,
-	0xf7, 0x35, 0x58, 0x0c, 0x9f, 0x41, 0x5b, 0xb8, 0xd9, 0xc6, 0x1e, 0xbd, 0x7f, 0x1b, 0x1a, 0xb4,
-	0x80, 0x8c, 0xc2, 0x02, 0xce, 0x40, 0x89, 0x88, 0x75, 0xa2, 0x17, 0x4e, 0x1f, 0x60, 0x7c, 0xa8,
-	0xc1, 0xe9, 0x21, 0x76, 0x26, 0xd9, 0xc4, 0x25, 0x28, 0x38, 0x9e, 0x8d, 0xef, 0x45, 0xdc, 0x84,
-	0xbf, 0x6c, 0x64, 0xbb, 0xeb, 0xb8, 0x76, 0xc4, 0x46, 0xf8, 0x8b, 0x56, 0x40, 0xc7, 0x9e, 0xb5,
-	0xed, 0xe2, 0x3a, 0xc7, 0xe5, 0x86, 0x5c, 0x34, 0xcb, 0x02, 0xb6, 0xc1, 0x40, 0xc6, 0x0f, 0x34,
-	0x98, 0x63, 0xb6, 0x26, 0x79, 0x24, 0x9f, 0xaf, 0xce, 0x96, 0xa1, 0x1c, 0x33, 0x26, 0xc9, 0x6e,
-	0x1c, 0x64, 0xec, 0xc2, 0x7c, 0x92, 0x9d, 0x49, 0x74, 0xf6, 0x10, 0x40, 0xb4, 0x23, 0xc2, 0xe6,
-	0xb3, 0x66, 0x0c, 0x62, 0x7c, 0xaa, 0x01, 0x12, 0x57, 0x2a, 0xae, 0x8c, 0x23, 0xce, 0xb8, 0xec,
-	0x38, 0xd8, 0xb5, 0xe3, 0x5e, 0xbb, 0xc4, 0x21, 0x7c, 0x78, 0x1d, 0x74, 0x7c, 0x8f, 0x06, 0x56,
-	0xbd, 0x63, 0x05, 0x56, 0x5b, 0x1c, 0x9e, 0xb1, 0x1c, 0x6c, 0x99, 0x93, 0x6d, 0x72, 0x2a, 0xe3,
-	0x8f, 0xec, 0x32, 0x26, 0x8d, 0xf2, 0xb8, 0x4b, 0x7c, 0x16, 0x80, 0x1b, 0xad, 0x18, 0xce, 0x89,
-	0x61, 0x0e, 0xe1, 0x21, 0xec, 0x43, 0x0d, 0xaa, 0x5c, 0x04, 0x21, 0x4f, 0x87, 0x4d, 0x3b, 0x40,
-	0xa3, 0x0d, 0xd0, 0x8c, 0x38, 0x42, 0x5f, 0x82, 0xbc, 0x54, 0x6c, 0x76, 0x5c, 0xc5, 0x4a, 0x82,
-	0x03, 0xc4, 0x30, 0x7e, 0xae, 0xc1, 0xc2, 0x80, 0xca, 0x27, 0xb1, 0xe8, 0x57, 0x01, 0x09, 0x09,
-	0xed, 0xbe, 0xd8, 0x61, 0xb8, 0x3d, 0xaf, 0x8c, 0x2d, 0x83, 0x4a, 0x32, 0x4f, 0x39, 0x03, 0x10,
-	0x62, 0xfc, 0x45, 0x83, 0x33, 0xb7, 0x30, 0xe5, 0xa8, 0x37, 0x98, 0xef, 0xd8, 0x0c, 0xfc, 0x66,
-	0x80, 0x09, 0x39, 0xb9, 0xf6, 0xf1, 0x13, 0x71, 0x3f, 0x53, 0x89, 0x34, 0x89, 0xfe, 0x57, 0x40,
-	0xe7, 0x6b, 0x60, 0xbb, 0x1e, 0xf8, 0xfb, 0x44, 0xda, 0x51, 0x59, 0xc2, 0x4c, 0x7f, 0x9f, 0x1b,
-	0x04, 0xf5, 0xa9, 0xe5, 0x0a, 0x04, 0x19, 0x18, 0x38, 0x84, 0x0d, 0xf3, 0x33, 0x18, 0x32, 0xc6,
-	0x26, 0xc7, 0x27, 0x57, 0xc7, 0xbf, 0xd4, 0x60, 0x61, 0x40, 0x94, 0x49, 0x74, 0xfb, 0x94, 0xb8,
-	0x3d, 0x0a, 0x61, 0x66, 0xd6, 0xce, 0x29, 0x69, 0x62, 0x8b, 0x09, 0x6c, 0x74, 0x0e, 0xca, 0x3b,
-	0x96, 0xe3, 0xd6, 0x03, 0x6c, 0x11, 0xdf, 0x93, 0x82, 0x02, 0x03, 0x99, 0x1c, 0x62, 0x7c, 0xa2,
-	0x41, 0x95, 0x3d, 0x41, 0x4f, 0xb8, 0xc7, 0xfb, 0x45, 0x06, 0x2a, 0x1b, 0x1e, 0xc1, 0x01, 0x3d,
-	0xfe, 0x2f, 0x0c, 0xf4, 0x3c, 0x94, 0xb9, 0x60, 0xa4, 0x6e, 0x5b, 0xd4, 0x92, 0xe1, 0xea, 0x21,
-	0x65, 0x16, 0xf9, 0x45, 0x86, 0xb7, 0x6e, 0x51, 0xcb, 0x14, 0xda, 0x21, 0xec, 0x1b, 0x3d, 0x08,
-	0xa5, 0x96, 0x45, 0x5a, 0xf5, 0x5d, 0xdc, 0x13, 0xd7, 0xbe, 0x8a, 0x59, 0x64, 0x80, 0x97, 0x70,
-	0x8f, 0xa0, 0x07, 0xa0, 0xe8, 0x75, 0xdb, 0xe2, 0x80, 0x15, 0x96, 0xb5, 0xd5, 0x8a, 0x59, 0xf0,
-	0xba, 0x6d, 0x7e, 0xbc, 0xfe, 0x94, 0x81, 0x99, 0xbb, 0x5d, 0xf6, 0x9e, 0xe1, 0x39, 0xf0, 0xae,
-	0x4b, 0xef, 0xcf, 0x18, 0x2f, 0x42, 0x56, 0xdc, 0x19, 0x18, 0xc5, 0x92, 0x92, 0xf1, 0x8d, 0x75,
-	0x62, 0x32, 0x24, 0x5e, 0x67, 0xea, 0x36, 0x1a, 0xf2, 0x92, 0x95, 0xe5, 0xcc, 0x96, 0x18, 0x84,
-	0x5b, 0x1c, 0x13, 0x05, 0x07, 0x41, 0x74, 0x05, 0xe3, 0xa2, 0xe0, 0x20, 0x10, 0x83, 0x06, 0xe8,
-	0x56, 0x63, 0xd7, 0xf3, 0xf7, 0x5d, 0x6c, 0x37, 0xb1, 0xcd, 0xb7, 0xbd, 0x68, 0x26, 0x60, 0xc2,
-	0x30, 0xd8, 0xc6, 0xd7, 0x1b, 0x1e, 0xe5, 0x0f, 0x89, 0x2c, 0x33, 0x0c, 0x06, 0xb9, 0xe9, 0x51,
-	0x36, 0x6c, 0x63, 0x17, 0x53, 0xcc, 0x87, 0x0b, 0x62, 0x58, 0x40, 0xe4, 0x70, 0xb7, 0x13, 0x51,
-	0x17, 0xc5, 0xb0, 0x80, 0xb0, 0xe1, 0x33, 0x50, 0xea, 0x27, 0xb9, 0x4b, 0xfd, 0x6c, 0xa0, 0x48,
-	0x6d, 0xef, 0x41, 0x75, 0xd3, 0xb5, 0x1a, 0xb8, 0xe5, 0xbb, 0x36, 0x0e, 0x78, 0xf4, 0x43, 0x55,
-	0xc8, 0x52, 0xab, 0x29, 0xc3, 0x2b, 0xfb, 0x44, 0xcf, 0xc8, 0x37, 0x8e, 0x38, 0xb8, 0x8f, 0x28,
-	0xe3, 0x50, 0x6c, 0x9a, 0x58, 0xea, 0x70, 0x11, 0xf2, 0xbc, 0x34, 0x23, 0x02, 0xaf, 0x6e, 0xca,
-	0x3f, 0xe3, 0xad, 0xc4, 0xba, 0xb7, 0x02, 0xbf, 0xdb, 0x41, 0x1b, 0xa0, 0x77, 0xfa, 0x30, 0xb6,
-	0x9b, 0xe9, 0x51, 0x6f, 0x90, 0x69, 0x33, 0x41, 0x6a, 0x7c, 0x9a, 0x85, 0xca, 0x16, 0xb6, 0x82,
-	0x46, 0xeb, 0x24, 0x24, 0x1b, 0x98, 0xc6, 0x6d, 0xe2, 0x4a, 0x97, 0xc0, 0x3e, 0xd1, 0x25, 0x38,
-	0x15, 0x13, 0xa8, 0xde, 0x64, 0x0a, 0xe2, 0x96, 0xa1, 0x9b, 0xd5, 0xce, 0xa0, 0xe2, 0x9e, 0x86,
-	0xa2, 0x4d, 0xdc, 0x3a, 0xdf, 0xa2, 0x02, 0xdf, 0x22, 0xb5, 0x7c, 0xeb, 0xc4, 0xe5, 0x5b, 0x53,
-	0xb0, 0xc5, 0x07, 0x7a, 0x18, 0x2a, 0x7e, 0x97, 0x76, 0xba, 0xb4, 0x2e, 0x4e, 0xe6, 0x52, 0x91,
-	0xb3, 0xa7, 0x0b, 0x20, 0x3f, 0xb8, 0x04, 0xbd, 0x08, 0x15, 0xc2, 0x55, 0x19, 0xde, 0x4d, 0x4b,
-	0xe3, 0x5e, 0xa1, 0x74, 0x41, 0x27, 0x2e, 0xa7, 0xe8, 0x02, 0x54, 0x69, 0x60, 0xed, 0x61, 0x37,
-	0x56, 0x74, 0x01, 0x6e, 0x8f, 0xb3, 0x02, 0xde, 0x2f, 0xd2, 0x5c, 0x81, 0xb9, 0x66, 0xd7, 0x0a,
-	0x2c, 0x8f, 0x62, 0x1c, 0xc3, 0x2e, 0x73, 0x6c, 0x14, 0x0d, 0xf5, 0x2b, 0x34, 0x7f, 0xcf, 0xc0,
-	0xac, 0x89, 0x69, 0xe0, 0xe0, 0x3d, 0x7c, 0x22, 0x76, 0xfc, 0x22, 0x64, 0x1d, 0x9b, 0xf0, 0x1d,
-	0x1f, 0xe9, 0x7e, 0x1c, 0x9b, 0x0c, 0xef, 0x52, 0x5e, 0xb1, 0x4b, 0x2a, 0xed, 0x16, 0x0e, 0xa5,
-	0xdd, 0x62, 0xaa, 0x76, 0x3f, 0xd6, 0xe2, 0xda, 0x65, 0x3e, 0x97, 0xdc, 0xb7, 0xd3, 0x65, 0x52,
-	0x67, 0xc6, 0x91, 0x7a, 0x20, 0xc2, 0x64, 0x0f, 0x1b, 0x61, 0x8c, 0x97, 0x60, 0xfa, 0xb6, 0x43,
-	0xf9, 0xe1, 0x62, 0x9e, 0x5e, 0xe3, 0xaf, 0x43, 0xee, 0xcf, 0x1f, 0x80, 0x62, 0xe0, 0xef, 0x8b,
-	0x79, 0x33, 0xdc, 0x2d, 0x15, 0x02, 0x7f, 0x9f, 0x87, 0x25, 0xde, 0x6a, 0xe0, 0x07, 0xd2, 0x5f,
-	0x65, 0x4c, 0xf9, 0x67, 0x7c, 0x47, 0xeb, 0x3b, 0x94, 0x09, 0x14, 0xf0, 0x3c, 0x14, 0x02, 0x41,
-	0x3f, 0xb2, 0xf0, 0x1a, 0x5f, 0x89, 0xcb, 0x15, 0x52, 0x19, 0xef, 0x6a, 0xa0, 0xbf, 0xe8, 0x76,
-	0xc9, 0xe7, 0xe1, 0xd7, 0x54, 0xa5, 0x96, 0xac, 0xba, 0xcc, 0xf3, 0xc3, 0x0c, 0x54, 0x24, 0x1b,
-	0x93, 0xdc, 0x08, 0x53, 0x59, 0xd9, 0x82, 0x32, 0x5b, 0xb2, 0x4e, 0x70, 0x33, 0xcc, 0x53, 0x95,
-	0xd7, 0xd6, 0x94, 0x91, 0x20, 0xc1, 0x06, 0x2f, 0x59, 0x6f, 0x71, 0xa2, 0xaf, 0x79, 0x34, 0xe8,
-	0x99, 0xd0, 0x88, 0x00, 0xb5, 0xb7, 0x60, 0x76, 0x60, 0x98, 0xd9, 0xc6, 0x2e, 0xee, 0x85, 0xa1,
-	0x6e, 0x17, 0xf7, 0xd0, 0x93, 0xf1, 0xc6, 0x82, 0x34, 0x83, 0xbb, 0xe3, 0x7b, 0xcd, 0xeb, 0x41,
-	0x60, 0xf5, 0x64, 0xe3, 0xc1, 0xb3, 0x99, 0x67, 0x34, 0xe3, 0x9f, 0x1a, 0xe8, 0xaf, 0x74, 0x71,
-	0xd0, 0x3b, 0x4a, 0x07, 0x84, 0x60, 0x1a, 0xdf, 0xeb, 0x04, 0xf2, 0xd2, 0xc6, 0xbf, 0x87, 0xfd,
-	0x47, 0x4e, 0xe1, 0x3f, 0x14, 0x9e, 0x2b, 0xaf, 0x4c, 0x8c, 0xbf, 0xdb, 0x17, 0x73, 0xa2, 0x83,
-	0x90, 0x38, 0xdd, 0x99, 0x43, 0x9f, 0xee, 0x8f, 0x34, 0x28, 0xbd, 0x8e, 0x1b, 0xd4, 0x0f, 0xd8,
-	0x89, 0x56, 0xe8, 0x47, 0x1b, 0xe3, 0x8a, 0x9e, 0x19, 0xbc, 0xa2, 0x5f, 0x83, 0xa2, 0x63, 0xd7,
-	0x2d, 0xb6, 0xb5, 0x5c, 0xc1, 0xa3, 0xbc, 0x54, 0xc1, 0xb1, 0xb9, 0x0d, 0x8c, 0x5f, 0x53, 0xf8,
-	0xa9, 0x06, 0xba, 0xe0, 0x99, 0x08, 0xca, 0xe7, 0x62, 0xcb, 0x69, 0x2a, 0x7b, 0x93, 0x3f, 0x91,
-	0xa0, 0xb7, 0xa7, 0xfa, 0xcb, 0x5e, 0x07, 0x60, 0xba, 0x93, 0xe4, 0xc2, 0x5c, 0x97, 0x95, 0xdc,
-	0x0a, 0x72, 0xae, 0xc7, 0xdb, 0x53, 0x66, 0x89, 0x51, 0xf1, 0x29, 0x6e, 0x14, 0x20, 0xc7, 0xa9,
-	0x8d, 0xff, 0x68, 0x30, 0x77, 0xd3, 0x72, 0x1b, 0xeb, 0x0e, 0xa1, 0x96, 0xd7, 0x98, 0x20, 0x86,
-	0x3e, 0x0b, 0x05, 0xbf, 0x53, 0x77, 0xf1, 0x0e, 0x95, 0x2c, 0xad, 0x8c, 0x90, 0x48, 0xa8, 0xc1,
-	0xcc, 0xfb, 0x9d, 0x3b, 0x78, 0x87, 0xa2, 0x2f, 0x43, 0xd1, 0xef, 0xd4, 0x03, 0xa7, 0xd9, 0xa2,
-	0x52, 0xfb, 0x63, 0x10, 0x17, 0xfc, 0x8e, 0xc9, 0x28, 0x62, 0x39, 0x9e, 0xe9, 0x43, 0xe6, 0x78,
-	0x8c, 0xbf, 0x0e, 0x89, 0x3f, 0x81, 0x69, 0x3f, 0x0b, 0x45, 0xc7, 0xa3, 0x75, 0xdb, 0x21, 0xa1,
-	0x0a, 0xce, 0xaa, 0x6d, 0xc8, 0xa3, 0x5c, 0x02, 0xbe, 0xa7, 0x1e, 0x65, 0x6b, 0xa3, 0x17, 0x00,
-	0x76, 0x5c, 0xdf, 0x92, 0xd4, 0x42, 0x07, 0xe7, 0xd4, 0xa7, 0x82, 0xa1, 0x85, 0xf4, 0x25, 0x4e,
-	0xc4, 0x66, 0xe8, 0x6f, 0xe9, 0x9f, 0x35, 0x58, 0xd8, 0xc4, 0x01, 0x71, 0x08, 0xc5, 0x1e, 0x95,
-	0xf9, 0xd6, 0x0d, 0x6f, 0xc7, 0x4f, 0x26, 0xb6, 0xb5, 0x81, 0xc4, 0xf6, 0x67, 0x93, 0xe6, 0x4d,
-	0xbc, 0xe0, 0x44, 0x79, 0x25, 0x7c, 0xc1, 0x85, 0x45, 0x24, 0xf1, 0x02, 0x9e, 0x49, 0xd9, 0x26,
-	0xc9, 0x6f, 0x3c, 0x11, 0x60, 0xfc, 0x48, 0x34, 0x74, 0x28, 0x85, 0xba, 0x7f, 0x83, 0x5d, 0x04,
-	0xe9, 0x64, 0x07, 0x5c, 0xee, 0x17, 0x60, 0xc0, 0x77, 0xa4, 0xb4, 0x99, 0xfc, 0x4c, 0x83, 0xe5,
-	0x74, 0xae, 0x26, 0x89, 0x8e, 0x2f, 0x40, 0xce, 0xf1, 0x76, 0xfc, 0x30, 0xfd, 0x77, 0x51, 0xfd,
-	0x10, 0x52, 0xae, 0x2b, 0x08, 0x8d, 0x7f, 0x69, 0x50, 0xe5, 0xbe, 0xfa, 0x08, 0xb6, 0xbf, 0x8d,
-	0xdb, 0x75, 0xe2, 0xbc, 0x8d, 0xc3, 0xed, 0x6f, 0xe3, 0xf6, 0x96, 0xf3, 0x36, 0x4e, 0x58, 0x46,
-	0x2e, 0x69, 0x19, 0xc9, 0x04, 0x49, 0x7e, 0x44, 0x7a, 0xb7, 0x90, 0x48, 0xef, 0x1a, 0xef, 0x6b,
-	0x50, 0xbb, 0x85, 0xe9, 0xa0, 0xa8, 0x47, 0x67, 0x14, 0x1f, 0x68, 0xf0, 0xa0, 0x92, 0xa1, 0x49,
-	0xec, 0xe1, 0xb9, 0xa4, 0x3d, 0xa8, 0x1f, 0xc6, 0x43, 0x4b, 0x4a, 0x53, 0xb8, 0x0a, 0xfa, 0x7a,
-	0xb7, 0xdd, 0x8e, 0x2e, 0x27, 0x2b, 0xa0, 0x07, 0xe2, 0x53, 0xbc, 0x1b, 0x45, 0xb8, 0x2c, 0x4b,
-	0x18, 0x7b, 0x1d, 0x1a, 0x97, 0xa0, 0x22, 0x49, 0x24, 0xd7, 0x35, 0x28, 0x06, 0xf2, 0x5b, 0xe2,
-	0x47, 0xff, 0xc6, 0x02, 0xcc, 0x99, 0xb8, 0xc9, 0x2c, 0x31, 0xb8, 0xe3, 0x78, 0xbb, 0x72, 0x19,
-	0xe3, 0x1d, 0x0d, 0xe6, 0x93, 0x70, 0x39, 0xd7, 0x17, 0xa1, 0x60, 0xd9, 0x76, 0x80, 0x09, 0x19,
-	0xb9, 0x2d, 0xd7, 0x05, 0x8e, 0x19, 0x22, 0xc7, 0x34, 0x97, 0x19, 0x5b, 0x73, 0x17, 0x57, 0xa0,
-	0x18, 0x96, 0x60, 0x51, 0x01, 0xb2, 0xd7, 0x5d, 0xb7, 0x3a, 0x85, 0x74, 0x28, 0x6e, 0xc8, 0x3a,
-	0x63, 0x55, 0xbb, 0xf8, 0x55, 0x98, 0x1d, 0xc8, 0x60, 0xa0, 0x22, 0x4c, 0xbf, 0xec, 0x7b, 0xb8,
-	0x3a, 0x85, 0xaa, 0xa0, 0xdf, 0x70, 0x3c, 0x2b, 0xe8, 0x89, 0xc8, 0x53, 0xb5, 0xd1, 0x2c, 0x94,
-	0xb9, 0x07, 0x96, 0x00, 0xbc, 0xf6, 0xef, 0xd3, 0x50, 0xb9, 0xcb, 0x39, 0xd9, 0xc2, 0xc1, 0x9e,
-	0xd3, 0xc0, 0xa8, 0x0e, 0xd5, 0xc1, 0x26, 0x6a, 0xf4, 0x98, 0x72, 0xcf, 0x52, 0x7a, 0xad, 0x6b,
-	0xa3, 0x64, 0x33, 0xa6, 0xd0, 0x9b, 0x30, 0x93, 0x6c, 0x6f, 0x46, 0x6a, 0x17, 0xa1, 0xec, 0x81,
-	0x3e, 0x68, 0xf2, 0x3a, 0x54, 0x12, 0xdd, 0xca, 0xe8, 0x82, 0x72, 0x6e, 0x55, 0x47, 0x73, 0x4d,
-	0x1d, 0xb5, 0xe3, 0x1d, 0xc5, 0x82, 0xfb, 0x64, 0x4f, 0x65, 0x0a, 0xf7, 0xca, 0xc6, 0xcb, 0x83,
-	0xb8, 0xb7, 0xe0, 0xd4, 0x50, 0x8b, 0x24, 0x7a, 0x5c, 0x39, 0x7f, 0x5a, 0x2b, 0xe5, 0x41, 0x4b,
-	0xec, 0x03, 0x1a, 0xee, 0xca, 0x45, 0x97, 0xd5, 0x3b, 0x90, 0xd6, 0x93, 0x5c, 0xbb, 0x32, 0x36,
-	0x7e, 0xa4, 0xb8, 0xef, 0x6a, 0x70, 0x3a, 0xa5, 0xaf, 0x11, 0x5d, 0x53, 0x4e, 0x37, 0xba, 0x39,
-	0xb3, 0xf6, 0xe4, 0xe1, 0x88, 0x22, 0x46, 0x3c, 0x98, 0x1d, 0x68, 0xf5, 0x43, 0x97, 0x52, 0xdb,
-	0x1f, 0x86, 0x7b, 0x1e, 0x6b, 0x8f, 0x8d, 0x87, 0x1c, 0xad, 0xc7, 0xde, 0x6f, 0xc9, 0xfe, 0xb8,
-	0x94, 0xf5, 0xd4, 0x5d, 0x74, 0x07, 0x6d, 0xe8, 0x1b, 0x50, 0x49, 0x34, 0xb2, 0xa5, 0x58, 0xbc,
-	0xaa, 0xd9, 0xed, 0xa0, 0xa9, 0xdf, 0x02, 0x3d, 0xde, 0x6f, 0x86, 0x56, 0xd3, 0xce, 0xd2, 0xd0,
-	0xc4, 0x87, 0x39, 0x4a, 0xfd, 0x76, 0x92, 0x11, 0x47, 0x69, 0xa8, 0x03, 0x67, 0xfc, 0xa3, 0x14,
-	0x9b, 0x7f, 0xe4, 0x51, 0x3a, 0xf4, 0x12, 0xef, 0x68, 0xb0, 0xa8, 0x6e, 0x57, 0x42, 0x6b, 0x69,
-	0xb6, 0x99, 0xde, 0x98, 0x55, 0xbb, 0x76, 0x28, 0x9a, 0x48, 0x8b, 0xbb, 0x30, 0x93, 0x6c, 0xca,
-	0x49, 0xd1, 0xa2, 0xb2, 0x8f, 0xa9, 0x76, 0x69, 0x2c, 0xdc, 0x68, 0xb1, 0xd7, 0xa0, 0x1c, 0x6b,
-	0x4c, 0x40, 0x8f, 0x8e, 0xb0, 0xe3, 0x78, 0x59, 0xeb, 0x20, 0x4d, 0xb6, 0xa0, 0x92, 0x28, 0x46,
-	0xa7, 0xd9, 0xb0, 0xa2, 0x47, 0xa0, 0x76, 0x71, 0x1c, 0xd4, 0x48, 0x80, 0x16, 0x54, 0x12, 0xa5,
-	0xc1, 0x94, 0x95, 0x54, 0x95, 0xd0, 0x94, 0x95, 0x94, 0x95, 0x46, 0x63, 0x0a, 0x7d, 0x3b, 0x56,
-	0x85, 0x4c, 0x54, 0x7a, 0xd1, 0xd5, 0x91, 0xf3, 0xa8, 0x0a, 0xdd, 0xb5, 0xb5, 0xc3, 0x90, 0x44,
-	0x2c, 0xbc, 0x02, 0xa5, 0xa8, 0xc0, 0x88, 0xce, 0xa7, 0xba, 0x85, 0xc3, 0xec, 0xd4, 0x16, 0xe4,
-	0x45, 0xb1, 0x0f, 0x19, 0x29, 0x65, 0xfd, 0x58, 0x25, 0xb0, 0xf6, 0xb0, 0x12, 0x27, 0x59, 0x07,
-	0x33, 0xa6, 0x90, 0x09, 0x79, 0x91, 0x3a, 0x4c, 0x99, 0x34, 0x51, 0x12, 0xa9, 0x8d, 0xc6, 0x11,
-	0xf9, 0xc6, 0x29, 0xf4, 0x0d, 0x28, 0x86, 0xb9, 0x5f, 0xf4, 0x48, 0xca, 0xb1, 0x4f, 0x24, 0xde,
-	0x6b, 0x07, 0x61, 0x85, 0x33, 0x6f, 0x42, 0x8e, 0x27, 0xef, 0xd0, 0xca, 0xa8, 0xc4, 0xde, 0x28,
-	0x5e, 0x13, 0xb9, 0x3f, 0x63, 0x0a, 0x7d, 0x1d, 0x72, 0xfc, 0xfe, 0x9b, 0x32, 0x63, 0x3c, 0x3b,
-	0x57, 0x1b, 0x89, 0x12, 0xb2, 0x68, 0x83, 0x1e, 0xcf, 0x0b, 0xa4, 0x38, 0x6e, 0x45, 0xe6, 0xa4,
-	0x36, 0x0e, 0x66, 0xb8, 0xca, 0xf7, 0x34, 0x58, 0x4a, 0x7b, 0x42, 0xa2, 0xd4, 0xe8, 0x3c, 0xea,
-	0x1d, 0x5c, 0x7b, 0xea, 0x90, 0x54, 0x91, 0x0a, 0xdf, 0x86, 0x39, 0xc5, 0xc3, 0x05, 0x5d, 0x49,
-	0x9b, 0x2f, 0xe5, 0xcd, 0x55, 0x7b, 0x62, 0x7c, 0x82, 0x68, 0xed, 0x4d, 0xc8, 0xf1, 0x07, 0x47,
-	0xca, 0xf6, 0xc5, 0xdf, 0x2f, 0x29, 0x06, 0x91, 0x78, 0xaf, 0x18, 0x53, 0x08, 0x83, 0x1e, 0x7f,
-	0x7d, 0xa4, 0xec, 0x9f, 0xe2, 0xe1, 0x52, 0xbb, 0x30, 0x06, 0x66, 0xb8, 0xcc, 0x5a, 0x17, 0xf4,
-	0xcd, 0xc0, 0xbf, 0xd7, 0x0b, 0xaf, 0xfe, 0xff, 0x9f, 0x65, 0x6f, 0x3c, 0xf5, 0xcd, 0x6b, 0x4d,
-	0x87, 0xb6, 0xba, 0xdb, 0xcc, 0xbb, 0x5c, 0x11, 0xb8, 0x8f, 0x3b, 0xbe, 0xfc, 0xba, 0xe2, 0x78,
-	0x14, 0x07, 0x9e, 0xe5, 0x5e, 0xe1, 0x73, 0x49, 0x68, 0x67, 0x7b, 0x3b, 0xcf, 0xff, 0xaf, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x57, 0x35, 0x52, 0x26, 0x3a, 0x00, 0x00,
+	// 3245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1b, 0x4b, 0x70, 0x1c, 0x47,
+	0xd5, 0xb3, 0xff, 0x7d, 0x3b, 0x2b, 0xad, 0x5b, 0xb2, 0xbc, 0x59, 0xdb, 0xb1, 0x3c, 0x89, 0x1d,
+	0xc5, 0x4e, 0xec, 0x58, 0x4e, 0x48, 0x48, 0x20, 0x89, 0x65, 0x11, 0x5b, 0x15, 0x3b, 0x28, 0xb3,
+	0x49, 0x8a, 0x10, 0xc2, 0xd4, 0x68, 0xa7, 0xb5, 0x9a, 0xd2, 0xec, 0xcc, 0x32, 0x3d, 0x2b, 0x79,
+	0x73, 0xa2, 0x2a, 0x01, 0x8a, 0x4a, 0x91, 0x14, 0x05, 0x05, 0xc5, 0x95, 0x5f, 0x15, 0x37, 0x42,
+	0xa8, 0x0a, 0xc5, 0x85, 0x0b, 0x07, 0x0e, 0x54, 0xf1, 0xb9, 0x72, 0xe1, 0x00, 0x47, 0x2e, 0x9c,
+	0xa9, 0x82, 0xea, 0xcf, 0xcc, 0xce, 0xec, 0xf6, 0xac, 0x56, 0xde, 0x18, 0x49, 0xb7, 0xe9, 0xd7,
+	0xef, 0x75, 0xbf, 0xf7, 0xfa, 0xf5, 0x7b, 0xdd, 0xaf, 0xdf, 0x80, 0xda, 0xb1, 0x9d, 0x9d, 0x1e,
+	0xb9, 0xdc, 0xf5, 0xbd, 0xc0, 0x43, 0x73, 0xf1, 0xd6, 0x65, 0xde, 0x68, 0xa8, 0x2d, 0xaf, 0xd3,
+	0xf1, 0x5c, 0x0e, 0x6c, 0xa8, 0xa4, 0xb5, 0x85, 0x3b, 0x26, 0x6f, 0x69, 0xbf, 0x57, 0xe0, 0xe4,
+	0x0d, 0x1f, 0x9b, 0x01, 0xbe, 0xe1, 0x39, 0x0e, 0x6e, 0x05, 0xb6, 0xe7, 0xea, 0xf8, 0x6b, 0x3d,
+	0x4c, 0x02, 0xf4, 0x04, 0xe4, 0x36, 0x4c, 0x82, 0xeb, 0xca, 0xa2, 0xb2, 0x54, 0x59, 0x3e, 0x7d,
+	0x39, 0x31, 0xb6, 0x18, 0xf3, 0x0e, 0x69, 0xaf, 0x98, 0x04, 0xeb, 0x0c, 0x13, 0x9d, 0x84, 0xa2,
+	0xb5, 0x61, 0xb8, 0x66, 0x07, 0xd7, 0x33, 0x8b, 0xca, 0x52, 0x59, 0x2f, 0x58, 0x1b, 0xaf, 0x98,
+	0x1d, 0x8c, 0x1e, 0x81, 0xd9, 0x56, 0x34, 0x3e, 0x47, 0xc8, 0x32, 0x84, 0x99, 0x01, 0x98, 0x21,
+	0x2e, 0x40, 0x81, 0xf3, 0x57, 0xcf, 0x2d, 0x2a, 0x4b, 0xaa, 0x2e, 0x5a, 0xe8, 0x0c, 0x00, 0xd9,
+	0x32, 0x7d, 0x8b, 0x18, 0x6e, 0xaf, 0x53, 0xcf, 0x2f, 0x2a, 0x4b, 0x79, 0xbd, 0xcc, 0x21, 0xaf,
+	0xf4, 0x3a, 0xda, 0xfb, 0x0a, 0x9c, 0x58, 0xf5, 0xbd, 0xee, 0xa1, 0x10, 0x42, 0xfb, 0x85, 0x02,
+	0xf3, 0xb7, 0x4c, 0x72, 0x38, 0x34, 0x7a, 0x06, 0x20, 0xb0, 0x3b, 0xd8, 0x20, 0x81, 0xd9, 0xe9,
+	0x32, 0xad, 0xe6, 0xf4, 0x32, 0x85, 0x34, 0x29, 0x40, 0x7b, 0x13, 0xd4, 0x15, 0xcf, 0x73, 0x74,
+	0x4c, 0xba, 0x9e, 0x4b, 0x30, 0xba, 0x06, 0x05, 0x12, 0x98, 0x41, 0x8f, 0x08, 0x26, 0x4f, 0x49,
+	0x99, 0x6c, 0x32, 0x14, 0x5d, 0xa0, 0xa2, 0x79, 0xc8, 0xef, 0x98, 0x4e, 0x8f, 0xf3, 0x58, 0xd2,
+	0x79, 0x43, 0x7b, 0x0b, 0x66, 0x9a, 0x81, 0x6f, 0xbb, 0xed, 0x4f, 0x71, 0xf0, 0x72, 0x38, 0xf8,
+	0x5f, 0x15, 0x78, 0x60, 0x15, 0x93, 0x96, 0x6f, 0x6f, 0x1c, 0x12, 0xd3, 0xd5, 0x40, 0x1d, 0x40,
+	0xd6, 0x56, 0x99, 0xaa, 0xb3, 0x7a, 0x02, 0x36, 0xb4, 0x18, 0xf9, 0xe1, 0xc5, 0xf8, 0x6f, 0x06,
+	0x1a, 0x32, 0xa1, 0xa6, 0x51, 0xdf, 0xe7, 0xa3, 0x1d, 0x95, 0x61, 0x44, 0xe7, 0x93, 0x44, 0xbc,
+	0xef, 0xf2, 0x60, 0xb6, 0x26, 0x03, 0x44, 0x1b, 0x6f, 0x58, 0xaa, 0xac, 0x44, 0xaa, 0x65, 0x38,
+	0xb1, 0x63, 0xfb, 0x41, 0xcf, 0x74, 0x8c, 0xd6, 0x96, 0xe9, 0xba, 0xd8, 0x61, 0x7a, 0x22, 0xf5,
+	0xdc, 0x62, 0x76, 0xa9, 0xac, 0xcf, 0x89, 0xce, 0x1b, 0xbc, 0x8f, 0x2a, 0x8b, 0xa0, 0x27, 0x61,
+	0xa1, 0xbb, 0xd5, 0x27, 0x76, 0x6b, 0x84, 0x28, 0xcf, 0x88, 0xe6, 0xc3, 0xde, 0x04, 0xd5, 0x25,
+	0x38, 0xde, 0x62, 0xde, 0xca, 0x32, 0xa8, 0xd6, 0xb8, 0x1a, 0x0b, 0x4c, 0x8d, 0x35, 0xd1, 0xf1,
+	0x5a, 0x08, 0xa7, 0x6c, 0x85, 0xc8, 0xbd, 0xa0, 0x15, 0x23, 0x28, 0x32, 0x82, 0x39, 0xd1, 0xf9,
+	0x7a, 0xd0, 0x8a, 0x68, 0x98, 0x23, 0xb9, 0xed, 0x99, 0xd6, 0xe1, 0x70, 0x24, 0x1f, 0x28, 0x50,
+	0xd7, 0xb1, 0x83, 0x4d, 0x72, 0x38, 0x6c, 0x5c, 0xfb, 0xbe, 0x02, 0x0f, 0xde, 0xc4, 0x41, 0xcc,
+	0x5a, 0x02, 0x33, 0xb0, 0x49, 0x60, 0xb7, 0xc8, 0x41, 0xb2, 0xf5, 0xa1, 0x02, 0x67, 0x53, 0xd9,
+	0x9a, 0x66, 0xf3, 0x3c, 0x0d, 0x79, 0xfa, 0x45, 0xea, 0x99, 0xc5, 0xec, 0x52, 0x65, 0xf9, 0x9c,
+	0x94, 0xe6, 0x65, 0xdc, 0x7f, 0x83, 0xfa, 0xa4, 0x75, 0xd3, 0xf6, 0x75, 0x8e, 0xaf, 0xfd, 0x5d,
+	0x81, 0x85, 0xe6, 0x96, 0xb7, 0x3b, 0x60, 0xe9, 0x7e, 0x28, 0x28, 0xe9, 0x4e, 0xb2, 0x43, 0xee,
+	0x04, 0x5d, 0x85, 0x5c, 0xd0, 0xef, 0x62, 0xe6, 0x89, 0x66, 0x96, 0xcf, 0x5c, 0x96, 0x1c, 0x0e,
+	0x2e, 0x53, 0x26, 0x5f, 0xeb, 0x77, 0xb1, 0xce, 0x50, 0xd1, 0xa3, 0x50, 0x1b, 0x52, 0x79, 0xb8,
+	0x21, 0x67, 0x93, 0x3a, 0x27, 0xda, 0x6f, 0x32, 0x70, 0x72, 0x44, 0xc4, 0x69, 0x94, 0x2d, 0x9b,
+	0x3b, 0x23, 0x9d, 0x1b, 0x9d, 0x87, 0x98, 0x09, 0x18, 0xb6, 0x45, 0xea, 0xd9, 0xc5, 0xec, 0x52,
+	0x56, 0xaf, 0x0e, 0xa0, 0x6b, 0x16, 0x41, 0x8f, 0x03, 0x1a, 0x71, 0x17, 0xdc, 0x2b, 0xe5, 0xf4,
+	0xe3, 0xc3, 0xfe, 0x82, 0xf9, 0x24, 0xa9, 0xc3, 0xe0, 0x2a, 0xc8, 0xe9, 0xf3, 0x12, 0x8f, 0x41,
+	0xd0, 0x55, 0x98, 0xb7, 0xdd, 0x3b, 0xb8, 0xe3, 0xf9, 0x7d, 0xa3, 0x8b, 0xfd, 0x16, 0x76, 0x03,
+	0xb3, 0x8d, 0x49, 0xbd, 0xc0, 0x38, 0x9a, 0x0b, 0xfb, 0xd6, 0x07, 0x5d, 0xda, 0xc7, 0x0a, 0x2c,
+	0xf0, 0x53, 0xd7, 0xba, 0xe9, 0x07, 0xf6, 0x41, 0x47, 0xae, 0xf3, 0x30, 0xd3, 0x0d, 0xf9, 0xe0,
+	0x78, 0x39, 0x86, 0x57, 0x8d, 0xa0, 0x6c, 0x97, 0x7d, 0xa4, 0xc0, 0x3c, 0x3d, 0x64, 0x1d, 0x25,
+	0x9e, 0x7f, 0xa9, 0xc0, 0xdc, 0x2d, 0x93, 0x1c, 0x25, 0x96, 0x7f, 0x2d, 0x42, 0x50, 0xc4, 0xf3,
+	0x41, 0xba, 0x56, 0x8a, 0x98, 0x64, 0x3a, 0x8c, 0xea, 0x33, 0x09, 0xae, 0x89, 0xf6, 0xc9, 0x20,
+	0x56, 0x1d, 0x31, 0xce, 0x7f, 0xab, 0xc0, 0x99, 0x9b, 0x38, 0x88, 0xb8, 0x3e, 0x14, 0x31, 0x6d,
+	0x52, 0x6b, 0xf9, 0x80, 0x47, 0x64, 0x29, 0xf3, 0x07, 0x12, 0xf9, 0xde, 0xcf, 0xc0, 0x09, 0x1a,
+	0x16, 0x0e, 0x87, 0x11, 0x4c, 0x72, 0x28, 0x97, 0x18, 0x4a, 0x5e, 0x66, 0x28, 0x51, 0x3c, 0x2d,
+	0x4c, 0x1c, 0x4f, 0xb5, 0x5f, 0x65, 0x60, 0x61, 0x58, 0x1b, 0xd3, 0x2c, 0x8b, 0x84, 0xd7, 0x8c,
+	0x94, 0x57, 0x0d, 0xd4, 0x08, 0xb2, 0xb6, 0x1a, 0xc6, 0xc7, 0x04, 0xec, 0xd0, 0x86, 0xc7, 0x9f,
+	0x2b, 0xb0, 0x10, 0x5e, 0x83, 0x9a, 0xb8, 0xdd, 0xc1, 0x6e, 0x70, 0xef, 0x36, 0x34, 0x6c, 0x01,
+	0x19, 0x89, 0x05, 0x9c, 0x86, 0x32, 0xe1, 0xf3, 0x44, 0x37, 0x9c, 0x01, 0x00, 0xd5, 0xa1, 0xb8,
+	0x69, 0x63, 0xc7, 0x8a, 0xcc, 0x27, 0x6c, 0x6a, 0xff, 0x56, 0xe0, 0xe4, 0x08, 0xa3, 0xd3, 0x2c,
+	0x6f, 0x1d, 0x8a, 0xb6, 0x6b, 0xe1, 0xbb, 0x11, 0x9f, 0x61, 0x93, 0xf6, 0x6c, 0xf4, 0x6c, 0xc7,
+	0x8a, 0x18, 0x0c, 0x9b, 0xe8, 0x1c, 0xa8, 0xd8, 0x35, 0x37, 0x1c, 0x6c, 0x30, 0x5c, 0xc6, 0x63,
+	0x49, 0xaf, 0x70, 0xd8, 0x1a, 0x05, 0xa1, 0x97, 0xa1, 0xca, 0x58, 0xe6, 0x18, 0xc2, 0xbe, 0x2b,
+	0xcb, 0x17, 0xe4, 0x16, 0xcc, 0x05, 0x79, 0x89, 0xc9, 0x48, 0xf1, 0x75, 0x75, 0x33, 0xfa, 0xc6,
+	0x44, 0x7b, 0x4f, 0x81, 0xe3, 0x23, 0x38, 0x71, 0x25, 0x29, 0x09, 0x25, 0xdd, 0x27, 0x99, 0xb4,
+	0xef, 0x28, 0x30, 0x47, 0x77, 0x96, 0x60, 0x85, 0xdc, 0x5f, 0x0b, 0x59, 0x84, 0x4a, 0x6c, 0xeb,
+	0x08, 0x76, 0xe3, 0x20, 0x6d, 0x1b, 0xe6, 0x93, 0xec, 0x4c, 0x63, 0x07, 0x0f, 0x02, 0x44, 0xf6,
+	0xc7, 0x77, 0x78, 0x56, 0x8f, 0x41, 0xb4, 0x7f, 0x29, 0x80, 0xf8, 0x01, 0x92, 0xaf, 0xd0, 0xc1,
+	0xe6, 0x97, 0xb8, 0x6d, 0xc5, 0x62, 0x54, 0x99, 0x41, 0x58, 0xf7, 0x2a, 0xa8, 0xf8, 0x6e, 0xe0,
+	0x9b, 0x46, 0xd7, 0xf4, 0xcd, 0x4e, 0x68, 0x79, 0x13, 0x84, 0x93, 0x0a, 0x23, 0x5b, 0x67, 0x54,
+	0xda, 0x1f, 0xe8, 0xd1, 0x53, 0x6c, 0xb4, 0xc3, 0x2e, 0xf1, 0x19, 0x00, 0x66, 0xb4, 0xbc, 0x3b,
+	0xcf, 0xbb, 0x19, 0x84, 0x05, 0xec, 0x9f, 0x29, 0x50, 0x63, 0x22, 0x70, 0x79, 0xba, 0x74, 0xd8,
+	0x21, 0x1a, 0x65, 0x88, 0x66, 0xcc, 0x16, 0xfa, 0x2c, 0x14, 0x84, 0x62, 0xb3, 0x93, 0x2a, 0x56,
+	0x10, 0xec, 0x21, 0x86, 0xf6, 0x63, 0x9a, 0x52, 0x4d, 0xaa, 0x7c, 0x1a, 0x8b, 0x7e, 0x0d, 0x10,
+	0x97, 0xd0, 0x1a, 0x88, 0x1d, 0x1e, 0x2e, 0xce, 0x4b, 0xfd, 0xd0, 0xb0, 0x92, 0xf4, 0xe3, 0xf6,
+	0x10, 0x84, 0x68, 0x7f, 0x56, 0xe0, 0xf4, 0x4d, 0x1c, 0x30, 0xd4, 0x15, 0xea, 0x3b, 0xd6, 0x7d,
+	0xaf, 0xed, 0x63, 0x42, 0x8e, 0xae, 0x7d, 0xfc, 0x80, 0x9f, 0x46, 0x65, 0x22, 0x4d, 0xa3, 0xff,
+	0x73, 0xa0, 0xb2, 0x39, 0xb0, 0x65, 0xf8, 0xde, 0x2e, 0x11, 0x76, 0x54, 0x11, 0x30, 0xdd, 0xdb,
+	0x65, 0x06, 0x11, 0x78, 0x81, 0xe9, 0x70, 0x04, 0x11, 0x06, 0x19, 0x84, 0x76, 0xb3, 0x3d, 0x18,
+	0x32, 0x46, 0x07, 0xc7, 0x47, 0x57, 0xc7, 0x3f, 0x55, 0xe0, 0xc4, 0x90, 0x28, 0xd3, 0xe8, 0xf6,
+	0x29, 0x7e, 0x56, 0xe6, 0xc2, 0xcc, 0x2c, 0x9f, 0x95, 0xd2, 0xc4, 0x26, 0xe3, 0xd8, 0xe8, 0x2c,
+	0x54, 0x36, 0x4d, 0xdb, 0x31, 0x7c, 0x6c, 0x12, 0xcf, 0x15, 0x82, 0x02, 0x05, 0xe9, 0x0c, 0x42,
+	0x1f, 0x67, 0x6a, 0xf4, 0xc2, 0x7d, 0xc4, 0x3d, 0xde, 0x4f, 0x32, 0x50, 0x5d, 0x73, 0x09, 0xf6,
+	0x83, 0xc3, 0x7f, 0x9f, 0x42, 0x2f, 0x40, 0x85, 0x09, 0x46, 0x0c, 0xcb, 0x0c, 0x4c, 0x11, 0xae,
+	0x1e, 0x94, 0xe6, 0xcc, 0xd9, 0xe9, 0x67, 0xd5, 0x0c, 0x4c, 0x9d, 0x6b, 0x87, 0xd0, 0x6f, 0x74,
+	0x0a, 0xca, 0x5b, 0x26, 0xd9, 0x32, 0xb6, 0x71, 0x9f, 0x1f, 0x72, 0xab, 0x7a, 0x89, 0x02, 0x5e,
+	0xc6, 0x7d, 0x82, 0x1e, 0x80, 0x92, 0xdb, 0xeb, 0xf0, 0x0d, 0x46, 0xb3, 0xd0, 0x55, 0xbd, 0xe8,
+	0xf6, 0x3a, 0x6c, 0x7b, 0xfd, 0x31, 0x03, 0x33, 0x77, 0x7a, 0x81, 0x29, 0x32, 0xfe, 0x3d, 0x27,
+	0xb8, 0x37, 0x63, 0xbc, 0x08, 0x59, 0x7e, 0x66, 0xa0, 0x14, 0x75, 0x29, 0xe3, 0x6b, 0xab, 0x44,
+	0xa7, 0x48, 0x74, 0xe1, 0x48, 0xaf, 0xd5, 0x12, 0x87, 0xac, 0x2c, 0x63, 0xb6, 0x4c, 0x21, 0xfc,
+	0x4c, 0x77, 0x0a, 0xca, 0xd8, 0xf7, 0xa3, 0x23, 0x18, 0x13, 0x05, 0xfb, 0x3e, 0xef, 0xd4, 0x40,
+	0x35, 0x5b, 0xdb, 0xae, 0xb7, 0xeb, 0x60, 0xab, 0x8d, 0x2d, 0xb6, 0xec, 0x25, 0x3d, 0x01, 0xe3,
+	0x86, 0x41, 0x17, 0xde, 0x68, 0xb9, 0x01, 0xbb, 0x36, 0x65, 0xf5, 0x32, 0x87, 0xdc, 0x70, 0x03,
+	0xda, 0x6d, 0x61, 0x07, 0x07, 0x98, 0x75, 0x17, 0x79, 0x37, 0x87, 0x88, 0xee, 0x5e, 0x37, 0xa2,
+	0x2e, 0xf1, 0x6e, 0x0e, 0xa1, 0xdd, 0xa7, 0xa1, 0x3c, 0x48, 0xe9, 0x97, 0x07, 0xb9, 0x4f, 0x06,
+	0xd0, 0x76, 0xa0, 0xb6, 0xee, 0x98, 0x2d, 0xbc, 0xe5, 0x39, 0x16, 0xf6, 0x59, 0xf4, 0x43, 0x35,
+	0xc8, 0x06, 0x66, 0x5b, 0x84, 0x57, 0xfa, 0x89, 0x9e, 0x11, 0x37, 0x3a, 0xbe, 0x71, 0x1f, 0x96,
+	0xc6, 0xa1, 0xd8, 0x30, 0xb1, 0x44, 0xe9, 0x02, 0x14, 0xd8, 0x43, 0x14, 0x0f, 0xbc, 0xaa, 0x2e,
+	0x5a, 0xda, 0xdb, 0x89, 0x79, 0x6f, 0xfa, 0x5e, 0xaf, 0x8b, 0xd6, 0x40, 0xed, 0x0e, 0x60, 0x74,
+	0x35, 0xd3, 0xa3, 0xde, 0x30, 0xd3, 0x7a, 0x82, 0x54, 0xfb, 0x56, 0x0e, 0xaa, 0x4d, 0x6c, 0xfa,
+	0xad, 0xad, 0xa3, 0x90, 0x5a, 0xa1, 0x1a, 0xb7, 0x88, 0x23, 0x5c, 0x02, 0xfd, 0xa4, 0x2f, 0x38,
+	0x31, 0x81, 0x8c, 0x36, 0x55, 0x10, 0xb3, 0x0c, 0x55, 0xaf, 0x75, 0x87, 0x15, 0xf7, 0x34, 0x94,
+	0x2c, 0xe2, 0x18, 0x6c, 0x89, 0x8a, 0x6c, 0x89, 0xe4, 0xf2, 0xad, 0x12, 0x87, 0x2d, 0x4d, 0xd1,
+	0xe2, 0x1f, 0xe8, 0x21, 0xa8, 0x7a, 0xbd, 0xa0, 0xdb, 0x0b, 0x0c, 0xbe, 0x33, 0xeb, 0x25, 0xc6,
+	0x9e, 0xca, 0x81, 0x6c, 0xe3, 0x12, 0xf4, 0x12, 0x54, 0x09, 0x53, 0x65, 0x78, 0x36, 0x2d, 0x4f,
+	0x7a, 0x84, 0x52, 0x39, 0x1d, 0x3f, 0x9c, 0xd2, 0xbc, 0x75, 0xe0, 0x9b, 0x3b, 0xd8, 0x89, 0x3d,
+	0x31, 0x01, 0xb3, 0xc7, 0x59, 0x0e, 0x1f, 0x3c, 0x49, 0x5d, 0x81, 0xb9, 0x76, 0xcf, 0xf4, 0x4d,
+	0x37, 0xc0, 0x38, 0x86, 0x5d, 0x61, 0xd8, 0x28, 0xea, 0x1a, 0x10, 0xd4, 0xa1, 0xd8, 0xf5, 0xbd,
+	0x4d, 0xdb, 0xc1, 0x75, 0x95, 0x6d, 0xb0, 0xb0, 0xa9, 0x7d, 0x92, 0x85, 0xb9, 0x5b, 0xfd, 0x0d,
+	0xdf, 0xb6, 0x8e, 0x90, 0x3d, 0x3c, 0x0f, 0x25, 0x9f, 0xf3, 0x19, 0xde, 0x04, 0xb4, 0x94, 0x3b,
+	0x68, 0x4c, 0x24, 0x3d, 0xa2, 0x41, 0x2b, 0x50, 0xf1, 0x4d, 0x77, 0x3b, 0x5c, 0xb0, 0xc2, 0xa4,
+	0x0b, 0x06, 0x94, 0x4a, 0x2c, 0xd7, 0x88, 0x6d, 0x14, 0x25, 0xb6, 0x21, 0x5b, 0xd3, 0xd2, 0xbe,
+	0xd6, 0xb4, 0x9c, 0xb6, 0xa6, 0xda, 0xdf, 0x32, 0x30, 0xab, 0xe3, 0xc0, 0xb7, 0xf1, 0x0e, 0x3e,
+	0x12, 0xab, 0x76, 0x11, 0xb2, 0xf4, 0x89, 0x25, 0xbf, 0x57, 0x48, 0xb1, 0x2d, 0x89, 0x76, 0x0b,
+	0x13, 0x6a, 0xb7, 0xb8, 0x2f, 0xed, 0x96, 0x52, 0xb5, 0xfb, 0xb1, 0x12, 0xd7, 0x2e, 0x8d, 0xa3,
+	0xe4, 0x9e, 0x03, 0x29, 0x95, 0x3a, 0x33, 0x89, 0xd4, 0x43, 0xa7, 0x86, 0xec, 0x7e, 0x4f, 0x0d,
+	0x1a, 0x81, 0xdc, 0x2d, 0x3b, 0x60, 0x0e, 0x73, 0x6d, 0x95, 0x47, 0x88, 0x2c, 0x8f, 0xd1, 0x0f,
+	0x40, 0xc9, 0xf7, 0x76, 0xf9, 0xb8, 0x19, 0x16, 0x6a, 0x8a, 0xbe, 0xb7, 0x4b, 0x89, 0x78, 0xb1,
+	0x8c, 0xe7, 0x8b, 0x18, 0x94, 0xd1, 0x45, 0x0b, 0x5d, 0x80, 0x59, 0xe6, 0x57, 0x8d, 0x8d, 0xbe,
+	0x21, 0x82, 0x54, 0x8e, 0x3f, 0x8f, 0x31, 0xf0, 0x0a, 0xdf, 0x18, 0x44, 0xfb, 0x9d, 0x32, 0x08,
+	0x26, 0x53, 0x28, 0xea, 0x05, 0x28, 0xfa, 0x9c, 0x7e, 0x6c, 0x89, 0x41, 0x7c, 0x26, 0x26, 0x7f,
+	0x48, 0x85, 0x9e, 0x19, 0x38, 0x39, 0xa9, 0xe6, 0x06, 0xd3, 0xb6, 0xf1, 0x0d, 0x8f, 0x04, 0x03,
+	0x27, 0xf8, 0x9e, 0x02, 0xea, 0x4b, 0x4e, 0x8f, 0xdc, 0x0f, 0xef, 0x27, 0x7b, 0x8e, 0xcc, 0xca,
+	0x9f, 0x42, 0xbf, 0x9b, 0x81, 0xaa, 0x60, 0x63, 0x9a, 0x7b, 0x44, 0x2a, 0x2b, 0x4d, 0xa8, 0xd0,
+	0x29, 0x0d, 0x82, 0xdb, 0x61, 0x2e, 0xb7, 0xb2, 0xbc, 0x2c, 0xf5, 0x9c, 0x09, 0x36, 0x58, 0x59,
+	0x47, 0x93, 0x11, 0x7d, 0xc1, 0x0d, 0xfc, 0xbe, 0x0e, 0xad, 0x08, 0xd0, 0x78, 0x1b, 0x66, 0x87,
+	0xba, 0xa9, 0xf5, 0x6d, 0xe3, 0x7e, 0x78, 0x40, 0xda, 0xc6, 0x7d, 0xf4, 0x64, 0xbc, 0xf8, 0x26,
+	0xcd, 0xa4, 0x6f, 0x7b, 0x6e, 0xfb, 0xba, 0xef, 0x9b, 0x7d, 0x51, 0x9c, 0xf3, 0x6c, 0xe6, 0x19,
+	0x45, 0xfb, 0x87, 0x02, 0xea, 0xab, 0x3d, 0xec, 0xf7, 0x0f, 0xd2, 0xc5, 0x21, 0xc8, 0xe1, 0xbb,
+	0x5d, 0x5f, 0x1c, 0xf5, 0xd9, 0xf7, 0xa8, 0x87, 0xca, 0x4b, 0x3c, 0x94, 0xc4, 0x37, 0x16, 0xa4,
+	0x8f, 0x47, 0xef, 0x0d, 0xc4, 0x9c, 0x6a, 0x0b, 0x25, 0xfc, 0x47, 0x66, 0xdf, 0xfe, 0xe3, 0x23,
+	0x05, 0xca, 0x6f, 0xe0, 0x56, 0xe0, 0xf9, 0xd4, 0x67, 0x48, 0xf4, 0xa3, 0x4c, 0x70, 0xb1, 0xcb,
+	0x0c, 0x5f, 0xec, 0xae, 0x41, 0xc9, 0xb6, 0x0c, 0x93, 0x2e, 0x6d, 0x3d, 0xbb, 0x87, 0x1f, 0x2c,
+	0xda, 0x16, 0xb3, 0x81, 0xc9, 0xdf, 0xdd, 0x7e, 0xa8, 0x80, 0xca, 0x79, 0x26, 0x9c, 0xf2, 0xb9,
+	0xd8, 0x74, 0x8a, 0xcc, 0xde, 0x44, 0x23, 0x12, 0xf4, 0xd6, 0xb1, 0xc1, 0xb4, 0xd7, 0x01, 0xa8,
+	0xee, 0x04, 0x39, 0x37, 0xd7, 0x45, 0x29, 0xb7, 0x9c, 0x9c, 0xe9, 0xf1, 0xd6, 0x31, 0xbd, 0x4c,
+	0xa9, 0xd8, 0x10, 0x2b, 0x45, 0xc8, 0x33, 0x6a, 0xed, 0x3f, 0x0a, 0xcc, 0xdd, 0x30, 0x9d, 0xd6,
+	0xaa, 0x4d, 0x02, 0xd3, 0x6d, 0x4d, 0x11, 0xa5, 0x9f, 0x85, 0xa2, 0xd7, 0x35, 0x1c, 0xbc, 0x19,
+	0x08, 0x96, 0xce, 0x8d, 0x91, 0x88, 0xab, 0x41, 0x2f, 0x78, 0xdd, 0xdb, 0x78, 0x33, 0x40, 0x9f,
+	0x83, 0x92, 0xd7, 0x35, 0x7c, 0xbb, 0xbd, 0x15, 0xd4, 0xb3, 0x93, 0x12, 0x17, 0xbd, 0xae, 0x4e,
+	0x29, 0x62, 0x99, 0xc1, 0xdc, 0x3e, 0x33, 0x83, 0xda, 0x5f, 0x46, 0xc4, 0x9f, 0xc2, 0xb4, 0x9f,
+	0x85, 0x92, 0xed, 0x06, 0x86, 0x65, 0x93, 0x50, 0x05, 0x67, 0xe4, 0x36, 0xe4, 0x06, 0x4c, 0x02,
+	0xb6, 0xa6, 0x6e, 0x40, 0xe7, 0x46, 0x2f, 0x02, 0x6c, 0x3a, 0x9e, 0x29, 0xa8, 0xb9, 0x0e, 0xce,
+	0xca, 0x77, 0x05, 0x45, 0x0b, 0xe9, 0xcb, 0x8c, 0x88, 0x8e, 0x30, 0x58, 0xd2, 0x3f, 0x29, 0x70,
+	0x62, 0x1d, 0xfb, 0xc4, 0x26, 0x01, 0x76, 0x03, 0x91, 0xa5, 0x5f, 0x73, 0x37, 0xbd, 0xe4, 0xe3,
+	0x8f, 0x32, 0xfc, 0xf8, 0xf3, 0xa9, 0x3c, 0x0e, 0x24, 0xee, 0xfd, 0xe2, 0x0d, 0x49, 0xdc, 0xfb,
+	0xc3, 0x87, 0x56, 0x9e, 0x37, 0x99, 0x49, 0x59, 0x26, 0xc1, 0x6f, 0x3c, 0x7d, 0xa4, 0x7d, 0x8f,
+	0x17, 0x3d, 0x49, 0x85, 0xba, 0x77, 0x83, 0x5d, 0x00, 0xe1, 0x64, 0x87, 0x5c, 0xee, 0x05, 0x18,
+	0xf2, 0x1d, 0x29, 0xa5, 0x58, 0x3f, 0x52, 0x60, 0x31, 0x9d, 0xab, 0x69, 0xa2, 0xe3, 0x8b, 0x90,
+	0xb7, 0xdd, 0x4d, 0x2f, 0x4c, 0x1a, 0x5f, 0x94, 0x5f, 0x9f, 0xa5, 0xf3, 0x72, 0x42, 0xed, 0x9f,
+	0x0a, 0xd4, 0x98, 0xaf, 0x3e, 0x80, 0xe5, 0xef, 0xe0, 0x8e, 0x41, 0xec, 0x77, 0x70, 0xb8, 0xfc,
+	0x1d, 0xdc, 0x69, 0xda, 0xef, 0xe0, 0x84, 0x65, 0xe4, 0x93, 0x96, 0x91, 0x4c, 0xab, 0x15, 0xc6,
+	0x3c, 0x0a, 0x14, 0x13, 0x8f, 0x02, 0xb4, 0x26, 0xa0, 0x71, 0x13, 0x07, 0xc3, 0xa2, 0x1e, 0x9c,
+	0x51, 0x7c, 0xa8, 0xc0, 0x29, 0x29, 0x43, 0xd3, 0xd8, 0xc3, 0x73, 0x49, 0x7b, 0x90, 0xa7, 0x53,
+	0x46, 0xa6, 0x14, 0xa6, 0xd0, 0x82, 0x1a, 0x2b, 0x18, 0x74, 0x37, 0xed, 0xf6, 0xbd, 0xeb, 0xe5,
+	0x0c, 0xc0, 0x36, 0xee, 0x1b, 0x5d, 0x1f, 0x6f, 0xda, 0x77, 0xc3, 0xf0, 0xb9, 0x8d, 0xfb, 0xeb,
+	0x0c, 0xa0, 0x7d, 0x43, 0x81, 0xe3, 0xb1, 0x59, 0xa6, 0x13, 0xb6, 0xd8, 0x62, 0xc3, 0xec, 0xa3,
+	0x20, 0x23, 0xa4, 0xd0, 0x1c, 0xa8, 0x35, 0xa7, 0x17, 0x56, 0x1c, 0x0e, 0x33, 0x83, 0xc3, 0x61,
+	0x54, 0x99, 0x9d, 0x8d, 0x57, 0x66, 0x5f, 0x05, 0x75, 0xb5, 0xd7, 0xe9, 0x44, 0xe7, 0xbe, 0x73,
+	0xa0, 0x8a, 0xfb, 0x3b, 0x4f, 0xe4, 0xf0, 0x93, 0x48, 0x45, 0xc0, 0x68, 0xba, 0x46, 0xbb, 0x04,
+	0x55, 0x41, 0x22, 0x74, 0xd4, 0xa0, 0x79, 0x02, 0xfe, 0x2d, 0xf0, 0xa3, 0xb6, 0x76, 0x02, 0xe6,
+	0x74, 0xdc, 0xa6, 0x9b, 0xdc, 0xbf, 0x6d, 0xbb, 0xdb, 0x62, 0x1a, 0xed, 0x5d, 0x05, 0xe6, 0x93,
+	0x70, 0x31, 0xd6, 0x67, 0xa0, 0x68, 0x5a, 0x96, 0x8f, 0x09, 0x19, 0x2b, 0xec, 0x75, 0x8e, 0xa3,
+	0x87, 0xc8, 0xb1, 0x75, 0xca, 0x4c, 0xbc, 0x4e, 0x17, 0xcf, 0x41, 0x29, 0xac, 0x00, 0x41, 0x45,
+	0xc8, 0x5e, 0x77, 0x9c, 0xda, 0x31, 0xa4, 0x42, 0x69, 0x4d, 0x94, 0x39, 0xd4, 0x94, 0x8b, 0xcf,
+	0xc3, 0xec, 0x50, 0x4a, 0x11, 0x95, 0x20, 0xf7, 0x8a, 0xe7, 0xe2, 0xda, 0x31, 0x54, 0x03, 0x75,
+	0xc5, 0x76, 0x4d, 0xbf, 0xcf, 0x83, 0x7a, 0xcd, 0x42, 0xb3, 0x50, 0x61, 0xc1, 0x4d, 0x00, 0xf0,
+	0xf2, 0xfb, 0x0d, 0xa8, 0xde, 0x61, 0x9c, 0x34, 0xb1, 0xbf, 0x63, 0xb7, 0x30, 0x32, 0xa0, 0x36,
+	0xfc, 0x0f, 0x07, 0x7a, 0x4c, 0xba, 0x1d, 0x52, 0x7e, 0xf5, 0x68, 0x8c, 0x93, 0x4d, 0x3b, 0x86,
+	0xde, 0x82, 0x99, 0xe4, 0xdf, 0x15, 0x48, 0xee, 0x7d, 0xa5, 0xbf, 0x60, 0xec, 0x35, 0xb8, 0x01,
+	0xd5, 0xc4, 0xcf, 0x12, 0xe8, 0x51, 0xe9, 0xd8, 0xb2, 0x1f, 0x2a, 0x1a, 0xf2, 0x03, 0x51, 0xfc,
+	0x87, 0x06, 0xce, 0x7d, 0xb2, 0xa4, 0x3b, 0x85, 0x7b, 0x69, 0xdd, 0xf7, 0x5e, 0xdc, 0x9b, 0x70,
+	0x7c, 0xa4, 0x42, 0x1b, 0x3d, 0x2e, 0x1d, 0x3f, 0xad, 0x92, 0x7b, 0xaf, 0x29, 0x76, 0x01, 0x8d,
+	0xfe, 0x14, 0x80, 0x2e, 0xcb, 0x57, 0x20, 0xed, 0x97, 0x88, 0xc6, 0x95, 0x89, 0xf1, 0x23, 0xc5,
+	0x7d, 0x53, 0x81, 0x93, 0x29, 0x65, 0xd5, 0xe8, 0x9a, 0x74, 0xb8, 0xf1, 0xb5, 0xe1, 0x8d, 0x27,
+	0xf7, 0x47, 0x14, 0x31, 0xe2, 0xc2, 0xec, 0x50, 0xa5, 0x31, 0xba, 0x94, 0x5a, 0x7d, 0x35, 0x5a,
+	0x72, 0xdd, 0x78, 0x6c, 0x32, 0xe4, 0x68, 0x3e, 0x7a, 0x35, 0x4e, 0x96, 0xe7, 0xa6, 0xcc, 0x27,
+	0x2f, 0xe2, 0xdd, 0x6b, 0x41, 0xdf, 0x84, 0x6a, 0xa2, 0x8e, 0x36, 0xc5, 0xe2, 0x65, 0xb5, 0xb6,
+	0x7b, 0x0d, 0xfd, 0x36, 0xa8, 0xf1, 0x72, 0x57, 0xb4, 0x94, 0xb6, 0x97, 0x46, 0x06, 0xde, 0xcf,
+	0x56, 0x8a, 0x88, 0xc9, 0x98, 0xad, 0x34, 0x52, 0x00, 0x38, 0xf9, 0x56, 0x8a, 0x8d, 0x3f, 0x76,
+	0x2b, 0xed, 0x7b, 0x8a, 0x77, 0x15, 0x58, 0x90, 0x57, 0x4b, 0xa2, 0xe5, 0x34, 0xdb, 0x4c, 0xaf,
+	0x0b, 0x6d, 0x5c, 0xdb, 0x17, 0x4d, 0xa4, 0xc5, 0x6d, 0x98, 0x49, 0xd6, 0x04, 0xa6, 0x68, 0x51,
+	0x5a, 0x46, 0xd9, 0xb8, 0x34, 0x11, 0x6e, 0x34, 0xd9, 0xeb, 0x50, 0x89, 0x55, 0x0a, 0xa1, 0x47,
+	0xc6, 0xd8, 0x71, 0xfc, 0x9d, 0x79, 0x2f, 0x4d, 0x6e, 0x41, 0x35, 0x51, 0x1d, 0x92, 0x66, 0xc3,
+	0x92, 0xa2, 0x9d, 0xc6, 0xc5, 0x49, 0x50, 0x23, 0x01, 0xb6, 0xa0, 0x9a, 0x78, 0xab, 0x4f, 0x99,
+	0x49, 0x56, 0x9a, 0xd0, 0xb8, 0x38, 0x09, 0x6a, 0x34, 0xd3, 0xd7, 0x63, 0x65, 0x01, 0x89, 0xd2,
+	0x0b, 0x74, 0x75, 0xec, 0x38, 0xb2, 0xca, 0x93, 0xc6, 0xf2, 0x7e, 0x48, 0x22, 0x16, 0x5e, 0x85,
+	0x72, 0xf4, 0xe2, 0x8f, 0xce, 0xa7, 0xba, 0x85, 0xfd, 0xac, 0x54, 0x13, 0x0a, 0xfc, 0xf5, 0x1d,
+	0x69, 0x29, 0x75, 0x36, 0xb1, 0xa7, 0xf9, 0xc6, 0x43, 0x52, 0x9c, 0xe4, 0xc3, 0xb4, 0x76, 0x0c,
+	0xe9, 0x50, 0xe0, 0xf9, 0x5c, 0x34, 0xc1, 0x03, 0x4e, 0x63, 0x3c, 0x0e, 0x1d, 0x92, 0x32, 0xfa,
+	0x55, 0x50, 0xe3, 0x0f, 0x5a, 0x69, 0xbe, 0x6b, 0xf4, 0xcd, 0x6b, 0xc2, 0xf1, 0xbf, 0x04, 0xa5,
+	0xf0, 0x61, 0x00, 0x3d, 0x9c, 0xe2, 0x56, 0x12, 0xaf, 0x32, 0x8d, 0xbd, 0xb0, 0xc2, 0x91, 0xd7,
+	0x21, 0xcf, 0xf2, 0xae, 0xe8, 0xdc, 0xb8, 0x9c, 0xec, 0x38, 0x5e, 0x13, 0x69, 0x5b, 0xed, 0x18,
+	0xfa, 0x22, 0xe4, 0xd9, 0xd5, 0x25, 0x65, 0xc4, 0x78, 0x62, 0xb5, 0x31, 0x16, 0x25, 0x64, 0xd1,
+	0x02, 0x35, 0x9e, 0xd2, 0x49, 0x51, 0xae, 0x24, 0xe9, 0xd5, 0x98, 0x04, 0x33, 0x9c, 0xe5, 0xdb,
+	0x0a, 0xd4, 0xd3, 0x6e, 0xff, 0x28, 0x35, 0xfa, 0x8f, 0x4b, 0x61, 0x34, 0x9e, 0xda, 0x27, 0x55,
+	0xa4, 0xc2, 0x77, 0x60, 0x4e, 0x72, 0xe7, 0x44, 0x57, 0xd2, 0xc6, 0x4b, 0xb9, 0x2e, 0x37, 0x9e,
+	0x98, 0x9c, 0x20, 0x9a, 0xfb, 0x2b, 0x50, 0x8e, 0x2e, 0x7e, 0x29, 0xdb, 0x78, 0xf8, 0xfa, 0xd9,
+	0xb8, 0xb0, 0x17, 0x5a, 0xdc, 0x49, 0x34, 0xf7, 0x18, 0x7d, 0xf8, 0xbe, 0xb7, 0x97, 0x93, 0x58,
+	0x87, 0x3c, 0xbb, 0x81, 0xa5, 0xd8, 0x5b, 0xfc, 0x42, 0xd7, 0xd0, 0xc6, 0xa1, 0x44, 0x4c, 0x62,
+	0x50, 0xe3, 0xd7, 0xb1, 0x14, 0x83, 0x93, 0xdc, 0xe4, 0x1a, 0x8f, 0x4e, 0x80, 0x19, 0x4e, 0xb3,
+	0xdc, 0x03, 0x75, 0xdd, 0xf7, 0xee, 0xf6, 0xc3, 0xbb, 0xd0, 0xff, 0x67, 0xda, 0x95, 0xa7, 0xbe,
+	0x7c, 0xad, 0x6d, 0x07, 0x5b, 0xbd, 0x0d, 0xaa, 0xc9, 0x2b, 0x1c, 0xf7, 0x71, 0xdb, 0x13, 0x5f,
+	0x57, 0x6c, 0x37, 0xc0, 0xbe, 0x6b, 0x3a, 0x57, 0xd8, 0x58, 0x02, 0xda, 0xdd, 0xd8, 0x28, 0xb0,
+	0xf6, 0xb5, 0xff, 0x0d, 0x00, 0xcf, 0x87, 0x11, 0x77, 0xb6, 0x3f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4263,12 +4641,15 @@ type MilvusServiceClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
@@ -4480,6 +4861,15 @@ func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HybridSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResults, error) {
 	out := new(RetrieveResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Retrieve", in, out, opts...)
@@ -4534,6 +4924,24 @@ func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQu
 	return out, nil
 }
 
+func (c *milvusServiceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
 	out := new(DummyResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
@@ -4576,12 +4984,15 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	HybridSearch(context.Context, *HybridSearchRequest) (*SearchResults, error)
 	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	SetConfig(context.Context, *SetConfigRequest) (*commonpb.Status, error)
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
@@ -4657,6 +5068,9 @@ func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *Insert
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (*UnimplementedMilvusServiceServer) HybridSearch(ctx context.Context, req *HybridSearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HybridSearch not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Retrieve(ctx context.Context, req *RetrieveRequest) (*RetrieveResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
 }
@@ -4675,6 +5089,12 @@ func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Co
 func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SetConfig(ctx context.Context, req *SetConfigRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
 }
@@ -5082,6 +5502,24 @@ func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, req.(*HybridSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Retrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetrieveRequest)
 	if err := dec(in); err != nil {
@@ -5190,6 +5628,42 @@ func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SetConfig(ctx, req.(*SetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DummyRequest)
 	if err := dec(in); err != nil {
@@ -5318,6 +5792,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
 		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _MilvusService_HybridSearch_Handler,
+		},
 		{
 			MethodName: "Retrieve",
 			Handler:    _MilvusService_Retrieve_Handler,
@@ -5342,6 +5820,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuerySegmentInfo",
 			Handler:    _MilvusService_GetQuerySegmentInfo_Handler,
 		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _MilvusService_GetConfig_Handler,
+		},
+		{
+			MethodName: "SetConfig",
+			Handler:    _MilvusService_SetConfig_Handler,
+		},
 		{
 			MethodName: "Dummy",
 			Handler:    _MilvusService_Dummy_Handler,
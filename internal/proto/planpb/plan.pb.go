@@ -61,6 +61,77 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type ArithOpType int32
+
+const (
+	ArithOpType_Unknown     ArithOpType = 0
+	ArithOpType_Add         ArithOpType = 1
+	ArithOpType_Sub         ArithOpType = 2
+	ArithOpType_Mul         ArithOpType = 3
+	ArithOpType_Div         ArithOpType = 4
+	ArithOpType_Mod         ArithOpType = 5
+	ArithOpType_ArrayLength ArithOpType = 6
+)
+
+var ArithOpType_name = map[int32]string{
+	0: "Unknown",
+	1: "Add",
+	2: "Sub",
+	3: "Mul",
+	4: "Div",
+	5: "Mod",
+	6: "ArrayLength",
+}
+
+var ArithOpType_value = map[string]int32{
+	"Unknown":     0,
+	"Add":         1,
+	"Sub":         2,
+	"Mul":         3,
+	"Div":         4,
+	"Mod":         5,
+	"ArrayLength": 6,
+}
+
+func (x ArithOpType) String() string {
+	return proto.EnumName(ArithOpType_name, int32(x))
+}
+
+func (ArithOpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
+type ArrayContainsExpr_ArrayOp int32
+
+const (
+	ArrayContainsExpr_Invalid     ArrayContainsExpr_ArrayOp = 0
+	ArrayContainsExpr_Contains    ArrayContainsExpr_ArrayOp = 1
+	ArrayContainsExpr_ContainsAll ArrayContainsExpr_ArrayOp = 2
+	ArrayContainsExpr_ContainsAny ArrayContainsExpr_ArrayOp = 3
+)
+
+var ArrayContainsExpr_ArrayOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Contains",
+	2: "ContainsAll",
+	3: "ContainsAny",
+}
+
+var ArrayContainsExpr_ArrayOp_value = map[string]int32{
+	"Invalid":     0,
+	"Contains":    1,
+	"ContainsAll": 2,
+	"ContainsAny": 3,
+}
+
+func (x ArrayContainsExpr_ArrayOp) String() string {
+	return proto.EnumName(ArrayContainsExpr_ArrayOp_name, int32(x))
+}
+
+func (ArrayContainsExpr_ArrayOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -83,7 +154,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -111,7 +182,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
 type GenericValue struct {
@@ -119,6 +190,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +238,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,19 +278,33 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	Topk         int64  `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType   string `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams string `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	// estimated fraction of rows passing the predicates, 0 if unknown
+	EstimatedSelectivity float64 `protobuf:"fixed64,5,opt,name=estimated_selectivity,json=estimatedSelectivity,proto3" json:"estimated_selectivity,omitempty"`
+	// search the raw vectors by brute force once no more than this many rows pass the predicates, 0 disables it
+	BruteForceThreshold int64 `protobuf:"varint,6,opt,name=brute_force_threshold,json=bruteForceThreshold,proto3" json:"brute_force_threshold,omitempty"`
+	// return the best hit of each distinct value of the field, 0 disables it
+	GroupByFieldId       int64    `protobuf:"varint,7,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -264,14 +356,37 @@ func (m *QueryInfo) GetSearchParams() string {
 	return ""
 }
 
+func (m *QueryInfo) GetEstimatedSelectivity() float64 {
+	if m != nil {
+		return m.EstimatedSelectivity
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetBruteForceThreshold() int64 {
+	if m != nil {
+		return m.BruteForceThreshold
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
 type ColumnInfo struct {
-	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FieldId      int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	DataType     schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IsPrimaryKey bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	IsAutoID     bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	// keys to walk into the value of a JSON field, e.g. ["a", "b"] for meta["a"]["b"]
+	NestedPath           []string `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
@@ -327,6 +442,13 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
 type UnaryRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Op                   OpType        `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
@@ -508,6 +630,134 @@ func (m *CompareExpr) GetOp() OpType {
 	return OpType_Invalid
 }
 
+// column arith_op right_operand op value, e.g. price * 0.9 < 100
+type BinaryArithOpEvalRangeExpr struct {
+	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	ArithOp              ArithOpType   `protobuf:"varint,2,opt,name=arith_op,json=arithOp,proto3,enum=milvus.proto.plan.ArithOpType" json:"arith_op,omitempty"`
+	RightOperand         *GenericValue `protobuf:"bytes,3,opt,name=right_operand,json=rightOperand,proto3" json:"right_operand,omitempty"`
+	Op                   OpType        `protobuf:"varint,4,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	Value                *GenericValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRangeExpr{} }
+func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
+func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
+func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
+}
+
+func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Unmarshal(m, b)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Marshal(b, m, deterministic)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.Merge(m, src)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Size() int {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Size(m)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryArithOpEvalRangeExpr proto.InternalMessageInfo
+
+func (m *BinaryArithOpEvalRangeExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetArithOp() ArithOpType {
+	if m != nil {
+		return m.ArithOp
+	}
+	return ArithOpType_Unknown
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetRightOperand() *GenericValue {
+	if m != nil {
+		return m.RightOperand
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetValue() *GenericValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// array_contains(column, x), array_contains_all(column, [x, y]) or array_contains_any(column, [x, y])
+type ArrayContainsExpr struct {
+	ColumnInfo           *ColumnInfo               `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   ArrayContainsExpr_ArrayOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.ArrayContainsExpr_ArrayOp" json:"op,omitempty"`
+	Elements             []*GenericValue           `protobuf:"bytes,3,rep,name=elements,proto3" json:"elements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArrayContainsExpr) Reset()         { *m = ArrayContainsExpr{} }
+func (m *ArrayContainsExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayContainsExpr) ProtoMessage()    {}
+func (*ArrayContainsExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
+}
+
+func (m *ArrayContainsExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayContainsExpr.Unmarshal(m, b)
+}
+func (m *ArrayContainsExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayContainsExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayContainsExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayContainsExpr.Merge(m, src)
+}
+func (m *ArrayContainsExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayContainsExpr.Size(m)
+}
+func (m *ArrayContainsExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayContainsExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayContainsExpr proto.InternalMessageInfo
+
+func (m *ArrayContainsExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetOp() ArrayContainsExpr_ArrayOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayContainsExpr_Invalid
+}
+
+func (m *ArrayContainsExpr) GetElements() []*GenericValue {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
 type TermExpr struct {
 	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Values               []*GenericValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
@@ -520,7 +770,7 @@ func (m *TermExpr) Reset()         { *m = TermExpr{} }
 func (m *TermExpr) String() string { return proto.CompactTextString(m) }
 func (*TermExpr) ProtoMessage()    {}
 func (*TermExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
 }
 
 func (m *TermExpr) XXX_Unmarshal(b []byte) error {
@@ -567,7 +817,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -615,7 +865,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -665,6 +915,8 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_BinaryArithOpEvalRangeExpr
+	//	*Expr_ArrayContainsExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -675,7 +927,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -724,6 +976,14 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_BinaryArithOpEvalRangeExpr struct {
+	BinaryArithOpEvalRangeExpr *BinaryArithOpEvalRangeExpr `protobuf:"bytes,7,opt,name=binary_arith_op_eval_range_expr,json=binaryArithOpEvalRangeExpr,proto3,oneof"`
+}
+
+type Expr_ArrayContainsExpr struct {
+	ArrayContainsExpr *ArrayContainsExpr `protobuf:"bytes,8,opt,name=array_contains_expr,json=arrayContainsExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -736,6 +996,10 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_BinaryArithOpEvalRangeExpr) isExpr_Expr() {}
+
+func (*Expr_ArrayContainsExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -785,6 +1049,20 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetBinaryArithOpEvalRangeExpr() *BinaryArithOpEvalRangeExpr {
+	if x, ok := m.GetExpr().(*Expr_BinaryArithOpEvalRangeExpr); ok {
+		return x.BinaryArithOpEvalRangeExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArrayContainsExpr() *ArrayContainsExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayContainsExpr); ok {
+		return x.ArrayContainsExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -794,6 +1072,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_BinaryArithOpEvalRangeExpr)(nil),
+		(*Expr_ArrayContainsExpr)(nil),
 	}
 }
 
@@ -812,7 +1092,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -882,7 +1162,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -943,6 +1223,8 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayContainsExpr_ArrayOp", ArrayContainsExpr_ArrayOp_name, ArrayContainsExpr_ArrayOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -951,6 +1233,8 @@ func init() {
 	proto.RegisterType((*UnaryRangeExpr)(nil), "milvus.proto.plan.UnaryRangeExpr")
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
+	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
+	proto.RegisterType((*ArrayContainsExpr)(nil), "milvus.proto.plan.ArrayContainsExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
@@ -962,71 +1246,94 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xb6, 0x2c, 0xdb, 0x91, 0x8e, 0x5c, 0xdb, 0xd1, 0x0d, 0x29, 0xa1, 0x24, 0x88, 0x0e, 0x0d,
-	0x30, 0x4d, 0x86, 0xb6, 0xa4, 0x33, 0x65, 0x60, 0xf2, 0xd3, 0x12, 0x7b, 0x28, 0x49, 0x10, 0x21,
-	0x17, 0xdc, 0x68, 0xd6, 0xd2, 0xc6, 0xde, 0xe9, 0x5a, 0xab, 0xac, 0x56, 0xa6, 0xbe, 0xe1, 0x86,
-	0x27, 0xe0, 0x25, 0xe0, 0x1a, 0x9e, 0x83, 0x07, 0xe0, 0x9e, 0x17, 0x61, 0xf6, 0xac, 0xe2, 0x9f,
-	0x8e, 0xd3, 0xa6, 0x33, 0xbd, 0x5b, 0x9d, 0x3d, 0x7f, 0xdf, 0x77, 0x7e, 0x56, 0x00, 0x19, 0x27,
-	0xe9, 0x76, 0x26, 0x85, 0x12, 0xfe, 0xea, 0x88, 0xf1, 0x71, 0x91, 0x9b, 0xaf, 0x6d, 0x7d, 0xf1,
-	0x7e, 0x33, 0x8f, 0x87, 0x74, 0x44, 0x8c, 0x28, 0xc8, 0xa0, 0x79, 0x44, 0x53, 0x2a, 0x59, 0x7c,
-	0x4e, 0x78, 0x41, 0xfd, 0x75, 0x70, 0xfa, 0x42, 0xf0, 0x68, 0x4c, 0xf8, 0x9a, 0xb5, 0x69, 0x6d,
-	0x39, 0xdd, 0x4a, 0xb8, 0xa2, 0x25, 0xe7, 0x84, 0xfb, 0x77, 0xc0, 0x65, 0xa9, 0xda, 0x7d, 0x84,
-	0xb7, 0xd5, 0x4d, 0x6b, 0xcb, 0xee, 0x56, 0x42, 0x07, 0x45, 0xe5, 0xf5, 0x05, 0x17, 0x44, 0xe1,
-	0xb5, 0xbd, 0x69, 0x6d, 0x59, 0xfa, 0x1a, 0x45, 0xe7, 0x84, 0x1f, 0xd4, 0xc1, 0x1e, 0x13, 0x1e,
-	0x50, 0x70, 0x7f, 0x28, 0xa8, 0x9c, 0xf4, 0xd2, 0x0b, 0xe1, 0xfb, 0x50, 0x53, 0x22, 0x7b, 0x81,
-	0xa1, 0xec, 0x10, 0xcf, 0xfe, 0x06, 0x78, 0x23, 0xaa, 0x24, 0x8b, 0x23, 0x35, 0xc9, 0x28, 0x3a,
-	0x72, 0x43, 0x30, 0xa2, 0xb3, 0x49, 0x46, 0xfd, 0x8f, 0xe1, 0x56, 0x4e, 0x89, 0x8c, 0x87, 0x51,
-	0x46, 0x24, 0x19, 0xe5, 0x6b, 0x35, 0x54, 0x69, 0x1a, 0xe1, 0x29, 0xca, 0x82, 0x3f, 0x2c, 0x80,
-	0x43, 0xc1, 0x8b, 0x51, 0x8a, 0x81, 0x6e, 0x83, 0x73, 0xc1, 0x28, 0x4f, 0x22, 0x96, 0x94, 0xc1,
-	0x56, 0xf0, 0xbb, 0x97, 0xf8, 0x4f, 0xc0, 0x4d, 0x88, 0x22, 0x26, 0x9a, 0x46, 0xd5, 0x7a, 0x70,
-	0x67, 0x7b, 0x81, 0xb7, 0x92, 0xb1, 0xa7, 0x44, 0x11, 0x9d, 0x40, 0xe8, 0x24, 0xe5, 0xc9, 0xbf,
-	0x0b, 0x2d, 0x96, 0x47, 0x99, 0x64, 0x23, 0x22, 0x27, 0xd1, 0x0b, 0x3a, 0xc1, 0x74, 0x9d, 0xb0,
-	0xc9, 0xf2, 0x53, 0x23, 0xfc, 0x8e, 0x4e, 0xfc, 0x75, 0x70, 0x59, 0x1e, 0x91, 0x42, 0x89, 0xde,
-	0x53, 0x4c, 0xd6, 0x09, 0x1d, 0x96, 0xef, 0xe3, 0x77, 0xf0, 0xb7, 0x05, 0xad, 0x9f, 0x52, 0x22,
-	0x27, 0x21, 0x49, 0x07, 0xf4, 0xd9, 0xcb, 0x4c, 0xfa, 0xdf, 0x80, 0x17, 0x63, 0xea, 0x11, 0x4b,
-	0x2f, 0x04, 0xe6, 0xeb, 0xbd, 0x9a, 0x13, 0x16, 0x79, 0x06, 0x30, 0x84, 0x78, 0x06, 0xf6, 0x53,
-	0xa8, 0x8a, 0xac, 0x84, 0x72, 0x7b, 0x89, 0xd9, 0x49, 0x86, 0x30, 0xaa, 0x22, 0xf3, 0xbf, 0x84,
-	0xfa, 0x58, 0x17, 0x1e, 0xf3, 0xf6, 0x1e, 0x6c, 0x2c, 0xd1, 0x9e, 0xef, 0x8f, 0xd0, 0x68, 0x07,
-	0x7f, 0x56, 0xa1, 0x7d, 0xc0, 0xde, 0x6d, 0xd6, 0xf7, 0xa0, 0xcd, 0xc5, 0x2f, 0x54, 0x46, 0x2c,
-	0x8d, 0x79, 0x91, 0xb3, 0xb1, 0xa9, 0x86, 0x13, 0xb6, 0x50, 0xdc, 0xbb, 0x92, 0x6a, 0xc5, 0x22,
-	0xcb, 0x16, 0x14, 0x0d, 0xeb, 0x2d, 0x14, 0xcf, 0x14, 0xf7, 0xc0, 0x33, 0x1e, 0x0d, 0xc4, 0xda,
-	0xcd, 0x20, 0x02, 0xda, 0x98, 0x71, 0xd8, 0x03, 0xcf, 0x84, 0x32, 0x1e, 0xea, 0x37, 0xf4, 0x80,
-	0x36, 0x78, 0x0e, 0xfe, 0xb1, 0xc0, 0x3b, 0x14, 0xa3, 0x8c, 0x48, 0xc3, 0xd2, 0x11, 0x74, 0x38,
-	0xbd, 0x50, 0xd1, 0x5b, 0x53, 0xd5, 0xd2, 0x66, 0x73, 0x1d, 0xdd, 0x83, 0x55, 0xc9, 0x06, 0xc3,
-	0x45, 0x4f, 0xd5, 0x9b, 0x78, 0x6a, 0xa3, 0xdd, 0xe1, 0xab, 0xfd, 0x62, 0xdf, 0xa0, 0x5f, 0x82,
-	0xdf, 0x2c, 0x70, 0xce, 0xa8, 0x1c, 0xbd, 0x93, 0x8a, 0x3f, 0x86, 0x06, 0xf2, 0x9a, 0xaf, 0x55,
-	0x37, 0xed, 0x9b, 0x10, 0x5b, 0xaa, 0x07, 0xbf, 0x5b, 0xe0, 0xe2, 0xcc, 0x60, 0x1a, 0x8f, 0x30,
-	0x7d, 0x0b, 0xd3, 0xbf, 0xbb, 0xc4, 0xc5, 0x54, 0xd3, 0x9c, 0x4e, 0x32, 0xec, 0xfc, 0xfb, 0x50,
-	0x8f, 0x87, 0x8c, 0x27, 0x25, 0x67, 0xef, 0x2d, 0x31, 0xd4, 0x36, 0xa1, 0xd1, 0x0a, 0x36, 0x60,
-	0xa5, 0xb4, 0xf6, 0x3d, 0x58, 0xe9, 0xa5, 0x63, 0xc2, 0x59, 0xd2, 0xa9, 0xf8, 0x2b, 0x60, 0x1f,
-	0x0b, 0xd5, 0xb1, 0x82, 0x7f, 0x2d, 0x00, 0x33, 0x12, 0x98, 0xd4, 0xee, 0x5c, 0x52, 0x9f, 0x2c,
-	0xf1, 0x3d, 0x53, 0x2d, 0x8f, 0x65, 0x5a, 0x9f, 0x43, 0x4d, 0x17, 0xfa, 0x4d, 0x59, 0xa1, 0x92,
-	0xc6, 0x80, 0xb5, 0x2c, 0xa7, 0xf7, 0x7a, 0x0c, 0xa8, 0x15, 0xec, 0x82, 0x73, 0x15, 0x6b, 0x11,
-	0x44, 0x0b, 0xe0, 0xb9, 0x18, 0xb0, 0x98, 0xf0, 0xfd, 0x34, 0xe9, 0x58, 0xfe, 0x2d, 0x70, 0xcb,
-	0xef, 0x13, 0xd9, 0xa9, 0x06, 0x7f, 0xd9, 0x50, 0x43, 0x50, 0x4f, 0xc0, 0x55, 0x54, 0x8e, 0x22,
-	0xfa, 0x32, 0x93, 0x65, 0xb9, 0xd7, 0x97, 0xc4, 0xbc, 0x6a, 0x10, 0xbd, 0xfe, 0xd5, 0x55, 0xb3,
-	0x7c, 0x0d, 0x50, 0xe8, 0xd8, 0xc6, 0xd8, 0xc0, 0xfb, 0xe0, 0x75, 0xd5, 0xea, 0x56, 0x42, 0xb7,
-	0x98, 0xf2, 0xb9, 0x07, 0x5e, 0x9f, 0xcd, 0xec, 0xed, 0x6b, 0x7b, 0x6d, 0x46, 0x6c, 0xb7, 0x12,
-	0x42, 0x7f, 0x56, 0x91, 0x43, 0x68, 0xc6, 0x66, 0x10, 0x8d, 0x0b, 0xb3, 0x0e, 0x3e, 0x5c, 0xda,
-	0xae, 0xd3, 0x79, 0xed, 0x56, 0x42, 0x2f, 0x9e, 0x1b, 0xdf, 0xef, 0xa1, 0x63, 0x50, 0x48, 0xbd,
-	0xf7, 0x8c, 0x23, 0xb3, 0x15, 0x3e, 0xba, 0x0e, 0xcb, 0x74, 0x43, 0x76, 0x2b, 0x61, 0xab, 0x58,
-	0xdc, 0x99, 0xa7, 0xb0, 0x5a, 0xa2, 0x9a, 0xf3, 0xd7, 0x40, 0x7f, 0xc1, 0xb5, 0xd8, 0xe6, 0x1d,
-	0xb6, 0xfb, 0x8b, 0xa2, 0x83, 0x06, 0xd4, 0xb4, 0x93, 0xe0, 0x3f, 0x0b, 0xe0, 0x9c, 0xc6, 0x4a,
-	0xc8, 0xfd, 0xe3, 0xe3, 0x1f, 0xcb, 0x27, 0xc8, 0x28, 0x9b, 0x87, 0x5d, 0x3f, 0x41, 0xc6, 0xdf,
-	0xc2, 0xe3, 0x58, 0x5d, 0x7c, 0x1c, 0x1f, 0x03, 0x64, 0x92, 0x26, 0x2c, 0x26, 0x8a, 0xe6, 0x6f,
-	0x6a, 0xb3, 0x39, 0x55, 0xff, 0x2b, 0x80, 0x4b, 0xfd, 0xcc, 0x9b, 0xd5, 0x50, 0xbb, 0xb6, 0xdc,
-	0xd3, 0x7f, 0x81, 0xd0, 0xbd, 0x9c, 0xfe, 0x16, 0xdc, 0x83, 0x76, 0xc6, 0x49, 0x4c, 0x87, 0x82,
-	0x27, 0x54, 0x46, 0x8a, 0x0c, 0x90, 0x64, 0x37, 0x6c, 0xcd, 0x89, 0xcf, 0xc8, 0x20, 0xf8, 0x15,
-	0x9c, 0x53, 0x4e, 0xd2, 0x63, 0x91, 0xe0, 0xae, 0x1e, 0x23, 0xe0, 0x88, 0xa4, 0x69, 0xfe, 0x9a,
-	0x6d, 0x34, 0xa3, 0x45, 0x77, 0x88, 0xb1, 0xd9, 0x4f, 0xd3, 0xdc, 0xdf, 0x82, 0x8e, 0x28, 0x54,
-	0x56, 0xa8, 0xe8, 0x8a, 0x0e, 0xb3, 0x99, 0xec, 0xb0, 0x65, 0xe4, 0xdf, 0x1a, 0x56, 0x72, 0xcd,
-	0x72, 0x2a, 0x12, 0xfa, 0x59, 0x0a, 0x0d, 0xb3, 0x1c, 0x17, 0xe7, 0xa9, 0x0d, 0xde, 0x91, 0xa4,
-	0x44, 0x51, 0x79, 0x36, 0x24, 0x69, 0xc7, 0xf2, 0x3b, 0xd0, 0x2c, 0x05, 0xcf, 0x2e, 0x0b, 0xc2,
-	0x3b, 0x55, 0xbf, 0x09, 0xce, 0x73, 0x9a, 0xe7, 0x78, 0x6f, 0xe3, 0xc0, 0xd1, 0x3c, 0x37, 0x97,
-	0x35, 0xdf, 0x85, 0xba, 0x39, 0xd6, 0xb5, 0xde, 0xb1, 0x50, 0xe6, 0xab, 0x71, 0xf0, 0xf0, 0xe7,
-	0x2f, 0x06, 0x4c, 0x0d, 0x8b, 0xfe, 0x76, 0x2c, 0x46, 0x3b, 0x06, 0xda, 0x7d, 0x26, 0xca, 0xd3,
-	0x0e, 0x4b, 0x15, 0x95, 0x29, 0xe1, 0x3b, 0x88, 0x76, 0x47, 0xa3, 0xcd, 0xfa, 0xfd, 0x06, 0x7e,
-	0x3d, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xda, 0x8a, 0xf0, 0x6c, 0x19, 0x0a, 0x00, 0x00,
+	// 1422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x73, 0x13, 0x47,
+	0x16, 0xd7, 0x68, 0xf4, 0x67, 0xf4, 0x24, 0xe4, 0x71, 0xb3, 0xd4, 0x0a, 0x58, 0xb0, 0x77, 0x96,
+	0xda, 0x35, 0xec, 0x62, 0xd7, 0x02, 0x81, 0x0a, 0x24, 0x29, 0x64, 0x1b, 0x90, 0x2b, 0x60, 0x3b,
+	0x83, 0x71, 0xa5, 0x72, 0x99, 0x6a, 0xcd, 0xb4, 0xa5, 0x2e, 0x46, 0xdd, 0x43, 0x4f, 0x8f, 0x40,
+	0x97, 0x5c, 0xf2, 0x09, 0x72, 0xc9, 0x57, 0xc8, 0x3d, 0x5f, 0x20, 0xc7, 0x5c, 0xf2, 0x01, 0x72,
+	0xcf, 0xa7, 0xc8, 0x29, 0xa9, 0xee, 0x1e, 0xfd, 0x73, 0xc9, 0x20, 0xaa, 0x7c, 0xeb, 0xfe, 0xbd,
+	0x3f, 0xfd, 0xde, 0xaf, 0x5f, 0xbf, 0x7e, 0x00, 0x49, 0x8c, 0xd9, 0x66, 0x22, 0xb8, 0xe4, 0x68,
+	0x75, 0x40, 0xe3, 0x61, 0x96, 0x9a, 0xdd, 0xa6, 0x12, 0x5c, 0x69, 0xa4, 0x61, 0x9f, 0x0c, 0xb0,
+	0x81, 0xbc, 0xef, 0x2d, 0x68, 0x3c, 0x23, 0x8c, 0x08, 0x1a, 0x1e, 0xe3, 0x38, 0x23, 0xe8, 0x2a,
+	0x38, 0x5d, 0xce, 0xe3, 0x60, 0x88, 0xe3, 0x96, 0xb5, 0x6e, 0x6d, 0x38, 0x9d, 0x82, 0x5f, 0x55,
+	0xc8, 0x31, 0x8e, 0xd1, 0x35, 0xa8, 0x51, 0x26, 0xef, 0xdf, 0xd3, 0xd2, 0xe2, 0xba, 0xb5, 0x61,
+	0x77, 0x0a, 0xbe, 0xa3, 0xa1, 0x5c, 0x7c, 0x12, 0x73, 0x2c, 0xb5, 0xd8, 0x5e, 0xb7, 0x36, 0x2c,
+	0x25, 0xd6, 0x90, 0x12, 0xaf, 0x01, 0xa4, 0x52, 0x50, 0xd6, 0xd3, 0xf2, 0xd2, 0xba, 0xb5, 0x51,
+	0xeb, 0x14, 0xfc, 0x9a, 0xc1, 0x8e, 0x71, 0xbc, 0x5d, 0x06, 0x7b, 0x88, 0x63, 0xef, 0x0f, 0x0b,
+	0x6a, 0x5f, 0x65, 0x44, 0x8c, 0xf6, 0xd8, 0x09, 0x47, 0x08, 0x4a, 0x92, 0x27, 0xaf, 0x75, 0x30,
+	0xb6, 0xaf, 0xd7, 0x68, 0x0d, 0xea, 0x03, 0x22, 0x05, 0x0d, 0x03, 0x39, 0x4a, 0x88, 0x3e, 0xaa,
+	0xe6, 0x83, 0x81, 0x8e, 0x46, 0x09, 0x41, 0xff, 0x82, 0x0b, 0x29, 0xc1, 0x22, 0xec, 0x07, 0x09,
+	0x16, 0x78, 0x90, 0x9a, 0xd3, 0xfc, 0x86, 0x01, 0x0f, 0x35, 0x86, 0xee, 0xc2, 0x25, 0x92, 0x4a,
+	0x3a, 0xc0, 0x92, 0x44, 0x41, 0x4a, 0x62, 0x12, 0x4a, 0x3a, 0xa4, 0x72, 0xd4, 0x2a, 0xab, 0xd0,
+	0xfd, 0xbf, 0x4d, 0x84, 0x2f, 0xa7, 0x32, 0x74, 0x07, 0x2e, 0x75, 0x45, 0x26, 0x49, 0x70, 0xc2,
+	0x45, 0x48, 0x02, 0xd9, 0x17, 0x24, 0xed, 0xf3, 0x38, 0x6a, 0x55, 0x74, 0x7c, 0x17, 0xb5, 0xf0,
+	0xa9, 0x92, 0x1d, 0x8d, 0x45, 0xe8, 0x26, 0xac, 0xf6, 0x04, 0xcf, 0x92, 0xa0, 0x3b, 0x0a, 0x4e,
+	0x28, 0x89, 0xa3, 0x80, 0x46, 0xad, 0xaa, 0xd6, 0x6f, 0x6a, 0xc1, 0xf6, 0xe8, 0xa9, 0x82, 0xf7,
+	0x22, 0xef, 0x17, 0x0b, 0x60, 0x87, 0xc7, 0xd9, 0x80, 0xe9, 0xe4, 0x2f, 0x83, 0x33, 0x31, 0x30,
+	0x04, 0x54, 0x4f, 0x8c, 0x26, 0x7a, 0x08, 0xb5, 0x08, 0x4b, 0x6c, 0x18, 0x50, 0x77, 0xd1, 0xbc,
+	0x73, 0x6d, 0x73, 0xee, 0xba, 0xf3, 0x8b, 0xde, 0xc5, 0x12, 0x2b, 0x52, 0x7c, 0x27, 0xca, 0x57,
+	0xe8, 0x06, 0x34, 0x69, 0x1a, 0x24, 0x82, 0x0e, 0xb0, 0x18, 0x05, 0xaf, 0xc9, 0x48, 0x53, 0xe8,
+	0xf8, 0x0d, 0x9a, 0x1e, 0x1a, 0xf0, 0x4b, 0x32, 0x42, 0x57, 0xa1, 0x46, 0xd3, 0x00, 0x67, 0x92,
+	0xef, 0xed, 0x6a, 0x02, 0x1d, 0xdf, 0xa1, 0x69, 0x5b, 0xef, 0xd5, 0x15, 0x30, 0x92, 0x2a, 0xe6,
+	0x12, 0x2c, 0xfb, 0xad, 0xf2, 0xba, 0xad, 0xae, 0xc0, 0x40, 0x87, 0x58, 0xf6, 0xbd, 0x9f, 0x2c,
+	0x68, 0xbe, 0x62, 0x58, 0x8c, 0x7c, 0xcc, 0x7a, 0xe4, 0xc9, 0xbb, 0x44, 0xa0, 0x2f, 0xa0, 0x1e,
+	0xea, 0xdc, 0x02, 0xca, 0x4e, 0xb8, 0x4e, 0xa8, 0x7e, 0x3a, 0x68, 0x5d, 0xbc, 0x53, 0x06, 0x7c,
+	0x08, 0xa7, 0x6c, 0xdc, 0x84, 0x22, 0x4f, 0xf2, 0x5c, 0x2f, 0x2f, 0x30, 0x3b, 0x48, 0x74, 0x9e,
+	0x45, 0x9e, 0xa0, 0x4f, 0xa0, 0x3c, 0x54, 0xf5, 0xac, 0x13, 0xab, 0xdf, 0x59, 0x5b, 0xa0, 0x3d,
+	0x5b, 0xf6, 0xbe, 0xd1, 0xf6, 0x7e, 0x2c, 0xc2, 0xca, 0x36, 0x3d, 0xdf, 0xa8, 0xff, 0x03, 0x2b,
+	0x31, 0x7f, 0x4b, 0x44, 0x40, 0x59, 0x18, 0x67, 0x29, 0x1d, 0x9a, 0xeb, 0x72, 0xfc, 0xa6, 0x86,
+	0xf7, 0xc6, 0xa8, 0x52, 0xcc, 0x92, 0x64, 0x4e, 0xd1, 0x5c, 0x4b, 0x53, 0xc3, 0x53, 0xc5, 0xc7,
+	0x50, 0x37, 0x1e, 0x4d, 0x8a, 0xa5, 0xe5, 0x52, 0x04, 0x6d, 0xa3, 0xd7, 0xca, 0x83, 0x39, 0xca,
+	0x78, 0x28, 0x2f, 0xe9, 0x41, 0xdb, 0xe8, 0xb5, 0xf7, 0xab, 0x05, 0xf5, 0x1d, 0x3e, 0x48, 0xb0,
+	0x30, 0x2c, 0x3d, 0x03, 0x37, 0x26, 0x27, 0x32, 0xf8, 0x68, 0xaa, 0x9a, 0xca, 0x6c, 0xba, 0x47,
+	0x7b, 0xb0, 0x2a, 0x68, 0xaf, 0x3f, 0xef, 0xa9, 0xb8, 0x8c, 0xa7, 0x15, 0x6d, 0xb7, 0x73, 0xba,
+	0x5e, 0xec, 0x25, 0xea, 0xc5, 0xfb, 0xb9, 0x08, 0x57, 0xcc, 0xc5, 0xb7, 0x05, 0x95, 0xfd, 0x83,
+	0xe4, 0xc9, 0x10, 0xc7, 0xe7, 0x57, 0x03, 0x9f, 0x82, 0x83, 0x95, 0xdf, 0x60, 0x52, 0xbf, 0xd7,
+	0x17, 0x18, 0xe7, 0x47, 0xeb, 0xa0, 0xaa, 0xd8, 0x6c, 0xd0, 0x2e, 0x5c, 0x30, 0x7c, 0xf0, 0x84,
+	0x08, 0xcc, 0xa2, 0x65, 0x2b, 0xba, 0xa1, 0xad, 0x0e, 0x8c, 0x51, 0x4e, 0x45, 0xe9, 0xa3, 0x9e,
+	0x4e, 0xf9, 0xa3, 0x9e, 0xce, 0x0f, 0x45, 0x58, 0x6d, 0x0b, 0x81, 0x47, 0x3b, 0x9c, 0x49, 0x4c,
+	0x59, 0x7a, 0x2e, 0xc4, 0x7d, 0x36, 0xf3, 0xe4, 0xff, 0xb7, 0x90, 0xb2, 0x53, 0x27, 0x1a, 0xe4,
+	0x20, 0xd1, 0xa9, 0x3c, 0x02, 0x87, 0xc4, 0x64, 0x40, 0x98, 0x4c, 0x5b, 0xf6, 0xba, 0xbd, 0x4c,
+	0x36, 0x13, 0x03, 0xef, 0x29, 0x54, 0x73, 0x5f, 0xa8, 0x0e, 0xd5, 0x3d, 0x36, 0xc4, 0x31, 0x8d,
+	0xdc, 0x02, 0x6a, 0x80, 0x33, 0x3e, 0xd0, 0xb5, 0xd0, 0x0a, 0xd4, 0xc7, 0xbb, 0x76, 0x1c, 0xbb,
+	0xc5, 0x39, 0x80, 0x8d, 0x5c, 0xdb, 0xfb, 0xce, 0x02, 0xe7, 0x88, 0x88, 0xc1, 0xb9, 0xf0, 0xf1,
+	0x00, 0x2a, 0x9a, 0xee, 0xb4, 0x55, 0x5c, 0x2e, 0x9f, 0x5c, 0x5d, 0x7d, 0xf4, 0x35, 0xdd, 0x8e,
+	0x75, 0x18, 0xf7, 0x34, 0xad, 0x96, 0xa6, 0xf5, 0xc6, 0x02, 0x17, 0x13, 0x4d, 0xb3, 0xca, 0xe9,
+	0xbc, 0x0d, 0xe5, 0xb0, 0x4f, 0xe3, 0x28, 0x7f, 0x8e, 0x7f, 0x5f, 0x60, 0xa8, 0x6c, 0x7c, 0xa3,
+	0xe5, 0xad, 0x41, 0x35, 0xb7, 0x9e, 0x27, 0xb0, 0x0a, 0xf6, 0x3e, 0x97, 0xae, 0xe5, 0xfd, 0x66,
+	0x01, 0x98, 0x47, 0xa7, 0x83, 0xba, 0x3f, 0x13, 0xd4, 0xbf, 0x17, 0xf8, 0x9e, 0xaa, 0xe6, 0xcb,
+	0x3c, 0xac, 0xff, 0x42, 0x49, 0xf5, 0x90, 0x0f, 0x45, 0xa5, 0x95, 0x54, 0x0e, 0xfa, 0x61, 0xb4,
+	0xec, 0xf7, 0x6b, 0x1b, 0x2d, 0xef, 0x3e, 0x38, 0xdb, 0x74, 0x51, 0x12, 0x4d, 0x80, 0xe7, 0xbc,
+	0x47, 0x43, 0x1c, 0xb7, 0x59, 0xe4, 0x5a, 0xe8, 0x02, 0xd4, 0xf2, 0xfd, 0x81, 0x70, 0x8b, 0xde,
+	0x9f, 0x25, 0x28, 0xe9, 0xa4, 0x1e, 0x42, 0x4d, 0x12, 0x31, 0x08, 0xc8, 0xbb, 0x44, 0xe4, 0xd7,
+	0x7d, 0x75, 0xc1, 0x99, 0xe3, 0x02, 0x51, 0x03, 0x93, 0xcc, 0xd7, 0xe8, 0x73, 0x80, 0x4c, 0x9d,
+	0x6d, 0x8c, 0x4d, 0x7a, 0xff, 0x78, 0xdf, 0x6d, 0xa9, 0x71, 0x2a, 0x9b, 0xf0, 0xf9, 0x18, 0xea,
+	0x5d, 0x3a, 0xb5, 0xb7, 0xcf, 0xac, 0xb5, 0x29, 0xb1, 0x9d, 0x82, 0x0f, 0xdd, 0xe9, 0x8d, 0xec,
+	0x40, 0x23, 0x34, 0x3d, 0xde, 0xb8, 0x30, 0x3f, 0xcd, 0xf5, 0x85, 0xe5, 0x3a, 0xf9, 0x0a, 0x3a,
+	0x05, 0xbf, 0x1e, 0x4e, 0xb7, 0xe8, 0x05, 0xb8, 0x26, 0x0b, 0xa1, 0xda, 0xa9, 0x71, 0x64, 0x5a,
+	0xcb, 0x3f, 0xcf, 0xca, 0x65, 0xd2, 0x78, 0x3b, 0x05, 0xbf, 0x99, 0xcd, 0x21, 0xe8, 0x10, 0x56,
+	0xbb, 0xf4, 0xb4, 0xbf, 0x8a, 0xf6, 0xe7, 0x9d, 0x99, 0xdb, 0xac, 0xc3, 0x95, 0xee, 0x3c, 0x84,
+	0x24, 0xac, 0xe5, 0x1e, 0xc7, 0x3d, 0x3a, 0x20, 0x43, 0x1c, 0xcf, 0xfa, 0xaf, 0x6a, 0xff, 0xb7,
+	0xcf, 0xf4, 0xbf, 0xe8, 0xd3, 0xe8, 0x14, 0xfc, 0x2b, 0xdd, 0x33, 0xa5, 0xe8, 0x18, 0x2e, 0x62,
+	0xd5, 0x5e, 0x82, 0x30, 0xef, 0x16, 0xe6, 0x24, 0x47, 0x9f, 0x74, 0x63, 0x99, 0x56, 0xd7, 0x29,
+	0xf8, 0xab, 0xf8, 0x34, 0xb8, 0x5d, 0x81, 0x92, 0x72, 0xe4, 0xfd, 0x6e, 0x01, 0x1c, 0x93, 0x50,
+	0x72, 0xd1, 0xde, 0xdf, 0x7f, 0x99, 0x0f, 0x73, 0x26, 0x9e, 0x96, 0x35, 0x1e, 0xe6, 0x4c, 0xf4,
+	0x73, 0x63, 0x66, 0x71, 0x7e, 0xcc, 0x7c, 0x00, 0x90, 0x08, 0x12, 0xd1, 0x10, 0x4b, 0x92, 0x7e,
+	0xe8, 0xd1, 0xcc, 0xa8, 0xa2, 0x47, 0x00, 0x6f, 0xd4, 0x10, 0x6f, 0x1a, 0x5d, 0xe9, 0xcc, 0xe2,
+	0x9d, 0x4c, 0xfa, 0x7e, 0xed, 0xcd, 0x78, 0xa9, 0x46, 0xa1, 0x24, 0xc6, 0x21, 0x51, 0xe3, 0x33,
+	0x11, 0x81, 0xc4, 0x3d, 0x5d, 0x32, 0x35, 0xbf, 0x39, 0x03, 0x1f, 0xe1, 0x9e, 0xf7, 0x2d, 0x38,
+	0x87, 0x31, 0x66, 0xfb, 0x3c, 0xd2, 0x43, 0xcd, 0x50, 0x27, 0x1c, 0x60, 0xc6, 0xd2, 0xf7, 0xf4,
+	0xd6, 0x29, 0x2d, 0xaa, 0xde, 0x8d, 0x4d, 0x9b, 0xb1, 0x14, 0x6d, 0x80, 0xcb, 0x33, 0x99, 0x64,
+	0x72, 0x32, 0xa6, 0x9b, 0x3e, 0x6b, 0xfb, 0x4d, 0x83, 0xe7, 0x63, 0x7a, 0xaa, 0x58, 0x66, 0x3c,
+	0x22, 0xb7, 0x18, 0x54, 0xcc, 0xd7, 0x39, 0xdf, 0x1d, 0x56, 0xa0, 0xfe, 0x4c, 0x10, 0x2c, 0x89,
+	0x38, 0xea, 0x63, 0xe6, 0x5a, 0xc8, 0x85, 0x46, 0x0e, 0x3c, 0x79, 0x93, 0x61, 0xf5, 0x4f, 0x34,
+	0xc0, 0x79, 0x4e, 0xd2, 0x54, 0xcb, 0x6d, 0xdd, 0x3e, 0x48, 0x9a, 0x1a, 0x61, 0x09, 0xd5, 0xa0,
+	0x6c, 0x96, 0x65, 0xa5, 0xb7, 0xcf, 0xa5, 0xd9, 0x55, 0x6e, 0x7d, 0x0d, 0xf5, 0x99, 0x29, 0x41,
+	0x1d, 0xfa, 0x8a, 0xbd, 0x66, 0xfc, 0x2d, 0x33, 0x7d, 0xb5, 0x1d, 0xa9, 0x5e, 0x54, 0x05, 0xfb,
+	0x65, 0xd6, 0x75, 0x8b, 0x6a, 0xf1, 0x22, 0x8b, 0x5d, 0x5b, 0x2d, 0x76, 0xe9, 0xd0, 0x2d, 0x69,
+	0x84, 0x47, 0x6e, 0x59, 0x45, 0xa8, 0x0b, 0xea, 0x39, 0x61, 0x3d, 0xd9, 0x77, 0x2b, 0xdb, 0x77,
+	0xbf, 0xf9, 0x7f, 0x8f, 0xca, 0x7e, 0xd6, 0xdd, 0x0c, 0xf9, 0x60, 0xcb, 0x90, 0x76, 0x9b, 0xf2,
+	0x7c, 0xb5, 0x45, 0x99, 0x24, 0x82, 0xe1, 0x78, 0x4b, 0xf3, 0xb8, 0xa5, 0x78, 0x4c, 0xba, 0xdd,
+	0x8a, 0xde, 0xdd, 0xfd, 0x6b, 0x00, 0x9a, 0xee, 0xd0, 0x04, 0x74, 0x0e, 0x00, 0x00,
 }
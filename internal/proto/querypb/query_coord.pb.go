@@ -100,7 +100,7 @@ func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
 }
 
-//----------------etcd-----------------
+// ----------------etcd-----------------
 type SegmentState int32
 
 const (
@@ -160,7 +160,7 @@ func (LoadType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
-//--------------------query coordinator proto------------------
+// --------------------query coordinator proto------------------
 type ShowCollectionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -1129,7 +1129,157 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
-//-----------------query node proto----------------
+type GetShardLeadersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{} }
+func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersRequest) ProtoMessage()    {}
+func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+}
+
+func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersRequest.Unmarshal(m, b)
+}
+func (m *GetShardLeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersRequest.Merge(m, src)
+}
+func (m *GetShardLeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersRequest.Size(m)
+}
+func (m *GetShardLeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersRequest proto.InternalMessageInfo
+
+func (m *GetShardLeadersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardLeadersRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// node_ids[0] watches the dml channel of the shard, the others hold sealed segments of the collection
+type ShardLeadersList struct {
+	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,2,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	NodeAddrs            []string `protobuf:"bytes,3,rep,name=node_addrs,json=nodeAddrs,proto3" json:"node_addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardLeadersList) Reset()         { *m = ShardLeadersList{} }
+func (m *ShardLeadersList) String() string { return proto.CompactTextString(m) }
+func (*ShardLeadersList) ProtoMessage()    {}
+func (*ShardLeadersList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+}
+
+func (m *ShardLeadersList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLeadersList.Unmarshal(m, b)
+}
+func (m *ShardLeadersList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLeadersList.Marshal(b, m, deterministic)
+}
+func (m *ShardLeadersList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLeadersList.Merge(m, src)
+}
+func (m *ShardLeadersList) XXX_Size() int {
+	return xxx_messageInfo_ShardLeadersList.Size(m)
+}
+func (m *ShardLeadersList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLeadersList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLeadersList proto.InternalMessageInfo
+
+func (m *ShardLeadersList) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardLeadersList) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *ShardLeadersList) GetNodeAddrs() []string {
+	if m != nil {
+		return m.NodeAddrs
+	}
+	return nil
+}
+
+type GetShardLeadersResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards               []*ShardLeadersList `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse{} }
+func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersResponse) ProtoMessage()    {}
+func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+}
+
+func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersResponse.Unmarshal(m, b)
+}
+func (m *GetShardLeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersResponse.Merge(m, src)
+}
+func (m *GetShardLeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersResponse.Size(m)
+}
+func (m *GetShardLeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersResponse proto.InternalMessageInfo
+
+func (m *GetShardLeadersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetShards() []*ShardLeadersList {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// -----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1145,7 +1295,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1216,7 +1366,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1289,7 +1439,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1359,7 +1509,7 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
-//used for handoff task
+// used for handoff task
 type SegmentLoadInfo struct {
 	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -1367,6 +1517,8 @@ type SegmentLoadInfo struct {
 	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
 	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*datapb.FieldBinlog `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1376,7 +1528,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1439,6 +1591,20 @@ func (m *SegmentLoadInfo) GetBinlogPaths() []*datapb.FieldBinlog {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetStatslogs() []*datapb.FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1454,7 +1620,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1526,7 +1692,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1589,6 +1755,220 @@ func (m *ReleaseSegmentsRequest) GetSegmentIDs() []int64 {
 	return nil
 }
 
+type SearchRequest struct {
+	Req                  *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels          []string                  `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetReq() *internalpb.SearchRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	Req                  *internalpb.RetrieveRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmlChannels          []string                    `protobuf:"bytes,2,rep,name=dml_channels,json=dmlChannels,proto3" json:"dml_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetReq() *internalpb.RetrieveRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDmlChannels() []string {
+	if m != nil {
+		return m.DmlChannels
+	}
+	return nil
+}
+
+type GetNodeResourcesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetNodeResourcesRequest) Reset()         { *m = GetNodeResourcesRequest{} }
+func (m *GetNodeResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeResourcesRequest) ProtoMessage()    {}
+func (*GetNodeResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+}
+
+func (m *GetNodeResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeResourcesRequest.Unmarshal(m, b)
+}
+func (m *GetNodeResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNodeResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeResourcesRequest.Merge(m, src)
+}
+func (m *GetNodeResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNodeResourcesRequest.Size(m)
+}
+func (m *GetNodeResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeResourcesRequest proto.InternalMessageInfo
+
+func (m *GetNodeResourcesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+// memory sizes are in bytes
+type GetNodeResourcesResponse struct {
+	Status      *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodeID      int64            `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemoryTotal uint64           `protobuf:"varint,3,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+	// loads pushing the used memory above the limit are rejected
+	MemoryLimit          uint64   `protobuf:"varint,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	MemoryUsed           uint64   `protobuf:"varint,5,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	SegmentsMemSize      int64    `protobuf:"varint,6,opt,name=segments_mem_size,json=segmentsMemSize,proto3" json:"segments_mem_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNodeResourcesResponse) Reset()         { *m = GetNodeResourcesResponse{} }
+func (m *GetNodeResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeResourcesResponse) ProtoMessage()    {}
+func (*GetNodeResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *GetNodeResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeResourcesResponse.Unmarshal(m, b)
+}
+func (m *GetNodeResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNodeResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeResourcesResponse.Merge(m, src)
+}
+func (m *GetNodeResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNodeResourcesResponse.Size(m)
+}
+func (m *GetNodeResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeResourcesResponse proto.InternalMessageInfo
+
+func (m *GetNodeResourcesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetNodeResourcesResponse) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *GetNodeResourcesResponse) GetMemoryTotal() uint64 {
+	if m != nil {
+		return m.MemoryTotal
+	}
+	return 0
+}
+
+func (m *GetNodeResourcesResponse) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
+
+func (m *GetNodeResourcesResponse) GetMemoryUsed() uint64 {
+	if m != nil {
+		return m.MemoryUsed
+	}
+	return 0
+}
+
+func (m *GetNodeResourcesResponse) GetSegmentsMemSize() int64 {
+	if m != nil {
+		return m.SegmentsMemSize
+	}
+	return 0
+}
+
 type DmChannelInfo struct {
 	NodeIDLoaded         int64    `protobuf:"varint,1,opt,name=nodeID_loaded,json=nodeIDLoaded,proto3" json:"nodeID_loaded,omitempty"`
 	ChannelIDs           []string `protobuf:"bytes,2,rep,name=channelIDs,proto3" json:"channelIDs,omitempty"`
@@ -1601,7 +1981,7 @@ func (m *DmChannelInfo) Reset()         { *m = DmChannelInfo{} }
 func (m *DmChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelInfo) ProtoMessage()    {}
 func (*DmChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *DmChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +2029,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1709,7 +2089,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1798,7 +2178,7 @@ func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
 func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegments) ProtoMessage()    {}
 func (*HandoffSegments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
@@ -1851,7 +2231,7 @@ func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{}
 func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
 func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1941,7 +2321,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2004,12 +2384,19 @@ func init() {
 	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
 	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
 	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
+	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
+	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
+	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
 	proto.RegisterType((*AddQueryChannelRequest)(nil), "milvus.proto.query.AddQueryChannelRequest")
 	proto.RegisterType((*RemoveQueryChannelRequest)(nil), "milvus.proto.query.RemoveQueryChannelRequest")
 	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
 	proto.RegisterType((*SegmentLoadInfo)(nil), "milvus.proto.query.SegmentLoadInfo")
 	proto.RegisterType((*LoadSegmentsRequest)(nil), "milvus.proto.query.LoadSegmentsRequest")
 	proto.RegisterType((*ReleaseSegmentsRequest)(nil), "milvus.proto.query.ReleaseSegmentsRequest")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
+	proto.RegisterType((*GetNodeResourcesRequest)(nil), "milvus.proto.query.GetNodeResourcesRequest")
+	proto.RegisterType((*GetNodeResourcesResponse)(nil), "milvus.proto.query.GetNodeResourcesResponse")
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
@@ -2021,128 +2408,150 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1933 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0xf1, 0x63, 0x3d, 0xb6, 0x54, 0x9a, 0xb5, 0x13, 0x75, 0x1d,
-	0xd7, 0x8e, 0xd2, 0x48, 0x89, 0x9c, 0x02, 0xf5, 0xa1, 0x87, 0x58, 0x8c, 0x55, 0xb6, 0x89, 0xa2,
-	0xae, 0xd4, 0x14, 0x35, 0x0c, 0x6c, 0x97, 0xdc, 0x11, 0xb5, 0xc8, 0xee, 0x0e, 0xbd, 0xb3, 0xb4,
-	0x2c, 0x1f, 0x7a, 0xea, 0xb9, 0xb7, 0x9e, 0x5a, 0x14, 0x28, 0xd0, 0x0f, 0xf4, 0xd0, 0x7f, 0xa0,
-	0xa7, 0xfc, 0x09, 0xfd, 0x07, 0x5a, 0xa0, 0x68, 0x6e, 0xfd, 0x27, 0x8a, 0xf9, 0xd8, 0xe5, 0x7e,
-	0x0c, 0x25, 0x4a, 0xaa, 0x62, 0xa3, 0xc8, 0x8d, 0xfb, 0xe6, 0xcd, 0xfb, 0x9e, 0xdf, 0xbc, 0x79,
-	0x84, 0x6b, 0xcf, 0x26, 0x38, 0x3c, 0xb1, 0x86, 0x84, 0x84, 0xce, 0xc6, 0x38, 0x24, 0x11, 0x41,
-	0xc8, 0x77, 0xbd, 0xe7, 0x13, 0x2a, 0xbe, 0x36, 0xf8, 0x7a, 0xb7, 0x31, 0x24, 0xbe, 0x4f, 0x02,
-	0x41, 0xeb, 0x36, 0xd2, 0x1c, 0xdd, 0x96, 0x1b, 0x44, 0x38, 0x0c, 0x6c, 0x2f, 0x5e, 0xa5, 0xc3,
-	0x23, 0xec, 0xdb, 0xf2, 0x4b, 0x77, 0xec, 0xc8, 0x4e, 0xcb, 0x37, 0x7e, 0xa9, 0xc1, 0xea, 0xfe,
-	0x11, 0x39, 0xde, 0x26, 0x9e, 0x87, 0x87, 0x91, 0x4b, 0x02, 0x6a, 0xe2, 0x67, 0x13, 0x4c, 0x23,
-	0xf4, 0x1e, 0x54, 0x06, 0x36, 0xc5, 0x1d, 0x6d, 0x4d, 0xbb, 0xbf, 0xbc, 0x75, 0x6b, 0x23, 0x63,
-	0x89, 0x34, 0xe1, 0x13, 0x3a, 0x7a, 0x64, 0x53, 0x6c, 0x72, 0x4e, 0x84, 0xa0, 0xe2, 0x0c, 0xfa,
-	0xbd, 0x4e, 0x69, 0x4d, 0xbb, 0x5f, 0x36, 0xf9, 0x6f, 0xf4, 0x16, 0x34, 0x87, 0x89, 0xec, 0x7e,
-	0x8f, 0x76, 0xca, 0x6b, 0xe5, 0xfb, 0x65, 0x33, 0x4b, 0x34, 0xfe, 0xac, 0xc1, 0x37, 0x0a, 0x66,
-	0xd0, 0x31, 0x09, 0x28, 0x46, 0x0f, 0xa0, 0x4a, 0x23, 0x3b, 0x9a, 0x50, 0x69, 0xc9, 0x37, 0x95,
-	0x96, 0xec, 0x73, 0x16, 0x53, 0xb2, 0x16, 0xd5, 0x96, 0x14, 0x6a, 0xd1, 0xfb, 0x70, 0xc3, 0x0d,
-	0x3e, 0xc1, 0x3e, 0x09, 0x4f, 0xac, 0x31, 0x0e, 0x87, 0x38, 0x88, 0xec, 0x11, 0x8e, 0x6d, 0xbc,
-	0x1e, 0xaf, 0xed, 0x4d, 0x97, 0x8c, 0x3f, 0x6a, 0xb0, 0xc2, 0x2c, 0xdd, 0xb3, 0xc3, 0xc8, 0xbd,
-	0x82, 0x78, 0x19, 0xd0, 0x48, 0xdb, 0xd8, 0x29, 0xf3, 0xb5, 0x0c, 0x8d, 0xf1, 0x8c, 0x63, 0xf5,
-	0xcc, 0xb7, 0x0a, 0x37, 0x37, 0x43, 0x33, 0xfe, 0x20, 0x13, 0x9b, 0xb6, 0xf3, 0x32, 0x01, 0xcd,
-	0xeb, 0x2c, 0x15, 0x75, 0x5e, 0x24, 0x9c, 0x5f, 0x68, 0xb0, 0xf2, 0x31, 0xb1, 0x9d, 0x69, 0xe2,
-	0xbf, 0xfa, 0x70, 0x7e, 0x1f, 0xaa, 0xe2, 0x94, 0x74, 0x2a, 0x5c, 0xd7, 0xdd, 0xac, 0x2e, 0x79,
-	0x82, 0xa6, 0x16, 0xee, 0x73, 0x82, 0x29, 0x37, 0x19, 0xbf, 0xd5, 0xa0, 0x63, 0x62, 0x0f, 0xdb,
-	0x14, 0xbf, 0x4a, 0x2f, 0x56, 0xa1, 0x1a, 0x10, 0x07, 0xf7, 0x7b, 0xdc, 0x8b, 0xb2, 0x29, 0xbf,
-	0x8c, 0x2f, 0x65, 0x84, 0x5f, 0xf3, 0x82, 0x4d, 0x65, 0x61, 0xf1, 0x22, 0x59, 0xf8, 0x62, 0x9a,
-	0x85, 0xd7, 0xdd, 0xd3, 0x69, 0xa6, 0x16, 0x33, 0x99, 0xfa, 0x19, 0xdc, 0xdc, 0x0e, 0xb1, 0x1d,
-	0xe1, 0x1f, 0x33, 0x98, 0xdf, 0x3e, 0xb2, 0x83, 0x00, 0x7b, 0xb1, 0x0b, 0x79, 0xe5, 0x9a, 0x42,
-	0x79, 0x07, 0x96, 0xc6, 0x21, 0x79, 0x71, 0x92, 0xd8, 0x1d, 0x7f, 0x1a, 0xbf, 0xd7, 0xa0, 0xab,
-	0x92, 0x7d, 0x19, 0x44, 0xb8, 0x07, 0xed, 0x50, 0x18, 0x67, 0x0d, 0x85, 0x3c, 0xae, 0xb5, 0x6e,
-	0xb6, 0x24, 0x59, 0x6a, 0x41, 0x77, 0xa1, 0x15, 0x62, 0x3a, 0xf1, 0xa6, 0x7c, 0x65, 0xce, 0xd7,
-	0x14, 0x54, 0xc9, 0x66, 0xfc, 0x45, 0x83, 0x9b, 0x3b, 0x38, 0x4a, 0xb2, 0xc7, 0xd4, 0xe1, 0xd7,
-	0x14, 0x5d, 0x7f, 0xa7, 0x41, 0x3b, 0x67, 0x28, 0x5a, 0x83, 0xe5, 0x14, 0x8f, 0x4c, 0x50, 0x9a,
-	0x84, 0xbe, 0x07, 0x8b, 0x2c, 0x76, 0x98, 0x9b, 0xd4, 0xda, 0x32, 0x36, 0x8a, 0x97, 0xfb, 0x46,
-	0x56, 0xaa, 0x29, 0x36, 0xa0, 0x4d, 0xb8, 0xae, 0x40, 0x56, 0x69, 0x3e, 0x2a, 0x02, 0xab, 0xf1,
-	0x57, 0x0d, 0xba, 0xaa, 0x60, 0x5e, 0x26, 0xe1, 0x4f, 0x60, 0x35, 0xf1, 0xc6, 0x72, 0x30, 0x1d,
-	0x86, 0xee, 0x98, 0x1f, 0x33, 0x7e, 0x19, 0x2c, 0x6f, 0xdd, 0x39, 0xdb, 0x1f, 0x6a, 0xae, 0x24,
-	0x22, 0x7a, 0x29, 0x09, 0x86, 0x0b, 0x2b, 0x3b, 0x38, 0xda, 0xc7, 0x23, 0x1f, 0x07, 0x51, 0x3f,
-	0x38, 0x24, 0x17, 0xcf, 0xfb, 0x1b, 0x00, 0x54, 0xca, 0x49, 0xee, 0xa9, 0x14, 0xc5, 0xf8, 0x47,
-	0x09, 0x96, 0x53, 0x8a, 0xd0, 0x2d, 0xa8, 0x27, 0xab, 0x32, 0x6b, 0x53, 0x42, 0xa1, 0x62, 0x4a,
-	0x8a, 0x8a, 0xc9, 0x65, 0xbe, 0x5c, 0xcc, 0xfc, 0x0c, 0x70, 0x46, 0x37, 0xa1, 0xe6, 0x63, 0xdf,
-	0xa2, 0xee, 0x4b, 0x2c, 0xc1, 0x60, 0xc9, 0xc7, 0xfe, 0xbe, 0xfb, 0x12, 0xb3, 0xa5, 0x60, 0xe2,
-	0x5b, 0x21, 0x39, 0xa6, 0x9d, 0xaa, 0x58, 0x0a, 0x26, 0xbe, 0x49, 0x8e, 0x29, 0xba, 0x0d, 0xe0,
-	0x06, 0x0e, 0x7e, 0x61, 0x05, 0xb6, 0x8f, 0x3b, 0x4b, 0xfc, 0x30, 0xd5, 0x39, 0x65, 0xd7, 0xf6,
-	0x31, 0x83, 0x01, 0xfe, 0xd1, 0xef, 0x75, 0x6a, 0x62, 0xa3, 0xfc, 0x64, 0xae, 0xca, 0x23, 0xd8,
-	0xef, 0x75, 0xea, 0x62, 0x5f, 0x42, 0x40, 0x1f, 0x41, 0x53, 0xfa, 0x6d, 0x89, 0x32, 0x05, 0x5e,
-	0xa6, 0x6b, 0xaa, 0xb4, 0xca, 0x00, 0x8a, 0x22, 0x6d, 0xd0, 0xd4, 0x17, 0x6f, 0x29, 0xf3, 0xb9,
-	0xbc, 0x4c, 0xd9, 0x7d, 0x17, 0x16, 0xdd, 0xe0, 0x90, 0xc4, 0x55, 0xf6, 0xe6, 0x29, 0xe6, 0x70,
-	0x65, 0x82, 0xdb, 0xf8, 0xa7, 0x06, 0xab, 0x1f, 0x3a, 0x8e, 0x0a, 0x4b, 0xcf, 0x5f, 0x53, 0xd3,
-	0xfc, 0x95, 0x32, 0xf9, 0x9b, 0x07, 0x4f, 0xde, 0x81, 0x6b, 0x39, 0x9c, 0x94, 0x65, 0x50, 0x37,
-	0xf5, 0x2c, 0x52, 0xf6, 0x7b, 0xe8, 0x6d, 0xd0, 0xb3, 0x58, 0x29, 0x6f, 0x89, 0xba, 0xd9, 0xce,
-	0xa0, 0x65, 0xbf, 0x67, 0xfc, 0x4b, 0x83, 0x9b, 0x26, 0xf6, 0xc9, 0x73, 0xfc, 0xff, 0xeb, 0xe3,
-	0xbf, 0x4b, 0xb0, 0xfa, 0x53, 0x3b, 0x1a, 0x1e, 0xf5, 0x7c, 0x49, 0xa4, 0xaf, 0xc6, 0xc1, 0xdc,
-	0x11, 0xaf, 0x14, 0x8f, 0x78, 0x52, 0xa6, 0x8b, 0xaa, 0x32, 0x65, 0x0f, 0xaf, 0x8d, 0xcf, 0x62,
-	0x7f, 0xa7, 0x65, 0x9a, 0x6a, 0x7b, 0xaa, 0x17, 0x68, 0x7b, 0xd0, 0x36, 0x34, 0xf1, 0x8b, 0xa1,
-	0x37, 0x71, 0xb0, 0x25, 0xb4, 0x2f, 0x71, 0xed, 0x6f, 0x28, 0xb4, 0xa7, 0xcf, 0x48, 0x43, 0x6e,
-	0xea, 0xf3, 0xa3, 0xf2, 0x1f, 0x0d, 0xda, 0x72, 0x95, 0x75, 0x8a, 0x73, 0xa0, 0x62, 0x2e, 0x1c,
-	0xa5, 0x62, 0x38, 0xe6, 0x09, 0x6a, 0x7c, 0x43, 0x57, 0x52, 0x37, 0xf4, 0x6d, 0x80, 0x43, 0x6f,
-	0x42, 0x8f, 0xac, 0xc8, 0xf5, 0x63, 0x4c, 0xac, 0x73, 0xca, 0x81, 0xeb, 0x63, 0xf4, 0x21, 0x34,
-	0x06, 0x6e, 0xe0, 0x91, 0x91, 0x35, 0xb6, 0xa3, 0x23, 0x86, 0x8c, 0xb3, 0xdc, 0x7d, 0xec, 0x62,
-	0xcf, 0x79, 0xc4, 0x79, 0xcd, 0x65, 0xb1, 0x67, 0x8f, 0x6d, 0x31, 0xfe, 0x54, 0x82, 0xeb, 0xcc,
-	0x4d, 0xe9, 0xf1, 0x15, 0x14, 0xd4, 0xc3, 0xb8, 0x14, 0xca, 0xb3, 0xef, 0xc5, 0x5c, 0xbc, 0x8b,
-	0xe5, 0x70, 0x91, 0xb7, 0x08, 0xfa, 0x11, 0xb4, 0x3c, 0x62, 0x3b, 0xd6, 0x90, 0x04, 0x0e, 0xcf,
-	0x04, 0x8f, 0x60, 0x6b, 0xeb, 0x2d, 0x95, 0x09, 0x07, 0xa1, 0x3b, 0x1a, 0xe1, 0x70, 0x3b, 0xe6,
-	0x35, 0x9b, 0x1e, 0x7f, 0x89, 0xc9, 0x4f, 0x8e, 0xa0, 0xb2, 0xa5, 0xbe, 0xba, 0x58, 0xc5, 0x35,
-	0x50, 0x3e, 0xa5, 0x4b, 0xab, 0xcc, 0xd1, 0xa5, 0x2d, 0x2a, 0x1a, 0xed, 0x6c, 0x27, 0x50, 0x2d,
-	0x74, 0x02, 0x07, 0xd0, 0x4c, 0x70, 0x85, 0x17, 0xfd, 0x1d, 0x68, 0x0a, 0xb3, 0x2c, 0x16, 0x09,
-	0xec, 0xc4, 0x5d, 0xb6, 0x20, 0x7e, 0xcc, 0x69, 0x4c, 0x6a, 0x82, 0x5b, 0xe2, 0x52, 0xaa, 0x9b,
-	0x29, 0x8a, 0xf1, 0x6b, 0x0d, 0xf4, 0x34, 0x22, 0x73, 0xc9, 0xf3, 0xb4, 0xef, 0xf7, 0xa0, 0x2d,
-	0x07, 0x40, 0x09, 0x2c, 0xca, 0x86, 0xfa, 0x59, 0x5a, 0x5c, 0x0f, 0x7d, 0x00, 0xab, 0x82, 0xb1,
-	0x00, 0xa3, 0xa2, 0xb1, 0xbe, 0xc1, 0x57, 0xcd, 0x1c, 0x96, 0xfe, 0xbd, 0x0c, 0xad, 0x69, 0xe1,
-	0xcc, 0x6d, 0xd5, 0x3c, 0x0f, 0xff, 0x5d, 0xd0, 0xa7, 0x9d, 0x21, 0xef, 0x1d, 0x4e, 0xad, 0xfd,
-	0x7c, 0x4f, 0xd8, 0x1e, 0xe7, 0x5a, 0xe9, 0xc7, 0xd0, 0x94, 0x3e, 0x49, 0x54, 0xab, 0x70, 0x61,
-	0xdf, 0x52, 0x09, 0xcb, 0x64, 0xd0, 0x6c, 0xa4, 0x20, 0x96, 0xa2, 0x87, 0x50, 0xe7, 0xc7, 0x21,
-	0x3a, 0x19, 0x63, 0x79, 0x12, 0x6e, 0xa9, 0x64, 0xb0, 0xcc, 0x1e, 0x9c, 0x8c, 0xb1, 0x59, 0xf3,
-	0xe4, 0xaf, 0xcb, 0xe2, 0xf2, 0x03, 0x58, 0x09, 0xc5, 0xd1, 0x71, 0xac, 0x4c, 0xf8, 0x96, 0x78,
-	0xf8, 0x6e, 0xc4, 0x8b, 0x7b, 0xe9, 0x30, 0xce, 0xe8, 0xf2, 0x6b, 0x33, 0xbb, 0xfc, 0x5f, 0x40,
-	0xfb, 0x07, 0x76, 0xe0, 0x90, 0xc3, 0xc3, 0xf8, 0x80, 0x5e, 0xe0, 0x64, 0x3e, 0xcc, 0xf6, 0x57,
-	0xe7, 0x40, 0x2b, 0xe3, 0x37, 0x25, 0x58, 0x65, 0xb4, 0x47, 0xb6, 0x67, 0x07, 0x43, 0x3c, 0x7f,
-	0x57, 0xfd, 0xbf, 0xb9, 0x3f, 0xee, 0x40, 0x93, 0x92, 0x49, 0x38, 0xc4, 0x56, 0xa6, 0xb9, 0x6e,
-	0x08, 0xe2, 0xae, 0x00, 0x98, 0xdb, 0x00, 0x0e, 0x8d, 0xac, 0xcc, 0x8b, 0xbb, 0xee, 0xd0, 0x48,
-	0x2e, 0xbf, 0x09, 0xcb, 0x52, 0x86, 0x43, 0x02, 0xcc, 0x93, 0x5d, 0x33, 0x41, 0x90, 0x7a, 0x24,
-	0xe0, 0x7d, 0x38, 0xdb, 0xcf, 0x57, 0x97, 0xf8, 0xea, 0x92, 0x43, 0x23, 0xbe, 0x74, 0x1b, 0xe0,
-	0xb9, 0xed, 0xb9, 0x0e, 0x2f, 0x52, 0x9e, 0xa6, 0x9a, 0x59, 0xe7, 0x14, 0x16, 0x02, 0xe3, 0x6f,
-	0x1a, 0xa0, 0x54, 0x74, 0x2e, 0x8e, 0x9d, 0x77, 0xa1, 0x95, 0xf1, 0x33, 0x99, 0x66, 0xa6, 0x1d,
-	0xa5, 0x0c, 0xfc, 0x07, 0x42, 0x95, 0x15, 0x62, 0x9b, 0x92, 0x80, 0x07, 0x6d, 0x6e, 0xf0, 0x1f,
-	0xc4, 0x66, 0xb2, 0xad, 0xeb, 0x2f, 0xa1, 0x95, 0x3d, 0xa6, 0xa8, 0x01, 0xb5, 0x5d, 0x12, 0x7d,
-	0xf4, 0xc2, 0xa5, 0x91, 0xbe, 0x80, 0x5a, 0x00, 0xbb, 0x24, 0xda, 0x0b, 0x31, 0xc5, 0x41, 0xa4,
-	0x6b, 0x08, 0xa0, 0xfa, 0x69, 0xd0, 0x73, 0xe9, 0xe7, 0x7a, 0x09, 0x5d, 0x97, 0x8f, 0x63, 0xdb,
-	0xeb, 0xcb, 0x9a, 0xd5, 0xcb, 0x6c, 0x7b, 0xf2, 0x55, 0x41, 0x3a, 0x34, 0x12, 0x96, 0x9d, 0xbd,
-	0x9f, 0xe8, 0x8b, 0xa8, 0x0e, 0x8b, 0xe2, 0x67, 0x75, 0xfd, 0x53, 0xd0, 0xf3, 0xe6, 0xa1, 0x65,
-	0x58, 0x3a, 0x12, 0xa5, 0xae, 0x2f, 0xa0, 0x36, 0x2c, 0x7b, 0xd3, 0xc0, 0xea, 0x1a, 0x23, 0x8c,
-	0xc2, 0xf1, 0x50, 0x86, 0x58, 0x2f, 0x31, 0x6d, 0x2c, 0x56, 0x3d, 0x72, 0x1c, 0xe8, 0xe5, 0xf5,
-	0x1f, 0x42, 0x23, 0xfd, 0x60, 0x41, 0x35, 0xa8, 0xec, 0x92, 0x00, 0xeb, 0x0b, 0x4c, 0xec, 0x4e,
-	0x48, 0x8e, 0xdd, 0x60, 0x24, 0x7c, 0x78, 0x1c, 0x92, 0x97, 0x38, 0xd0, 0x4b, 0x6c, 0x81, 0x62,
-	0xdb, 0x63, 0x0b, 0x65, 0xb6, 0xc0, 0x3e, 0xb0, 0xa3, 0x57, 0xd6, 0xdf, 0x87, 0x5a, 0x0c, 0x17,
-	0xe8, 0x1a, 0x34, 0x33, 0xa3, 0x35, 0x7d, 0x01, 0x21, 0x71, 0x03, 0x4f, 0x81, 0x41, 0xd7, 0xb6,
-	0x7e, 0x05, 0x00, 0xe2, 0x46, 0x20, 0x24, 0x74, 0xd0, 0x18, 0xd0, 0x0e, 0x8e, 0xb6, 0x89, 0x3f,
-	0x26, 0x41, 0x6c, 0x12, 0x45, 0xef, 0x65, 0xb3, 0x94, 0xcc, 0xf1, 0x8b, 0xac, 0xd2, 0xcb, 0xee,
-	0xb7, 0x67, 0xec, 0xc8, 0xb1, 0x1b, 0x0b, 0xc8, 0xe7, 0x1a, 0x59, 0x03, 0x75, 0xe0, 0x0e, 0x3f,
-	0x8f, 0xe7, 0x32, 0xa7, 0x68, 0xcc, 0xb1, 0xc6, 0x1a, 0x73, 0xd8, 0x20, 0x3f, 0xf6, 0xa3, 0xd0,
-	0x0d, 0x46, 0xf1, 0x23, 0xcf, 0x58, 0x40, 0xcf, 0xe0, 0x06, 0x7b, 0x00, 0x46, 0x76, 0xe4, 0xd2,
-	0xc8, 0x1d, 0xd2, 0x58, 0xe1, 0xd6, 0x6c, 0x85, 0x05, 0xe6, 0x73, 0xaa, 0xf4, 0xa0, 0x9d, 0xfb,
-	0xff, 0x00, 0xad, 0x2b, 0x81, 0x4c, 0xf9, 0x5f, 0x47, 0xf7, 0x9d, 0xb9, 0x78, 0x13, 0x6d, 0x2e,
-	0xb4, 0xb2, 0xb3, 0x75, 0xf4, 0xf6, 0x2c, 0x01, 0x85, 0x61, 0x64, 0x77, 0x7d, 0x1e, 0xd6, 0x44,
-	0xd5, 0x13, 0x68, 0x65, 0xa7, 0xb7, 0x6a, 0x55, 0xca, 0x09, 0x6f, 0xf7, 0xb4, 0xf7, 0xb5, 0xb1,
-	0x80, 0x7e, 0x0e, 0xd7, 0x0a, 0x23, 0x53, 0xf4, 0x1d, 0x95, 0xf8, 0x59, 0x93, 0xd5, 0xb3, 0x34,
-	0x48, 0xeb, 0xa7, 0x51, 0x9c, 0x6d, 0x7d, 0x61, 0x76, 0x3e, 0xbf, 0xf5, 0x29, 0xf1, 0xa7, 0x59,
-	0x7f, 0x6e, 0x0d, 0x13, 0x40, 0xc5, 0xa1, 0x29, 0x7a, 0x57, 0xa5, 0x62, 0xe6, 0xe0, 0xb6, 0xbb,
-	0x31, 0x2f, 0x7b, 0x92, 0xf2, 0x09, 0x3f, 0xad, 0xf9, 0xf1, 0xa2, 0x52, 0xed, 0xcc, 0x79, 0xa9,
-	0x5a, 0xed, 0xec, 0x89, 0xa0, 0x28, 0xea, 0xec, 0xd8, 0x46, 0x9d, 0x2b, 0xe5, 0x98, 0x4e, 0x5d,
-	0xd4, 0xea, 0x29, 0x90, 0xb1, 0xb0, 0xf5, 0x65, 0x0d, 0xea, 0xdc, 0x79, 0x76, 0x75, 0x7d, 0x8d,
-	0x87, 0x57, 0x80, 0x87, 0x4f, 0xa1, 0x9d, 0x1b, 0x7e, 0xa9, 0xf1, 0x50, 0x3d, 0x21, 0x3b, 0xeb,
-	0x60, 0x0c, 0x00, 0x15, 0x27, 0x4f, 0xea, 0x0a, 0x9d, 0x39, 0xa1, 0x3a, 0x4b, 0xc7, 0x53, 0x68,
-	0xe7, 0x26, 0x3f, 0x6a, 0x0f, 0xd4, 0xe3, 0xa1, 0xb3, 0xa4, 0x7f, 0x06, 0x8d, 0xf4, 0x0c, 0x00,
-	0xdd, 0x9b, 0x05, 0x4b, 0xb9, 0x97, 0xef, 0xab, 0x07, 0xa5, 0xab, 0x07, 0xed, 0xa7, 0xd0, 0xce,
-	0x3d, 0xfb, 0xd5, 0x91, 0x57, 0xcf, 0x06, 0xce, 0x92, 0xfe, 0xd5, 0xc1, 0xcc, 0xa3, 0x0f, 0x9e,
-	0x6c, 0x8d, 0xdc, 0xe8, 0x68, 0x32, 0x60, 0x46, 0x6c, 0x8a, 0x9d, 0xef, 0xba, 0x44, 0xfe, 0xda,
-	0x8c, 0xcf, 0xdb, 0x26, 0x17, 0xb6, 0xc9, 0x85, 0x8d, 0x07, 0x83, 0x2a, 0xff, 0x7c, 0xf0, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xcb, 0xb1, 0x73, 0x8e, 0x21, 0x00, 0x00,
+	// 2282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4b, 0x6f, 0x1b, 0xc9,
+	0xd1, 0x1a, 0x92, 0xe2, 0xa3, 0xf8, 0x1a, 0xb7, 0x6d, 0x2d, 0xcd, 0xcf, 0x0f, 0x79, 0xbc, 0xfe,
+	0xec, 0x95, 0x77, 0xa5, 0x5d, 0x79, 0x13, 0xac, 0x81, 0xcd, 0xc1, 0x16, 0xd7, 0x0a, 0xb3, 0xb2,
+	0xac, 0x8c, 0xb4, 0x1b, 0xc4, 0x30, 0xc0, 0x8c, 0x38, 0x2d, 0x72, 0xe2, 0x99, 0x69, 0x6a, 0x7a,
+	0x68, 0x59, 0x3e, 0xe4, 0x94, 0x63, 0xae, 0x39, 0x25, 0x08, 0x10, 0x20, 0x0f, 0xe4, 0x90, 0x3f,
+	0x90, 0xd3, 0x5e, 0x73, 0xcb, 0x1f, 0x48, 0x80, 0x20, 0xf9, 0x15, 0x41, 0x80, 0x04, 0xfd, 0x98,
+	0xe1, 0xbc, 0x28, 0x51, 0x52, 0xb4, 0x36, 0x82, 0xdc, 0x66, 0xaa, 0xab, 0xab, 0xaa, 0xab, 0xaa,
+	0xeb, 0xd5, 0x70, 0x61, 0x7f, 0x8c, 0xbd, 0xc3, 0x5e, 0x9f, 0x10, 0xcf, 0x5c, 0x1e, 0x79, 0xc4,
+	0x27, 0x08, 0x39, 0x96, 0xfd, 0x72, 0x4c, 0xc5, 0xdf, 0x32, 0x5f, 0x6f, 0xd7, 0xfa, 0xc4, 0x71,
+	0x88, 0x2b, 0x60, 0xed, 0x5a, 0x14, 0xa3, 0xdd, 0xb0, 0x5c, 0x1f, 0x7b, 0xae, 0x61, 0x07, 0xab,
+	0xb4, 0x3f, 0xc4, 0x8e, 0x21, 0xff, 0x54, 0xd3, 0xf0, 0x8d, 0x28, 0x7d, 0xed, 0xc7, 0x0a, 0x2c,
+	0x6c, 0x0f, 0xc9, 0xc1, 0x1a, 0xb1, 0x6d, 0xdc, 0xf7, 0x2d, 0xe2, 0x52, 0x1d, 0xef, 0x8f, 0x31,
+	0xf5, 0xd1, 0x87, 0x50, 0xd8, 0x35, 0x28, 0x6e, 0x29, 0x8b, 0xca, 0xdd, 0xea, 0xea, 0xd5, 0xe5,
+	0x98, 0x24, 0x52, 0x84, 0x27, 0x74, 0xf0, 0xc8, 0xa0, 0x58, 0xe7, 0x98, 0x08, 0x41, 0xc1, 0xdc,
+	0xed, 0x76, 0x5a, 0xb9, 0x45, 0xe5, 0x6e, 0x5e, 0xe7, 0xdf, 0xe8, 0x5d, 0xa8, 0xf7, 0x43, 0xda,
+	0xdd, 0x0e, 0x6d, 0xe5, 0x17, 0xf3, 0x77, 0xf3, 0x7a, 0x1c, 0xa8, 0xfd, 0x56, 0x81, 0x77, 0x52,
+	0x62, 0xd0, 0x11, 0x71, 0x29, 0x46, 0xf7, 0xa1, 0x48, 0x7d, 0xc3, 0x1f, 0x53, 0x29, 0xc9, 0xff,
+	0x65, 0x4a, 0xb2, 0xcd, 0x51, 0x74, 0x89, 0x9a, 0x66, 0x9b, 0xcb, 0x60, 0x8b, 0x3e, 0x82, 0x4b,
+	0x96, 0xfb, 0x04, 0x3b, 0xc4, 0x3b, 0xec, 0x8d, 0xb0, 0xd7, 0xc7, 0xae, 0x6f, 0x0c, 0x70, 0x20,
+	0xe3, 0xc5, 0x60, 0x6d, 0x6b, 0xb2, 0xa4, 0xfd, 0x5a, 0x81, 0xcb, 0x4c, 0xd2, 0x2d, 0xc3, 0xf3,
+	0xad, 0x73, 0xd0, 0x97, 0x06, 0xb5, 0xa8, 0x8c, 0xad, 0x3c, 0x5f, 0x8b, 0xc1, 0x18, 0xce, 0x28,
+	0x60, 0xcf, 0xce, 0x56, 0xe0, 0xe2, 0xc6, 0x60, 0xda, 0xaf, 0xa4, 0x61, 0xa3, 0x72, 0x9e, 0x45,
+	0xa1, 0x49, 0x9e, 0xb9, 0x34, 0xcf, 0xd3, 0xa8, 0xf3, 0x2b, 0x05, 0x2e, 0x6f, 0x10, 0xc3, 0x9c,
+	0x18, 0xfe, 0xeb, 0x57, 0xe7, 0xb7, 0xa0, 0x28, 0x6e, 0x49, 0xab, 0xc0, 0x79, 0xdd, 0x8e, 0xf3,
+	0x12, 0x6b, 0xcb, 0x13, 0x09, 0xb7, 0x39, 0x40, 0x97, 0x9b, 0xb4, 0x9f, 0x2b, 0xd0, 0xd2, 0xb1,
+	0x8d, 0x0d, 0x8a, 0xdf, 0xe4, 0x29, 0x16, 0xa0, 0xe8, 0x12, 0x13, 0x77, 0x3b, 0xfc, 0x14, 0x79,
+	0x5d, 0xfe, 0x69, 0x7f, 0x97, 0x1a, 0x7e, 0xcb, 0x1d, 0x36, 0x62, 0x85, 0xf9, 0xd3, 0x58, 0xe1,
+	0xab, 0x89, 0x15, 0xde, 0xf6, 0x93, 0x4e, 0x2c, 0x35, 0x1f, 0xb3, 0xd4, 0xf7, 0xe1, 0xca, 0x9a,
+	0x87, 0x0d, 0x1f, 0x7f, 0x97, 0x85, 0xf9, 0xb5, 0xa1, 0xe1, 0xba, 0xd8, 0x0e, 0x8e, 0x90, 0x64,
+	0xae, 0x64, 0x30, 0x6f, 0x41, 0x69, 0xe4, 0x91, 0x57, 0x87, 0xa1, 0xdc, 0xc1, 0xaf, 0xf6, 0x4b,
+	0x05, 0xda, 0x59, 0xb4, 0xcf, 0x12, 0x11, 0xee, 0x40, 0xd3, 0x13, 0xc2, 0xf5, 0xfa, 0x82, 0x1e,
+	0xe7, 0x5a, 0xd1, 0x1b, 0x12, 0x2c, 0xb9, 0xa0, 0xdb, 0xd0, 0xf0, 0x30, 0x1d, 0xdb, 0x13, 0xbc,
+	0x3c, 0xc7, 0xab, 0x0b, 0xa8, 0x44, 0xd3, 0x7e, 0xa7, 0xc0, 0x95, 0x75, 0xec, 0x87, 0xd6, 0x63,
+	0xec, 0xf0, 0x5b, 0x1a, 0x5d, 0x7f, 0xa1, 0x40, 0x33, 0x21, 0x28, 0x5a, 0x84, 0x6a, 0x04, 0x47,
+	0x1a, 0x28, 0x0a, 0x42, 0x9f, 0xc0, 0x3c, 0xd3, 0x1d, 0xe6, 0x22, 0x35, 0x56, 0xb5, 0xe5, 0x74,
+	0x72, 0x5f, 0x8e, 0x53, 0xd5, 0xc5, 0x06, 0xb4, 0x02, 0x17, 0x33, 0x22, 0xab, 0x14, 0x1f, 0xa5,
+	0x03, 0xab, 0xf6, 0x7b, 0x05, 0xda, 0x59, 0xca, 0x3c, 0x8b, 0xc1, 0x9f, 0xc1, 0x42, 0x78, 0x9a,
+	0x9e, 0x89, 0x69, 0xdf, 0xb3, 0x46, 0xec, 0x5b, 0x24, 0x83, 0xea, 0xea, 0xad, 0xe3, 0xcf, 0x43,
+	0xf5, 0xcb, 0x21, 0x89, 0x4e, 0x84, 0x82, 0x66, 0xc1, 0xe5, 0x75, 0xec, 0x6f, 0xe3, 0x81, 0x83,
+	0x5d, 0xbf, 0xeb, 0xee, 0x91, 0xd3, 0xdb, 0xfd, 0x3a, 0x00, 0x95, 0x74, 0xc2, 0x3c, 0x15, 0x81,
+	0x68, 0x7f, 0xce, 0x41, 0x35, 0xc2, 0x08, 0x5d, 0x85, 0x4a, 0xb8, 0x2a, 0xad, 0x36, 0x01, 0xa4,
+	0x3c, 0x26, 0x97, 0xe1, 0x31, 0x09, 0xcb, 0xe7, 0xd3, 0x96, 0x9f, 0x12, 0x9c, 0xd1, 0x15, 0x28,
+	0x3b, 0xd8, 0xe9, 0x51, 0xeb, 0x35, 0x96, 0xc1, 0xa0, 0xe4, 0x60, 0x67, 0xdb, 0x7a, 0x8d, 0xd9,
+	0x92, 0x3b, 0x76, 0x7a, 0x1e, 0x39, 0xa0, 0xad, 0xa2, 0x58, 0x72, 0xc7, 0x8e, 0x4e, 0x0e, 0x28,
+	0xba, 0x06, 0x60, 0xb9, 0x26, 0x7e, 0xd5, 0x73, 0x0d, 0x07, 0xb7, 0x4a, 0xfc, 0x32, 0x55, 0x38,
+	0x64, 0xd3, 0x70, 0x30, 0x0b, 0x03, 0xfc, 0xa7, 0xdb, 0x69, 0x95, 0xc5, 0x46, 0xf9, 0xcb, 0x8e,
+	0x2a, 0xaf, 0x60, 0xb7, 0xd3, 0xaa, 0x88, 0x7d, 0x21, 0x00, 0x7d, 0x06, 0x75, 0x79, 0xee, 0x9e,
+	0x70, 0x53, 0xe0, 0x6e, 0xba, 0x98, 0x65, 0x56, 0xa9, 0x40, 0xe1, 0xa4, 0x35, 0x1a, 0xf9, 0xe3,
+	0x25, 0x65, 0xd2, 0x96, 0x67, 0x71, 0xbb, 0x6f, 0xc0, 0xbc, 0xe5, 0xee, 0x91, 0xc0, 0xcb, 0x6e,
+	0x1c, 0x21, 0x0e, 0x67, 0x26, 0xb0, 0x35, 0x57, 0x48, 0x31, 0x34, 0x3c, 0x73, 0x03, 0x1b, 0x26,
+	0xf6, 0xce, 0x10, 0x4a, 0x66, 0x70, 0x02, 0x8d, 0x80, 0x1a, 0x65, 0xb6, 0x61, 0x51, 0x1f, 0xdd,
+	0x84, 0x9a, 0x54, 0xaf, 0x30, 0x95, 0xc2, 0x55, 0x5e, 0x95, 0x30, 0x6e, 0x2c, 0x66, 0x66, 0x62,
+	0xe2, 0x9e, 0x65, 0x06, 0xbe, 0x5a, 0xe2, 0xbe, 0x61, 0x72, 0x33, 0xf3, 0x25, 0xc3, 0x34, 0x3d,
+	0x51, 0x44, 0x55, 0xf4, 0x0a, 0x83, 0x3c, 0x64, 0x00, 0xed, 0x27, 0x0a, 0xbc, 0x93, 0x3a, 0xe1,
+	0x59, 0x14, 0xfd, 0x29, 0x14, 0x29, 0x23, 0x16, 0x68, 0xfa, 0xdd, 0x4c, 0x4d, 0x27, 0xce, 0xa8,
+	0xcb, 0x3d, 0xda, 0x5f, 0x14, 0x58, 0x78, 0x68, 0x9a, 0x59, 0xb9, 0xeb, 0xe4, 0x0a, 0x9f, 0xdc,
+	0x97, 0x5c, 0xec, 0xbe, 0xcc, 0x12, 0xbf, 0xef, 0xc1, 0x85, 0x44, 0x5e, 0x92, 0xd7, 0xae, 0xa2,
+	0xab, 0xf1, 0xcc, 0xd4, 0xed, 0xa0, 0xf7, 0x40, 0x8d, 0xe7, 0x26, 0x99, 0x95, 0x2b, 0x7a, 0x33,
+	0x96, 0x9d, 0xba, 0x1d, 0xed, 0xaf, 0x0a, 0x5c, 0xd1, 0xb1, 0x43, 0x5e, 0xe2, 0xff, 0xde, 0x33,
+	0xfe, 0x2d, 0x07, 0x0b, 0xdf, 0x33, 0xfc, 0xfe, 0xb0, 0xe3, 0x48, 0x20, 0x7d, 0x33, 0x07, 0x4c,
+	0x84, 0xd4, 0x42, 0x3a, 0xa4, 0x86, 0x61, 0x61, 0x3e, 0x2b, 0x2c, 0xb0, 0x46, 0x77, 0xf9, 0xcb,
+	0xe0, 0xbc, 0x93, 0xb0, 0x10, 0x29, 0x33, 0x8b, 0xa7, 0x28, 0x33, 0xd1, 0x1a, 0xd4, 0xf1, 0xab,
+	0xbe, 0x3d, 0x66, 0x37, 0x96, 0x73, 0x2f, 0x71, 0xee, 0xd7, 0x33, 0xb8, 0x47, 0x63, 0x52, 0x4d,
+	0x6e, 0xea, 0xf2, 0xd0, 0xf4, 0xc7, 0x1c, 0x34, 0xe5, 0x2a, 0xab, 0xcc, 0x67, 0xc8, 0x42, 0x09,
+	0x75, 0xe4, 0xd2, 0xea, 0x98, 0x45, 0xa9, 0x41, 0x45, 0x54, 0x88, 0x54, 0x44, 0xd7, 0x00, 0xf6,
+	0xec, 0x31, 0x1d, 0xf6, 0x7c, 0xcb, 0x09, 0x72, 0x50, 0x85, 0x43, 0x76, 0x2c, 0x07, 0xa3, 0x87,
+	0x50, 0xdb, 0xb5, 0x5c, 0x9b, 0x0c, 0x7a, 0x23, 0xc3, 0x1f, 0xb2, 0x4c, 0x34, 0xed, 0xb8, 0x8f,
+	0x2d, 0x6c, 0x9b, 0x8f, 0x38, 0xae, 0x5e, 0x15, 0x7b, 0xb6, 0xd8, 0x16, 0x74, 0x1d, 0xaa, 0x2c,
+	0x91, 0x91, 0x3d, 0x91, 0xcb, 0x4a, 0x82, 0x85, 0x3b, 0x76, 0x9e, 0xee, 0xf1, 0x6c, 0xf6, 0x29,
+	0x54, 0x58, 0x00, 0xa2, 0x36, 0x19, 0xd0, 0x56, 0x79, 0x26, 0xfa, 0x93, 0x0d, 0xda, 0x6f, 0x72,
+	0x70, 0x91, 0x29, 0x51, 0xea, 0xf3, 0x1c, 0xdc, 0xf5, 0x41, 0xe0, 0x68, 0xf9, 0xe9, 0x55, 0x4e,
+	0xc2, 0x9a, 0x69, 0x67, 0x3b, 0x4d, 0x67, 0x89, 0x3e, 0x87, 0x86, 0x4d, 0x0c, 0xb3, 0xd7, 0x27,
+	0xae, 0xc9, 0xed, 0xcc, 0xed, 0xd3, 0xc8, 0x0e, 0xcc, 0x3b, 0x9e, 0x35, 0x18, 0x60, 0x6f, 0x2d,
+	0xc0, 0xd5, 0xeb, 0x36, 0xef, 0xab, 0xe5, 0x2f, 0x8f, 0xcf, 0xb2, 0x41, 0x3a, 0x3f, 0x5d, 0x05,
+	0x1e, 0x96, 0x3f, 0xa2, 0xe6, 0x2e, 0xcc, 0x50, 0x73, 0xcf, 0x67, 0xb4, 0x4d, 0xf1, 0xba, 0xae,
+	0x98, 0xaa, 0xeb, 0x7e, 0x08, 0xf5, 0x6d, 0x6c, 0x78, 0xfd, 0x61, 0x70, 0xac, 0x6f, 0x42, 0xde,
+	0xc3, 0xfb, 0xf2, 0x54, 0x09, 0x9d, 0x85, 0x63, 0xb2, 0xd8, 0x16, 0x9d, 0x6d, 0x60, 0x59, 0xdb,
+	0x74, 0xec, 0x20, 0x58, 0x8a, 0x6c, 0x58, 0xd1, 0xab, 0xa6, 0x63, 0x07, 0x31, 0x51, 0x7b, 0x01,
+	0x35, 0x9e, 0x04, 0x02, 0x56, 0x9f, 0x44, 0x59, 0xfd, 0xff, 0x14, 0x56, 0x3a, 0xf6, 0x3d, 0x0b,
+	0xbf, 0xc4, 0x27, 0x65, 0xf6, 0x39, 0xcf, 0xf3, 0x9b, 0xc4, 0xc4, 0x3a, 0xa6, 0x64, 0xec, 0xf5,
+	0xcf, 0xd0, 0x15, 0x69, 0xff, 0x50, 0xa0, 0x95, 0xa6, 0x76, 0x96, 0xb2, 0x61, 0x9a, 0x2f, 0xdc,
+	0x84, 0x9a, 0x23, 0x3a, 0x16, 0x9f, 0xf8, 0x86, 0x68, 0xfa, 0x0a, 0x7a, 0x55, 0xc0, 0x76, 0x18,
+	0x28, 0x82, 0x62, 0x5b, 0x8e, 0xe5, 0xb7, 0x0a, 0x51, 0x94, 0x0d, 0x06, 0x42, 0x37, 0x40, 0xfe,
+	0xf6, 0xc6, 0x14, 0x9b, 0xfc, 0x02, 0x14, 0x74, 0x10, 0xa0, 0x2f, 0x28, 0x36, 0xd1, 0x12, 0x5c,
+	0x90, 0x4e, 0x40, 0x7b, 0x61, 0x2d, 0x2d, 0x0a, 0xe6, 0x66, 0xb0, 0xf0, 0x44, 0xd4, 0xd4, 0xda,
+	0x0e, 0xd4, 0xc3, 0xc4, 0xc6, 0xa3, 0xee, 0x2d, 0xa8, 0x0b, 0x69, 0x7b, 0xec, 0xb2, 0x60, 0x33,
+	0x68, 0xab, 0x05, 0x70, 0x83, 0xc3, 0x98, 0xe3, 0x85, 0x89, 0x33, 0x30, 0x50, 0x04, 0xa2, 0xfd,
+	0x54, 0x01, 0x35, 0x5a, 0x12, 0x70, 0xca, 0xb3, 0xf4, 0xeb, 0x77, 0xa0, 0x29, 0x27, 0xbe, 0x61,
+	0x5e, 0x96, 0x1d, 0xf4, 0x7e, 0x94, 0x5c, 0x07, 0x7d, 0x0c, 0x0b, 0x02, 0x31, 0x95, 0xc7, 0x45,
+	0x27, 0x7d, 0x69, 0x5f, 0x38, 0x63, 0x3c, 0x99, 0xff, 0x29, 0x0f, 0x8d, 0x49, 0x6c, 0x99, 0x59,
+	0xaa, 0x59, 0x26, 0x7d, 0x9b, 0xa0, 0x4e, 0x5a, 0x41, 0xde, 0x2c, 0x1c, 0x19, 0x1e, 0x93, 0x4d,
+	0x60, 0x73, 0x14, 0x07, 0xa0, 0xc7, 0x50, 0x0f, 0x0a, 0x65, 0x11, 0x6b, 0x0b, 0x9c, 0xd8, 0xcd,
+	0x2c, 0x62, 0x31, 0x0b, 0xea, 0xb5, 0x48, 0x8e, 0xa7, 0xe8, 0x01, 0x54, 0x78, 0xc4, 0xf4, 0x0f,
+	0x47, 0x58, 0x06, 0xcb, 0xab, 0x59, 0x34, 0x98, 0x65, 0x77, 0x0e, 0x47, 0x58, 0x2f, 0xdb, 0xf2,
+	0xeb, 0xac, 0x85, 0xc1, 0x7d, 0xb8, 0xec, 0x89, 0xe8, 0x6a, 0xf6, 0x62, 0xea, 0x2b, 0x71, 0xf5,
+	0x5d, 0x0a, 0x16, 0xb7, 0xa2, 0x6a, 0x9c, 0xd2, 0xd6, 0x97, 0xa7, 0xb6, 0xf5, 0x3f, 0x82, 0xe6,
+	0xb7, 0x0d, 0xd7, 0x24, 0x7b, 0x7b, 0x41, 0x0c, 0x3f, 0x45, 0xf0, 0x7e, 0x10, 0x6f, 0xa8, 0x4e,
+	0x90, 0xd0, 0xb4, 0x9f, 0xe5, 0x60, 0x81, 0xc1, 0x1e, 0x19, 0xb6, 0xe1, 0xf6, 0xf1, 0xec, 0x6d,
+	0xf4, 0x7f, 0xa6, 0x80, 0xb9, 0x05, 0x75, 0x11, 0xb2, 0x7a, 0xb1, 0x6e, 0xba, 0x26, 0x80, 0x9b,
+	0x1c, 0xc6, 0x2a, 0x1a, 0x93, 0xfa, 0xbd, 0xd8, 0x88, 0xad, 0x62, 0x52, 0x5f, 0x2e, 0xdf, 0x80,
+	0xaa, 0xa4, 0x61, 0x12, 0x57, 0x44, 0x8a, 0xb2, 0x0e, 0x02, 0xd4, 0x21, 0x2e, 0xef, 0xc8, 0xd8,
+	0x7e, 0xbe, 0x5a, 0xe2, 0xab, 0x25, 0x93, 0xfa, 0x7c, 0xe9, 0x1a, 0xc0, 0x4b, 0xc3, 0xb6, 0x4c,
+	0xee, 0xa4, 0xdc, 0x4c, 0x65, 0xbd, 0xc2, 0x21, 0x4c, 0x05, 0xda, 0x1f, 0x14, 0x40, 0x11, 0xed,
+	0x9c, 0x3e, 0xbd, 0xde, 0x86, 0x46, 0xec, 0x9c, 0xe1, 0xf3, 0x45, 0xf4, 0xa0, 0x94, 0xd5, 0x07,
+	0xbb, 0x82, 0x55, 0xcf, 0xc3, 0x06, 0x25, 0x6e, 0x2b, 0x7f, 0x92, 0xfa, 0x60, 0x37, 0x10, 0x93,
+	0x6d, 0x5d, 0x7a, 0x0d, 0x8d, 0xf8, 0x35, 0x45, 0x35, 0x28, 0x6f, 0x12, 0xff, 0xb3, 0x57, 0x16,
+	0xf5, 0xd5, 0x39, 0xd4, 0x00, 0xd8, 0x24, 0xfe, 0x96, 0x87, 0x29, 0x76, 0x7d, 0x55, 0x41, 0x00,
+	0xc5, 0xa7, 0x6e, 0xc7, 0xa2, 0x2f, 0xd4, 0x1c, 0xba, 0x28, 0xa7, 0x61, 0x86, 0xdd, 0x95, 0x3e,
+	0xab, 0xe6, 0xd9, 0xf6, 0xf0, 0xaf, 0x80, 0x54, 0xa8, 0x85, 0x28, 0xeb, 0x5b, 0x5f, 0xa8, 0xf3,
+	0xa8, 0x02, 0xf3, 0xe2, 0xb3, 0xb8, 0xf4, 0x14, 0xd4, 0xa4, 0x78, 0xa8, 0x0a, 0xa5, 0xa1, 0x70,
+	0x75, 0x75, 0x0e, 0x35, 0xa1, 0x6a, 0x4f, 0x14, 0xab, 0x2a, 0x0c, 0x30, 0xf0, 0x46, 0x7d, 0xa9,
+	0x62, 0x35, 0xc7, 0xb8, 0x31, 0x5d, 0x75, 0xc8, 0x81, 0xab, 0xe6, 0x97, 0xbe, 0x03, 0xb5, 0xe8,
+	0x84, 0x02, 0x95, 0xa1, 0xb0, 0x49, 0x5c, 0xac, 0xce, 0x31, 0xb2, 0xeb, 0x1e, 0x39, 0xb0, 0xdc,
+	0x81, 0x38, 0xc3, 0x63, 0x8f, 0xbc, 0xc6, 0xae, 0x9a, 0x63, 0x0b, 0x14, 0x1b, 0x36, 0x5b, 0xc8,
+	0xb3, 0x05, 0xf6, 0x83, 0x4d, 0xb5, 0xb0, 0xf4, 0x11, 0x94, 0x83, 0x70, 0x81, 0x2e, 0x40, 0x3d,
+	0x36, 0x4b, 0x57, 0xe7, 0x10, 0x12, 0x45, 0xda, 0x24, 0x30, 0xa8, 0xca, 0xea, 0x3f, 0x01, 0x40,
+	0x64, 0x04, 0xf6, 0xd4, 0x86, 0x46, 0x80, 0xd6, 0xb1, 0xbf, 0x46, 0x9c, 0x11, 0x71, 0x03, 0x91,
+	0x28, 0xfa, 0x70, 0x4a, 0x99, 0x90, 0x46, 0x95, 0xa7, 0x6c, 0x4f, 0x2b, 0x2c, 0x12, 0xe8, 0xda,
+	0x1c, 0x72, 0x38, 0x47, 0x56, 0xc1, 0xef, 0x58, 0xfd, 0x17, 0xc1, 0x20, 0xf6, 0x08, 0x8e, 0x09,
+	0xd4, 0x80, 0x63, 0x22, 0x36, 0xc8, 0x9f, 0x6d, 0xdf, 0xb3, 0xdc, 0x41, 0x50, 0x35, 0x68, 0x73,
+	0x68, 0x1f, 0x2e, 0xb1, 0x49, 0x84, 0x6f, 0xf8, 0x16, 0xf5, 0xad, 0x3e, 0x0d, 0x18, 0xae, 0x4e,
+	0x67, 0x98, 0x42, 0x3e, 0x21, 0x4b, 0x1b, 0x9a, 0x89, 0x07, 0x43, 0xb4, 0x94, 0x3d, 0xaf, 0xc8,
+	0x7a, 0xdc, 0x6c, 0xdf, 0x9b, 0x09, 0x37, 0xe4, 0x66, 0x41, 0x23, 0xfe, 0x98, 0x86, 0xde, 0x9b,
+	0x46, 0x20, 0xf5, 0xfa, 0xd0, 0x5e, 0x9a, 0x05, 0x35, 0x64, 0xf5, 0x0c, 0x1a, 0xf1, 0xe7, 0x9a,
+	0x6c, 0x56, 0x99, 0x4f, 0x3a, 0xed, 0xa3, 0x0a, 0x36, 0x6d, 0x0e, 0xfd, 0x00, 0x2e, 0xa4, 0xde,
+	0x48, 0xd0, 0xfb, 0x59, 0xe4, 0xa7, 0x3d, 0xa5, 0x1c, 0xc7, 0x41, 0x4a, 0x3f, 0xd1, 0xe2, 0x74,
+	0xe9, 0x53, 0x8f, 0x65, 0xb3, 0x4b, 0x1f, 0x21, 0x7f, 0x94, 0xf4, 0x27, 0xe6, 0x30, 0x06, 0x94,
+	0x7e, 0x25, 0x41, 0x1f, 0x64, 0xb1, 0x98, 0xfa, 0x52, 0xd3, 0x5e, 0x9e, 0x15, 0x3d, 0x34, 0xf9,
+	0x98, 0xdf, 0xd6, 0xe4, 0x7b, 0x42, 0x26, 0xdb, 0xa9, 0x0f, 0x24, 0xed, 0xe5, 0x59, 0xd1, 0xa3,
+	0x4e, 0x1d, 0x9f, 0xd3, 0x66, 0xdb, 0x2a, 0x73, 0x2e, 0xdf, 0x5e, 0x9a, 0x05, 0x35, 0x7a, 0x5b,
+	0x13, 0xa3, 0x4a, 0x34, 0x95, 0x40, 0x7a, 0x62, 0xdb, 0xbe, 0x37, 0x13, 0x6e, 0xc0, 0x6d, 0xf5,
+	0x5f, 0x00, 0x15, 0xae, 0x6a, 0x96, 0x28, 0xff, 0x17, 0x7d, 0xcf, 0x21, 0xfa, 0x3e, 0x87, 0x66,
+	0x62, 0xd6, 0x9b, 0x6d, 0xcf, 0xec, 0x81, 0xf0, 0x71, 0xd7, 0x70, 0x17, 0x50, 0x7a, 0xd0, 0x9a,
+	0x7d, 0x1f, 0xa6, 0x0e, 0x64, 0x8f, 0xe3, 0xf1, 0x1c, 0x9a, 0x89, 0x41, 0x67, 0xf6, 0x09, 0xb2,
+	0xa7, 0xa1, 0xc7, 0x51, 0xff, 0x12, 0x6a, 0xd1, 0xa1, 0x14, 0xba, 0x33, 0x2d, 0x08, 0x26, 0x46,
+	0x31, 0x6f, 0x3e, 0x04, 0x9e, 0x7f, 0x8a, 0x78, 0x0e, 0xcd, 0xc4, 0x1c, 0x2a, 0x5b, 0xf3, 0xd9,
+	0xc3, 0xaa, 0xe3, 0xa8, 0x7f, 0x8d, 0x41, 0x6d, 0x1b, 0x8a, 0x62, 0x7a, 0x84, 0x6e, 0x66, 0xb7,
+	0x50, 0x91, 0xc9, 0x52, 0xfb, 0xb8, 0xf9, 0x13, 0x6b, 0xdd, 0x29, 0x27, 0x3a, 0xcf, 0xbd, 0x19,
+	0x65, 0x3e, 0xbb, 0x45, 0x87, 0x4e, 0xed, 0xe3, 0xe7, 0x4c, 0x01, 0x51, 0x02, 0x6a, 0x72, 0xe6,
+	0x83, 0xa6, 0xc5, 0xd4, 0xac, 0x39, 0x53, 0xfb, 0xfd, 0xd9, 0x90, 0x03, 0xd5, 0x3c, 0xfa, 0xf8,
+	0xd9, 0xea, 0xc0, 0xf2, 0x87, 0xe3, 0x5d, 0x66, 0x9f, 0x15, 0xb1, 0xf7, 0x03, 0x8b, 0xc8, 0xaf,
+	0x95, 0x40, 0xd4, 0x15, 0x4e, 0x6e, 0x85, 0x93, 0x1b, 0xed, 0xee, 0x16, 0xf9, 0xef, 0xfd, 0x7f,
+	0x0f, 0x00, 0xdc, 0xde, 0x96, 0x2a, 0x08, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2169,6 +2578,7 @@ type QueryCoordClient interface {
 	CreateQueryChannel(ctx context.Context, in *CreateQueryChannelRequest, opts ...grpc.CallOption) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
 }
 
 type queryCoordClient struct {
@@ -2287,6 +2697,15 @@ func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInf
 	return out, nil
 }
 
+func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
+	out := new(GetShardLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2301,6 +2720,7 @@ type QueryCoordServer interface {
 	CreateQueryChannel(context.Context, *CreateQueryChannelRequest) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -2343,6 +2763,9 @@ func (*UnimplementedQueryCoordServer) GetPartitionStates(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *GetShardLeadersRequest) (*GetShardLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeaders not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -2564,6 +2987,24 @@ func _QueryCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_GetShardLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetShardLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, req.(*GetShardLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -2616,6 +3057,10 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryCoord_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "GetShardLeaders",
+			Handler:    _QueryCoord_GetShardLeaders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -2636,6 +3081,9 @@ type QueryNodeClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
+	GetNodeResources(ctx context.Context, in *GetNodeResourcesRequest, opts ...grpc.CallOption) (*GetNodeResourcesResponse, error)
 }
 
 type queryNodeClient struct {
@@ -2745,6 +3193,33 @@ func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfo
 	return out, nil
 }
 
+func (c *queryNodeClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	out := new(internalpb.SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	out := new(internalpb.RetrieveResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) GetNodeResources(ctx context.Context, in *GetNodeResourcesRequest, opts ...grpc.CallOption) (*GetNodeResourcesResponse, error) {
+	out := new(GetNodeResourcesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetNodeResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 type QueryNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2758,6 +3233,9 @@ type QueryNodeServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	Search(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
+	Query(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
+	GetNodeResources(context.Context, *GetNodeResourcesRequest) (*GetNodeResourcesResponse, error)
 }
 
 // UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
@@ -2797,6 +3275,15 @@ func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *R
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryNodeServer) Search(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedQueryNodeServer) Query(ctx context.Context, req *QueryRequest) (*internalpb.RetrieveResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedQueryNodeServer) GetNodeResources(ctx context.Context, req *GetNodeResourcesRequest) (*GetNodeResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeResources not implemented")
+}
 
 func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
 	s.RegisterService(&_QueryNode_serviceDesc, srv)
@@ -3000,6 +3487,60 @@ func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_GetNodeResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetNodeResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/GetNodeResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetNodeResources(ctx, req.(*GetNodeResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryNode",
 	HandlerType: (*QueryNodeServer)(nil),
@@ -3048,6 +3589,18 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _QueryNode_Search_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QueryNode_Query_Handler,
+		},
+		{
+			MethodName: "GetNodeResources",
+			Handler:    _QueryNode_GetNodeResources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -21,7 +21,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//*
 // @brief Field data type
 type DataType int32
 
@@ -35,6 +34,8 @@ const (
 	DataType_Float        DataType = 10
 	DataType_Double       DataType = 11
 	DataType_String       DataType = 20
+	DataType_Array        DataType = 22
+	DataType_JSON         DataType = 23
 	DataType_BinaryVector DataType = 100
 	DataType_FloatVector  DataType = 101
 )
@@ -49,6 +50,8 @@ var DataType_name = map[int32]string{
 	10:  "Float",
 	11:  "Double",
 	20:  "String",
+	22:  "Array",
+	23:  "JSON",
 	100: "BinaryVector",
 	101: "FloatVector",
 }
@@ -63,6 +66,8 @@ var DataType_value = map[string]int32{
 	"Float":        10,
 	"Double":       11,
 	"String":       20,
+	"Array":        22,
+	"JSON":         23,
 	"BinaryVector": 100,
 	"FloatVector":  101,
 }
@@ -75,7 +80,6 @@ func (DataType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c5fb4d8cc22d66a, []int{0}
 }
 
-//*
 // @brief Field schema
 type FieldSchema struct {
 	FieldID              int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -86,6 +90,8 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	ElementType          DataType                 `protobuf:"varint,9,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+	IsDynamic            bool                     `protobuf:"varint,10,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,13 +178,27 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
-//*
+func (m *FieldSchema) GetElementType() DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return DataType_None
+}
+
+func (m *FieldSchema) GetIsDynamic() bool {
+	if m != nil {
+		return m.IsDynamic
+	}
+	return false
+}
+
 // @brief Collection schema
 type CollectionSchema struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	EnableDynamicField   bool           `protobuf:"varint,5,opt,name=enable_dynamic_field,json=enableDynamicField,proto3" json:"enable_dynamic_field,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -237,6 +257,13 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetEnableDynamicField() bool {
+	if m != nil {
+		return m.EnableDynamicField
+	}
+	return false
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -511,6 +538,94 @@ func (m *StringArray) GetData() []string {
 	return nil
 }
 
+// Each element is a JSON document encoded as UTF-8 text
+type JSONArray struct {
+	Data                 [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONArray) Reset()         { *m = JSONArray{} }
+func (m *JSONArray) String() string { return proto.CompactTextString(m) }
+func (*JSONArray) ProtoMessage()    {}
+func (*JSONArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+}
+
+func (m *JSONArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONArray.Unmarshal(m, b)
+}
+func (m *JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONArray.Marshal(b, m, deterministic)
+}
+func (m *JSONArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONArray.Merge(m, src)
+}
+func (m *JSONArray) XXX_Size() int {
+	return xxx_messageInfo_JSONArray.Size(m)
+}
+func (m *JSONArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONArray proto.InternalMessageInfo
+
+func (m *JSONArray) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Each element is an array, whose elements are kept in the data of element_type
+type ArrayArray struct {
+	Data                 []*ScalarField `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	ElementType          DataType       `protobuf:"varint,2,opt,name=element_type,json=elementType,proto3,enum=milvus.proto.schema.DataType" json:"element_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ArrayArray) Reset()         { *m = ArrayArray{} }
+func (m *ArrayArray) String() string { return proto.CompactTextString(m) }
+func (*ArrayArray) ProtoMessage()    {}
+func (*ArrayArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+}
+
+func (m *ArrayArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayArray.Unmarshal(m, b)
+}
+func (m *ArrayArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayArray.Marshal(b, m, deterministic)
+}
+func (m *ArrayArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayArray.Merge(m, src)
+}
+func (m *ArrayArray) XXX_Size() int {
+	return xxx_messageInfo_ArrayArray.Size(m)
+}
+func (m *ArrayArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayArray proto.InternalMessageInfo
+
+func (m *ArrayArray) GetData() []*ScalarField {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ArrayArray) GetElementType() DataType {
+	if m != nil {
+		return m.ElementType
+	}
+	return DataType_None
+}
+
 type ScalarField struct {
 	// Types that are valid to be assigned to Data:
 	//	*ScalarField_BoolData
@@ -520,6 +635,8 @@ type ScalarField struct {
 	//	*ScalarField_DoubleData
 	//	*ScalarField_StringData
 	//	*ScalarField_BytesData
+	//	*ScalarField_ArrayData
+	//	*ScalarField_JsonData
 	Data                 isScalarField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -530,7 +647,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -583,6 +700,14 @@ type ScalarField_BytesData struct {
 	BytesData *BytesArray `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
 }
 
+type ScalarField_ArrayData struct {
+	ArrayData *ArrayArray `protobuf:"bytes,8,opt,name=array_data,json=arrayData,proto3,oneof"`
+}
+
+type ScalarField_JsonData struct {
+	JsonData *JSONArray `protobuf:"bytes,9,opt,name=json_data,json=jsonData,proto3,oneof"`
+}
+
 func (*ScalarField_BoolData) isScalarField_Data() {}
 
 func (*ScalarField_IntData) isScalarField_Data() {}
@@ -597,6 +722,10 @@ func (*ScalarField_StringData) isScalarField_Data() {}
 
 func (*ScalarField_BytesData) isScalarField_Data() {}
 
+func (*ScalarField_ArrayData) isScalarField_Data() {}
+
+func (*ScalarField_JsonData) isScalarField_Data() {}
+
 func (m *ScalarField) GetData() isScalarField_Data {
 	if m != nil {
 		return m.Data
@@ -653,6 +782,20 @@ func (m *ScalarField) GetBytesData() *BytesArray {
 	return nil
 }
 
+func (m *ScalarField) GetArrayData() *ArrayArray {
+	if x, ok := m.GetData().(*ScalarField_ArrayData); ok {
+		return x.ArrayData
+	}
+	return nil
+}
+
+func (m *ScalarField) GetJsonData() *JSONArray {
+	if x, ok := m.GetData().(*ScalarField_JsonData); ok {
+		return x.JsonData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ScalarField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -663,6 +806,8 @@ func (*ScalarField) XXX_OneofWrappers() []interface{} {
 		(*ScalarField_DoubleData)(nil),
 		(*ScalarField_StringData)(nil),
 		(*ScalarField_BytesData)(nil),
+		(*ScalarField_ArrayData)(nil),
+		(*ScalarField_JsonData)(nil),
 	}
 }
 
@@ -681,7 +826,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -771,7 +916,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -872,7 +1017,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -945,6 +1090,7 @@ type SearchResultData struct {
 	Scores               []float32    `protobuf:"fixed32,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 	Ids                  *IDs         `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
 	Topks                []int64      `protobuf:"varint,6,rep,packed,name=topks,proto3" json:"topks,omitempty"`
+	GroupByValues        []int64      `protobuf:"varint,7,rep,packed,name=group_by_values,json=groupByValues,proto3" json:"group_by_values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -954,7 +1100,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1017,6 +1163,13 @@ func (m *SearchResultData) GetTopks() []int64 {
 	return nil
 }
 
+func (m *SearchResultData) GetGroupByValues() []int64 {
+	if m != nil {
+		return m.GroupByValues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
@@ -1028,6 +1181,8 @@ func init() {
 	proto.RegisterType((*DoubleArray)(nil), "milvus.proto.schema.DoubleArray")
 	proto.RegisterType((*BytesArray)(nil), "milvus.proto.schema.BytesArray")
 	proto.RegisterType((*StringArray)(nil), "milvus.proto.schema.StringArray")
+	proto.RegisterType((*JSONArray)(nil), "milvus.proto.schema.JSONArray")
+	proto.RegisterType((*ArrayArray)(nil), "milvus.proto.schema.ArrayArray")
 	proto.RegisterType((*ScalarField)(nil), "milvus.proto.schema.ScalarField")
 	proto.RegisterType((*VectorField)(nil), "milvus.proto.schema.VectorField")
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
@@ -1038,66 +1193,75 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xf7, 0xf9, 0xfc, 0xe7, 0x6e, 0xce, 0x6d, 0x4f, 0xdb, 0xaa, 0x1c, 0x48, 0x6d, 0x5c, 0x0b,
+	0x90, 0x55, 0x89, 0x84, 0xa6, 0xa5, 0x94, 0x8a, 0x0a, 0x70, 0xad, 0x28, 0x26, 0x28, 0x84, 0x0b,
+	0xea, 0x03, 0x2f, 0xa7, 0xb3, 0x6f, 0x9b, 0x2c, 0xb9, 0xdb, 0x35, 0xb7, 0xeb, 0x08, 0xbf, 0xc3,
+	0x13, 0xaf, 0x7c, 0x04, 0x3e, 0x09, 0xcf, 0x7c, 0x06, 0xbe, 0x09, 0x12, 0xda, 0x9d, 0x3d, 0xdb,
+	0xc1, 0x8e, 0x15, 0xde, 0x66, 0x77, 0xe7, 0xf7, 0xbb, 0x99, 0x9d, 0xdf, 0xcc, 0x1e, 0x74, 0xe4,
+	0xe4, 0x9c, 0x16, 0xe9, 0xee, 0xb4, 0x14, 0x4a, 0x90, 0xbb, 0x05, 0xcb, 0x2f, 0x67, 0x12, 0x57,
+	0xbb, 0x78, 0xf4, 0x5e, 0x67, 0x22, 0x8a, 0x42, 0x70, 0xdc, 0xec, 0xfd, 0xe9, 0x42, 0x70, 0xc0,
+	0x68, 0x9e, 0x9d, 0x9a, 0x53, 0x12, 0x41, 0xfb, 0xad, 0x5e, 0x8e, 0x86, 0x91, 0xd3, 0x75, 0xfa,
+	0x6e, 0x5c, 0x2d, 0x09, 0x81, 0x06, 0x4f, 0x0b, 0x1a, 0xd5, 0xbb, 0x4e, 0xdf, 0x8f, 0x8d, 0x4d,
+	0xde, 0x87, 0xdb, 0x4c, 0x26, 0xd3, 0x92, 0x15, 0x69, 0x39, 0x4f, 0x2e, 0xe8, 0x3c, 0x72, 0xbb,
+	0x4e, 0xdf, 0x8b, 0x3b, 0x4c, 0x9e, 0xe0, 0xe6, 0x11, 0x9d, 0x93, 0x2e, 0x04, 0x19, 0x95, 0x93,
+	0x92, 0x4d, 0x15, 0x13, 0x3c, 0x6a, 0x18, 0x82, 0xd5, 0x2d, 0xf2, 0x12, 0xfc, 0x2c, 0x55, 0x69,
+	0xa2, 0xe6, 0x53, 0x1a, 0x35, 0xbb, 0x4e, 0xff, 0xf6, 0xfe, 0x83, 0xdd, 0x0d, 0xc1, 0xef, 0x0e,
+	0x53, 0x95, 0x7e, 0x3f, 0x9f, 0xd2, 0xd8, 0xcb, 0xac, 0x45, 0x06, 0x10, 0x68, 0x58, 0x32, 0x4d,
+	0xcb, 0xb4, 0x90, 0x51, 0xab, 0xeb, 0xf6, 0x83, 0xfd, 0x47, 0x57, 0xd1, 0x36, 0xe5, 0x23, 0x3a,
+	0x7f, 0x93, 0xe6, 0x33, 0x7a, 0x92, 0xb2, 0x32, 0x06, 0x8d, 0x3a, 0x31, 0x20, 0x32, 0x84, 0x0e,
+	0xe3, 0x19, 0xfd, 0xb9, 0x22, 0x69, 0xdf, 0x94, 0x24, 0x30, 0x30, 0xcb, 0x72, 0x1f, 0x5a, 0xe9,
+	0x4c, 0x89, 0xd1, 0x30, 0xf2, 0xcc, 0x2d, 0xd8, 0x15, 0xf9, 0x12, 0x3a, 0x34, 0xa7, 0x05, 0xe5,
+	0x0a, 0x13, 0xf4, 0x6f, 0x92, 0x60, 0x60, 0x21, 0x26, 0xc7, 0x07, 0x00, 0x4c, 0x26, 0xd9, 0x9c,
+	0xa7, 0x05, 0x9b, 0x44, 0x60, 0xd8, 0x7d, 0x26, 0x87, 0xb8, 0xd1, 0xfb, 0xcb, 0x81, 0xf0, 0xb5,
+	0xc8, 0x73, 0x3a, 0xd1, 0xb7, 0x69, 0x2b, 0x59, 0xd5, 0xcb, 0x59, 0xa9, 0xd7, 0x7f, 0x2a, 0x51,
+	0x5f, 0xaf, 0xc4, 0x32, 0x07, 0xf7, 0x4a, 0x0e, 0x2f, 0xa0, 0x65, 0x84, 0x20, 0xa3, 0x86, 0xb9,
+	0x9b, 0xee, 0xc6, 0xe8, 0x57, 0x94, 0x14, 0x5b, 0x7f, 0xf2, 0x31, 0xdc, 0xa3, 0x3c, 0x1d, 0xe7,
+	0xb4, 0x8a, 0x3f, 0x31, 0x07, 0xa6, 0xcc, 0x5e, 0x4c, 0xf0, 0xcc, 0x66, 0x62, 0xf0, 0xbd, 0x1d,
+	0xf0, 0x07, 0x42, 0xe4, 0x5f, 0x95, 0x65, 0x3a, 0xd7, 0x69, 0xe8, 0x52, 0x47, 0x4e, 0xd7, 0xed,
+	0x7b, 0xb1, 0xb1, 0x7b, 0x0f, 0xc1, 0x1b, 0x71, 0xb5, 0x7e, 0xde, 0xb4, 0xe7, 0x3b, 0xe0, 0x7f,
+	0x23, 0xf8, 0xd9, 0xba, 0x83, 0x6b, 0x1d, 0xba, 0x00, 0x07, 0xb9, 0x48, 0x37, 0x50, 0xd4, 0xad,
+	0xc7, 0x23, 0x08, 0x86, 0x62, 0x36, 0xce, 0xe9, 0xba, 0x8b, 0xb3, 0x24, 0x19, 0xcc, 0x15, 0x95,
+	0xeb, 0x1e, 0x9d, 0x25, 0xc9, 0xa9, 0x2a, 0xd9, 0xa6, 0x48, 0xfc, 0x65, 0xa8, 0x5f, 0x9f, 0x7e,
+	0x7b, 0x7c, 0x3d, 0xc7, 0x2f, 0x0e, 0x80, 0x39, 0x45, 0x97, 0x67, 0x2b, 0x2e, 0xd7, 0x55, 0xe1,
+	0x74, 0x92, 0xe6, 0x69, 0x69, 0xee, 0x12, 0x49, 0xd6, 0x14, 0x58, 0xff, 0xbf, 0x0a, 0xec, 0xfd,
+	0xdd, 0x80, 0x60, 0x85, 0x97, 0xbc, 0x02, 0x7f, 0x2c, 0x44, 0x9e, 0xd8, 0x60, 0x9c, 0x7e, 0xb0,
+	0xff, 0x70, 0x23, 0xdd, 0xa2, 0x92, 0x87, 0xb5, 0xd8, 0xd3, 0x10, 0xcd, 0x4f, 0x5e, 0x82, 0xc7,
+	0xb8, 0x42, 0x74, 0xdd, 0xa0, 0x37, 0x07, 0x53, 0x95, 0xf9, 0xb0, 0x16, 0xb7, 0x19, 0x57, 0x06,
+	0xfb, 0x0a, 0xfc, 0x5c, 0xf0, 0x33, 0x04, 0xbb, 0x5b, 0x3e, 0xbd, 0xd0, 0x80, 0xfe, 0xb4, 0x86,
+	0x0c, 0xf1, 0x2e, 0xe0, 0xad, 0xae, 0x3d, 0xe2, 0x1b, 0x06, 0xbf, 0xb3, 0x59, 0xcd, 0x0b, 0x89,
+	0x1c, 0xd6, 0x62, 0xdf, 0x80, 0x0c, 0xc3, 0x6b, 0x08, 0x32, 0xa3, 0x0d, 0xa4, 0x68, 0x76, 0x9d,
+	0x6b, 0x4b, 0xb1, 0xa2, 0xa1, 0xc3, 0x5a, 0x0c, 0x08, 0xab, 0x48, 0xa4, 0xd1, 0x06, 0x92, 0xb4,
+	0xb6, 0x90, 0xac, 0x68, 0x48, 0x93, 0x20, 0xac, 0xca, 0x65, 0xac, 0x25, 0x88, 0x1c, 0xed, 0x2d,
+	0xb9, 0x2c, 0x95, 0xaa, 0x73, 0x31, 0xa0, 0x8a, 0x21, 0xd5, 0xbb, 0xc8, 0xe0, 0x6d, 0x61, 0x58,
+	0x8a, 0x50, 0x33, 0x18, 0x50, 0x55, 0x8e, 0x1f, 0xa5, 0xe0, 0x48, 0xe0, 0x6f, 0x29, 0xc7, 0x42,
+	0xe7, 0xba, 0x1c, 0x1a, 0xa2, 0xe1, 0x83, 0x16, 0x0a, 0xba, 0xf7, 0xbb, 0x03, 0xc1, 0x1b, 0x3a,
+	0x51, 0xc2, 0x0a, 0x2c, 0x04, 0x37, 0x63, 0x85, 0x7d, 0x84, 0xb4, 0xa9, 0x87, 0x34, 0x16, 0xee,
+	0xd2, 0xb8, 0x45, 0xf5, 0x2d, 0xc1, 0x5e, 0x29, 0x5d, 0x60, 0x60, 0x48, 0x4e, 0x3e, 0x80, 0x5b,
+	0x63, 0xc6, 0xf5, 0x73, 0x65, 0x69, 0xb4, 0x82, 0x3a, 0x87, 0xb5, 0xb8, 0x83, 0xdb, 0xe8, 0xb6,
+	0x08, 0xeb, 0x1f, 0x07, 0x7c, 0x13, 0x90, 0xc9, 0xf5, 0x09, 0x34, 0x4c, 0xff, 0x38, 0x37, 0xe9,
+	0x9f, 0x86, 0xb2, 0xa3, 0xdb, 0xcc, 0xbb, 0x64, 0xe5, 0xf1, 0xf4, 0xcd, 0xce, 0xb1, 0x9e, 0xc8,
+	0x9f, 0x43, 0x5b, 0x9a, 0xb6, 0x92, 0x91, 0xbb, 0x4d, 0x02, 0xcb, 0xd6, 0xd3, 0xad, 0x60, 0x21,
+	0x1a, 0x8d, 0x59, 0xc8, 0xa8, 0xb1, 0x05, 0xbd, 0x72, 0xaf, 0x1a, 0x6d, 0x21, 0xe4, 0x5d, 0xf0,
+	0x30, 0x34, 0x86, 0xd3, 0x78, 0xf1, 0xd8, 0x67, 0x83, 0x36, 0x34, 0x8d, 0xd9, 0xfb, 0xd5, 0x01,
+	0x77, 0x34, 0x94, 0xe4, 0x53, 0x68, 0xe9, 0x86, 0x65, 0x59, 0xe4, 0xdc, 0xb0, 0xe3, 0x9a, 0x8c,
+	0xab, 0x51, 0x46, 0x3e, 0x83, 0x96, 0x54, 0xa5, 0x06, 0xd6, 0x6f, 0x2c, 0xf1, 0xa6, 0x54, 0xe5,
+	0x28, 0x1b, 0x00, 0x78, 0x2c, 0xc3, 0xd7, 0xa2, 0xf7, 0x5b, 0x1d, 0xc2, 0x53, 0x9a, 0x96, 0x93,
+	0xf3, 0x98, 0xca, 0x59, 0x8e, 0x8d, 0xb8, 0x03, 0x01, 0x9f, 0x15, 0xc9, 0x4f, 0x33, 0x5a, 0x32,
+	0x2a, 0xad, 0x56, 0x80, 0xcf, 0x8a, 0xef, 0x70, 0x87, 0xdc, 0x85, 0xa6, 0x12, 0xd3, 0xe4, 0xc2,
+	0x7c, 0xdb, 0x8d, 0x1b, 0x4a, 0x4c, 0x8f, 0xc8, 0x17, 0x10, 0xe0, 0xd3, 0x54, 0x4d, 0x10, 0xf7,
+	0xda, 0x7c, 0x16, 0x95, 0x8f, 0xb1, 0x88, 0xd8, 0x33, 0xf7, 0xa1, 0x25, 0x27, 0xa2, 0xa4, 0xf8,
+	0x16, 0xd6, 0x63, 0xbb, 0x22, 0x8f, 0xc1, 0x65, 0x99, 0xb4, 0xf3, 0x20, 0xda, 0x3c, 0xcf, 0x86,
+	0x32, 0xd6, 0x4e, 0xe4, 0x9e, 0x89, 0xec, 0x02, 0xff, 0x57, 0xdc, 0x18, 0x17, 0xe4, 0x43, 0xb8,
+	0x73, 0x56, 0x8a, 0xd9, 0x34, 0x19, 0xcf, 0x93, 0x4b, 0xfd, 0x93, 0x81, 0xbf, 0x22, 0x6e, 0x7c,
+	0xcb, 0x6c, 0x0f, 0xf0, 0xcf, 0x43, 0x3e, 0xfe, 0xc3, 0x01, 0xaf, 0xd2, 0x19, 0xf1, 0xa0, 0x71,
+	0x2c, 0x38, 0x0d, 0x6b, 0xda, 0xd2, 0xe3, 0x36, 0x74, 0xb4, 0x35, 0xe2, 0xea, 0x45, 0x58, 0x27,
+	0x3e, 0x34, 0x47, 0x5c, 0x3d, 0x79, 0x1e, 0xba, 0xd6, 0x7c, 0xba, 0x1f, 0x36, 0xac, 0xf9, 0xfc,
+	0x59, 0xd8, 0xd4, 0xa6, 0xe9, 0x96, 0x10, 0x08, 0x40, 0x0b, 0x07, 0x56, 0x18, 0x68, 0x1b, 0x8b,
+	0x12, 0xde, 0xd3, 0x2e, 0xa6, 0x34, 0xe1, 0x7d, 0x4d, 0xac, 0xfb, 0x38, 0x7c, 0x87, 0x84, 0xd0,
+	0x19, 0xac, 0x74, 0x4c, 0x98, 0x91, 0x3b, 0x10, 0x1c, 0x2c, 0x3b, 0x2d, 0xa4, 0x83, 0x4f, 0x7e,
+	0x78, 0x7a, 0xc6, 0xd4, 0xf9, 0x6c, 0xac, 0xff, 0x9d, 0xf6, 0xf0, 0x3e, 0x3e, 0x62, 0xc2, 0x5a,
+	0x7b, 0x8c, 0x2b, 0x5a, 0xf2, 0x34, 0xdf, 0x33, 0x57, 0xb4, 0x87, 0x57, 0x34, 0x1d, 0x8f, 0x5b,
+	0x66, 0xfd, 0xf4, 0xdf, 0x01, 0x00, 0xc7, 0xaa, 0x29, 0x0f, 0xcd, 0x0a, 0x00, 0x00,
 }
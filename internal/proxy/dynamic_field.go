@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// DynamicFieldName is the name of the hidden json field of a collection with enable_dynamic_field,
+// it isn't a valid field name so it never conflicts with the fields of the schema
+const DynamicFieldName = "$meta"
+
+// newDynamicField returns the hidden json field appended to the schema of a collection with enable_dynamic_field,
+// its max_length is proxy.dynamicFieldMaxLength since users can't declare the field
+func newDynamicField() *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{
+		Name:        DynamicFieldName,
+		Description: "dynamic field",
+		DataType:    schemapb.DataType_JSON,
+		IsDynamic:   true,
+		TypeParams: []*commonpb.KeyValuePair{
+			{
+				Key:   MaxLengthKey,
+				Value: strconv.FormatInt(Params.DynamicFieldMaxLength, 10),
+			},
+		},
+	}
+}
+
+// dynamicFieldValues returns the values of a field not in the schema, one per row
+func dynamicFieldValues(fieldData *schemapb.FieldData) ([]interface{}, error) {
+	scalars := fieldData.GetScalars()
+	if scalars == nil {
+		return nil, fmt.Errorf("field %s not in the schema can only be a scalar field", fieldData.FieldName)
+	}
+
+	var values []interface{}
+	switch data := scalars.Data.(type) {
+	case *schemapb.ScalarField_BoolData:
+		for _, v := range data.BoolData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_IntData:
+		for _, v := range data.IntData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_LongData:
+		for _, v := range data.LongData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_FloatData:
+		for _, v := range data.FloatData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_DoubleData:
+		for _, v := range data.DoubleData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_StringData:
+		for _, v := range data.StringData.Data {
+			values = append(values, v)
+		}
+	case *schemapb.ScalarField_JsonData:
+		for i, v := range data.JsonData.Data {
+			if !json.Valid(v) {
+				return nil, fmt.Errorf("invalid json value of field %s at row %d", fieldData.FieldName, i)
+			}
+			values = append(values, json.RawMessage(v))
+		}
+	case *schemapb.ScalarField_ArrayData:
+		for _, v := range data.ArrayData.Data {
+			encoded, err := typeutil.EncodeArray(v, data.ArrayData.ElementType)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, json.RawMessage(encoded))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data of field %s not in the schema", fieldData.FieldName)
+	}
+	return values, nil
+}
+
+// packDynamicFieldData moves the fields not in the schema into a json object per row,
+// which is inserted as the data of the dynamic field
+func packDynamicFieldData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, numRows int) ([]*schemapb.FieldData, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	dynamicField, err := helper.GetDynamicField()
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetJSONMaxLength(dynamicField)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]map[string]interface{}, numRows)
+	for i := range rows {
+		rows[i] = make(map[string]interface{})
+	}
+	ret := make([]*schemapb.FieldData, 0, len(fieldsData)+1)
+	for _, fieldData := range fieldsData {
+		if fieldData.FieldName == dynamicField.Name {
+			return nil, fmt.Errorf("field name %s is reserved for the dynamic field", dynamicField.Name)
+		}
+		if _, err := helper.GetFieldFromName(fieldData.FieldName); err == nil {
+			ret = append(ret, fieldData)
+			continue
+		}
+		values, err := dynamicFieldValues(fieldData)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != numRows {
+			return nil, fmt.Errorf("the num_rows (%d) of field %s is not equal to passed num_rows (%d)", len(values), fieldData.FieldName, numRows)
+		}
+		for i, value := range values {
+			rows[i][fieldData.FieldName] = value
+		}
+	}
+
+	data := make([][]byte, 0, numRows)
+	for i, row := range rows {
+		value, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		if len(value) > maxLength {
+			return nil, fmt.Errorf("the length %d of the fields not in the schema at row %d exceeds max_length %d of the dynamic field %s",
+				len(value), i, maxLength, dynamicField.Name)
+		}
+		data = append(data, value)
+	}
+	ret = append(ret, &schemapb.FieldData{
+		Type:      schemapb.DataType_JSON,
+		FieldName: dynamicField.Name,
+		FieldId:   dynamicField.FieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{
+					JsonData: &schemapb.JSONArray{
+						Data: data,
+					},
+				},
+			},
+		},
+	})
+	return ret, nil
+}
+
+// splitDynamicOutputFields replaces the output fields not in the schema by the dynamic field,
+// and returns them as the keys to unpack from it
+func splitDynamicOutputFields(outputFields []string, schema *schemapb.CollectionSchema) ([]string, []string, error) {
+	if !schema.EnableDynamicField {
+		return outputFields, nil, nil
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicField, err := helper.GetDynamicField()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := make([]string, 0, len(outputFields))
+	var dynamicKeys []string
+	hasDynamicField := false
+	for _, name := range outputFields {
+		name = strings.TrimSpace(name)
+		if _, err := helper.GetFieldFromName(name); err == nil || name == "*" || name == "%" {
+			fields = append(fields, name)
+			continue
+		}
+		dynamicKeys = append(dynamicKeys, name)
+		if !hasDynamicField {
+			fields = append(fields, dynamicField.Name)
+			hasDynamicField = true
+		}
+	}
+	return fields, dynamicKeys, nil
+}
+
+// unpackDynamicFieldData keeps only the dynamicKeys in the rows of the dynamic field, all the keys are kept if none
+// are given. The dynamic field is still returned as one json field, so no two fields share a field id
+func unpackDynamicFieldData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, dynamicKeys []string) ([]*schemapb.FieldData, error) {
+	if !schema.EnableDynamicField || len(dynamicKeys) == 0 {
+		return fieldsData, nil
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	dynamicField, err := helper.GetDynamicField()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fieldData := range fieldsData {
+		if fieldData.FieldId != dynamicField.FieldID {
+			continue
+		}
+		jsonData := fieldData.GetScalars().GetJsonData()
+		if jsonData == nil {
+			return nil, fmt.Errorf("invalid data of the dynamic field %s", dynamicField.Name)
+		}
+		for i, value := range jsonData.Data {
+			row := make(map[string]json.RawMessage)
+			if err := json.Unmarshal(value, &row); err != nil {
+				return nil, err
+			}
+			picked := make(map[string]json.RawMessage, len(dynamicKeys))
+			for _, key := range dynamicKeys {
+				if v, ok := row[key]; ok {
+					picked[key] = v
+				}
+			}
+			if jsonData.Data[i], err = json.Marshal(picked); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fieldsData, nil
+}
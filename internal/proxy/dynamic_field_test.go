@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDynamicTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "dynamic",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    101,
+				Name:       DynamicFieldName,
+				DataType:   schemapb.DataType_JSON,
+				IsDynamic:  true,
+				TypeParams: []*commonpb.KeyValuePair{{Key: MaxLengthKey, Value: "64"}},
+			},
+		},
+		EnableDynamicField: true,
+	}
+}
+
+func newDynamicTestFieldData(name string, scalars *schemapb.ScalarField) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		FieldName: name,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+}
+
+func TestPackDynamicFieldData(t *testing.T) {
+	schema := newDynamicTestSchema()
+	fieldsData := []*schemapb.FieldData{
+		newDynamicTestFieldData("pk", &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+		}),
+		newDynamicTestFieldData("color", &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"red", "blue"}}},
+		}),
+		newDynamicTestFieldData("size", &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{1.5, 2}}},
+		}),
+	}
+
+	packed, err := packDynamicFieldData(schema, fieldsData, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(packed))
+	assert.Equal(t, "pk", packed[0].FieldName)
+	assert.Equal(t, DynamicFieldName, packed[1].FieldName)
+	assert.Equal(t, int64(101), packed[1].FieldId)
+	assert.Equal(t, [][]byte{[]byte(`{"color":"red","size":1.5}`), []byte(`{"color":"blue","size":2}`)},
+		packed[1].GetScalars().GetJsonData().GetData())
+
+	// row nums mismatch
+	_, err = packDynamicFieldData(schema, fieldsData, 3)
+	assert.NotNil(t, err)
+
+	// the dynamic field is reserved
+	_, err = packDynamicFieldData(schema, append(fieldsData, newDynamicTestFieldData(DynamicFieldName, &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{}`), []byte(`{}`)}}},
+	})), 2)
+	assert.NotNil(t, err)
+
+	// vectors can't be dynamic fields
+	_, err = packDynamicFieldData(schema, append(fieldsData, &schemapb.FieldData{
+		FieldName: "vec",
+		Field:     &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{Dim: 1}},
+	}), 2)
+	assert.NotNil(t, err)
+
+	// the packed row is longer than max_length of the dynamic field
+	_, err = packDynamicFieldData(schema, append(fieldsData, newDynamicTestFieldData("text", &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"short", strings.Repeat("a", 64)}}},
+	})), 2)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "row 1")
+	assert.Contains(t, err.Error(), DynamicFieldName)
+}
+
+func TestNewDynamicField(t *testing.T) {
+	field := newDynamicField()
+	maxLength, err := typeutil.GetJSONMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, Params.DynamicFieldMaxLength, int64(maxLength))
+	assert.LessOrEqual(t, Params.DynamicFieldMaxLength, Params.MaxJSONLength)
+}
+
+func TestSplitDynamicOutputFields(t *testing.T) {
+	schema := newDynamicTestSchema()
+	fields, keys, err := splitDynamicOutputFields([]string{"pk", "color", "size"}, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"pk", DynamicFieldName}, fields)
+	assert.Equal(t, []string{"color", "size"}, keys)
+
+	fields, keys, err = splitDynamicOutputFields([]string{"*"}, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"*"}, fields)
+	assert.Nil(t, keys)
+
+	schema.EnableDynamicField = false
+	fields, keys, err = splitDynamicOutputFields([]string{"pk", "color"}, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"pk", "color"}, fields)
+	assert.Nil(t, keys)
+}
+
+func TestUnpackDynamicFieldData(t *testing.T) {
+	schema := newDynamicTestSchema()
+	newFieldsData := func(rows ...string) []*schemapb.FieldData {
+		data := make([][]byte, 0, len(rows))
+		for _, row := range rows {
+			data = append(data, []byte(row))
+		}
+		return []*schemapb.FieldData{
+			{
+				FieldName: "pk",
+				FieldId:   100,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				}},
+			},
+			{
+				FieldName: DynamicFieldName,
+				FieldId:   101,
+				Type:      schemapb.DataType_JSON,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: data}},
+				}},
+			},
+		}
+	}
+
+	unpacked, err := unpackDynamicFieldData(schema, newFieldsData(`{"color":"red","size":1.5}`, `{"size":2}`), []string{"color"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(unpacked))
+	assert.Equal(t, DynamicFieldName, unpacked[1].FieldName)
+	assert.Equal(t, int64(101), unpacked[1].FieldId)
+	assert.Equal(t, schemapb.DataType_JSON, unpacked[1].Type)
+	assert.Equal(t, [][]byte{[]byte(`{"color":"red"}`), []byte(`{}`)}, unpacked[1].GetScalars().GetJsonData().GetData())
+
+	unpacked, err = unpackDynamicFieldData(schema, newFieldsData(`{"color":"red","size":1.5}`, `{"size":2}`), []string{"size", "color"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"color":"red","size":1.5}`), []byte(`{"size":2}`)}, unpacked[1].GetScalars().GetJsonData().GetData())
+
+	// all the keys are kept if none are given
+	fieldsData := newFieldsData(`{"color":"red","size":1.5}`, `{"size":2}`)
+	unpacked, err = unpackDynamicFieldData(schema, fieldsData, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fieldsData, unpacked)
+
+	// no two fields share a field id
+	ids := make(map[int64]struct{})
+	for _, fieldData := range unpacked {
+		ids[fieldData.FieldId] = struct{}{}
+	}
+	assert.Equal(t, len(unpacked), len(ids))
+
+	fieldsData = newFieldsData(`{"color":"red"}`)[:1]
+	unpacked, err = unpackDynamicFieldData(schema, fieldsData, []string{"color"})
+	assert.Nil(t, err)
+	assert.Equal(t, fieldsData, unpacked)
+
+	_, err = unpackDynamicFieldData(schema, newFieldsData(`{"color":`), []string{"color"})
+	assert.NotNil(t, err)
+
+	schema.EnableDynamicField = false
+	fieldsData = newFieldsData(`{"color":"red"}`)
+	unpacked, err = unpackDynamicFieldData(schema, fieldsData, []string{"color"})
+	assert.Nil(t, err)
+	assert.Equal(t, fieldsData, unpacked)
+}
@@ -16,7 +16,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -104,6 +106,9 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}
 
 	_ = node.chMgr.removeDQLStream(request.CollectionID)
+	if node.shardMgr != nil {
+		node.shardMgr.clearShardLeaders(request.CollectionID)
+	}
 
 	log.Debug("ReleaseDQLMessageStream Done",
 		zap.Any("role", Params.RoleName),
@@ -1245,21 +1250,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
-	qt := &SearchTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		SearchRequest: &internalpb.SearchRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_Search,
-				SourceID: Params.ProxyID,
-			},
-			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
-		},
-		resultBuf: make(chan []*internalpb.SearchResults),
-		query:     request,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
-	}
+	qt := newSearchTask(ctx, request, node.chMgr, node.queryCoord, node.shardMgr)
 
 	err := node.sched.DqQueue.Enqueue(qt)
 	if err != nil {
@@ -1271,7 +1262,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		}, nil
 	}
 
-	log.Debug("Search",
+	log.Ctx(ctx).Debug("Search",
 		zap.String("role", Params.RoleName),
 		zap.Int64("msgID", qt.Base.MsgID),
 		zap.Uint64("timestamp", qt.Base.Timestamp),
@@ -1282,7 +1273,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		zap.Any("len(PlaceholderGroup)", len(request.PlaceholderGroup)),
 		zap.Any("OutputFields", request.OutputFields))
 	defer func() {
-		log.Debug("Search Done",
+		log.Ctx(ctx).Debug("Search Done",
 			zap.Error(err),
 			zap.String("role", Params.RoleName),
 			zap.Int64("msgID", qt.Base.MsgID),
@@ -1296,7 +1287,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	}()
 
 	err = qt.WaitToFinish()
-	log.Debug("Search Finished",
+	log.Ctx(ctx).Debug("Search Finished",
 		zap.Error(err),
 		zap.String("role", Params.RoleName),
 		zap.Int64("msgID", qt.Base.MsgID),
@@ -1308,6 +1299,8 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		zap.Any("len(PlaceholderGroup)", len(request.PlaceholderGroup)))
 
 	if err != nil {
+		// the task may be still running if it timed out, so the stages are not read
+		logSlowSearch(qt.Base.MsgID, request, qt.tr.ElapseSpan(), nil, err)
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -1316,9 +1309,71 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		}, nil
 	}
 
+	stageCosts := qt.stageCosts()
+	logSlowSearch(qt.Base.MsgID, request, qt.tr.ElapseSpan(), stageCosts, nil)
+	if request.Profile {
+		qt.result.Profile = stageCosts
+	}
 	return qt.result, nil
 }
 
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	hst := &HybridSearchTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		HybridSearchRequest: request,
+		chMgr:               node.chMgr,
+		qc:                  node.queryCoord,
+		shardMgr:            node.shardMgr,
+		dqQueue:             node.sched.DqQueue,
+	}
+
+	err := node.sched.DqQueue.Enqueue(hst)
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Ctx(ctx).Debug("HybridSearch",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", hst.Base.MsgID),
+		zap.Uint64("timestamp", hst.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("len(Requests)", len(request.Requests)),
+		zap.Any("RankParams", request.RankParams),
+		zap.Any("OutputFields", request.OutputFields))
+
+	err = hst.WaitToFinish()
+	log.Ctx(ctx).Debug("HybridSearch Finished",
+		zap.Error(err),
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", hst.Base.MsgID),
+		zap.Uint64("timestamp", hst.Base.Timestamp),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return hst.result, nil
+}
+
 func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveRequest) (*milvuspb.RetrieveResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.RetrieveResults{
@@ -1335,10 +1390,11 @@ func (node *Proxy) Retrieve(ctx context.Context, request *milvuspb.RetrieveReque
 			},
 			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 		},
-		resultBuf: make(chan []*internalpb.RetrieveResults),
+		resultBuf: make(chan []*internalpb.RetrieveResults, 1),
 		retrieve:  request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		shardMgr:  node.shardMgr,
 	}
 
 	err := node.sched.DqQueue.Enqueue(rt)
@@ -1508,10 +1564,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 				},
 				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 			},
-			resultBuf: make(chan []*internalpb.RetrieveResults),
+			resultBuf: make(chan []*internalpb.RetrieveResults, 1),
 			retrieve:  retrieveRequest,
 			chMgr:     node.chMgr,
 			qc:        node.queryCoord,
+			shardMgr:  node.shardMgr,
 		}
 
 		err := node.sched.DqQueue.Enqueue(rt)
@@ -1524,7 +1581,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			}, nil
 		}
 
-		log.Debug("Retrieve",
+		log.Ctx(ctx).Debug("Retrieve",
 			zap.String("role", Params.RoleName),
 			zap.Int64("msgID", rt.Base.MsgID),
 			zap.Uint64("timestamp", rt.Base.Timestamp),
@@ -1533,7 +1590,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			zap.Any("partitions", retrieveRequest.PartitionNames),
 			zap.Any("len(Ids)", len(retrieveRequest.Ids.IdField.(*schemapb.IDs_IntId).IntId.Data)))
 		defer func() {
-			log.Debug("Retrieve Done",
+			log.Ctx(ctx).Debug("Retrieve Done",
 				zap.Error(err),
 				zap.String("role", Params.RoleName),
 				zap.Int64("msgID", rt.Base.MsgID),
@@ -1901,6 +1958,83 @@ func (node *Proxy) getSegmentsOfCollection(ctx context.Context, dbName string, c
 	return ret, nil
 }
 
+// GetConfig returns the configs of proxy whose key has the prefix, along with the configs
+// saved in etcd, which include the configs of the other components
+func (node *Proxy) GetConfig(ctx context.Context, req *milvuspb.GetConfigRequest) (*milvuspb.GetConfigResponse, error) {
+	log.Debug("GetConfig",
+		zap.String("role", Params.RoleName),
+		zap.String("keyPrefix", req.KeyPrefix))
+
+	resp := &milvuspb.GetConfigResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	prefix := strings.ToLower(req.KeyPrefix)
+	configs := make(map[string]string)
+	keys, values, err := Params.LoadRange(prefix, prefix+"\xff", 0)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	for i, key := range keys {
+		configs[key] = values[i]
+	}
+	etcdConfigs, err := Params.LoadEtcdConfigs(ctx, prefix)
+	if err != nil {
+		log.Warn("GetConfig load etcd configs failed", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	for key, value := range etcdConfigs {
+		configs[key] = value
+	}
+
+	resp.Configs = make([]*commonpb.KeyValuePair, 0, len(configs))
+	for key, value := range configs {
+		resp.Configs = append(resp.Configs, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	sort.Slice(resp.Configs, func(i, j int) bool {
+		return resp.Configs[i].Key < resp.Configs[j].Key
+	})
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// SetConfig saves the config into etcd, every component watching it picks up the new value,
+// an empty value removes the config and the default value is used again
+func (node *Proxy) SetConfig(ctx context.Context, req *milvuspb.SetConfigRequest) (*commonpb.Status, error) {
+	log.Debug("SetConfig",
+		zap.String("role", Params.RoleName),
+		zap.String("key", req.Key),
+		zap.String("value", req.Value))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Key == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "config key is empty",
+		}, nil
+	}
+	if err := Params.SaveEtcdConfig(ctx, req.Key, req.Value); err != nil {
+		log.Warn("SetConfig save etcd config failed", zap.String("key", req.Key), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	failedResponse := &milvuspb.DummyResponse{
 		Response: `{"status": "fail"}`,
@@ -52,11 +52,18 @@ type ParamTable struct {
 	MaxNameLength              int64
 	MaxFieldNum                int64
 	MaxDimension               int64
+	MaxJSONLength              int64
+	DynamicFieldMaxLength      int64
 	DefaultPartitionName       string
 	DefaultIndexName           string
+	ShardQueryEnabled          bool
+	ShardQueryTimeout          time.Duration
+	MaxTaskNum                 int64
+	SlowQueryThreshold         time.Duration
 
 	PulsarMaxMessageSize int
 	Log                  log.Config
+	SlowQueryLog         log.Config
 	RoleName             string
 }
 
@@ -87,8 +94,14 @@ func (pt *ParamTable) initParams() {
 	pt.initMaxNameLength()
 	pt.initMaxFieldNum()
 	pt.initMaxDimension()
+	pt.initMaxJSONLength()
+	pt.initDynamicFieldMaxLength()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
+	pt.initShardQueryEnabled()
+	pt.initShardQueryTimeout()
+	pt.initMaxTaskNum()
+	pt.initSlowQueryThreshold()
 
 	pt.initPulsarMaxMessageSize()
 	pt.initRoleName()
@@ -126,6 +139,38 @@ func (pt *ParamTable) initTimeTickInterval() {
 	pt.TimeTickInterval = time.Duration(interval) * time.Millisecond
 }
 
+func (pt *ParamTable) initShardQueryEnabled() {
+	enabled, err := pt.Load("proxy.shardQuery.enabled")
+	if err != nil {
+		panic(err)
+	}
+	pt.ShardQueryEnabled, err = strconv.ParseBool(enabled)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pt *ParamTable) initShardQueryTimeout() {
+	timeoutStr, err := pt.Load("proxy.shardQuery.timeout")
+	if err != nil {
+		panic(err)
+	}
+	timeout, err := strconv.Atoi(timeoutStr)
+	if err != nil {
+		panic(err)
+	}
+	pt.ShardQueryTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initMaxTaskNum() {
+	pt.MaxTaskNum = pt.ParseInt64("proxy.maxTaskNum")
+}
+
+func (pt *ParamTable) initSlowQueryThreshold() {
+	threshold := pt.ParseInt64("proxy.slowQuery.threshold")
+	pt.SlowQueryThreshold = time.Duration(threshold) * time.Millisecond
+}
+
 func (pt *ParamTable) initProxySubName() {
 	prefix, err := pt.Load("msgChannel.subNamePrefix.proxySubNamePrefix")
 	if err != nil {
@@ -183,6 +228,17 @@ func (pt *ParamTable) initMaxDimension() {
 	pt.MaxDimension = maxDimension
 }
 
+func (pt *ParamTable) initMaxJSONLength() {
+	pt.MaxJSONLength = pt.ParseInt64("proxy.maxJSONLength")
+}
+
+func (pt *ParamTable) initDynamicFieldMaxLength() {
+	pt.DynamicFieldMaxLength = pt.ParseInt64("proxy.dynamicFieldMaxLength")
+	if pt.DynamicFieldMaxLength <= 0 || pt.DynamicFieldMaxLength > pt.MaxJSONLength {
+		pt.DynamicFieldMaxLength = pt.MaxJSONLength
+	}
+}
+
 func (pt *ParamTable) initDefaultPartitionName() {
 	name, err := pt.Load("common.defaultPartitionName")
 	if err != nil {
@@ -259,6 +315,13 @@ func (pt *ParamTable) initLogCfg() {
 	} else {
 		pt.Log.File.Filename = ""
 	}
+
+	// the slow queries are always logged whatever the log level is
+	pt.SlowQueryLog = pt.Log
+	pt.SlowQueryLog.Level = "info"
+	if len(rootPath) != 0 {
+		pt.SlowQueryLog.File.Filename = path.Join(rootPath, fmt.Sprintf("proxy-slow-query-%s.log", pt.Alias))
+	}
 }
 
 func (pt *ParamTable) initRoleName() {
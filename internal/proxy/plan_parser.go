@@ -69,6 +69,11 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 		}
 
 	case *ant_ast.BinaryNode:
+		// arithmetic on a field or a json path can't be folded, it's left to handleArithExpr
+		if isColumnNode(node.Left) || isColumnNode(node.Right) {
+			return
+		}
+
 		floatNodeLeft, leftFloat := node.Left.(*ant_ast.FloatNode)
 		integerNodeLeft, leftInteger := node.Left.(*ant_ast.IntegerNode)
 		floatNodeRight, rightFloat := node.Right.(*ant_ast.FloatNode)
@@ -245,6 +250,31 @@ func getCompareOpType(opStr string, reverse bool) planpb.OpType {
 	return op
 }
 
+func getArithOpType(opStr string) planpb.ArithOpType {
+	switch opStr {
+	case "+":
+		return planpb.ArithOpType_Add
+	case "-":
+		return planpb.ArithOpType_Sub
+	case "*":
+		return planpb.ArithOpType_Mul
+	case "/":
+		return planpb.ArithOpType_Div
+	case "%":
+		return planpb.ArithOpType_Mod
+	default:
+		return planpb.ArithOpType_Unknown
+	}
+}
+
+func isArithNode(node ant_ast.Node) (*ant_ast.BinaryNode, bool) {
+	binNode, ok := node.(*ant_ast.BinaryNode)
+	if !ok || getArithOpType(binNode.Operator) == planpb.ArithOpType_Unknown {
+		return nil, false
+	}
+	return binNode, true
+}
+
 func getLogicalOpType(opStr string) planpb.BinaryExpr_BinaryOp {
 	switch opStr {
 	case "&&", "and":
@@ -256,7 +286,429 @@ func getLogicalOpType(opStr string) planpb.BinaryExpr_BinaryOp {
 	}
 }
 
+// isColumnNode returns whether node is a field or a path of a json field
+func isColumnNode(node ant_ast.Node) bool {
+	switch node.(type) {
+	case *ant_ast.IdentifierNode, *ant_ast.IndexNode:
+		return true
+	default:
+		return false
+	}
+}
+
+// handleColumn returns the field and the keys of a column, the keys are empty unless it's a json path,
+// a field not in the schema is looked up as a path of the dynamic field
+func (context *ParserContext) handleColumn(node ant_ast.Node) (*schemapb.FieldSchema, []string, error) {
+	switch columnNode := context.rewriteDynamicField(node).(type) {
+	case *ant_ast.IdentifierNode:
+		field, err := context.handleIdentifier(columnNode)
+		return field, nil, err
+	case *ant_ast.IndexNode:
+		return context.handleJSONPath(columnNode)
+	default:
+		return nil, nil, fmt.Errorf("expr must be a field or a json path")
+	}
+}
+
+// handleJSONNumberValue converts a number constant used with a json path
+func (context *ParserContext) handleJSONNumberValue(nodeRaw *ant_ast.Node) (*planpb.GenericValue, error) {
+	switch (*nodeRaw).(type) {
+	case *ant_ast.IntegerNode, *ant_ast.FloatNode:
+		return context.handleJSONLeafValue(nodeRaw)
+	default:
+		return nil, fmt.Errorf("arithmetic on a json path needs number constants")
+	}
+}
+
+// checkIntegerRange checks that an integer constant fits the integer type of the field,
+// segcore casts it to the type of the field
+func checkIntegerRange(value *planpb.GenericValue, dataType schemapb.DataType) error {
+	intVal, ok := value.GetVal().(*planpb.GenericValue_Int64Val)
+	if !ok {
+		return nil
+	}
+	var min, max int64
+	switch dataType {
+	case schemapb.DataType_Int8:
+		min, max = math.MinInt8, math.MaxInt8
+	case schemapb.DataType_Int16:
+		min, max = math.MinInt16, math.MaxInt16
+	case schemapb.DataType_Int32:
+		min, max = math.MinInt32, math.MaxInt32
+	default:
+		return nil
+	}
+	if intVal.Int64Val < min || intVal.Int64Val > max {
+		return fmt.Errorf("constant %d is out of the range of %s", intVal.Int64Val, dataType.String())
+	}
+	return nil
+}
+
+// handleArithExpr returns the field, the keys if it's a json path, and the constant operand of
+// an arithmetic expr like `field * 0.9`
+func (context *ParserContext) handleArithExpr(node *ant_ast.BinaryNode) (*schemapb.FieldSchema, []string, *planpb.GenericValue, error) {
+	arithOp := getArithOpType(node.Operator)
+	columnNode := node.Left
+	operandNode := &node.Right
+	if !isColumnNode(columnNode) {
+		// only addition and multiplication are commutative
+		if columnNode = node.Right; !isColumnNode(columnNode) {
+			return nil, nil, nil, fmt.Errorf("arithmetic expr must be between a field and a constant")
+		}
+		if arithOp != planpb.ArithOpType_Add && arithOp != planpb.ArithOpType_Mul {
+			return nil, nil, nil, fmt.Errorf("field must be the left operand of %s", node.Operator)
+		}
+		operandNode = &node.Left
+	}
+	if isColumnNode(*operandNode) {
+		return nil, nil, nil, fmt.Errorf("arithmetic expr must be between a field and a constant")
+	}
+
+	field, keys, err := context.handleColumn(columnNode)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var operand *planpb.GenericValue
+	if field.DataType == schemapb.DataType_JSON {
+		// the type of a json value is only known at runtime, the operand only has to be a number
+		operand, err = context.handleJSONNumberValue(operandNode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if arithOp == planpb.ArithOpType_Mod && operand.GetFloatVal() != 0 {
+			return nil, nil, nil, fmt.Errorf("modulo of a json path needs an integer operand")
+		}
+	} else {
+		if !typeutil.IsIntergerType(field.DataType) && !typeutil.IsFloatingType(field.DataType) {
+			return nil, nil, nil, fmt.Errorf("arithmetic is not supported on field %s of type %s", field.Name, field.DataType.String())
+		}
+		if arithOp == planpb.ArithOpType_Mod && !typeutil.IsIntergerType(field.DataType) {
+			return nil, nil, nil, fmt.Errorf("modulo is not supported on field %s of type %s", field.Name, field.DataType.String())
+		}
+		operand, err = context.handleLeafValue(operandNode, field.DataType)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := checkIntegerRange(operand, field.DataType); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if arithOp == planpb.ArithOpType_Div || arithOp == planpb.ArithOpType_Mod {
+		if operand.GetInt64Val() == 0 && operand.GetFloatVal() == 0 {
+			return nil, nil, nil, fmt.Errorf("number divide by zero")
+		}
+	}
+	return field, keys, operand, nil
+}
+
+func (context *ParserContext) createArithCmpExpr(arithNode *ant_ast.BinaryNode, valueNode *ant_ast.Node, operator string, isReversed bool) (*planpb.Expr, error) {
+	field, keys, operand, err := context.handleArithExpr(arithNode)
+	if err != nil {
+		return nil, err
+	}
+
+	var val *planpb.GenericValue
+	if field.DataType == schemapb.DataType_JSON {
+		val, err = context.handleJSONNumberValue(valueNode)
+	} else if val, err = context.handleLeafValue(valueNode, field.DataType); err == nil {
+		err = checkIntegerRange(val, field.DataType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	op := getCompareOpType(operator, isReversed)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+
+	columnInfo := context.createColumnInfo(field)
+	columnInfo.NestedPath = keys
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
+			BinaryArithOpEvalRangeExpr: &planpb.BinaryArithOpEvalRangeExpr{
+				ColumnInfo:   columnInfo,
+				ArithOp:      getArithOpType(arithNode.Operator),
+				RightOperand: operand,
+				Op:           op,
+				Value:        val,
+			},
+		},
+	}
+	return expr, nil
+}
+
+// handleJSONPath returns the json field and the keys of a path expr like `meta["a"]["b"]`
+func (context *ParserContext) handleJSONPath(node *ant_ast.IndexNode) (*schemapb.FieldSchema, []string, error) {
+	var keys []string
+	var curNode ant_ast.Node = node
+	for {
+		indexNode, ok := curNode.(*ant_ast.IndexNode)
+		if !ok {
+			break
+		}
+		keyNode, ok := indexNode.Index.(*ant_ast.StringNode)
+		if !ok {
+			return nil, nil, fmt.Errorf("keys of a json path must be strings")
+		}
+		keys = append([]string{keyNode.Value}, keys...)
+		curNode = indexNode.Node
+	}
+	idNode, ok := curNode.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, nil, fmt.Errorf("json path must start with a field")
+	}
+	field, err := context.schema.GetFieldFromName(idNode.Value)
+	if err != nil {
+		// a field not in the schema is a key of the dynamic field
+		dynamicField, dynamicErr := context.schema.GetDynamicField()
+		if dynamicErr != nil {
+			return nil, nil, err
+		}
+		field = dynamicField
+		keys = append([]string{idNode.Value}, keys...)
+	}
+	if field.DataType != schemapb.DataType_JSON {
+		return nil, nil, fmt.Errorf("path is not supported on field %s of type %s", field.Name, field.DataType.String())
+	}
+	return field, keys, nil
+}
+
+func (context *ParserContext) createJSONCmpExpr(pathNode *ant_ast.IndexNode, valueNode *ant_ast.Node, operator string, isReversed bool) (*planpb.Expr, error) {
+	field, keys, err := context.handleJSONPath(pathNode)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := context.handleJSONLeafValue(valueNode)
+	if err != nil {
+		return nil, err
+	}
+
+	op := getCompareOpType(operator, isReversed)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+
+	columnInfo := context.createColumnInfo(field)
+	columnInfo.NestedPath = keys
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: columnInfo,
+				Op:         op,
+				Value:      val,
+			},
+		},
+	}
+	return expr, nil
+}
+
+// handleArrayField returns the array field passed as the first argument of an array function,
+// or the json field and the keys if it's a json path holding arrays
+func (context *ParserContext) handleArrayField(node *ant_ast.FunctionNode) (*schemapb.FieldSchema, []string, error) {
+	if len(node.Arguments) == 0 {
+		return nil, nil, fmt.Errorf("%s needs an array field as the first argument", node.Name)
+	}
+	switch argNode := context.rewriteDynamicField(node.Arguments[0]).(type) {
+	case *ant_ast.IdentifierNode:
+		field, err := context.schema.GetFieldFromName(argNode.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if field.DataType != schemapb.DataType_Array {
+			return nil, nil, fmt.Errorf("%s is not supported on field %s of type %s", node.Name, field.Name, field.DataType.String())
+		}
+		return field, nil, nil
+	case *ant_ast.IndexNode:
+		return context.handleJSONPath(argNode)
+	default:
+		return nil, nil, fmt.Errorf("the first argument of %s must be a field", node.Name)
+	}
+}
+
+// handleArrayElement converts a constant compared with the elements of an array field or a json path
+func (context *ParserContext) handleArrayElement(nodeRaw *ant_ast.Node, field *schemapb.FieldSchema) (*planpb.GenericValue, error) {
+	if field.DataType == schemapb.DataType_JSON {
+		return context.handleJSONLeafValue(nodeRaw)
+	}
+	return context.handleArrayElementValue(nodeRaw, field.ElementType)
+}
+
+// handleArrayElementValue converts a constant compared with the elements of an array field
+func (context *ParserContext) handleArrayElementValue(nodeRaw *ant_ast.Node, elementType schemapb.DataType) (*planpb.GenericValue, error) {
+	switch node := (*nodeRaw).(type) {
+	case *ant_ast.StringNode:
+		if elementType != schemapb.DataType_String {
+			return nil, fmt.Errorf("type mismatch")
+		}
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: node.Value}}, nil
+	case *ant_ast.BoolNode:
+		if elementType != schemapb.DataType_Bool {
+			return nil, fmt.Errorf("type mismatch")
+		}
+		return &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: node.Value}}, nil
+	default:
+		return context.handleLeafValue(nodeRaw, elementType)
+	}
+}
+
+func getArrayOpType(funcName string) planpb.ArrayContainsExpr_ArrayOp {
+	switch funcName {
+	case "array_contains":
+		return planpb.ArrayContainsExpr_Contains
+	case "array_contains_all":
+		return planpb.ArrayContainsExpr_ContainsAll
+	case "array_contains_any":
+		return planpb.ArrayContainsExpr_ContainsAny
+	default:
+		return planpb.ArrayContainsExpr_Invalid
+	}
+}
+
+// handleFunctionExpr handles the predicates on array fields like `array_contains(tags, "x")`
+func (context *ParserContext) handleFunctionExpr(node *ant_ast.FunctionNode) (*planpb.Expr, error) {
+	op := getArrayOpType(node.Name)
+	if op == planpb.ArrayContainsExpr_Invalid {
+		if node.Name == "array_length" {
+			return nil, fmt.Errorf("array_length can only be compared with a constant")
+		}
+		return nil, fmt.Errorf("unsupported function %s", node.Name)
+	}
+	field, keys, err := context.handleArrayField(node)
+	if err != nil {
+		return nil, err
+	}
+	if len(node.Arguments) != 2 {
+		return nil, fmt.Errorf("%s takes 2 arguments but %d are given", node.Name, len(node.Arguments))
+	}
+
+	var elements []*planpb.GenericValue
+	if op == planpb.ArrayContainsExpr_Contains {
+		val, err := context.handleArrayElement(&node.Arguments[1], field)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, val)
+	} else {
+		arrayNode, ok := node.Arguments[1].(*ant_ast.ArrayNode)
+		if !ok {
+			return nil, fmt.Errorf("the second argument of %s must be an array", node.Name)
+		}
+		for i := range arrayNode.Nodes {
+			val, err := context.handleArrayElement(&arrayNode.Nodes[i], field)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, val)
+		}
+	}
+
+	columnInfo := context.createColumnInfo(field)
+	columnInfo.NestedPath = keys
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_ArrayContainsExpr{
+			ArrayContainsExpr: &planpb.ArrayContainsExpr{
+				ColumnInfo: columnInfo,
+				Op:         op,
+				Elements:   elements,
+			},
+		},
+	}
+	return expr, nil
+}
+
+func (context *ParserContext) createArrayLengthCmpExpr(funcNode *ant_ast.FunctionNode, valueNode *ant_ast.Node, operator string, isReversed bool) (*planpb.Expr, error) {
+	if funcNode.Name != "array_length" {
+		return nil, fmt.Errorf("%s can not be compared", funcNode.Name)
+	}
+	field, keys, err := context.handleArrayField(funcNode)
+	if err != nil {
+		return nil, err
+	}
+	if len(funcNode.Arguments) != 1 {
+		return nil, fmt.Errorf("array_length takes 1 argument but %d are given", len(funcNode.Arguments))
+	}
+
+	val, err := context.handleLeafValue(valueNode, schemapb.DataType_Int64)
+	if err != nil {
+		return nil, err
+	}
+
+	op := getCompareOpType(operator, isReversed)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+
+	columnInfo := context.createColumnInfo(field)
+	columnInfo.NestedPath = keys
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
+			BinaryArithOpEvalRangeExpr: &planpb.BinaryArithOpEvalRangeExpr{
+				ColumnInfo: columnInfo,
+				ArithOp:    planpb.ArithOpType_ArrayLength,
+				Op:         op,
+				Value:      val,
+			},
+		},
+	}
+	return expr, nil
+}
+
+// rewriteDynamicField rewrites a field not in the schema to the path of it in the dynamic field,
+// e.g. `color` to `$meta["color"]`
+func (context *ParserContext) rewriteDynamicField(node ant_ast.Node) ant_ast.Node {
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok {
+		return node
+	}
+	if _, err := context.schema.GetFieldFromName(idNode.Value); err == nil {
+		return node
+	}
+	dynamicField, err := context.schema.GetDynamicField()
+	if err != nil {
+		return node
+	}
+	return &ant_ast.IndexNode{
+		Node:  &ant_ast.IdentifierNode{Value: dynamicField.Name},
+		Index: &ant_ast.StringNode{Value: idNode.Value},
+	}
+}
+
 func (context *ParserContext) createCmpExpr(left, right ant_ast.Node, operator string) (*planpb.Expr, error) {
+	left = context.rewriteDynamicField(left)
+	right = context.rewriteDynamicField(right)
+
+	funcNodeLeft, leftFuncNode := left.(*ant_ast.FunctionNode)
+	funcNodeRight, rightFuncNode := right.(*ant_ast.FunctionNode)
+	if leftFuncNode && rightFuncNode {
+		return nil, fmt.Errorf("array_length can only be compared with a constant")
+	} else if leftFuncNode {
+		return context.createArrayLengthCmpExpr(funcNodeLeft, &right, operator, false)
+	} else if rightFuncNode {
+		return context.createArrayLengthCmpExpr(funcNodeRight, &left, operator, true)
+	}
+
+	pathNodeLeft, leftPathNode := left.(*ant_ast.IndexNode)
+	pathNodeRight, rightPathNode := right.(*ant_ast.IndexNode)
+	if leftPathNode && rightPathNode {
+		return nil, fmt.Errorf("json paths can only be compared with a constant")
+	} else if leftPathNode {
+		return context.createJSONCmpExpr(pathNodeLeft, &right, operator, false)
+	} else if rightPathNode {
+		return context.createJSONCmpExpr(pathNodeRight, &left, operator, true)
+	}
+
+	arithNodeLeft, leftArithNode := isArithNode(left)
+	arithNodeRight, rightArithNode := isArithNode(right)
+	if leftArithNode && rightArithNode {
+		return nil, fmt.Errorf("arithmetic exprs can only be compared with a constant")
+	} else if leftArithNode {
+		return context.createArithCmpExpr(arithNodeLeft, &right, operator, false)
+	} else if rightArithNode {
+		return context.createArithCmpExpr(arithNodeRight, &left, operator, true)
+	}
+
 	idNodeLeft, leftIDNode := left.(*ant_ast.IdentifierNode)
 	idNodeRight, rightIDNode := right.(*ant_ast.IdentifierNode)
 
@@ -379,20 +831,32 @@ func (context *ParserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Ex
 	if node.Operator != "in" && node.Operator != "not in" {
 		return nil, fmt.Errorf("invalid operator(%s)", node.Operator)
 	}
-	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
-	if !ok {
-		return nil, fmt.Errorf("left operand of the InExpr must be identifier")
+	if !isColumnNode(node.Left) {
+		return nil, fmt.Errorf("left operand of the InExpr must be a field or a json path")
 	}
-	field, err := context.handleIdentifier(idNode)
+	field, keys, err := context.handleColumn(node.Left)
 	if err != nil {
 		return nil, err
 	}
+
+	var expr *planpb.Expr
+	if field.DataType == schemapb.DataType_JSON {
+		expr, err = context.createJSONInExpr(field, keys, &node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if node.Operator == "not in" {
+			return context.createNotExpr(expr)
+		}
+		return expr, nil
+	}
+
 	arrayData, err := context.handleArrayExpr(&node.Right, field.DataType)
 	if err != nil {
 		return nil, err
 	}
 
-	expr := &planpb.Expr{
+	expr = &planpb.Expr{
 		Expr: &planpb.Expr_TermExpr{
 			TermExpr: &planpb.TermExpr{
 				ColumnInfo: context.createColumnInfo(field),
@@ -407,6 +871,49 @@ func (context *ParserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Ex
 	return expr, nil
 }
 
+// createJSONInExpr expands `path in [a, b]` to `path == a || path == b`, as there's no TermExpr on json paths
+func (context *ParserContext) createJSONInExpr(field *schemapb.FieldSchema, keys []string, node *ant_ast.Node) (*planpb.Expr, error) {
+	arrayNode, ok := (*node).(*ant_ast.ArrayNode)
+	if !ok {
+		return nil, fmt.Errorf("right operand of the InExpr must be array")
+	}
+	if len(arrayNode.Nodes) == 0 {
+		return nil, fmt.Errorf("right operand of the InExpr on a json path can't be empty")
+	}
+	var expr *planpb.Expr
+	for i := range arrayNode.Nodes {
+		val, err := context.handleJSONLeafValue(&arrayNode.Nodes[i])
+		if err != nil {
+			return nil, err
+		}
+		columnInfo := context.createColumnInfo(field)
+		columnInfo.NestedPath = keys
+		equalExpr := &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: columnInfo,
+					Op:         planpb.OpType_Equal,
+					Value:      val,
+				},
+			},
+		}
+		if expr == nil {
+			expr = equalExpr
+			continue
+		}
+		expr = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalOr,
+					Left:  expr,
+					Right: equalExpr,
+				},
+			},
+		}
+	}
+	return expr, nil
+}
+
 func (context *ParserContext) combineUnaryRangeExpr(a, b *planpb.UnaryRangeExpr) *planpb.Expr {
 	if a.Op == planpb.OpType_LessEqual || a.Op == planpb.OpType_LessThan {
 		a, b = b, a
@@ -436,7 +943,7 @@ func (context *ParserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*pla
 	// handle multiple relational operator
 	for {
 		binNodeLeft, LeftOk := curNode.Left.(*ant_ast.BinaryNode)
-		if !LeftOk {
+		if _, isArith := isArithNode(curNode.Left); !LeftOk || isArith {
 			expr, err := context.handleCmpExpr(curNode)
 			if err != nil {
 				return nil, err
@@ -460,7 +967,9 @@ func (context *ParserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*pla
 	var lastExpr *planpb.UnaryRangeExpr
 	for i := len(exprs) - 1; i >= 0; i-- {
 		if expr, ok := exprs[i].Expr.(*planpb.Expr_UnaryRangeExpr); ok {
-			if lastExpr != nil && expr.UnaryRangeExpr.ColumnInfo.FieldId == lastExpr.ColumnInfo.FieldId {
+			// json fields only support UnaryRangeExpr, the bounds of them are connected by `&&`
+			if lastExpr != nil && expr.UnaryRangeExpr.ColumnInfo.FieldId == lastExpr.ColumnInfo.FieldId &&
+				expr.UnaryRangeExpr.ColumnInfo.DataType != schemapb.DataType_JSON {
 				binaryRangeExpr := context.combineUnaryRangeExpr(expr.UnaryRangeExpr, lastExpr)
 				exprs = append(exprs[0:i], append([]*planpb.Expr{binaryRangeExpr}, exprs[i+2:]...)...)
 				lastExpr = nil
@@ -560,10 +1069,35 @@ func (context *ParserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType sc
 	return gv, nil
 }
 
+// handleJSONLeafValue converts a constant compared with a json path, whose type is only known at runtime
+func (context *ParserContext) handleJSONLeafValue(nodeRaw *ant_ast.Node) (*planpb.GenericValue, error) {
+	switch node := (*nodeRaw).(type) {
+	case *ant_ast.FloatNode:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: node.Value}}, nil
+	case *ant_ast.IntegerNode:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(node.Value)}}, nil
+	case *ant_ast.BoolNode:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: node.Value}}, nil
+	case *ant_ast.StringNode:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: node.Value}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported leaf node")
+	}
+}
+
 func (context *ParserContext) handleIdentifier(node *ant_ast.IdentifierNode) (*schemapb.FieldSchema, error) {
 	fieldName := node.Value
 	field, err := context.schema.GetFieldFromName(fieldName)
-	return field, err
+	if err != nil {
+		return nil, err
+	}
+	if field.DataType == schemapb.DataType_JSON {
+		return nil, fmt.Errorf("json field %s can only be filtered by a path like %s[\"key\"]", fieldName, fieldName)
+	}
+	if field.DataType == schemapb.DataType_Array {
+		return nil, fmt.Errorf("array field %s can only be filtered by array_contains, array_contains_all, array_contains_any or array_length", fieldName)
+	}
+	return field, nil
 }
 
 func (context *ParserContext) handleUnaryExpr(node *ant_ast.UnaryNode) (*planpb.Expr, error) {
@@ -594,6 +1128,8 @@ func (context *ParserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, e
 		return expr, nil
 	case *ant_ast.BinaryNode:
 		return context.handleBinaryExpr(node)
+	case *ant_ast.FunctionNode:
+		return context.handleFunctionExpr(node)
 	default:
 		return nil, fmt.Errorf("unsupported node (%s)", node.Type().String())
 	}
@@ -137,6 +137,38 @@ func TestExprPlan_Str(t *testing.T) {
 	}
 }
 
+func TestExprPlan_MultipleVectorFields(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "image", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "text", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 102, Name: "hash", DataType: schemapb.DataType_BinaryVector},
+		{FieldID: 103, Name: "age", DataType: schemapb.DataType_Int64},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      true,
+		Fields:      fields,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	for _, field := range fields[:3] {
+		planProto, err := CreateQueryPlan(schema, "age > 10", field.Name, queryInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, field.FieldID, planProto.GetVectorAnns().GetFieldId())
+		assert.Equal(t, field.DataType == schemapb.DataType_BinaryVector, planProto.GetVectorAnns().GetIsBinary())
+	}
+
+	_, err := CreateQueryPlan(schema, "age > 10", "age", queryInfo)
+	assert.NotNil(t, err)
+}
+
 func TestExprMultiRange_Str(t *testing.T) {
 	exprStrs := []string{
 		"3 < FloatN < 4.0",
@@ -214,3 +246,354 @@ func TestExprFieldCompare_Str(t *testing.T) {
 		println(dbgStr)
 	}
 }
+
+func TestExprBinaryArithOp_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "price", DataType: schemapb.DataType_Double},
+		{FieldID: 103, Name: "count", DataType: schemapb.DataType_Int32},
+		{FieldID: 104, Name: "level", DataType: schemapb.DataType_Int8},
+		{FieldID: 105, Name: "rank", DataType: schemapb.DataType_Int16},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      true,
+		Fields:      fields,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	exprStrs := []string{
+		"price * 0.9 < 100",
+		"100 > price * 0.9",
+		"0.9 * price < 100",
+		"age + 1 == 10",
+		"age - 1 != 10",
+		"age / 2 >= 3 + 4",
+		"age % 3 == 1",
+		"1 < price / 2 < 10",
+		"age % 3 == 1 && price * 2 > 10",
+		"level + 127 > -128",
+		"rank * 2 < 32767",
+		"count - 2147483647 > 0",
+	}
+	for offset, exprStr := range exprStrs {
+		fmt.Printf("case %d: %s\n", offset, exprStr)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.Nil(t, err)
+		dbgStr := proto.MarshalTextString(planProto)
+		println(dbgStr)
+	}
+
+	planProto, err := CreateQueryPlan(schema, "100 > price * 0.9", "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr := planProto.GetVectorAnns().GetPredicates().GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, int64(102), expr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.ArithOpType_Mul, expr.GetArithOp())
+	assert.Equal(t, 0.9, expr.GetRightOperand().GetFloatVal())
+	assert.Equal(t, planpb.OpType_LessThan, expr.GetOp())
+	assert.Equal(t, float64(100), expr.GetValue().GetFloatVal())
+
+	planProto, err = CreateQueryPlan(schema, "age / 2 >= 3 + 4", "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr = planProto.GetVectorAnns().GetPredicates().GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, planpb.ArithOpType_Div, expr.GetArithOp())
+	assert.Equal(t, int64(2), expr.GetRightOperand().GetInt64Val())
+	assert.Equal(t, planpb.OpType_GreaterEqual, expr.GetOp())
+	assert.Equal(t, int64(7), expr.GetValue().GetInt64Val())
+
+	invalidExprStrs := []string{
+		"age + count > 10",
+		"age + 1 > count",
+		"age + 1 > price * 2",
+		"10 - age > 1",
+		"price % 2 == 1",
+		"age / 0 == 1",
+		"price / 0.0 == 1",
+		"age * 0.5 < 10",
+		"fakevec + 1 > 10",
+		"not_exist + 1 > 10",
+		// constants out of the range of the field type
+		"level + 300 > 0",
+		"level + 1 > 128",
+		"level - 1 < -129",
+		"rank * 40000 > 0",
+		"rank + 1 == 32768",
+		"count + 2147483648 > 0",
+	}
+	for _, exprStr := range invalidExprStrs {
+		_, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestExprJSONPath_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      true,
+		Fields:      fields,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	exprStrs := []string{
+		`meta["color"] == "red"`,
+		`meta["size"] > 3`,
+		`3 < meta["size"]`,
+		`meta["shape"]["round"] == true`,
+		`meta["price"] <= 9.9`,
+		`1 < meta["size"] < 5`,
+		`meta["color"] != "red" && age > 10`,
+		`not (meta["size"] >= 3)`,
+		`meta["size"] in [1, 2]`,
+		`meta["size"] * 2 > 3`,
+		`array_contains(meta["tags"], "x")`,
+	}
+	for offset, exprStr := range exprStrs {
+		fmt.Printf("case %d: %s\n", offset, exprStr)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.Nil(t, err)
+		dbgStr := proto.MarshalTextString(planProto)
+		println(dbgStr)
+	}
+
+	planProto, err := CreateQueryPlan(schema, `meta["color"] == "red"`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr := planProto.GetVectorAnns().GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, int64(102), expr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, schemapb.DataType_JSON, expr.GetColumnInfo().GetDataType())
+	assert.Equal(t, []string{"color"}, expr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_Equal, expr.GetOp())
+	assert.Equal(t, "red", expr.GetValue().GetStringVal())
+
+	planProto, err = CreateQueryPlan(schema, `3 < meta["shape"]["size"]`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr = planProto.GetVectorAnns().GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, []string{"shape", "size"}, expr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetOp())
+	assert.Equal(t, int64(3), expr.GetValue().GetInt64Val())
+
+	planProto, err = CreateQueryPlan(schema, `1 < meta["size"] < 5`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	binaryExpr := planProto.GetVectorAnns().GetPredicates().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	assert.NotNil(t, binaryExpr.GetLeft().GetUnaryRangeExpr())
+	assert.NotNil(t, binaryExpr.GetRight().GetUnaryRangeExpr())
+
+	invalidExprStrs := []string{
+		`meta == 1`,
+		`meta in [1, 2]`,
+		`meta + 1 > 2`,
+		`meta["size"] == meta["count"]`,
+		`meta["size"] in []`,
+		`meta["size"] * "x" > 3`,
+		`meta["size"] % 1.5 > 3`,
+		`meta[1] == 2`,
+		`age["size"] == 1`,
+		`not_exist["size"] == 1`,
+		`meta["size"] == age`,
+	}
+	for _, exprStr := range invalidExprStrs {
+		_, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestExprArray_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_String},
+		{FieldID: 103, Name: "scores", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      true,
+		Fields:      fields,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	exprStrs := []string{
+		`array_contains(tags, "x")`,
+		`array_contains_all(tags, ["x", "y"])`,
+		`array_contains_any(scores, [1, 2, 3])`,
+		`array_length(tags) > 2`,
+		`3 == array_length(scores)`,
+		`1 < array_length(tags) < 5`,
+		`array_contains(scores, 1) && age > 10`,
+		`not array_contains(tags, "x")`,
+	}
+	for offset, exprStr := range exprStrs {
+		fmt.Printf("case %d: %s\n", offset, exprStr)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.Nil(t, err, exprStr)
+		dbgStr := proto.MarshalTextString(planProto)
+		println(dbgStr)
+	}
+
+	planProto, err := CreateQueryPlan(schema, `array_contains_all(tags, ["x", "y"])`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr := planProto.GetVectorAnns().GetPredicates().GetArrayContainsExpr()
+	assert.Equal(t, int64(102), expr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAll, expr.GetOp())
+	assert.Equal(t, 2, len(expr.GetElements()))
+	assert.Equal(t, "y", expr.GetElements()[1].GetStringVal())
+
+	planProto, err = CreateQueryPlan(schema, `3 < array_length(scores)`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	arithExpr := planProto.GetVectorAnns().GetPredicates().GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, int64(103), arithExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.ArithOpType_ArrayLength, arithExpr.GetArithOp())
+	assert.Equal(t, planpb.OpType_GreaterThan, arithExpr.GetOp())
+	assert.Equal(t, int64(3), arithExpr.GetValue().GetInt64Val())
+
+	invalidExprStrs := []string{
+		`tags == 1`,
+		`tags in [1, 2]`,
+		`array_contains(tags, 1)`,
+		`array_contains(scores, "x")`,
+		`array_contains(age, 1)`,
+		`array_contains(tags)`,
+		`array_contains_any(tags, "x")`,
+		`array_length(tags)`,
+		`array_length(tags) > "x"`,
+		`array_length(tags) == array_length(scores)`,
+		`array_contains(tags, "x") > 1`,
+		`unknown_func(tags, "x")`,
+	}
+	for _, exprStr := range invalidExprStrs {
+		_, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestExprDynamicField_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:               "default-collection",
+		Description:        "",
+		AutoID:             true,
+		Fields:             fields,
+		EnableDynamicField: true,
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	}
+
+	exprStrs := []string{
+		`color == "red"`,
+		`3 < size`,
+		`1 < size < 5 && age > 10`,
+		`shape["round"] == true`,
+		`color in ["red", "blue"]`,
+		`color not in ["red"]`,
+		`price + 1 > 2`,
+		`2 * price <= 10`,
+		`array_contains(tags, "x")`,
+		`array_contains_any(tags, ["x", 1])`,
+		`array_length(tags) == 2`,
+	}
+	for offset, exprStr := range exprStrs {
+		fmt.Printf("case %d: %s\n", offset, exprStr)
+		planProto, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.Nil(t, err, exprStr)
+		dbgStr := proto.MarshalTextString(planProto)
+		println(dbgStr)
+	}
+
+	planProto, err := CreateQueryPlan(schema, `3 < size`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr := planProto.GetVectorAnns().GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, int64(102), expr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"size"}, expr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetOp())
+	assert.Equal(t, int64(3), expr.GetValue().GetInt64Val())
+
+	planProto, err = CreateQueryPlan(schema, `shape["round"] == true`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	expr = planProto.GetVectorAnns().GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, []string{"shape", "round"}, expr.GetColumnInfo().GetNestedPath())
+
+	planProto, err = CreateQueryPlan(schema, `color in ["red", "blue"]`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	orExpr := planProto.GetVectorAnns().GetPredicates().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalOr, orExpr.GetOp())
+	assert.Equal(t, []string{"color"}, orExpr.GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, "red", orExpr.GetLeft().GetUnaryRangeExpr().GetValue().GetStringVal())
+	assert.Equal(t, planpb.OpType_Equal, orExpr.GetRight().GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "blue", orExpr.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	planProto, err = CreateQueryPlan(schema, `price + 1 > 2`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	arithExpr := planProto.GetVectorAnns().GetPredicates().GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, int64(102), arithExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"price"}, arithExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.ArithOpType_Add, arithExpr.GetArithOp())
+	assert.Equal(t, int64(1), arithExpr.GetRightOperand().GetInt64Val())
+	assert.Equal(t, planpb.OpType_GreaterThan, arithExpr.GetOp())
+	assert.Equal(t, int64(2), arithExpr.GetValue().GetInt64Val())
+
+	planProto, err = CreateQueryPlan(schema, `array_contains(tags, "x")`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	containsExpr := planProto.GetVectorAnns().GetPredicates().GetArrayContainsExpr()
+	assert.Equal(t, int64(102), containsExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"tags"}, containsExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, "x", containsExpr.GetElements()[0].GetStringVal())
+
+	planProto, err = CreateQueryPlan(schema, `array_length(tags) == 2`, "fakevec", queryInfo)
+	assert.Nil(t, err)
+	arithExpr = planProto.GetVectorAnns().GetPredicates().GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, []string{"tags"}, arithExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.ArithOpType_ArrayLength, arithExpr.GetArithOp())
+
+	invalidExprStrs := []string{
+		`color in []`,
+		`price + "x" > 2`,
+		`price + 1 > "x"`,
+		`price + size > 2`,
+		`age + 1 > "x"`,
+	}
+	for _, exprStr := range invalidExprStrs {
+		_, err := CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+
+	schema.EnableDynamicField = false
+	for _, exprStr := range []string{`color == "red"`, `color in ["red"]`, `price + 1 > 2`, `array_contains(tags, "x")`} {
+		_, err = CreateQueryPlan(schema, exprStr, "fakevec", queryInfo)
+		assert.NotNil(t, err, exprStr)
+	}
+}
@@ -16,12 +16,15 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/metrics"
 
+	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
@@ -33,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -59,7 +63,8 @@ type Proxy struct {
 	dataCoord  types.DataCoord
 	queryCoord types.QueryCoord
 
-	chMgr channelsMgr
+	chMgr    channelsMgr
+	shardMgr *shardClientMgr
 
 	sched *TaskScheduler
 	tick  *timeTick
@@ -102,6 +107,11 @@ func (node *Proxy) Register() error {
 	return nil
 }
 
+// EtcdClient returns the etcd client of the proxy session, nil before it registers
+func (node *Proxy) EtcdClient() *clientv3.Client {
+	return node.session.EtcdClient()
+}
+
 func (node *Proxy) Init() error {
 	// wait for datacoord state changed to Healthy
 	if node.dataCoord != nil {
@@ -257,6 +267,36 @@ func (node *Proxy) Init() error {
 	if err != nil {
 		return err
 	}
+	Params.RegisterRefresher("proxy.maxTaskNum", func(value string) error {
+		num, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if num <= 0 {
+			return fmt.Errorf("maxTaskNum should be positive, but got %d", num)
+		}
+		node.sched.setMaxTaskNum(num)
+		return nil
+	})
+
+	if err := initSlowQueryLog(); err != nil {
+		log.Debug("Proxy init slow query log failed", zap.Error(err))
+		return err
+	}
+	Params.RegisterRefresher("proxy.slowQuery.threshold", func(value string) error {
+		threshold, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		setSlowQueryThreshold(time.Duration(threshold) * time.Millisecond)
+		return nil
+	})
+
+	configRoot := path.Join(Params.MetaRootPath, paramtable.ConfigSubPath)
+	if err := Params.WatchEtcdConfig(node.ctx, Params.EtcdEndpoints, configRoot); err != nil {
+		log.Debug("Proxy watch etcd config failed", zap.Error(err))
+		return err
+	}
 
 	node.tick = newTimeTick(node.ctx, node.tsoAllocator, time.Millisecond*200, node.sched.TaskDoneTest, node.msFactory)
 
@@ -389,6 +429,9 @@ func (node *Proxy) Stop() error {
 	if node.tick != nil {
 		node.tick.Close()
 	}
+	if node.shardMgr != nil {
+		node.shardMgr.close()
+	}
 	if node.chTicker != nil {
 		err := node.chTicker.close()
 		if err != nil {
@@ -434,3 +477,9 @@ func (node *Proxy) SetDataCoordClient(cli types.DataCoord) {
 func (node *Proxy) SetQueryCoordClient(cli types.QueryCoord) {
 	node.queryCoord = cli
 }
+
+// SetQueryNodeCreator sets how the proxy connects to query nodes, search and query requests
+// are sent to the shard leaders directly only if it is set
+func (node *Proxy) SetQueryNodeCreator(creator func(ctx context.Context, addr string) (types.QueryNode, error)) {
+	node.shardMgr = newShardClientMgr(node.ctx, creator)
+}
@@ -0,0 +1,292 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	RankStrategyKey      = "strategy"
+	WeightedRankStrategy = "weighted"
+	RRFRankStrategy      = "rrf"
+
+	defaultRRFK = 60
+	maxRRFK     = 16384
+)
+
+// reranker fuses the results of several searches into one ranking
+type reranker interface {
+	// score returns the contribution of a hit of the i-th search, rank starts from 0
+	score(i int, rank int, score float32) float32
+}
+
+// weightedReranker sums the normalized scores of the hits weighted by their searches
+type weightedReranker struct {
+	weights     []float32
+	metricTypes []string
+}
+
+func (r *weightedReranker) score(i int, rank int, score float32) float32 {
+	return r.weights[i] * normalizeScore(r.metricTypes[i], score)
+}
+
+// rrfReranker sums the reciprocal ranks of the hits, known as reciprocal rank fusion
+type rrfReranker struct {
+	k float32
+}
+
+func (r *rrfReranker) score(i int, rank int, score float32) float32 {
+	return 1 / (r.k + float32(rank+1))
+}
+
+// normalizeScore maps the score of a metric into (0, 1], the larger the more similar
+func normalizeScore(metricType string, score float32) float32 {
+	if metricType == "IP" {
+		return 0.5 + float32(math.Atan(float64(score))/math.Pi)
+	}
+	// the other metrics are distances
+	return 1 - float32(2*math.Atan(float64(score))/math.Pi)
+}
+
+// parseRankParams returns the reranker and the topk of the fused results, metricTypes are
+// the metric types of the searches to fuse
+func parseRankParams(rankParams []*commonpb.KeyValuePair, metricTypes []string) (reranker, int, error) {
+	topKStr, err := GetAttrByKeyFromRepeatedKV(TopKKey, rankParams)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s not found in rank_params", TopKKey)
+	}
+	topK, err := strconv.Atoi(topKStr)
+	if err != nil || topK <= 0 {
+		return nil, 0, fmt.Errorf("%s %s is invalid", TopKKey, topKStr)
+	}
+
+	strategy, err := GetAttrByKeyFromRepeatedKV(RankStrategyKey, rankParams)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s not found in rank_params", RankStrategyKey)
+	}
+	// params are optional for rrf
+	paramsStr, err := GetAttrByKeyFromRepeatedKV(SearchParamsKey, rankParams)
+	if err != nil {
+		paramsStr = "{}"
+	}
+
+	switch strategy {
+	case WeightedRankStrategy:
+		var params struct {
+			Weights []float32 `json:"weights"`
+		}
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, 0, fmt.Errorf("invalid rank params %s: %s", paramsStr, err.Error())
+		}
+		if len(params.Weights) != len(metricTypes) {
+			return nil, 0, fmt.Errorf("the number of weights %d doesn't match the number of searches %d",
+				len(params.Weights), len(metricTypes))
+		}
+		for _, weight := range params.Weights {
+			if weight < 0 || weight > 1 {
+				return nil, 0, fmt.Errorf("weight %f should be in range [0, 1]", weight)
+			}
+		}
+		return &weightedReranker{weights: params.Weights, metricTypes: metricTypes}, topK, nil
+	case RRFRankStrategy:
+		params := struct {
+			K float32 `json:"k"`
+		}{K: defaultRRFK}
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, 0, fmt.Errorf("invalid rank params %s: %s", paramsStr, err.Error())
+		}
+		if params.K <= 0 || params.K >= maxRRFK {
+			return nil, 0, fmt.Errorf("k %f of rrf should be in range (0, %d)", params.K, maxRRFK)
+		}
+		return &rrfReranker{k: params.K}, topK, nil
+	default:
+		return nil, 0, fmt.Errorf("unknown rank strategy %s", strategy)
+	}
+}
+
+// fuseSearchResults reranks the hits of every query in results, and keeps the topk hits with
+// the largest fused scores, results of searches without any hit are nil
+func fuseSearchResults(results []*schemapb.SearchResultData, nq int64, topK int, rr reranker) (*schemapb.SearchResultData, error) {
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       int64(topK),
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0),
+	}
+
+	type hitLoc struct {
+		result int
+		offset int64
+	}
+	for i, result := range results {
+		if result != nil && (result.NumQueries != nq || int64(len(result.Topks)) != nq) {
+			return nil, fmt.Errorf("the number of queries of search %d is %d, expected %d", i, result.NumQueries, nq)
+		}
+	}
+
+	offsets := make([]int64, len(results))
+	for q := int64(0); q < nq; q++ {
+		scores := make(map[int64]float32)
+		locs := make(map[int64]hitLoc)
+		ids := make([]int64, 0)
+		for i, result := range results {
+			if result == nil {
+				continue
+			}
+			begin, end := offsets[i], offsets[i]+result.Topks[q]
+			offsets[i] = end
+			for j := begin; j < end; j++ {
+				id := result.Ids.GetIntId().Data[j]
+				if _, ok := locs[id]; !ok {
+					ids = append(ids, id)
+					locs[id] = hitLoc{result: i, offset: j}
+				}
+				scores[id] += rr.score(i, int(j-begin), result.Scores[j])
+			}
+		}
+
+		sort.SliceStable(ids, func(a, b int) bool {
+			if scores[ids[a]] != scores[ids[b]] {
+				return scores[ids[a]] > scores[ids[b]]
+			}
+			return ids[a] < ids[b]
+		})
+		if len(ids) > topK {
+			ids = ids[:topK]
+		}
+		for _, id := range ids {
+			loc := locs[id]
+			ret.Ids.GetIntId().Data = append(ret.Ids.GetIntId().Data, id)
+			ret.Scores = append(ret.Scores, scores[id])
+			var err error
+			ret.FieldsData, err = appendFieldDataRow(ret.FieldsData, results[loc.result].FieldsData, loc.offset)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ret.Topks = append(ret.Topks, int64(len(ids)))
+	}
+	return ret, nil
+}
+
+// appendFieldDataRow appends the idx-th row of src to dst, and returns the new dst
+func appendFieldDataRow(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) ([]*schemapb.FieldData, error) {
+	if dst == nil {
+		dst = make([]*schemapb.FieldData, len(src))
+		for k, fieldData := range src {
+			dst[k] = &schemapb.FieldData{
+				Type:      fieldData.Type,
+				FieldName: fieldData.FieldName,
+				FieldId:   fieldData.FieldId,
+			}
+		}
+	}
+	if len(dst) != len(src) {
+		return nil, fmt.Errorf("the number of fields %d doesn't match %d", len(src), len(dst))
+	}
+
+	for k, fieldData := range src {
+		switch fieldType := fieldData.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			if dst[k].GetScalars() == nil {
+				dst[k].Field = &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{}}
+			}
+			scalars := dst[k].GetScalars()
+			switch scalarType := fieldType.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				if scalars.GetBoolData() == nil {
+					scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{}}
+				}
+				scalars.GetBoolData().Data = append(scalars.GetBoolData().Data, scalarType.BoolData.Data[idx])
+			case *schemapb.ScalarField_IntData:
+				if scalars.GetIntData() == nil {
+					scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{}}
+				}
+				scalars.GetIntData().Data = append(scalars.GetIntData().Data, scalarType.IntData.Data[idx])
+			case *schemapb.ScalarField_LongData:
+				if scalars.GetLongData() == nil {
+					scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}}
+				}
+				scalars.GetLongData().Data = append(scalars.GetLongData().Data, scalarType.LongData.Data[idx])
+			case *schemapb.ScalarField_FloatData:
+				if scalars.GetFloatData() == nil {
+					scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{}}
+				}
+				scalars.GetFloatData().Data = append(scalars.GetFloatData().Data, scalarType.FloatData.Data[idx])
+			case *schemapb.ScalarField_DoubleData:
+				if scalars.GetDoubleData() == nil {
+					scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{}}
+				}
+				scalars.GetDoubleData().Data = append(scalars.GetDoubleData().Data, scalarType.DoubleData.Data[idx])
+			case *schemapb.ScalarField_StringData:
+				if scalars.GetStringData() == nil {
+					scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}}
+				}
+				scalars.GetStringData().Data = append(scalars.GetStringData().Data, scalarType.StringData.Data[idx])
+			case *schemapb.ScalarField_BytesData:
+				if scalars.GetBytesData() == nil {
+					scalars.Data = &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{}}
+				}
+				scalars.GetBytesData().Data = append(scalars.GetBytesData().Data, scalarType.BytesData.Data[idx])
+			case *schemapb.ScalarField_JsonData:
+				if scalars.GetJsonData() == nil {
+					scalars.Data = &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{}}
+				}
+				scalars.GetJsonData().Data = append(scalars.GetJsonData().Data, scalarType.JsonData.Data[idx])
+			case *schemapb.ScalarField_ArrayData:
+				if scalars.GetArrayData() == nil {
+					scalars.Data = &schemapb.ScalarField_ArrayData{ArrayData: &schemapb.ArrayArray{ElementType: scalarType.ArrayData.ElementType}}
+				}
+				scalars.GetArrayData().Data = append(scalars.GetArrayData().Data, scalarType.ArrayData.Data[idx])
+			default:
+				return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
+			}
+		case *schemapb.FieldData_Vectors:
+			dim := fieldType.Vectors.Dim
+			if dst[k].GetVectors() == nil {
+				dst[k].Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{Dim: dim}}
+			}
+			vectors := dst[k].GetVectors()
+			switch vectorType := fieldType.Vectors.Data.(type) {
+			case *schemapb.VectorField_FloatVector:
+				if vectors.GetFloatVector() == nil {
+					vectors.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{}}
+				}
+				vectors.GetFloatVector().Data = append(vectors.GetFloatVector().Data, vectorType.FloatVector.Data[idx*dim:(idx+1)*dim]...)
+			case *schemapb.VectorField_BinaryVector:
+				vectors.Data = &schemapb.VectorField_BinaryVector{
+					BinaryVector: append(vectors.GetBinaryVector(), vectorType.BinaryVector[idx*dim/8:(idx+1)*dim/8]...),
+				}
+			default:
+				return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
+			}
+		default:
+			return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
+		}
+	}
+	return dst, nil
+}
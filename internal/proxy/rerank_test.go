@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newRankParams(strategy string, params string, topK string) []*commonpb.KeyValuePair {
+	kvs := []*commonpb.KeyValuePair{
+		{Key: RankStrategyKey, Value: strategy},
+		{Key: TopKKey, Value: topK},
+	}
+	if params != "" {
+		kvs = append(kvs, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: params})
+	}
+	return kvs
+}
+
+func newTestSearchResultData(ids [][]int64, scores [][]float32) *schemapb.SearchResultData {
+	data := &schemapb.SearchResultData{
+		NumQueries: int64(len(ids)),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "id",
+				FieldId:   100,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{},
+						},
+					},
+				},
+			},
+		},
+	}
+	for q := range ids {
+		data.Ids.GetIntId().Data = append(data.Ids.GetIntId().Data, ids[q]...)
+		data.FieldsData[0].GetScalars().GetLongData().Data = append(data.FieldsData[0].GetScalars().GetLongData().Data, ids[q]...)
+		data.Scores = append(data.Scores, scores[q]...)
+		data.Topks = append(data.Topks, int64(len(ids[q])))
+	}
+	return data
+}
+
+func TestParseRankParams(t *testing.T) {
+	metricTypes := []string{"L2", "IP"}
+
+	rr, topK, err := parseRankParams(newRankParams(RRFRankStrategy, "", "10"), metricTypes)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, topK)
+	assert.Equal(t, float32(defaultRRFK), rr.(*rrfReranker).k)
+
+	rr, _, err = parseRankParams(newRankParams(RRFRankStrategy, `{"k": 10}`, "10"), metricTypes)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(10), rr.(*rrfReranker).k)
+
+	rr, _, err = parseRankParams(newRankParams(WeightedRankStrategy, `{"weights": [0.3, 0.7]}`, "10"), metricTypes)
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{0.3, 0.7}, rr.(*weightedReranker).weights)
+
+	invalidParams := [][]*commonpb.KeyValuePair{
+		newRankParams(RRFRankStrategy, "", "0"),
+		newRankParams(RRFRankStrategy, "", "abc"),
+		newRankParams(RRFRankStrategy, `{"k": 0}`, "10"),
+		newRankParams(RRFRankStrategy, `{"k": 16384}`, "10"),
+		newRankParams(RRFRankStrategy, `{"k":`, "10"),
+		newRankParams(WeightedRankStrategy, "", "10"),
+		newRankParams(WeightedRankStrategy, `{"weights": [0.3]}`, "10"),
+		newRankParams(WeightedRankStrategy, `{"weights": [0.3, 1.7]}`, "10"),
+		newRankParams("unknown", "", "10"),
+		{{Key: RankStrategyKey, Value: RRFRankStrategy}},
+		{{Key: TopKKey, Value: "10"}},
+	}
+	for _, params := range invalidParams {
+		_, _, err = parseRankParams(params, metricTypes)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	// the larger ip the more similar
+	assert.Greater(t, normalizeScore("IP", 1), normalizeScore("IP", -1))
+	assert.Equal(t, float32(0.5), normalizeScore("IP", 0))
+	// the smaller distance the more similar
+	assert.Greater(t, normalizeScore("L2", 1), normalizeScore("L2", 2))
+	assert.Equal(t, float32(1), normalizeScore("L2", 0))
+	assert.Greater(t, normalizeScore("HAMMING", 1), normalizeScore("HAMMING", 2))
+}
+
+func TestFuseSearchResults_RRF(t *testing.T) {
+	results := []*schemapb.SearchResultData{
+		newTestSearchResultData([][]int64{{1, 2, 3}, {4, 5}}, [][]float32{{0.1, 0.2, 0.3}, {0.4, 0.5}}),
+		newTestSearchResultData([][]int64{{3, 1, 6}, {}}, [][]float32{{0.9, 0.8, 0.7}, {}}),
+		nil,
+	}
+	fused, err := fuseSearchResults(results, 2, 3, &rrfReranker{k: 60})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), fused.NumQueries)
+	assert.Equal(t, []int64{3, 2}, fused.Topks)
+	// id 1 ranks 1st and 2nd, id 3 ranks 3rd and 1st, id 2 ranks 2nd
+	assert.Equal(t, []int64{1, 3, 2, 4, 5}, fused.Ids.GetIntId().Data)
+	assert.InDelta(t, 1.0/61+1.0/62, fused.Scores[0], 1e-6)
+	assert.InDelta(t, 1.0/63+1.0/61, fused.Scores[1], 1e-6)
+	assert.InDelta(t, 1.0/62, fused.Scores[2], 1e-6)
+	assert.Equal(t, fused.Ids.GetIntId().Data, fused.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, "id", fused.FieldsData[0].FieldName)
+
+	_, err = fuseSearchResults(results, 3, 3, &rrfReranker{k: 60})
+	assert.NotNil(t, err)
+}
+
+func TestFuseSearchResults_Weighted(t *testing.T) {
+	results := []*schemapb.SearchResultData{
+		// l2 distances
+		newTestSearchResultData([][]int64{{1, 2}}, [][]float32{{0, 1}}),
+		// inner products
+		newTestSearchResultData([][]int64{{2, 3}}, [][]float32{{10, 0}}),
+	}
+	rr := &weightedReranker{weights: []float32{0.5, 0.5}, metricTypes: []string{"L2", "IP"}}
+	fused, err := fuseSearchResults(results, 1, 10, rr)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3}, fused.Topks)
+	assert.Equal(t, []int64{2, 1, 3}, fused.Ids.GetIntId().Data)
+	assert.InDelta(t, 0.5*normalizeScore("L2", 1)+0.5*normalizeScore("IP", 10), fused.Scores[0], 1e-6)
+	assert.InDelta(t, 0.5, fused.Scores[1], 1e-6)
+	assert.InDelta(t, 0.25, fused.Scores[2], 1e-6)
+
+	rr.weights = []float32{1, 0}
+	fused, err = fuseSearchResults(results, 1, 1, rr)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, fused.Ids.GetIntId().Data)
+}
+
+func TestAppendFieldDataRow(t *testing.T) {
+	src := []*schemapb.FieldData{
+		{
+			Type: schemapb.DataType_Double,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_DoubleData{
+						DoubleData: &schemapb.DoubleArray{Data: []float64{1, 2}},
+					},
+				},
+			},
+		},
+		{
+			Type: schemapb.DataType_FloatVector,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 2,
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}},
+					},
+				},
+			},
+		},
+		{
+			Type: schemapb.DataType_BinaryVector,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 8,
+					Data: &schemapb.VectorField_BinaryVector{
+						BinaryVector: []byte{1, 2},
+					},
+				},
+			},
+		},
+	}
+
+	dst, err := appendFieldDataRow(nil, src, 1)
+	assert.Nil(t, err)
+	dst, err = appendFieldDataRow(dst, src, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{2, 1}, dst[0].GetScalars().GetDoubleData().Data)
+	assert.Equal(t, []float32{3, 4, 1, 2}, dst[1].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, []byte{2, 1}, dst[2].GetVectors().GetBinaryVector())
+	assert.Equal(t, schemapb.DataType_BinaryVector, dst[2].Type)
+
+	_, err = appendFieldDataRow(dst, src[:1], 0)
+	assert.NotNil(t, err)
+}
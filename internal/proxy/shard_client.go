@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// errShardUnavailable means the shard leaders could not serve the request, the
+// request should be sent through the query channel instead
+var errShardUnavailable = errors.New("shard leaders unavailable")
+
+type queryNodeCreatorFunc func(ctx context.Context, addr string) (types.QueryNode, error)
+
+type shardClient struct {
+	addr   string
+	client types.QueryNode
+}
+
+// shardClientMgr keeps the clients of query nodes and the shard leaders of collections,
+// the proxy uses them to search and query the query nodes directly
+type shardClientMgr struct {
+	ctx     context.Context
+	creator queryNodeCreatorFunc
+
+	mu      sync.Mutex
+	clients map[UniqueID]*shardClient
+	leaders map[UniqueID][]*querypb.ShardLeadersList
+}
+
+func newShardClientMgr(ctx context.Context, creator queryNodeCreatorFunc) *shardClientMgr {
+	return &shardClientMgr{
+		ctx:     ctx,
+		creator: creator,
+		clients: make(map[UniqueID]*shardClient),
+		leaders: make(map[UniqueID][]*querypb.ShardLeadersList),
+	}
+}
+
+func (mgr *shardClientMgr) getClient(nodeID UniqueID, addr string) (types.QueryNode, error) {
+	mgr.mu.Lock()
+	sc, ok := mgr.clients[nodeID]
+	mgr.mu.Unlock()
+	if ok && sc.addr == addr {
+		return sc.client, nil
+	}
+
+	client, err := mgr.creator(mgr.ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if old, ok := mgr.clients[nodeID]; ok {
+		if old.addr == addr {
+			go client.Stop()
+			return old.client, nil
+		}
+		go old.client.Stop()
+	}
+	mgr.clients[nodeID] = &shardClient{addr: addr, client: client}
+	return client, nil
+}
+
+func (mgr *shardClientMgr) removeClient(nodeID UniqueID) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if sc, ok := mgr.clients[nodeID]; ok {
+		go sc.client.Stop()
+		delete(mgr.clients, nodeID)
+	}
+}
+
+func (mgr *shardClientMgr) getShardLeaders(ctx context.Context, qc types.QueryCoord, collID UniqueID) ([]*querypb.ShardLeadersList, error) {
+	mgr.mu.Lock()
+	shards, ok := mgr.leaders[collID]
+	mgr.mu.Unlock()
+	if ok {
+		return shards, nil
+	}
+
+	resp, err := qc.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Undefined,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	if len(resp.Shards) == 0 {
+		return nil, fmt.Errorf("no shard leader of collection %d", collID)
+	}
+
+	mgr.mu.Lock()
+	mgr.leaders[collID] = resp.Shards
+	mgr.mu.Unlock()
+	return resp.Shards, nil
+}
+
+func (mgr *shardClientMgr) clearShardLeaders(collID UniqueID) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	delete(mgr.leaders, collID)
+}
+
+// callShardLeaders calls fn concurrently on every query node serving the shards of the collection,
+// a node gets the dml channels it leads, and every call is bounded by Params.ShardQueryTimeout.
+// The returned error wraps errShardUnavailable if the request could be served by the query channel.
+func (mgr *shardClientMgr) callShardLeaders(ctx context.Context, qc types.QueryCoord, collID UniqueID,
+	fn func(ctx context.Context, node types.QueryNode, channels []string) error) error {
+
+	shards, err := mgr.getShardLeaders(ctx, qc, collID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errShardUnavailable, err.Error())
+	}
+
+	nodeChannels := make(map[UniqueID][]string)
+	nodeAddrs := make(map[UniqueID]string)
+	for _, shard := range shards {
+		if len(shard.NodeIds) == 0 || len(shard.NodeIds) != len(shard.NodeAddrs) {
+			mgr.clearShardLeaders(collID)
+			return fmt.Errorf("%w: invalid leaders of shard %s", errShardUnavailable, shard.ChannelName)
+		}
+		nodeChannels[shard.NodeIds[0]] = append(nodeChannels[shard.NodeIds[0]], shard.ChannelName)
+		for i, nodeID := range shard.NodeIds {
+			if _, ok := nodeChannels[nodeID]; !ok {
+				nodeChannels[nodeID] = []string{}
+			}
+			nodeAddrs[nodeID] = shard.NodeAddrs[i]
+		}
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(nodeChannels))
+	for nodeID, channels := range nodeChannels {
+		wg.Add(1)
+		go func(nodeID UniqueID, channels []string) {
+			defer wg.Done()
+			client, err := mgr.getClient(nodeID, nodeAddrs[nodeID])
+			if err != nil {
+				errCh <- fmt.Errorf("%w: connect to query node %d failed, %s", errShardUnavailable, nodeID, err.Error())
+				return
+			}
+			callCtx, cancel := context.WithTimeout(ctx, Params.ShardQueryTimeout)
+			defer cancel()
+			if err = fn(callCtx, client, channels); err != nil {
+				if errors.Is(err, errShardUnavailable) {
+					mgr.removeClient(nodeID)
+				}
+				errCh <- err
+			}
+		}(nodeID, channels)
+	}
+	wg.Wait()
+	close(errCh)
+
+	var unavailableErr error
+	for err := range errCh {
+		if !errors.Is(err, errShardUnavailable) {
+			return err
+		}
+		unavailableErr = err
+	}
+	if unavailableErr != nil {
+		log.Warn("call shard leaders failed", zap.Int64("collectionID", collID), zap.Error(unavailableErr))
+		mgr.clearShardLeaders(collID)
+	}
+	return unavailableErr
+}
+
+func (mgr *shardClientMgr) close() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	for nodeID, sc := range mgr.clients {
+		if err := sc.client.Stop(); err != nil {
+			log.Warn("stop query node client failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+	mgr.clients = make(map[UniqueID]*shardClient)
+	mgr.leaders = make(map[UniqueID][]*querypb.ShardLeadersList)
+}
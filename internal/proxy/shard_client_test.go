@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockShardQueryCoord struct {
+	types.QueryCoord
+	shards []*querypb.ShardLeadersList
+	calls  int
+}
+
+func (m *mockShardQueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	m.calls++
+	return &querypb.GetShardLeadersResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Shards: m.shards,
+	}, nil
+}
+
+type mockShardQueryNode struct {
+	types.QueryNode
+	addr string
+}
+
+func (m *mockShardQueryNode) Stop() error {
+	return nil
+}
+
+func TestShardClientMgr_callShardLeaders(t *testing.T) {
+	Params.ShardQueryTimeout = time.Second
+	qc := &mockShardQueryCoord{
+		shards: []*querypb.ShardLeadersList{
+			{ChannelName: "dml_0", NodeIds: []int64{1, 3}, NodeAddrs: []string{"addr1", "addr3"}},
+			{ChannelName: "dml_1", NodeIds: []int64{2, 3}, NodeAddrs: []string{"addr2", "addr3"}},
+		},
+	}
+	mgr := newShardClientMgr(context.Background(), func(ctx context.Context, addr string) (types.QueryNode, error) {
+		return &mockShardQueryNode{addr: addr}, nil
+	})
+	defer mgr.close()
+
+	var mu sync.Mutex
+	called := make(map[string][]string)
+	err := mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+		mu.Lock()
+		defer mu.Unlock()
+		called[node.(*mockShardQueryNode).addr] = channels
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(called))
+	assert.Equal(t, []string{"dml_0"}, called["addr1"])
+	assert.Equal(t, []string{"dml_1"}, called["addr2"])
+	assert.Equal(t, 0, len(called["addr3"]))
+
+	// shard leaders are cached until a node is unavailable
+	err = mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, qc.calls)
+
+	failedErr := errors.New("search failed")
+	err = mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		if node.(*mockShardQueryNode).addr == "addr1" {
+			return failedErr
+		}
+		return nil
+	})
+	assert.Equal(t, failedErr, err)
+	assert.False(t, errors.Is(err, errShardUnavailable))
+
+	err = mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		if node.(*mockShardQueryNode).addr == "addr2" {
+			return errShardUnavailable
+		}
+		return nil
+	})
+	assert.True(t, errors.Is(err, errShardUnavailable))
+	nodeIDs := make([]int64, 0)
+	for nodeID := range mgr.clients {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+	assert.Equal(t, []int64{1, 3}, nodeIDs)
+
+	err = mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, qc.calls)
+}
+
+func TestShardClientMgr_InvalidShardLeaders(t *testing.T) {
+	qc := &mockShardQueryCoord{
+		shards: []*querypb.ShardLeadersList{
+			{ChannelName: "dml_0", NodeIds: []int64{1}},
+		},
+	}
+	mgr := newShardClientMgr(context.Background(), func(ctx context.Context, addr string) (types.QueryNode, error) {
+		return &mockShardQueryNode{addr: addr}, nil
+	})
+	defer mgr.close()
+
+	err := mgr.callShardLeaders(context.Background(), qc, 100, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		return nil
+	})
+	assert.True(t, errors.Is(err, errShardUnavailable))
+	assert.Equal(t, 0, len(mgr.leaders))
+}
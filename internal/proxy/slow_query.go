@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"go.uber.org/zap"
+)
+
+var (
+	// slowQueryLogger writes to a separate file if the logs are written to files
+	slowQueryLogger atomic.Value // *zap.Logger
+	// slowQueryThreshold is in nanoseconds, the slow query log is disabled if it is not positive
+	slowQueryThreshold int64
+)
+
+func init() {
+	slowQueryLogger.Store(zap.NewNop())
+}
+
+// initSlowQueryLog creates the slow query logger by Params.SlowQueryLog
+func initSlowQueryLog() error {
+	logger, _, err := log.InitLogger(&Params.SlowQueryLog)
+	if err != nil {
+		return err
+	}
+	slowQueryLogger.Store(logger)
+	setSlowQueryThreshold(Params.SlowQueryThreshold)
+	return nil
+}
+
+func setSlowQueryThreshold(threshold time.Duration) {
+	atomic.StoreInt64(&slowQueryThreshold, int64(threshold))
+}
+
+func isSlowQuery(elapsed time.Duration) bool {
+	threshold := atomic.LoadInt64(&slowQueryThreshold)
+	return threshold > 0 && int64(elapsed) >= threshold
+}
+
+// logSlowSearch writes the search into the slow query log if it takes longer than the threshold,
+// stageCosts is empty if the time spent in each stage is unknown, such as the search timed out
+func logSlowSearch(msgID UniqueID, request *milvuspb.SearchRequest, elapsed time.Duration, stageCosts []*commonpb.StageCost, err error) {
+	if !isSlowQuery(elapsed) {
+		return
+	}
+	stages := make([]string, 0, len(stageCosts))
+	for _, cost := range stageCosts {
+		stages = append(stages, formatStageCost(cost))
+	}
+	slowQueryLogger.Load().(*zap.Logger).Info("slow search",
+		zap.Int64("msgID", msgID),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Strings("partitions", request.PartitionNames),
+		zap.String("dsl", request.Dsl),
+		zap.Strings("outputFields", request.OutputFields),
+		zap.Uint64("travelTimestamp", request.TravelTimestamp),
+		zap.Uint64("guaranteeTimestamp", request.GuaranteeTimestamp),
+		zap.Duration("elapsed", elapsed),
+		zap.Strings("stages", stages),
+		zap.Error(err))
+}
+
+func formatStageCost(cost *commonpb.StageCost) string {
+	return cost.Role + "-" + strconv.FormatInt(cost.NodeID, 10) + " " + cost.Stage + ": " +
+		(time.Duration(cost.CostUs) * time.Microsecond).String()
+}
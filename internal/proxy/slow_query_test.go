@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	old := slowQueryLogger.Load()
+	slowQueryLogger.Store(zap.New(core))
+	defer slowQueryLogger.Store(old)
+	defer setSlowQueryThreshold(0)
+
+	request := &milvuspb.SearchRequest{CollectionName: "coll"}
+	stageCosts := []*commonpb.StageCost{
+		{Role: "Proxy", NodeID: 1, Stage: "queue", CostUs: 1500},
+		{Role: "QueryNode", NodeID: 2, Stage: "waitTSafe", CostUs: 2000000},
+	}
+
+	setSlowQueryThreshold(0)
+	logSlowSearch(1, request, time.Hour, stageCosts, nil)
+	assert.Equal(t, 0, logs.Len())
+
+	setSlowQueryThreshold(time.Second)
+	logSlowSearch(1, request, time.Millisecond, stageCosts, nil)
+	assert.Equal(t, 0, logs.Len())
+
+	logSlowSearch(1, request, 2*time.Second, stageCosts, nil)
+	assert.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "coll", fields["collection"])
+	assert.Equal(t, []interface{}{"Proxy-1 queue: 1.5ms", "QueryNode-2 waitTSafe: 2s"}, fields["stages"])
+}
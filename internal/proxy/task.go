@@ -15,15 +15,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"reflect"
 	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unsafe"
 
@@ -44,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -52,11 +54,15 @@ const (
 	CreateCollectionTaskName        = "CreateCollectionTask"
 	DropCollectionTaskName          = "DropCollectionTask"
 	SearchTaskName                  = "SearchTask"
+	HybridSearchTaskName            = "HybridSearchTask"
 	RetrieveTaskName                = "RetrieveTask"
 	AnnsFieldKey                    = "anns_field"
 	TopKKey                         = "topk"
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
+	GroupByFieldKey                 = "group_by_field"
+	MaxLengthKey                    = "max_length"
+	DefaultJSONMaxLength            = 1024
 	HasCollectionTaskName           = "HasCollectionTask"
 	DescribeCollectionTaskName      = "DescribeCollectionTask"
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
@@ -286,6 +292,16 @@ func (it *InsertTask) checkRowNums() error {
 				if fieldNumRows != rowNums {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
+			case *schemapb.ScalarField_JsonData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetJsonData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.ScalarField_ArrayData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetArrayData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errUnsupportedDType("bytes")
 			case *schemapb.ScalarField_StringData:
@@ -439,6 +455,28 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 				if err != nil {
 					return err
 				}
+			case *schemapb.ScalarField_JsonData:
+				jsonData, err := it.encodeJSONFieldData(field.FieldName, scalarField.GetJsonData().Data)
+				if err != nil {
+					return err
+				}
+				err = appendScalarField(func() interface{} {
+					return jsonData
+				})
+				if err != nil {
+					return err
+				}
+			case *schemapb.ScalarField_ArrayData:
+				arrayData, err := it.encodeArrayFieldData(field.FieldName, scalarField.GetArrayData().Data)
+				if err != nil {
+					return err
+				}
+				err = appendScalarField(func() interface{} {
+					return arrayData
+				})
+				if err != nil {
+					return err
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errors.New("bytes field is not supported now")
 			case *schemapb.ScalarField_StringData:
@@ -555,6 +593,9 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_JSON, schemapb.DataType_Array:
+				d := datas[j][i].([]byte)
+				blob.Value = append(blob.Value, d...)
 			default:
 				log.Warn("unsupported data type")
 			}
@@ -569,6 +610,74 @@ func (it *InsertTask) transferColumnBasedRequestToRowBasedData() error {
 	return nil
 }
 
+// encodeJSONFieldData checks the values of a json field and pads them to the max_length of the field
+func (it *InsertTask) encodeJSONFieldData(fieldName string, values [][]byte) ([][]byte, error) {
+	schemaHelper, err := typeutil.CreateSchemaHelper(it.schema)
+	if err != nil {
+		return nil, err
+	}
+	field, err := schemaHelper.GetFieldFromName(fieldName)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetJSONMaxLength(field)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([][]byte, 0, len(values))
+	for i, value := range values {
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("invalid json value of field %s at row %d", fieldName, i)
+		}
+		slot, err := typeutil.EncodeJSONSlot(value, maxLength)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+// encodeArrayFieldData checks the capacity of the values of an array field and encodes them into json slots
+func (it *InsertTask) encodeArrayFieldData(fieldName string, values []*schemapb.ScalarField) ([][]byte, error) {
+	schemaHelper, err := typeutil.CreateSchemaHelper(it.schema)
+	if err != nil {
+		return nil, err
+	}
+	field, err := schemaHelper.GetFieldFromName(fieldName)
+	if err != nil {
+		return nil, err
+	}
+	maxCapacity, err := typeutil.GetArrayMaxCapacity(field)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetJSONMaxLength(field)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([][]byte, 0, len(values))
+	for i, value := range values {
+		length, err := typeutil.GetArrayLength(value, field.ElementType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid array value of field %s at row %d: %w", fieldName, i, err)
+		}
+		if length > maxCapacity {
+			return nil, fmt.Errorf("array value of field %s at row %d has %d elements, exceeds max_capacity %d", fieldName, i, length, maxCapacity)
+		}
+		encoded, err := typeutil.EncodeArray(value, field.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		slot, err := typeutil.EncodeJSONSlot(encoded, maxLength)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
 func (it *InsertTask) checkFieldAutoID() error {
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
 	if it.req.NumRows <= 0 {
@@ -747,6 +856,13 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 	}
 	it.schema = collSchema
 
+	if collSchema.EnableDynamicField {
+		it.req.FieldsData, err = packDynamicFieldData(collSchema, it.req.FieldsData, int(it.req.NumRows))
+		if err != nil {
+			return err
+		}
+	}
+
 	err = it.checkRowNums()
 	if err != nil {
 		return err
@@ -1136,6 +1252,9 @@ func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
 		if err := ValidateFieldName(field.Name); err != nil {
 			return err
 		}
+		if field.IsDynamic {
+			return fmt.Errorf("field %s can not be a dynamic field, set enable_dynamic_field of the schema instead", field.Name)
+		}
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			exist := false
 			var dim int64 = 0
@@ -1163,11 +1282,49 @@ func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
 				}
 			}
 		}
+		if field.DataType == schemapb.DataType_JSON || field.DataType == schemapb.DataType_Array {
+			if field.IsPrimaryKey {
+				return errors.New("the data type of primary key should be int64")
+			}
+			if err := fillJSONMaxLength(field); err != nil {
+				return err
+			}
+		}
+		if field.DataType == schemapb.DataType_Array {
+			if err := ValidateArrayField(field); err != nil {
+				return err
+			}
+		}
+	}
+	if cct.schema.EnableDynamicField {
+		cct.schema.Fields = append(cct.schema.Fields, newDynamicField())
+	}
+	cct.CreateCollectionRequest.Schema, err = proto.Marshal(cct.schema)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// fillJSONMaxLength sets max_length of a json or array field to DefaultJSONMaxLength if it's not given
+func fillJSONMaxLength(field *schemapb.FieldSchema) error {
+	for _, param := range field.TypeParams {
+		if param.Key == MaxLengthKey {
+			maxLength, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			return ValidateJSONMaxLength(maxLength)
+		}
+	}
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{
+		Key:   MaxLengthKey,
+		Value: strconv.Itoa(DefaultJSONMaxLength),
+	})
+	return nil
+}
+
 func (cct *CreateCollectionTask) Execute(ctx context.Context) error {
 	var err error
 	cct.result, err = cct.rootCoord.CreateCollection(ctx, cct.CreateCollectionRequest)
@@ -1314,6 +1471,54 @@ type SearchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
+
+	tr *timerecord.TimeRecorder
+	// nodeStageCosts are the stages of the query nodes carried by the search results
+	nodeStageCosts []*commonpb.StageCost
+	// dynamicOutputFields are the output fields unpacked from the dynamic field
+	dynamicOutputFields []string
+}
+
+// parseGroupByField returns the id of the group_by_field in searchParams, or 0 if the search isn't grouped
+func parseGroupByField(schema *schemapb.CollectionSchema, searchParams []*commonpb.KeyValuePair) (int64, error) {
+	groupByField, err := GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParams)
+	if err != nil {
+		return 0, nil
+	}
+	for _, field := range schema.Fields {
+		if field.Name != groupByField {
+			continue
+		}
+		switch field.DataType {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+			schemapb.DataType_Int32, schemapb.DataType_Int64:
+			return field.FieldID, nil
+		default:
+			return 0, fmt.Errorf("group by field %s of type %s is not supported", groupByField, field.DataType.String())
+		}
+	}
+	return 0, fmt.Errorf("group by field %s not exist", groupByField)
+}
+
+func newSearchTask(ctx context.Context, request *milvuspb.SearchRequest, chMgr channelsMgr, qc types.QueryCoord, shardMgr *shardClientMgr) *SearchTask {
+	return &SearchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Search,
+				SourceID: Params.ProxyID,
+			},
+			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
+		},
+		resultBuf: make(chan []*internalpb.SearchResults, 1),
+		query:     request,
+		chMgr:     chMgr,
+		qc:        qc,
+		shardMgr:  shardMgr,
+		tr:        timerecord.NewTimeRecorder("search"),
+	}
 }
 
 func (st *SearchTask) TraceCtx() context.Context {
@@ -1379,7 +1584,29 @@ func (st *SearchTask) getVChannels() ([]vChan, error) {
 	return st.chMgr.getVChannels(collID)
 }
 
+// stageCosts returns the time spent in every stage of the search on the proxy and the query nodes
+func (st *SearchTask) stageCosts() []*commonpb.StageCost {
+	stages := st.tr.StageCosts()
+	costs := make([]*commonpb.StageCost, 0, len(stages)+1+len(st.nodeStageCosts))
+	for _, stage := range stages {
+		costs = append(costs, &commonpb.StageCost{
+			Role:   typeutil.ProxyRole,
+			NodeID: Params.ProxyID,
+			Stage:  stage.Stage,
+			CostUs: stage.Cost.Microseconds(),
+		})
+	}
+	costs = append(costs, &commonpb.StageCost{
+		Role:   typeutil.ProxyRole,
+		NodeID: Params.ProxyID,
+		Stage:  "total",
+		CostUs: st.tr.ElapseSpan().Microseconds(),
+	})
+	return append(costs, st.nodeStageCosts...)
+}
+
 func (st *SearchTask) PreExecute(ctx context.Context) error {
+	st.tr.RecordStage("queue")
 	st.Base.MsgType = commonpb.MsgType_Search
 	st.Base.SourceID = Params.ProxyID
 
@@ -1440,7 +1667,12 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	outputFields, err := translateOutputFields(st.query.OutputFields, schema, false)
+	outputFields, dynamicOutputFields, err := splitDynamicOutputFields(st.query.OutputFields, schema)
+	if err != nil {
+		return err
+	}
+	st.dynamicOutputFields = dynamicOutputFields
+	outputFields, err = translateOutputFields(outputFields, schema, false)
 	if err != nil {
 		return err
 	}
@@ -1472,10 +1704,16 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 			return errors.New(SearchParamsKey + " not found in search_params")
 		}
 
+		groupByFieldID, err := parseGroupByField(schema, st.query.SearchParams)
+		if err != nil {
+			return err
+		}
+
 		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
-			MetricType:   metricType,
-			SearchParams: searchParams,
+			Topk:           int64(topK),
+			MetricType:     metricType,
+			SearchParams:   searchParams,
+			GroupByFieldId: groupByFieldID,
 		}
 
 		plan, err := CreateQueryPlan(schema, st.query.Dsl, annsField, queryInfo)
@@ -1566,6 +1804,16 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 }
 
 func (st *SearchTask) Execute(ctx context.Context) error {
+	st.tr.RecordStage("preExecute")
+	if st.shardMgr != nil && Params.ShardQueryEnabled {
+		err := st.searchShardLeaders(ctx)
+		if err == nil || !errors.Is(err, errShardUnavailable) {
+			return err
+		}
+		log.Warn("search shard leaders failed, fall back to the query channel",
+			zap.Any("requestID", st.Base.MsgID), zap.Error(err))
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1615,6 +1863,52 @@ func (st *SearchTask) Execute(ctx context.Context) error {
 	return err
 }
 
+// searchShardLeaders sends the search request to the query nodes directly, the results are
+// reduced only if they cover every dml channel and sealed segment of the collection
+func (st *SearchTask) searchShardLeaders(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.CollectionName)
+	if err != nil {
+		return err
+	}
+	vchans, err := st.getVChannels()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errShardUnavailable, err.Error())
+	}
+
+	resultBuf := newSearchResultBuf()
+	for _, vchan := range vchans {
+		resultBuf.usedVChans[vchan] = struct{}{}
+	}
+	var mu sync.Mutex
+	err = st.shardMgr.callShardLeaders(ctx, st.qc, collID, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		result, err := node.Search(ctx, &querypb.SearchRequest{
+			Req:         st.SearchRequest,
+			DmlChannels: channels,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %s", errShardUnavailable, err.Error())
+		}
+		if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(result.Status.Reason)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		resultBuf.addPartialResult(result)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !resultBuf.readyToReduce() {
+		return fmt.Errorf("%w: search results don't cover all channels and sealed segments", errShardUnavailable)
+	}
+
+	log.Debug("proxy searched shard leaders", zap.Any("collectionID", collID),
+		zap.Any("msgID", st.Base.MsgID), zap.Int("answer cnt", len(resultBuf.resultBuf)))
+	st.resultBuf <- resultBuf.resultBuf
+	return nil
+}
+
 func decodeSearchResultsSerial(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
 	log.Debug("reduceSearchResultDataParallel", zap.Any("lenOfSearchResults", len(searchResults)))
 
@@ -1679,17 +1973,28 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 		},
 	}
 
-	const minFloat32 = -1 * float32(math.MaxFloat32)
+	// a group by search keeps only the best hit of each group among all query nodes
+	isGroupBy := false
+	for _, sData := range searchResultData {
+		if len(sData.GroupByValues) > 0 {
+			isGroupBy = true
+			break
+		}
+	}
+	if isGroupBy {
+		ret.Results.GroupByValues = make([]int64, 0)
+	}
 
 	// TODO(yukun): Use parallel function
 	realTopK := -1
 	for idx := 0; idx < nq; idx++ {
 		locs := make([]int, availableQueryNodeNum)
+		selectedGroups := make(map[int64]struct{})
 
 		j := 0
-		for ; j < topk; j++ {
-			valid := true
-			choice, maxDistance := 0, minFloat32
+		for j < topk {
+			// invalid hits are at the end of the results, a way is exhausted once it reaches one
+			choice, maxDistance := -1, float32(0)
 			for q, loc := range locs { // query num, the number of ways to merge
 				if loc >= topk {
 					continue
@@ -1697,25 +2002,30 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 				curIdx := idx*topk + loc
 				id := searchResultData[q].Ids.GetIntId().Data[curIdx]
 				if id == -1 {
-					valid = false
-				} else {
-					distance := searchResultData[q].Scores[curIdx]
-					if distance > maxDistance {
-						choice = q
-						maxDistance = distance
-					}
+					continue
+				}
+				distance := searchResultData[q].Scores[curIdx]
+				if choice == -1 || distance > maxDistance {
+					choice = q
+					maxDistance = distance
 				}
 			}
-			if !valid {
+			if choice == -1 {
 				break
 			}
 			choiceOffset := locs[choice]
 			curIdx := idx*topk + choiceOffset
-
-			// ignore invalid search result
 			id := searchResultData[choice].Ids.GetIntId().Data[curIdx]
-			if id == -1 {
-				continue
+
+			if isGroupBy {
+				groupByValue := searchResultData[choice].GroupByValues[curIdx]
+				if _, ok := selectedGroups[groupByValue]; ok {
+					// a better hit of the group is already selected
+					locs[choice]++
+					continue
+				}
+				selectedGroups[groupByValue] = struct{}{}
+				ret.Results.GroupByValues = append(ret.Results.GroupByValues, groupByValue)
 			}
 			ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, id)
 			// TODO(yukun): Process searchResultData.FieldsData
@@ -1792,6 +2102,31 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 						} else {
 							ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data = append(ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data[curIdx])
 						}
+					case *schemapb.ScalarField_JsonData:
+						if ret.Results.FieldsData[k].GetScalars().GetJsonData() == nil {
+							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_JsonData{
+									JsonData: &schemapb.JSONArray{
+										Data: [][]byte{scalarType.JsonData.Data[curIdx]},
+									},
+								},
+							}
+						} else {
+							ret.Results.FieldsData[k].GetScalars().GetJsonData().Data = append(ret.Results.FieldsData[k].GetScalars().GetJsonData().Data, scalarType.JsonData.Data[curIdx])
+						}
+					case *schemapb.ScalarField_ArrayData:
+						if ret.Results.FieldsData[k].GetScalars().GetArrayData() == nil {
+							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_ArrayData{
+									ArrayData: &schemapb.ArrayArray{
+										Data:        []*schemapb.ScalarField{scalarType.ArrayData.Data[curIdx]},
+										ElementType: scalarType.ArrayData.ElementType,
+									},
+								},
+							}
+						} else {
+							ret.Results.FieldsData[k].GetScalars().GetArrayData().Data = append(ret.Results.FieldsData[k].GetScalars().GetArrayData().Data, scalarType.ArrayData.Data[curIdx])
+						}
 					default:
 						log.Debug("Not supported field type")
 						return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
@@ -1835,6 +2170,7 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 			}
 			ret.Results.Scores = append(ret.Results.Scores, searchResultData[choice].Scores[idx*topk+choiceOffset])
 			locs[choice]++
+			j++
 		}
 		if realTopK != -1 && realTopK != j {
 			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
@@ -1880,12 +2216,17 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 	defer func() {
 		log.Debug("WaitAndPostExecute", zap.Any("time cost", time.Since(t0)))
 	}()
+	st.tr.RecordStage("execute")
 	for {
 		select {
 		case <-st.TraceCtx().Done():
 			log.Debug("Proxy", zap.Int64("SearchTask PostExecute Loop exit caused by ctx.Done", st.ID()))
 			return fmt.Errorf("SearchTask:wait to finish failed, timeout: %d", st.ID())
 		case searchResults := <-st.resultBuf:
+			st.tr.RecordStage("waitResults")
+			for _, partialSearchResult := range searchResults {
+				st.nodeStageCosts = append(st.nodeStageCosts, partialSearchResult.StageCosts...)
+			}
 			// fmt.Println("searchResults: ", searchResults)
 			filterSearchResult := make([]*internalpb.SearchResults, 0)
 			var filterReason string
@@ -1959,6 +2300,7 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+			st.tr.RecordStage("reduce")
 
 			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
 			if err != nil {
@@ -1974,6 +2316,10 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 						}
 					}
 				}
+				st.result.Results.FieldsData, err = unpackDynamicFieldData(schema, st.result.Results.FieldsData, st.dynamicOutputFields)
+				if err != nil {
+					return err
+				}
 			}
 			log.Debug("Proxy Search PostExecute Done")
 			return nil
@@ -1981,6 +2327,164 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 	}
 }
 
+// HybridSearchTask searches several vector fields of a collection with a SearchTask per field,
+// and fuses their results by the rank params into a single ranking
+type HybridSearchTask struct {
+	Condition
+	*milvuspb.HybridSearchRequest
+	ctx      context.Context
+	result   *milvuspb.SearchResults
+	chMgr    channelsMgr
+	qc       types.QueryCoord
+	shardMgr *shardClientMgr
+	// the searches are enqueued to the query queue to receive their results
+	dqQueue TaskQueue
+
+	reranker reranker
+	topK     int
+	subTasks []*SearchTask
+}
+
+func (hst *HybridSearchTask) TraceCtx() context.Context {
+	return hst.ctx
+}
+
+func (hst *HybridSearchTask) ID() UniqueID {
+	return hst.Base.MsgID
+}
+
+func (hst *HybridSearchTask) SetID(uid UniqueID) {
+	hst.Base.MsgID = uid
+}
+
+func (hst *HybridSearchTask) Name() string {
+	return HybridSearchTaskName
+}
+
+func (hst *HybridSearchTask) Type() commonpb.MsgType {
+	return hst.Base.MsgType
+}
+
+func (hst *HybridSearchTask) BeginTs() Timestamp {
+	return hst.Base.Timestamp
+}
+
+func (hst *HybridSearchTask) EndTs() Timestamp {
+	return hst.Base.Timestamp
+}
+
+func (hst *HybridSearchTask) SetTs(ts Timestamp) {
+	hst.Base.Timestamp = ts
+}
+
+func (hst *HybridSearchTask) OnEnqueue() error {
+	hst.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (hst *HybridSearchTask) PreExecute(ctx context.Context) error {
+	hst.Base.MsgType = commonpb.MsgType_Search
+	hst.Base.SourceID = Params.ProxyID
+
+	if err := ValidateCollectionName(hst.CollectionName); err != nil {
+		return err
+	}
+	if len(hst.Requests) == 0 {
+		return errors.New("no search request in hybrid search")
+	}
+
+	metricTypes := make([]string, 0, len(hst.Requests))
+	for i, req := range hst.Requests {
+		if req.GetDslType() != commonpb.DslType_BoolExprV1 {
+			return fmt.Errorf("search request %d of hybrid search should be a boolean expression", i)
+		}
+		metricType, err := GetAttrByKeyFromRepeatedKV(MetricTypeKey, req.SearchParams)
+		if err != nil {
+			return fmt.Errorf("%s not found in search_params of search request %d", MetricTypeKey, i)
+		}
+		metricTypes = append(metricTypes, metricType)
+	}
+	var err error
+	hst.reranker, hst.topK, err = parseRankParams(hst.RankParams, metricTypes)
+	if err != nil {
+		return err
+	}
+
+	// all the searches read the same snapshot of the collection
+	travelTimestamp := hst.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp = hst.BeginTs()
+	}
+	guaranteeTimestamp := hst.GuaranteeTimestamp
+	if guaranteeTimestamp == 0 {
+		guaranteeTimestamp = hst.BeginTs()
+	}
+	hst.subTasks = make([]*SearchTask, 0, len(hst.Requests))
+	for _, req := range hst.Requests {
+		req.DbName = hst.DbName
+		req.CollectionName = hst.CollectionName
+		req.PartitionNames = hst.PartitionNames
+		req.OutputFields = hst.OutputFields
+		req.TravelTimestamp = travelTimestamp
+		req.GuaranteeTimestamp = guaranteeTimestamp
+		hst.subTasks = append(hst.subTasks, newSearchTask(hst.ctx, req, hst.chMgr, hst.qc, hst.shardMgr))
+	}
+	return nil
+}
+
+func (hst *HybridSearchTask) Execute(ctx context.Context) error {
+	for i, st := range hst.subTasks {
+		if err := hst.dqQueue.Enqueue(st); err != nil {
+			return fmt.Errorf("failed to enqueue search request %d: %s", i, err.Error())
+		}
+	}
+	var errs []string
+	for i, st := range hst.subTasks {
+		if err := st.WaitToFinish(); err != nil {
+			errs = append(errs, fmt.Sprintf("search request %d failed: %s", i, err.Error()))
+		} else if st.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+			errs = append(errs, fmt.Sprintf("search request %d failed: %s", i, st.result.Status.Reason))
+		}
+	}
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func (hst *HybridSearchTask) PostExecute(ctx context.Context) error {
+	nq := int64(0)
+	results := make([]*schemapb.SearchResultData, len(hst.subTasks))
+	for i, st := range hst.subTasks {
+		results[i] = st.result.Results
+		if results[i] != nil {
+			nq = results[i].NumQueries
+		}
+	}
+	if nq <= 0 {
+		hst.result = &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}
+		return nil
+	}
+
+	fused, err := fuseSearchResults(results, nq, hst.topK, hst.reranker)
+	if err != nil {
+		return err
+	}
+	hst.result = &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: fused,
+	}
+	log.Debug("Proxy HybridSearch PostExecute Done", zap.Int64("msgID", hst.ID()),
+		zap.Int64("nq", nq), zap.Int("topK", hst.topK))
+	return nil
+}
+
 type RetrieveTask struct {
 	Condition
 	*internalpb.RetrieveRequest
@@ -1990,6 +2494,9 @@ type RetrieveTask struct {
 	retrieve  *milvuspb.RetrieveRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
+	// dynamicOutputFields are the output fields unpacked from the dynamic field
+	dynamicOutputFields []string
 }
 
 func (rt *RetrieveTask) TraceCtx() context.Context {
@@ -2130,6 +2637,10 @@ func (rt *RetrieveTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	rt.retrieve.OutputFields, rt.dynamicOutputFields, err = splitDynamicOutputFields(rt.retrieve.OutputFields, schema)
+	if err != nil {
+		return err
+	}
 	rt.retrieve.OutputFields, err = translateOutputFields(rt.retrieve.OutputFields, schema, true)
 	if err != nil {
 		return err
@@ -2225,6 +2736,15 @@ func (rt *RetrieveTask) PreExecute(ctx context.Context) error {
 }
 
 func (rt *RetrieveTask) Execute(ctx context.Context) error {
+	if rt.shardMgr != nil && Params.ShardQueryEnabled {
+		err := rt.queryShardLeaders(ctx)
+		if err == nil || !errors.Is(err, errShardUnavailable) {
+			return err
+		}
+		log.Warn("query shard leaders failed, fall back to the query channel",
+			zap.Any("requestID", rt.Base.MsgID), zap.Error(err))
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.RetrieveMsg{
 		RetrieveRequest: *rt.RetrieveRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -2274,6 +2794,52 @@ func (rt *RetrieveTask) Execute(ctx context.Context) error {
 	return err
 }
 
+// queryShardLeaders sends the retrieve request to the query nodes directly, the results are
+// merged only if they cover every dml channel and sealed segment of the collection
+func (rt *RetrieveTask) queryShardLeaders(ctx context.Context) error {
+	collID, err := globalMetaCache.GetCollectionID(ctx, rt.retrieve.CollectionName)
+	if err != nil {
+		return err
+	}
+	vchans, err := rt.getVChannels()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errShardUnavailable, err.Error())
+	}
+
+	resultBuf := newQueryResultBuf()
+	for _, vchan := range vchans {
+		resultBuf.usedVChans[vchan] = struct{}{}
+	}
+	var mu sync.Mutex
+	err = rt.shardMgr.callShardLeaders(ctx, rt.qc, collID, func(ctx context.Context, node types.QueryNode, channels []string) error {
+		result, err := node.Query(ctx, &querypb.QueryRequest{
+			Req:         rt.RetrieveRequest,
+			DmlChannels: channels,
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %s", errShardUnavailable, err.Error())
+		}
+		if result.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(result.Status.Reason)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		resultBuf.addPartialResult(result)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !resultBuf.readyToReduce() {
+		return fmt.Errorf("%w: retrieve results don't cover all channels and sealed segments", errShardUnavailable)
+	}
+
+	log.Debug("proxy queried shard leaders", zap.Any("collectionID", collID),
+		zap.Any("msgID", rt.Base.MsgID), zap.Int("answer cnt", len(resultBuf.resultBuf)))
+	rt.resultBuf <- resultBuf.resultBuf
+	return nil
+}
+
 func (rt *RetrieveTask) PostExecute(ctx context.Context) error {
 	t0 := time.Now()
 	defer func() {
@@ -2367,6 +2933,10 @@ func (rt *RetrieveTask) PostExecute(ctx context.Context) error {
 								rt.result.FieldsData[k].GetScalars().GetFloatData().Data = append(rt.result.FieldsData[k].GetScalars().GetFloatData().Data, scalarType.FloatData.Data...)
 							case *schemapb.ScalarField_DoubleData:
 								rt.result.FieldsData[k].GetScalars().GetDoubleData().Data = append(rt.result.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data...)
+							case *schemapb.ScalarField_JsonData:
+								rt.result.FieldsData[k].GetScalars().GetJsonData().Data = append(rt.result.FieldsData[k].GetScalars().GetJsonData().Data, scalarType.JsonData.Data...)
+							case *schemapb.ScalarField_ArrayData:
+								rt.result.FieldsData[k].GetScalars().GetArrayData().Data = append(rt.result.FieldsData[k].GetScalars().GetArrayData().Data, scalarType.ArrayData.Data...)
 							default:
 								log.Debug("Retrieve received not supported data type")
 							}
@@ -2422,6 +2992,10 @@ func (rt *RetrieveTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+		rt.result.FieldsData, err = unpackDynamicFieldData(schema, rt.result.FieldsData, rt.dynamicOutputFields)
+		if err != nil {
+			return err
+		}
 	}
 
 	log.Info("Retrieve PostExecute done.",
@@ -2590,8 +3164,10 @@ func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.CreatedTimestamp = result.CreatedTimestamp
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
 
+		dct.result.Schema.EnableDynamicField = result.Schema.EnableDynamicField
 		for _, field := range result.Schema.Fields {
-			if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserFieldID replacing 100
+			// the dynamic field is hidden from users
+			if field.FieldID >= 100 && !field.IsDynamic { // TODO(dragondriver): use StartOfUserFieldID replacing 100
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
 					FieldID:      field.FieldID,
 					Name:         field.Name,
@@ -2601,6 +3177,7 @@ func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
 					DataType:     field.DataType,
 					TypeParams:   field.TypeParams,
 					IndexParams:  field.IndexParams,
+					ElementType:  field.ElementType,
 				})
 			}
 		}
@@ -3419,9 +3996,34 @@ func (cit *CreateIndexTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collName)
+	if err != nil {
+		return err
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range schema.Fields {
+		if f.Name == fieldName {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return fmt.Errorf("field %s not found in collection %s", fieldName, collName)
+	}
+	isVectorField := typeutil.IsVectorType(field.DataType)
+
 	indexType, exist := indexParams["index_type"] // TODO(dragondriver): change `index_type` to const variable
 	if !exist {
-		indexType = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		if isVectorField {
+			indexType = indexparamcheck.IndexFaissIvfPQ // IVF_PQ is the default index type
+		} else {
+			// index nodes need the index type to build a scalar index
+			indexType = indexparamcheck.DefaultScalarIndexType(field.DataType)
+			cit.ExtraParams = append(cit.ExtraParams, &commonpb.KeyValuePair{
+				Key:   "index_type",
+				Value: indexType,
+			})
+		}
 	}
 
 	adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
@@ -3430,6 +4032,12 @@ func (cit *CreateIndexTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("invalid index type: %s", indexType)
 	}
 
+	if isVectorField == indexparamcheck.IsScalarIndexType(indexType) ||
+		(!isVectorField && !indexparamcheck.CheckScalarIndexType(indexType, field.DataType)) {
+		return fmt.Errorf("index type %s can't be built on field %s of type %s",
+			indexType, fieldName, field.DataType.String())
+	}
+
 	ok := adapter.CheckTrain(indexParams)
 	if !ok {
 		log.Warn("Create index with invalid params", zap.Any("index_params", indexParams))
@@ -3614,6 +4222,26 @@ func (dit *DropIndexTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// matchIndexOnField finds the index named indexName in the descriptions, and returns its id
+// with the id of the field it is built on
+func matchIndexOnField(schema *schemapb.CollectionSchema, descriptions []*milvuspb.IndexDescription, indexName string) (UniqueID, UniqueID, error) {
+	for _, desc := range descriptions {
+		if desc.IndexName != indexName {
+			continue
+		}
+		helper, err := typeutil.CreateSchemaHelper(schema)
+		if err != nil {
+			return 0, 0, err
+		}
+		field, err := helper.GetFieldFromName(desc.FieldName)
+		if err != nil {
+			return 0, 0, err
+		}
+		return desc.IndexID, field.FieldID, nil
+	}
+	return 0, 0, fmt.Errorf("no index is created")
+}
+
 type GetIndexBuildProgressTask struct {
 	Condition
 	*milvuspb.GetIndexBuildProgressRequest
@@ -3708,6 +4336,7 @@ func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 		},
 		DbName:         gibpt.DbName,
 		CollectionName: gibpt.CollectionName,
+		FieldName:      gibpt.FieldName,
 		//		IndexName:      gibpt.IndexName,
 	}
 
@@ -3716,17 +4345,14 @@ func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 		return err2
 	}
 
-	matchIndexID := int64(-1)
-	foundIndexID := false
-	for _, desc := range indexDescriptionResp.IndexDescriptions {
-		if desc.IndexName == gibpt.IndexName {
-			matchIndexID = desc.IndexID
-			foundIndexID = true
-			break
-		}
+	// every vector field has its own index, describe segments on the field of the matched index
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		return err
 	}
-	if !foundIndexID {
-		return fmt.Errorf("no index is created")
+	matchIndexID, matchFieldID, err := matchIndexOnField(schema, indexDescriptionResp.IndexDescriptions, gibpt.IndexName)
+	if err != nil {
+		return err
 	}
 
 	var allSegmentIDs []UniqueID
@@ -3766,6 +4392,7 @@ func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 			},
 			CollectionID: collectionID,
 			SegmentID:    segmentID,
+			FieldID:      matchFieldID,
 		}
 		segmentDesc, err := gibpt.rootCoord.DescribeSegment(ctx, describeSegmentRequest)
 		if err != nil {
@@ -3929,6 +4556,7 @@ func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 		},
 		DbName:         gist.DbName,
 		CollectionName: gist.CollectionName,
+		FieldName:      gist.FieldName,
 		IndexName:      gist.IndexName,
 	}
 
@@ -3937,17 +4565,14 @@ func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 		return err2
 	}
 
-	matchIndexID := int64(-1)
-	foundIndexID := false
-	for _, desc := range indexDescriptionResp.IndexDescriptions {
-		if desc.IndexName == gist.IndexName {
-			matchIndexID = desc.IndexID
-			foundIndexID = true
-			break
-		}
+	// every vector field has its own index, describe segments on the field of the matched index
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		return err
 	}
-	if !foundIndexID {
-		return fmt.Errorf("no index is created")
+	matchIndexID, matchFieldID, err := matchIndexOnField(schema, indexDescriptionResp.IndexDescriptions, gist.IndexName)
+	if err != nil {
+		return err
 	}
 
 	var allSegmentIDs []UniqueID
@@ -3986,6 +4611,7 @@ func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 			},
 			CollectionID: collectionID,
 			SegmentID:    segmentID,
+			FieldID:      matchFieldID,
 		}
 		segmentDesc, err := gist.rootCoord.DescribeSegment(ctx, describeSegmentRequest)
 		if err != nil {
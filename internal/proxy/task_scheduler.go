@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 
@@ -27,8 +28,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type TaskQueue interface {
@@ -43,6 +44,7 @@ type TaskQueue interface {
 	getTaskByReqID(reqID UniqueID) task
 	TaskDoneTest(ts Timestamp) bool
 	Enqueue(t task) error
+	setMaxTaskNum(num int64)
 }
 
 type BaseTaskQueue struct {
@@ -51,7 +53,7 @@ type BaseTaskQueue struct {
 	utLock        sync.RWMutex
 	atLock        sync.RWMutex
 
-	// maxTaskNum should keep still
+	// maxTaskNum is refreshable, access it atomically
 	maxTaskNum int64
 
 	utBufChan chan int // to block scheduler
@@ -70,7 +72,11 @@ func (queue *BaseTaskQueue) utEmpty() bool {
 }
 
 func (queue *BaseTaskQueue) utFull() bool {
-	return int64(queue.unissuedTasks.Len()) >= queue.maxTaskNum
+	return int64(queue.unissuedTasks.Len()) >= atomic.LoadInt64(&queue.maxTaskNum)
+}
+
+func (queue *BaseTaskQueue) setMaxTaskNum(num int64) {
+	atomic.StoreInt64(&queue.maxTaskNum, num)
 }
 
 func (queue *BaseTaskQueue) addUnissuedTask(t task) error {
@@ -340,7 +346,7 @@ func NewDdTaskQueue(sched *TaskScheduler) *DdTaskQueue {
 		BaseTaskQueue: BaseTaskQueue{
 			unissuedTasks: list.New(),
 			activeTasks:   make(map[UniqueID]task),
-			maxTaskNum:    1024,
+			maxTaskNum:    Params.MaxTaskNum,
 			utBufChan:     make(chan int, 1024),
 			sched:         sched,
 		},
@@ -352,7 +358,7 @@ func NewDmTaskQueue(sched *TaskScheduler) *DmTaskQueue {
 		BaseTaskQueue: BaseTaskQueue{
 			unissuedTasks: list.New(),
 			activeTasks:   make(map[UniqueID]task),
-			maxTaskNum:    1024,
+			maxTaskNum:    Params.MaxTaskNum,
 			utBufChan:     make(chan int, 1024),
 			sched:         sched,
 		},
@@ -365,7 +371,7 @@ func NewDqTaskQueue(sched *TaskScheduler) *DqTaskQueue {
 		BaseTaskQueue: BaseTaskQueue{
 			unissuedTasks: list.New(),
 			activeTasks:   make(map[UniqueID]task),
-			maxTaskNum:    1024,
+			maxTaskNum:    Params.MaxTaskNum,
 			utBufChan:     make(chan int, 1024),
 			sched:         sched,
 		},
@@ -406,6 +412,13 @@ func NewTaskScheduler(ctx context.Context,
 	return s, nil
 }
 
+// setMaxTaskNum changes the capacity of every task queue
+func (sched *TaskScheduler) setMaxTaskNum(num int64) {
+	sched.DdQueue.setMaxTaskNum(num)
+	sched.DmQueue.setMaxTaskNum(num)
+	sched.DqQueue.setMaxTaskNum(num)
+}
+
 func (sched *TaskScheduler) scheduleDdTask() task {
 	return sched.DdQueue.PopUnissuedTask()
 }
@@ -433,20 +446,19 @@ func (sched *TaskScheduler) getTaskByReqID(collMeta UniqueID) task {
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
-		opentracing.Tags{
-			"Type": t.Name(),
-			"ID":   t.ID(),
-		})
-	defer span.Finish()
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Name()),
+			attribute.Int64("ID", t.ID())))
+	defer span.End()
 
-	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
+	span.AddEvent("scheduler process AddActiveTask", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	q.AddActiveTask(t)
 
 	defer func() {
-		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
+		span.AddEvent("scheduler process PopActiveTask", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 		q.PopActiveTask(t.ID())
 	}()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	span.AddEvent("scheduler process PreExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 
 	err := t.PreExecute(ctx)
 
@@ -458,14 +470,14 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
+	span.AddEvent("scheduler process Execute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	err = t.Execute(ctx)
 	if err != nil {
 		trace.LogError(span, err)
 		return
 	}
 
-	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
+	span.AddEvent("scheduler process PostExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	err = t.PostExecute(ctx)
 }
 
@@ -754,7 +766,7 @@ func (sched *TaskScheduler) collectResultLoop() {
 						delete(searchResultBufs, reqID)
 					}
 
-					sp.Finish()
+					sp.End()
 				}
 				if queryResultMsg, rtOk := tsMsg.(*msgstream.RetrieveResultMsg); rtOk {
 					//reqID := retrieveResultMsg.Base.MsgID
@@ -852,7 +864,7 @@ func (sched *TaskScheduler) collectResultLoop() {
 						st.resultBuf <- resultBuf.resultBuf
 						delete(queryResultBufs, reqID)
 					}
-					sp.Finish()
+					sp.End()
 				}
 			}
 		case <-sched.ctx.Done():
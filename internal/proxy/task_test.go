@@ -1,8 +1,10 @@
 package proxy
 
 import (
+	"math"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -508,3 +510,106 @@ func TestTranslateOutputFields(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 }
+
+func TestParseGroupByField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestParseGroupByField",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "brand", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	fieldID, err := parseGroupByField(schema, []*commonpb.KeyValuePair{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), fieldID)
+
+	fieldID, err = parseGroupByField(schema, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "brand"}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), fieldID)
+
+	for _, name := range []string{"price", "vec", "not_exist"} {
+		_, err = parseGroupByField(schema, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: name}})
+		assert.NotNil(t, err)
+	}
+}
+
+func TestReduceSearchResultData(t *testing.T) {
+	minFloat32 := float32(-math.MaxFloat32)
+	results := []*schemapb.SearchResultData{
+		newTestSearchResultData([][]int64{{1, 2, 3}}, [][]float32{{0.9, 0.8, 0.7}}),
+		newTestSearchResultData([][]int64{{4, 5, -1}}, [][]float32{{0.85, 0.75, minFloat32}}),
+	}
+	ret, err := reduceSearchResultData(results, 1, 2, 3, "IP")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 4, 2}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{0.9, 0.85, 0.8}, ret.Results.Scores)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+	assert.Nil(t, ret.Results.GroupByValues)
+
+	// an exhausted query node doesn't stop the others
+	results[1] = newTestSearchResultData([][]int64{{4, -1, -1}}, [][]float32{{0.95, minFloat32, minFloat32}})
+	ret, err = reduceSearchResultData(results, 1, 2, 3, "IP")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 1, 2}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, ret.Results.Ids.GetIntId().Data, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+}
+
+func TestReduceSearchResultData_GroupBy(t *testing.T) {
+	results := []*schemapb.SearchResultData{
+		newTestSearchResultData([][]int64{{1, 2, 3}}, [][]float32{{0.9, 0.8, 0.7}}),
+		newTestSearchResultData([][]int64{{4, 5, -1}}, [][]float32{{0.85, 0.75, float32(-math.MaxFloat32)}}),
+	}
+	results[0].GroupByValues = []int64{10, 20, 30}
+	results[1].GroupByValues = []int64{10, 40, 0}
+
+	ret, err := reduceSearchResultData(results, 1, 2, 3, "IP")
+	assert.Nil(t, err)
+	// hit 4 is skipped since hit 1 of the same group is better
+	assert.Equal(t, []int64{1, 2, 5}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{10, 20, 40}, ret.Results.GroupByValues)
+	assert.Equal(t, []float32{0.9, 0.8, 0.75}, ret.Results.Scores)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+	assert.Equal(t, ret.Results.Ids.GetIntId().Data, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+
+	// fewer groups than topk
+	results[0].GroupByValues = []int64{10, 10, 10}
+	results[1].GroupByValues = []int64{10, 10, 0}
+	ret, err = reduceSearchResultData(results, 1, 2, 3, "IP")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{1}, ret.Results.Topks)
+}
+
+func TestMatchIndexOnField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec1", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "vec2", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	descriptions := []*milvuspb.IndexDescription{
+		{IndexName: "idx1", IndexID: 1, FieldName: "vec1"},
+		{IndexName: "idx2", IndexID: 2, FieldName: "vec2"},
+		{IndexName: "idx3", IndexID: 3, FieldName: "unknown"},
+	}
+
+	indexID, fieldID, err := matchIndexOnField(schema, descriptions, "idx2")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), indexID)
+	assert.Equal(t, int64(102), fieldID)
+
+	indexID, fieldID, err = matchIndexOnField(schema, descriptions, "idx1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), indexID)
+	assert.Equal(t, int64(101), fieldID)
+
+	_, _, err = matchIndexOnField(schema, descriptions, "idx3")
+	assert.NotNil(t, err)
+
+	_, _, err = matchIndexOnField(schema, descriptions, "idx4")
+	assert.NotNil(t, err)
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isAlpha(c uint8) bool {
@@ -141,6 +142,27 @@ func ValidateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+func ValidateJSONMaxLength(maxLength int64) error {
+	if maxLength <= 0 || maxLength > Params.MaxJSONLength {
+		return fmt.Errorf("invalid max_length: %d. should be in range 1 ~ %d", maxLength, Params.MaxJSONLength)
+	}
+	return nil
+}
+
+// ValidateArrayField checks the element type, max_capacity and max_length of an array field
+func ValidateArrayField(field *schemapb.FieldSchema) error {
+	if !typeutil.IsArrayElementType(field.ElementType) {
+		return fmt.Errorf("invalid element type %s of array field %s", field.ElementType.String(), field.Name)
+	}
+	if _, err := typeutil.GetArrayMaxCapacity(field); err != nil {
+		return err
+	}
+	if _, err := typeutil.GetJSONMaxLength(field); err != nil {
+		return err
+	}
+	return nil
+}
+
 func ValidateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
 		return nil
@@ -218,7 +240,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_JSON, schemapb.DataType_Array:
 		return false, nil
 
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -316,7 +339,15 @@ func ValidateSchema(coll *schemapb.CollectionSchema) error {
 			if len(field.IndexParams) != 0 {
 				return fmt.Errorf("index params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
-			if len(field.TypeParams) != 0 {
+			if field.DataType == schemapb.DataType_JSON {
+				if _, err := typeutil.GetJSONMaxLength(field); err != nil {
+					return err
+				}
+			} else if field.DataType == schemapb.DataType_Array {
+				if err := ValidateArrayField(field); err != nil {
+					return err
+				}
+			} else if len(field.TypeParams) != 0 {
 				return fmt.Errorf("type params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
 		}
@@ -372,4 +372,46 @@ func TestValidateSchema(t *testing.T) {
 
 	pf3.IndexParams = ip3Good
 	assert.Nil(t, ValidateSchema(coll))
+
+	pf4 := &schemapb.FieldSchema{
+		Name:         "f4",
+		FieldID:      103,
+		IsPrimaryKey: false,
+		Description:  "",
+		DataType:     schemapb.DataType_JSON,
+	}
+
+	coll.Fields = append(coll.Fields, pf4)
+	assert.NotNil(t, ValidateSchema(coll))
+
+	pf4.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "0"}}
+	assert.NotNil(t, ValidateSchema(coll))
+
+	pf4.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "1024"}}
+	assert.Nil(t, ValidateSchema(coll))
+
+	pf5 := &schemapb.FieldSchema{
+		Name:         "f5",
+		FieldID:      104,
+		IsPrimaryKey: false,
+		Description:  "",
+		DataType:     schemapb.DataType_Array,
+		ElementType:  schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: "max_length", Value: "1024"},
+			{Key: "max_capacity", Value: "16"},
+		},
+	}
+
+	coll.Fields = append(coll.Fields, pf5)
+	assert.NotNil(t, ValidateSchema(coll))
+
+	pf5.ElementType = schemapb.DataType_String
+	assert.Nil(t, ValidateSchema(coll))
+
+	pf5.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "1024"}}
+	assert.NotNil(t, ValidateSchema(coll))
+
+	pf5.TypeParams = []*commonpb.KeyValuePair{{Key: "max_capacity", Value: "16"}}
+	assert.NotNil(t, ValidateSchema(coll))
 }
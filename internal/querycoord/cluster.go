@@ -42,6 +42,7 @@ type Cluster interface {
 	loadSegments(ctx context.Context, nodeID int64, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, nodeID int64, in *querypb.ReleaseSegmentsRequest) error
 	getNumSegments(nodeID int64) (int, error)
+	getNodeResources(ctx context.Context, nodeID int64) (*querypb.GetNodeResourcesResponse, error)
 
 	watchDmChannels(ctx context.Context, nodeID int64, in *querypb.WatchDmChannelsRequest) error
 	getNumDmChannels(nodeID int64) (int, error)
@@ -390,6 +391,16 @@ func (c *queryNodeCluster) getNumSegments(nodeID int64) (int, error) {
 	return numSegment, nil
 }
 
+func (c *queryNodeCluster) getNodeResources(ctx context.Context, nodeID int64) (*querypb.GetNodeResourcesResponse, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		return node.getNodeResources(ctx)
+	}
+	return nil, fmt.Errorf("GetNodeResources: can't find query node %d", nodeID)
+}
+
 func (c *queryNodeCluster) registerNode(ctx context.Context, session *sessionutil.Session, id UniqueID) error {
 	c.Lock()
 	defer c.Unlock()
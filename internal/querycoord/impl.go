@@ -500,3 +500,81 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 		Infos:  segmentInfos,
 	}, nil
 }
+
+// GetShardLeaders returns the query nodes serving each dml channel of a loaded collection,
+// the node watching the channel comes first and is followed by the nodes holding sealed segments
+func (qc *QueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getShardLeaders end with query coordinator not healthy")
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, err
+	}
+
+	collectionInfo, err := qc.meta.getCollectionInfoByID(req.CollectionID)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, err
+	}
+
+	sealedNodeIDs := make([]int64, 0)
+	for _, info := range qc.meta.showSegmentInfos(req.CollectionID, nil) {
+		found := false
+		for _, nodeID := range sealedNodeIDs {
+			if nodeID == info.NodeID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			sealedNodeIDs = append(sealedNodeIDs, info.NodeID)
+		}
+	}
+
+	shards := make([]*querypb.ShardLeadersList, 0)
+	for _, channelInfo := range collectionInfo.ChannelInfos {
+		nodeIDs := []int64{channelInfo.NodeIDLoaded}
+		for _, nodeID := range sealedNodeIDs {
+			if nodeID != channelInfo.NodeIDLoaded {
+				nodeIDs = append(nodeIDs, nodeID)
+			}
+		}
+		nodeAddrs := make([]string, 0, len(nodeIDs))
+		for _, nodeID := range nodeIDs {
+			node, err := qc.cluster.getNodeByID(nodeID)
+			if err == nil && !node.isOnService() {
+				err = fmt.Errorf("query node %d is offline", nodeID)
+			}
+			if err != nil {
+				status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+				status.Reason = err.Error()
+				return &querypb.GetShardLeadersResponse{
+					Status: status,
+				}, err
+			}
+			nodeAddrs = append(nodeAddrs, node.getAddress())
+		}
+		for _, channel := range channelInfo.ChannelIDs {
+			shards = append(shards, &querypb.ShardLeadersList{
+				ChannelName: channel,
+				NodeIds:     nodeIDs,
+				NodeAddrs:   nodeAddrs,
+			})
+		}
+	}
+	log.Debug("getShardLeaders", zap.Int64("collectionID", req.CollectionID), zap.Int("num of shards", len(shards)))
+
+	return &querypb.GetShardLeadersResponse{
+		Status: status,
+		Shards: shards,
+	}, nil
+}
@@ -388,6 +388,7 @@ type queryNodeServerMock struct {
 	releaseCollection func() (*commonpb.Status, error)
 	releasePartition  func() (*commonpb.Status, error)
 	releaseSegment    func() (*commonpb.Status, error)
+	getNodeResources  func() (*querypb.GetNodeResourcesResponse, error)
 }
 
 func newQueryNodeServerMock(ctx context.Context) *queryNodeServerMock {
@@ -406,6 +407,7 @@ func newQueryNodeServerMock(ctx context.Context) *queryNodeServerMock {
 		releaseCollection: returnSuccessResult,
 		releasePartition:  returnSuccessResult,
 		releaseSegment:    returnSuccessResult,
+		getNodeResources:  returnEnoughResources,
 	}
 }
 
@@ -512,6 +514,10 @@ func (qs *queryNodeServerMock) ReleaseSegments(ctx context.Context, req *querypb
 	return qs.releaseSegment()
 }
 
+func (qs *queryNodeServerMock) GetNodeResources(ctx context.Context, req *querypb.GetNodeResourcesRequest) (*querypb.GetNodeResourcesResponse, error) {
+	return qs.getNodeResources()
+}
+
 func startQueryNodeServer(ctx context.Context) (*queryNodeServerMock, error) {
 	node := newQueryNodeServerMock(ctx)
 	err := node.run()
@@ -533,3 +539,13 @@ func returnFailedResult() (*commonpb.Status, error) {
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}, errors.New("query node do task failed")
 }
+
+func returnEnoughResources() (*querypb.GetNodeResourcesResponse, error) {
+	return &querypb.GetNodeResourcesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		MemoryTotal: 64 * 1024 * 1024 * 1024,
+		MemoryLimit: 64 * 1024 * 1024 * 1024,
+	}, nil
+}
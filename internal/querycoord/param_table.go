@@ -58,6 +58,10 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+
+	paramtable.StorageConfig
+
+	EnableActiveStandby bool
 }
 
 var Params ParamTable
@@ -96,6 +100,9 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSLStr()
 		p.initMinioBucketName()
+		p.InitStorageConfig(&p.BaseTable)
+
+		p.initEnableActiveStandby()
 	})
 }
 
@@ -247,3 +254,14 @@ func (p *ParamTable) initMinioBucketName() {
 	}
 	p.MinioBucketName = bucketName
 }
+
+func (p *ParamTable) initEnableActiveStandby() {
+	enable, err := p.Load("queryCoord.enableActiveStandby")
+	if err != nil {
+		panic(err)
+	}
+	p.EnableActiveStandby, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
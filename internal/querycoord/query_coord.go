@@ -20,9 +20,9 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/golang/protobuf/proto"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -72,12 +72,37 @@ type QueryCoord struct {
 func (qc *QueryCoord) Register() error {
 	log.Debug("query coord session info", zap.String("metaPath", Params.MetaRootPath), zap.Strings("etcdEndPoints", Params.EtcdEndpoints), zap.String("address", Params.Address))
 	qc.session = sessionutil.NewSession(qc.loopCtx, Params.MetaRootPath, Params.EtcdEndpoints)
+	qc.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	qc.session.Init(typeutil.QueryCoordRole, Params.Address, true)
 	Params.NodeID = uint64(qc.session.ServerID)
 	return nil
 }
 
+// EtcdClient returns the etcd client of the QueryCoord session, nil before it registers
+func (qc *QueryCoord) EtcdClient() *clientv3.Client {
+	return qc.session.EtcdClient()
+}
+
+// activeStandBy blocks a standby query coordinator until the active one is down, then only moves
+// the state to Initializing. Init calls it before loading anything, so the meta and cluster are
+// loaded by the rest of Init once this query coordinator is active.
+func (qc *QueryCoord) activeStandBy() error {
+	qc.UpdateStateCode(internalpb.StateCode_StandBy)
+	log.Debug("query coordinator enter standby mode", zap.Int64("nodeID", qc.session.ServerID))
+	return qc.session.ProcessActiveStandBy(func() error {
+		log.Debug("query coordinator switch from standby to active", zap.Int64("nodeID", qc.session.ServerID))
+		qc.UpdateStateCode(internalpb.StateCode_Initializing)
+		return nil
+	})
+}
+
 func (qc *QueryCoord) Init() error {
+	if Params.EnableActiveStandby {
+		if err := qc.activeStandBy(); err != nil {
+			log.Error("query coordinator wait for active failed", zap.Error(err))
+			return err
+		}
+	}
 	connectEtcdFn := func() error {
 		etcdClient, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
 		if err != nil {
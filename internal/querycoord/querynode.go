@@ -58,11 +58,13 @@ type Node interface {
 
 	setNodeState(onService bool)
 	isOnService() bool
+	getAddress() string
 
 	getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error
 	releaseSegments(ctx context.Context, in *querypb.ReleaseSegmentsRequest) error
 	getComponentInfo(ctx context.Context) *internalpb.ComponentInfo
+	getNodeResources(ctx context.Context) (*querypb.GetNodeResourcesResponse, error)
 }
 
 type queryNode struct {
@@ -438,6 +440,10 @@ func (qn *queryNode) isOnService() bool {
 	return qn.onService
 }
 
+func (qn *queryNode) getAddress() string {
+	return qn.address
+}
+
 //***********************grpc req*************************//
 func (qn *queryNode) watchDmChannels(ctx context.Context, in *querypb.WatchDmChannelsRequest) error {
 	qn.serviceLock.RLock()
@@ -642,6 +648,24 @@ func (qn *queryNode) releaseSegments(ctx context.Context, in *querypb.ReleaseSeg
 	return nil
 }
 
+func (qn *queryNode) getNodeResources(ctx context.Context) (*querypb.GetNodeResourcesResponse, error) {
+	qn.serviceLock.RLock()
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
+		return nil, errors.New("GetNodeResources: queryNode is offline")
+	}
+
+	res, err := qn.client.GetNodeResources(ctx, &querypb.GetNodeResourcesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(res.Status.Reason)
+	}
+	return res, nil
+}
+
 //****************************************************//
 
 func saveNodeCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, nodeID int64, kv *etcdkv.EtcdKV) error {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -27,9 +28,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -245,6 +248,8 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
+				Statslogs:    segmentBingLog.Statslogs,
 			}
 
 			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
@@ -301,7 +306,12 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	assignInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs)
+	err = assignInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs)
+	if err != nil {
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
 	log.Debug("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID))
 
 	log.Debug("LoadCollection execute done",
@@ -548,6 +558,8 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
+				Statslogs:    segmentBingLog.Statslogs,
 			}
 
 			msgBase := proto.Clone(lpt.Base).(*commonpb.MsgBase)
@@ -578,7 +590,12 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 			log.Debug("LoadPartitionTask: set watchDmChannelsRequests", zap.Any("request", watchDmRequest), zap.Int64("collectionID", collectionID))
 		}
 	}
-	assignInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs)
+	err := assignInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs)
+	if err != nil {
+		status.Reason = err.Error()
+		lpt.result = status
+		return err
+	}
 	log.Debug("LoadPartitionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 
 	log.Debug("LoadPartitionTask Execute done",
@@ -837,13 +854,12 @@ func (lst *LoadSegmentTask) PostExecute(context.Context) error {
 }
 
 func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
-	segmentIDs := make([]UniqueID, 0)
 	collectionID := lst.Infos[0].CollectionID
 	reScheduledTask := make([]task, 0)
-	for _, info := range lst.Infos {
-		segmentIDs = append(segmentIDs, info.SegmentID)
+	segment2Nodes, err := shuffleSegmentsToQueryNode(lst.ctx, lst.Infos, lst.Schema, lst.cluster)
+	if err != nil {
+		return nil, err
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster)
 	node2segmentInfos := make(map[int64][]*querypb.SegmentLoadInfo)
 	for index, info := range lst.Infos {
 		nodeID := segment2Nodes[index]
@@ -1285,6 +1301,8 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 							PartitionID:  partitionID,
 							CollectionID: collectionID,
 							BinlogPaths:  segmentBingLog.FieldBinlogs,
+							NumOfRows:    segmentBingLog.NumOfRows,
+							Statslogs:    segmentBingLog.Statslogs,
 						}
 
 						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
@@ -1344,7 +1362,12 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 						}
 					}
 				}
-				assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs)
+				err = assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs)
+				if err != nil {
+					status.Reason = err.Error()
+					lbt.result = status
+					return err
+				}
 				log.Debug("loadBalanceTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 			}
 		}
@@ -1432,8 +1455,14 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 }
 
-func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster) []int64 {
-	maxNumSegments := 0
+// shuffleSegmentsToQueryNode places the segments on the query nodes by their estimated memory size,
+// the larger segments are placed first and every segment goes to the node with the most memory available
+func shuffleSegmentsToQueryNode(ctx context.Context, infos []*querypb.SegmentLoadInfo, schema *schemapb.CollectionSchema, cluster *queryNodeCluster) ([]int64, error) {
+	res := make([]int64, len(infos))
+	if len(infos) == 0 {
+		return res, nil
+	}
+
 	nodes := make(map[int64]Node)
 	var err error
 	for {
@@ -1445,47 +1474,51 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster
 		}
 		break
 	}
+
+	availableMemory := make(map[int64]int64)
 	for nodeID := range nodes {
-		numSegments, _ := cluster.getNumSegments(nodeID)
-		if numSegments > maxNumSegments {
-			maxNumSegments = numSegments
+		resources, err := cluster.getNodeResources(ctx, nodeID)
+		if err != nil {
+			log.Warn("shuffleSegmentsToQueryNode: get node resources failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
 		}
+		availableMemory[nodeID] = int64(resources.MemoryLimit) - int64(resources.MemoryUsed)
 	}
-	res := make([]int64, 0)
-
-	if len(segmentIDs) == 0 {
-		return res
+	if len(availableMemory) == 0 {
+		return nil, errors.New("no query node reports its memory usage")
 	}
 
-	offset := 0
-	loopAll := false
-	for {
-		lastOffset := offset
-		if !loopAll {
-			for nodeID := range nodes {
-				numSegments, _ := cluster.getNumSegments(nodeID)
-				if numSegments >= maxNumSegments {
-					continue
-				}
-				res = append(res, nodeID)
-				offset++
-				if offset == len(segmentIDs) {
-					return res
-				}
-			}
-		} else {
-			for nodeID := range nodes {
-				res = append(res, nodeID)
-				offset++
-				if offset == len(segmentIDs) {
-					return res
-				}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return nil, err
+	}
+	segmentSizes := make([]int64, len(infos))
+	order := make([]int, len(infos))
+	for i, info := range infos {
+		segmentSizes[i] = info.NumOfRows * int64(sizePerRecord)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return segmentSizes[order[i]] > segmentSizes[order[j]]
+	})
+
+	for _, i := range order {
+		selectedNodeID := int64(-1)
+		for nodeID, available := range availableMemory {
+			if selectedNodeID == -1 || available > availableMemory[selectedNodeID] ||
+				(available == availableMemory[selectedNodeID] && nodeID < selectedNodeID) {
+				selectedNodeID = nodeID
 			}
 		}
-		if lastOffset == offset {
-			loopAll = true
+		if availableMemory[selectedNodeID] < segmentSizes[i] {
+			return nil, fmt.Errorf("no query node has enough memory to load segment %d, which needs about %d bytes, "+
+				"query node %d has the most memory available with %d bytes",
+				infos[i].SegmentID, segmentSizes[i], selectedNodeID, availableMemory[selectedNodeID])
 		}
+		res[i] = selectedNodeID
+		availableMemory[selectedNodeID] -= segmentSizes[i]
 	}
+	return res, nil
 }
 
 func mergeVChannelInfo(info1 *datapb.VchannelInfo, info2 *datapb.VchannelInfo) *datapb.VchannelInfo {
@@ -1525,19 +1558,27 @@ func assignInternalTask(ctx context.Context,
 	meta Meta,
 	cluster *queryNodeCluster,
 	loadSegmentRequests []*querypb.LoadSegmentsRequest,
-	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) {
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) error {
 
 	sp, _ := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
-	segmentsToLoad := make([]UniqueID, 0)
+	defer sp.End()
+	segmentsToLoad := make([]*querypb.SegmentLoadInfo, 0)
 	for _, req := range loadSegmentRequests {
-		segmentsToLoad = append(segmentsToLoad, req.Infos[0].SegmentID)
+		segmentsToLoad = append(segmentsToLoad, req.Infos[0])
 	}
 	channelsToWatch := make([]string, 0)
 	for _, req := range watchDmChannelRequests {
 		channelsToWatch = append(channelsToWatch, req.Infos[0].ChannelName)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentsToLoad, cluster)
+	var schema *schemapb.CollectionSchema
+	if len(loadSegmentRequests) > 0 {
+		schema = loadSegmentRequests[0].Schema
+	}
+	segment2Nodes, err := shuffleSegmentsToQueryNode(ctx, segmentsToLoad, schema, cluster)
+	if err != nil {
+		log.Error("assignInternalTask: assign segments to query nodes failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
 	watchRequest2Nodes := shuffleChannelsToQueryNode(channelsToWatch, cluster)
 	log.Debug("assignInternalTask: segment to node", zap.Any("segments map", segment2Nodes), zap.Int64("collectionID", collectionID))
 	log.Debug("assignInternalTask: watch request to node", zap.Any("request map", watchRequest2Nodes), zap.Int64("collectionID", collectionID))
@@ -1565,7 +1606,7 @@ func assignInternalTask(ctx context.Context,
 	}
 
 	for nodeID, loadSegmentsReq := range node2Segments {
-		ctx = opentracing.ContextWithSpan(context.Background(), sp)
+		ctx = oteltrace.ContextWithSpan(context.Background(), sp)
 		loadSegmentsReq.NodeID = nodeID
 		loadSegmentTask := &LoadSegmentTask{
 			BaseTask: BaseTask{
@@ -1583,7 +1624,7 @@ func assignInternalTask(ctx context.Context,
 	}
 
 	for index, nodeID := range watchRequest2Nodes {
-		ctx = opentracing.ContextWithSpan(context.Background(), sp)
+		ctx = oteltrace.ContextWithSpan(context.Background(), sp)
 		watchDmChannelReq := watchDmChannelRequests[index]
 		watchDmChannelReq.NodeID = nodeID
 		watchDmChannelTask := &WatchDmChannelTask{
@@ -1602,7 +1643,7 @@ func assignInternalTask(ctx context.Context,
 
 	for nodeID, watched := range watchQueryChannelInfo {
 		if !watched {
-			ctx = opentracing.ContextWithSpan(context.Background(), sp)
+			ctx = oteltrace.ContextWithSpan(context.Background(), sp)
 			queryChannel, queryResultChannel := meta.GetQueryChannel(collectionID)
 
 			msgBase := proto.Clone(parentTask.MsgBase()).(*commonpb.MsgBase)
@@ -1628,4 +1669,5 @@ func assignInternalTask(ctx context.Context,
 			log.Debug("assignInternalTask: add a watchQueryChannelTask childTask", zap.Any("task", watchQueryChannelTask))
 		}
 	}
+	return nil
 }
@@ -21,7 +21,7 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
@@ -32,7 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
-	oplog "github.com/opentracing/opentracing-go/log"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type TaskQueue struct {
@@ -444,12 +444,11 @@ func (scheduler *TaskScheduler) Enqueue(tasks []task) {
 
 func (scheduler *TaskScheduler) processTask(t task) error {
 	span, ctx := trace.StartSpanFromContext(t.TraceCtx(),
-		opentracing.Tags{
-			"Type": t.Type(),
-			"ID":   t.ID(),
-		})
-	defer span.Finish()
-	span.LogFields(oplog.Int64("processTask: scheduler process PreExecute", t.ID()))
+		oteltrace.WithAttributes(
+			attribute.String("Type", t.Type().String()),
+			attribute.Int64("ID", t.ID())))
+	defer span.End()
+	span.AddEvent("processTask: scheduler process PreExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	t.PreExecute(ctx)
 
 	key := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
@@ -461,7 +460,7 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 	}
 	t.SetState(taskDoing)
 
-	span.LogFields(oplog.Int64("processTask: scheduler process Execute", t.ID()))
+	span.AddEvent("processTask: scheduler process Execute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	err = t.Execute(ctx)
 	if err != nil {
 		log.Debug("processTask: execute err", zap.String("reason", err.Error()), zap.Int64("taskID", t.ID()))
@@ -507,7 +506,7 @@ func (scheduler *TaskScheduler) processTask(t task) error {
 		return err
 	}
 
-	span.LogFields(oplog.Int64("processTask: scheduler process PostExecute", t.ID()))
+	span.AddEvent("processTask: scheduler process PostExecute", oteltrace.WithAttributes(attribute.Int64("ID", t.ID())))
 	t.PostExecute(ctx)
 	t.SetState(taskDone)
 
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 )
 
 /*
@@ -55,6 +56,7 @@ type ReplicaInterface interface {
 	getCollectionNum() int
 	getPartitionIDs(collectionID UniqueID) ([]UniqueID, error)
 	getVecFieldIDsByCollectionID(collectionID UniqueID) ([]int64, error)
+	getScalarFieldIDsByCollectionID(collectionID UniqueID) ([]int64, error)
 
 	// partition
 	addPartition(collectionID UniqueID, partitionID UniqueID) error
@@ -72,6 +74,7 @@ type ReplicaInterface interface {
 	getSegmentByID(segmentID UniqueID) (*Segment, error)
 	hasSegment(segmentID UniqueID) bool
 	getSegmentNum() int
+	countSegments() (num int, numRows int64, memSize int64)
 	getSegmentStatistics() []*internalpb.SegmentStats
 
 	// excluded segments
@@ -221,6 +224,28 @@ func (colReplica *collectionReplica) getVecFieldIDsByCollectionID(collectionID U
 	return vecFields, nil
 }
 
+// getScalarFieldIDsByCollectionID returns the ids of user defined fields which are not vectors
+func (colReplica *collectionReplica) getScalarFieldIDsByCollectionID(collectionID UniqueID) ([]int64, error) {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	fields, err := colReplica.getFieldsByCollectionIDPrivate(collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	scalarFields := make([]int64, 0)
+	for _, field := range fields {
+		if field.FieldID < rootcoord.StartOfUserFieldID {
+			continue
+		}
+		if field.DataType != schemapb.DataType_BinaryVector && field.DataType != schemapb.DataType_FloatVector {
+			scalarFields = append(scalarFields, field.FieldID)
+		}
+	}
+	return scalarFields, nil
+}
+
 func (colReplica *collectionReplica) getFieldsByCollectionIDPrivate(collectionID UniqueID) ([]*schemapb.FieldSchema, error) {
 	collection, err := colReplica.getCollectionByIDPrivate(collectionID)
 	if err != nil {
@@ -448,6 +473,18 @@ func (colReplica *collectionReplica) getSegmentNum() int {
 	return len(colReplica.segments)
 }
 
+// countSegments returns the num of segments, together with their total row count and memory size
+func (colReplica *collectionReplica) countSegments() (num int, numRows int64, memSize int64) {
+	colReplica.mu.RLock()
+	defer colReplica.mu.RUnlock()
+
+	for _, segment := range colReplica.segments {
+		numRows += segment.getRowCount()
+		memSize += segment.getMemSize()
+	}
+	return len(colReplica.segments), numRows, memSize
+}
+
 func (colReplica *collectionReplica) getSegmentStatistics() []*internalpb.SegmentStats {
 	colReplica.mu.RLock()
 	defer colReplica.mu.RUnlock()
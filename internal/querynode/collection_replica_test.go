@@ -71,6 +71,26 @@ func TestCollectionReplica_hasCollection(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_getFieldIDsByCollectionID(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	vecFieldIDs, err := node.historical.replica.getVecFieldIDsByCollectionID(collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{100}, vecFieldIDs)
+
+	scalarFieldIDs, err := node.historical.replica.getScalarFieldIDsByCollectionID(collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{101}, scalarFieldIDs)
+
+	_, err = node.historical.replica.getScalarFieldIDsByCollectionID(UniqueID(1))
+	assert.Error(t, err)
+
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
 //----------------------------------------------------------------------------------------------------- partition
 func TestCollectionReplica_getPartitionNum(t *testing.T) {
 	node := newQueryNodeMock()
@@ -246,6 +266,26 @@ func TestCollectionReplica_hasSegment(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_countSegments(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	const segmentNum = 3
+
+	num, _, _ := node.historical.replica.countSegments()
+	for i := 0; i < segmentNum; i++ {
+		err := node.historical.replica.addSegment(UniqueID(i+100), defaultPartitionID, collectionID, "", segmentTypeGrowing, true)
+		assert.NoError(t, err)
+	}
+	numAdded, numRows, _ := node.historical.replica.countSegments()
+	assert.Equal(t, num+segmentNum, numAdded)
+	assert.GreaterOrEqual(t, numRows, int64(0))
+
+	err := node.Stop()
+	assert.NoError(t, err)
+}
+
 func TestCollectionReplica_freeAll(t *testing.T) {
 	node := newQueryNodeMock()
 	collectionID := UniqueID(0)
@@ -13,7 +13,6 @@ package querynode
 
 import (
 	"github.com/golang/protobuf/proto"
-	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -47,7 +46,7 @@ func (ddNode *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// TODO: add error handling
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range msMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -94,7 +93,7 @@ func (ddNode *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var res Msg = ddNode.ddMsg
 	for _, span := range spans {
-		span.Finish()
+		span.End()
 	}
 	return []Msg{res}
 }
@@ -184,7 +183,7 @@ func (ddNode *ddNode) dropPartition(msg *msgstream.DropPartitionMsg) {
 }
 
 func newDDNode(replica ReplicaInterface) *ddNode {
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := baseNode{}
@@ -25,7 +25,7 @@ func (dNode *deleteNode) Operate(in []*Msg) []*Msg {
 }
 
 func newDeleteNode() *deleteNode {
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := baseNode{}
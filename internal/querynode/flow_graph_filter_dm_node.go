@@ -14,7 +14,6 @@ package querynode
 import (
 	"errors"
 
-	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -54,7 +53,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		return []Msg{}
 	}
 
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range msgStreamMsg.TsMessages() {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		spans = append(spans, sp)
@@ -82,7 +81,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var res Msg = &iMsg
 	for _, sp := range spans {
-		sp.Finish()
+		sp.End()
 	}
 	return []Msg{res}
 }
@@ -90,7 +89,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg) *msgstream.InsertMsg {
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	// check if collection and partition exist
 	collection := fdmNode.replica.hasCollection(msg.CollectionID)
 	partition := fdmNode.replica.hasPartition(msg.PartitionID)
@@ -175,7 +174,7 @@ func newFilteredDmNode(replica ReplicaInterface,
 	collectionID UniqueID,
 	partitionID UniqueID) *filterDmNode {
 
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := baseNode{}
@@ -65,7 +65,7 @@ func (gcNode *gcNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 }
 
 func newGCNode(replica ReplicaInterface) *gcNode {
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := baseNode{}
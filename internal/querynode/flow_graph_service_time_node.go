@@ -112,7 +112,7 @@ func newServiceTimeNode(ctx context.Context,
 	channel Channel,
 	factory msgstream.Factory) *serviceTimeNode {
 
-	maxQueueLength := Params.FlowGraphMaxQueueLength
+	maxQueueLength := Params.GetFlowGraphMaxQueueLength()
 	maxParallelism := Params.FlowGraphMaxParallelism
 
 	baseNode := baseNode{}
@@ -19,9 +19,9 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/golang/protobuf/proto"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"go.etcd.io/etcd/mvcc/mvccpb"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -177,6 +177,29 @@ func (h *historical) removeGlobalSegmentIDsByPartitionIds(partitionIDs []UniqueI
 	}
 }
 
+// getSegmentsOnService returns the sealed segments on service in the partitions,
+// every partition of the collection is included if partIDs is empty
+func (h *historical) getSegmentsOnService(collID UniqueID, partIDs []UniqueID) []*Segment {
+	if len(partIDs) == 0 {
+		partIDs, _ = h.replica.getPartitionIDs(collID)
+	}
+	segments := make([]*Segment, 0)
+	for _, partID := range partIDs {
+		segIDs, err := h.replica.getSegmentIDs(partID)
+		if err != nil {
+			continue
+		}
+		for _, segID := range segIDs {
+			seg, err := h.replica.getSegmentByID(segID)
+			if err != nil || !seg.getOnService() {
+				continue
+			}
+			segments = append(segments, seg)
+		}
+	}
+	return segments
+}
+
 func (h *historical) search(searchReqs []*searchRequest,
 	collID UniqueID,
 	partIDs []UniqueID,
@@ -22,10 +22,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -109,7 +111,14 @@ func (node *QueryNode) AddQueryChannel(ctx context.Context, in *queryPb.AddQuery
 	}
 
 	// add request channel
-	sc := node.queryService.queryCollections[in.CollectionID]
+	sc, err := node.queryService.getQueryCollection(collectionID)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
 	consumeChannels := []string{in.RequestChannelID}
 	//consumeSubName := Params.MsgChannelSubName
 	consumeSubName := Params.MsgChannelSubName + "-" + strconv.FormatInt(collectionID, 10) + "-" + strconv.Itoa(rand.Int())
@@ -479,3 +488,128 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 		Infos: infos,
 	}, nil
 }
+
+// Search searches the collection on this node directly instead of through the query channel,
+// only the growing segments of the requested dml channels are searched. An error is returned
+// only if the node can't serve the request, failures of the search itself are in the status.
+func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (*internalpb.SearchResults, error) {
+	failedResults := func(err error) (*internalpb.SearchResults, error) {
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, err
+	}
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return failedResults(fmt.Errorf("query node %d is not ready", Params.QueryNodeID))
+	}
+	if node.queryService == nil {
+		return failedResults(errors.New("null query service"))
+	}
+	qc, err := node.queryService.getQueryCollection(req.Req.CollectionID)
+	if err != nil {
+		return failedResults(err)
+	}
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("Search %d", req.Req.Base.MsgID))
+	searchMsg := &msgstream.SearchMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            withTimeRecorder(ctx, tr),
+			BeginTimestamp: req.Req.Base.Timestamp,
+			EndTimestamp:   req.Req.Base.Timestamp,
+		},
+		SearchRequest: *req.Req,
+	}
+	if err = qc.waitQueryable(ctx, searchMsg); err != nil {
+		return failedResults(err)
+	}
+	results, err := qc.doSearch(searchMsg, req.DmlChannels)
+	if err == nil && len(results) == 0 {
+		err = fmt.Errorf("empty search results, msgID = %d", searchMsg.ID())
+	}
+	if err != nil {
+		log.Warn("QueryNode::Impl::Search failed", zap.Int64("msgID", searchMsg.ID()), zap.Error(err))
+		// the request is served but failed, so only the status carries the error
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("QueryNode::Impl::Search done",
+		zap.Int64("collectionID", req.Req.CollectionID),
+		zap.Int64("msgID", searchMsg.ID()),
+		zap.Strings("dmlChannels", req.DmlChannels))
+	return results[0], nil
+}
+
+// Query retrieves entities from the collection on this node directly instead of through the query channel,
+// only the growing segments of the requested dml channels are retrieved. An error is returned
+// only if the node can't serve the request, failures of the retrieval itself are in the status.
+func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	failedResults := func(err error) (*internalpb.RetrieveResults, error) {
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, err
+	}
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return failedResults(fmt.Errorf("query node %d is not ready", Params.QueryNodeID))
+	}
+	if node.queryService == nil {
+		return failedResults(errors.New("null query service"))
+	}
+	qc, err := node.queryService.getQueryCollection(req.Req.CollectionID)
+	if err != nil {
+		return failedResults(err)
+	}
+
+	retrieveMsg := &msgstream.RetrieveMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Req.Base.Timestamp,
+			EndTimestamp:   req.Req.Base.Timestamp,
+		},
+		RetrieveRequest: *req.Req,
+	}
+	if err = qc.waitQueryable(ctx, retrieveMsg); err != nil {
+		return failedResults(err)
+	}
+	result, err := qc.doRetrieve(retrieveMsg, req.DmlChannels)
+	if err != nil {
+		log.Warn("QueryNode::Impl::Query failed", zap.Int64("msgID", retrieveMsg.ID()), zap.Error(err))
+		// the request is served but failed, so only the status carries the error
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("QueryNode::Impl::Query done",
+		zap.Int64("collectionID", req.Req.CollectionID),
+		zap.Int64("msgID", retrieveMsg.ID()),
+		zap.Strings("dmlChannels", req.DmlChannels))
+	return result, nil
+}
+
+func (node *QueryNode) GetNodeResources(ctx context.Context, req *queryPb.GetNodeResourcesRequest) (*queryPb.GetNodeResourcesResponse, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", Params.QueryNodeID)
+		res := &queryPb.GetNodeResourcesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+		return res, err
+	}
+	return node.getNodeResources(), nil
+}
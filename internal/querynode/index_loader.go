@@ -32,6 +32,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/retry"
 )
 
@@ -132,10 +134,13 @@ func (loader *indexLoader) loadIndex(segment *Segment, fieldID int64) error {
 	if err != nil {
 		return err
 	}
-	// 3. drop vector field data if index loaded successfully
-	err = segment.dropFieldData(fieldID)
-	if err != nil {
-		return err
+	// 3. drop vector field data if index loaded successfully,
+	// scalar field data is kept for output fields
+	if !indexparamcheck.IsScalarIndexType(indexParams["index_type"]) {
+		err = segment.dropFieldData(fieldID)
+		if err != nil {
+			return err
+		}
 	}
 	// 4. update segment index stats
 	err = loader.updateSegmentIndexStats(segment)
@@ -286,7 +291,9 @@ func (loader *indexLoader) sendQueryNodeStats() error {
 	return nil
 }
 
-func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment, fieldID UniqueID) error {
+// setIndexInfos describes the segment once for the indexes on all its fields, and sets the index info
+// of the fields in fieldIDs which are indexed, the ids of these indexed fields are returned
+func (loader *indexLoader) setIndexInfos(collectionID UniqueID, segment *Segment, fieldIDs []UniqueID) ([]UniqueID, error) {
 	ctx := context.TODO()
 	req := &milvuspb.DescribeSegmentRequest{
 		Base: &commonpb.MsgBase{
@@ -297,45 +304,72 @@ func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment,
 	}
 	response, err := loader.rootCoord.DescribeSegment(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if response.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(response.Status.Reason)
+		return nil, errors.New(response.Status.Reason)
 	}
 
-	if !response.EnableIndex {
-		return errors.New("there are no indexes on this segment")
+	fieldIndexes := make(map[UniqueID]*milvuspb.SegmentFieldIndex)
+	buildIDs := make([]UniqueID, 0)
+	for _, fieldIndex := range response.FieldIndexes {
+		if !fieldIndex.EnableIndex || !funcutil.SliceContain(fieldIDs, fieldIndex.FieldID) {
+			continue
+		}
+		// a field with several indexes loads the first one
+		if _, ok := fieldIndexes[fieldIndex.FieldID]; ok {
+			continue
+		}
+		fieldIndexes[fieldIndex.FieldID] = fieldIndex
+		buildIDs = append(buildIDs, fieldIndex.BuildID)
+	}
+	if len(fieldIndexes) == 0 {
+		return nil, nil
 	}
 
 	if loader.indexCoord == nil {
-		return errors.New("null index coordinator client")
+		return nil, errors.New("null index coordinator client")
 	}
 
 	indexFilePathRequest := &indexpb.GetIndexFilePathsRequest{
-		IndexBuildIDs: []UniqueID{response.BuildID},
+		IndexBuildIDs: buildIDs,
 	}
 	pathResponse, err := loader.indexCoord.GetIndexFilePaths(ctx, indexFilePathRequest)
-	if err != nil || pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return err
-	}
-
-	if len(pathResponse.FilePaths) <= 0 {
-		return errors.New("illegal index file paths")
+	if err != nil {
+		return nil, err
 	}
-
-	info := &indexInfo{
-		indexID:    response.IndexID,
-		buildID:    response.BuildID,
-		indexPaths: pathResponse.FilePaths[0].IndexFilePaths,
-		readyLoad:  true,
+	if pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(pathResponse.Status.Reason)
 	}
-	segment.setEnableIndex(response.EnableIndex)
-	err = segment.setIndexInfo(fieldID, info)
-	if err != nil {
-		return err
+	indexPaths := make(map[UniqueID][]string)
+	for _, filePaths := range pathResponse.FilePaths {
+		indexPaths[filePaths.IndexBuildID] = filePaths.IndexFilePaths
 	}
 
-	return nil
+	indexedFieldIDs := make([]UniqueID, 0, len(fieldIndexes))
+	for _, fieldID := range fieldIDs {
+		fieldIndex, ok := fieldIndexes[fieldID]
+		if !ok {
+			continue
+		}
+		paths, ok := indexPaths[fieldIndex.BuildID]
+		if !ok || len(paths) <= 0 {
+			return nil, fmt.Errorf("illegal index file paths, fieldID = %d, buildID = %d", fieldID, fieldIndex.BuildID)
+		}
+		info := &indexInfo{
+			indexID:    fieldIndex.IndexID,
+			buildID:    fieldIndex.BuildID,
+			indexPaths: paths,
+			readyLoad:  true,
+		}
+		err = segment.setIndexInfo(fieldID, info)
+		if err != nil {
+			return nil, err
+		}
+		indexedFieldIDs = append(indexedFieldIDs, fieldID)
+	}
+	segment.setEnableIndex(true)
+	return indexedFieldIDs, nil
 }
 
 //func (loader *indexLoader) getIndexPaths(indexBuildID UniqueID) ([]string, error) {
@@ -369,7 +403,7 @@ func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord t
 		BucketName:        Params.MinioBucketName,
 	}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	client, err := storage.NewObjectStorageKV(ctx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
 		panic(err)
 	}
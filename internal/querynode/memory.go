@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/hardware"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// memoryLimit returns the memory in bytes the query node may use before rejecting loads
+func memoryLimit(total uint64) uint64 {
+	return uint64(float64(total) * Params.MemoryWatermark)
+}
+
+// getNodeResources reports the memory capacity and usage of the query node
+func (node *QueryNode) getNodeResources() *queryPb.GetNodeResourcesResponse {
+	var segmentsMemSize int64
+	if node.historical != nil {
+		_, _, memSize := node.historical.replica.countSegments()
+		segmentsMemSize += memSize
+	}
+	if node.streaming != nil {
+		_, _, memSize := node.streaming.replica.countSegments()
+		segmentsMemSize += memSize
+	}
+
+	total := hardware.GetMemoryCount()
+	return &queryPb.GetNodeResourcesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		NodeID:          Params.QueryNodeID,
+		MemoryTotal:     total,
+		MemoryLimit:     memoryLimit(total),
+		MemoryUsed:      hardware.GetUsedMemoryCount(),
+		SegmentsMemSize: segmentsMemSize,
+	}
+}
+
+// estimateLoadSize estimates the memory in bytes taken by the segments once loaded
+func estimateLoadSize(req *queryPb.LoadSegmentsRequest) (uint64, error) {
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(req.Schema)
+	if err != nil {
+		return 0, err
+	}
+	var numRows int64
+	for _, info := range req.Infos {
+		numRows += info.NumOfRows
+	}
+	return uint64(numRows) * uint64(sizePerRecord), nil
+}
+
+// checkLoadMemory rejects the load if the segments would push the memory usage of the query node
+// above the watermark, the check is skipped if the memory of the host is unknown
+func checkLoadMemory(req *queryPb.LoadSegmentsRequest) error {
+	total := hardware.GetMemoryCount()
+	if total == 0 {
+		return nil
+	}
+	loadSize, err := estimateLoadSize(req)
+	if err != nil {
+		return err
+	}
+	used := hardware.GetUsedMemoryCount()
+	limit := memoryLimit(total)
+	if used+loadSize > limit {
+		return fmt.Errorf("query node %d doesn't have enough memory to load the segments, "+
+			"they need about %d bytes while %d bytes are in use, the limit is %d bytes (memory watermark %.2f of %d bytes)",
+			Params.QueryNodeID, loadSize, used, limit, Params.MemoryWatermark, total)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	queryPb "github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestMemory_estimateLoadSize(t *testing.T) {
+	req := &queryPb.LoadSegmentsRequest{
+		Schema: genTestCollectionMeta(UniqueID(0), false).Schema,
+		Infos: []*queryPb.SegmentLoadInfo{
+			{SegmentID: 1, NumOfRows: 100},
+			{SegmentID: 2, NumOfRows: 50},
+		},
+	}
+	size, err := estimateLoadSize(req)
+	assert.NoError(t, err)
+	// 16 float dims and an int32 field per row
+	assert.Equal(t, uint64(150*(16*4+4)), size)
+}
+
+func TestMemory_checkLoadMemory(t *testing.T) {
+	watermark := Params.MemoryWatermark
+	defer func() {
+		Params.MemoryWatermark = watermark
+	}()
+
+	req := &queryPb.LoadSegmentsRequest{
+		Schema: genTestCollectionMeta(UniqueID(0), false).Schema,
+		Infos: []*queryPb.SegmentLoadInfo{
+			{SegmentID: 1, NumOfRows: 100},
+		},
+	}
+	Params.MemoryWatermark = 1
+	assert.NoError(t, checkLoadMemory(req))
+
+	Params.MemoryWatermark = 0
+	assert.Error(t, checkLoadMemory(req))
+}
+
+func TestMemory_getNodeResources(t *testing.T) {
+	node := &QueryNode{}
+	resources := node.getNodeResources()
+	assert.Equal(t, Params.QueryNodeID, resources.NodeID)
+	assert.Greater(t, resources.MemoryTotal, uint64(0))
+	assert.LessOrEqual(t, resources.MemoryLimit, resources.MemoryTotal)
+	assert.Greater(t, resources.MemoryUsed, uint64(0))
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	metricsSegmentTypeSealed  = "sealed"
+	metricsSegmentTypeGrowing = "growing"
+)
+
+// metricsLoop refreshes the gauges of query node periodically
+func (node *QueryNode) metricsLoop() {
+	interval := time.Duration(Params.StatsPublishInterval) * time.Millisecond
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-node.queryNodeLoopCtx.Done():
+			log.Debug("query node metrics loop closed")
+			return
+		case <-ticker.C:
+			node.updateMetrics()
+		}
+	}
+}
+
+func (node *QueryNode) updateMetrics() {
+	nodeID := strconv.FormatInt(Params.QueryNodeID, 10)
+
+	setSegmentMetrics := func(replica ReplicaInterface, segType string) {
+		num, numRows, memSize := replica.countSegments()
+		metrics.QueryNodeNumSegments.WithLabelValues(nodeID, segType).Set(float64(num))
+		metrics.QueryNodeNumRows.WithLabelValues(nodeID, segType).Set(float64(numRows))
+		metrics.QueryNodeSegmentMemorySize.WithLabelValues(nodeID, segType).Set(float64(memSize))
+	}
+	if node.historical != nil {
+		setSegmentMetrics(node.historical.replica, metricsSegmentTypeSealed)
+	}
+	if node.streaming != nil {
+		setSegmentMetrics(node.streaming.replica, metricsSegmentTypeGrowing)
+
+		for _, vChannel := range node.streaming.tSafeReplica.getTSafeChannels() {
+			ts := node.streaming.tSafeReplica.getTSafe(vChannel)
+			if ts == 0 {
+				continue
+			}
+			physicalTime, _ := tsoutil.ParseTS(ts)
+			metrics.QueryNodeTSafeLag.WithLabelValues(nodeID, vChannel).Set(time.Since(physicalTime).Seconds())
+		}
+	}
+}
+
+// observeSearchLatency records the time spent in every stage of a search
+func observeSearchLatency(tr *timerecord.TimeRecorder) {
+	nodeID := strconv.FormatInt(Params.QueryNodeID, 10)
+	for _, stage := range tr.StageCosts() {
+		metrics.QueryNodeSearchLatency.WithLabelValues(nodeID, stage.Stage).Observe(stage.Cost.Seconds())
+	}
+}
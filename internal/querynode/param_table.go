@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -46,12 +47,18 @@ type ParamTable struct {
 	MinioUseSSLStr       bool
 	MinioBucketName      string
 
+	paramtable.StorageConfig
+
 	// search
 	SearchChannelNames         []string
 	SearchResultChannelNames   []string
 	SearchReceiveBufSize       int64
 	SearchPulsarBufSize        int64
 	SearchResultReceiveBufSize int64
+	SearchBruteForceThreshold  int64
+
+	// memory
+	MemoryWatermark float64
 
 	// Retrieve
 	RetrieveChannelNames         []string
@@ -93,6 +100,7 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSLStr()
 		p.initMinioBucketName()
+		p.InitStorageConfig(&p.BaseTable)
 
 		p.initPulsarAddress()
 		p.initRocksmqPath()
@@ -107,11 +115,16 @@ func (p *ParamTable) Init() {
 		p.initSearchReceiveBufSize()
 		p.initSearchPulsarBufSize()
 		p.initSearchResultReceiveBufSize()
+		p.initSearchBruteForceThreshold()
+
+		p.initMemoryWatermark()
 
 		p.initStatsPublishInterval()
 		p.initStatsChannelName()
 
 		p.initLogCfg()
+
+		p.initRefreshers()
 	})
 }
 
@@ -196,6 +209,13 @@ func (p *ParamTable) initFlowGraphMaxQueueLength() {
 	p.FlowGraphMaxQueueLength = p.ParseInt32("queryNode.dataSync.flowGraph.maxQueueLength")
 }
 
+// GetFlowGraphMaxQueueLength returns the max queue length of flow graph nodes, it is refreshable
+// but only the flow graphs created after the change pick up the new value, the queues of the
+// running ones are buffered channels which can't be resized
+func (p *ParamTable) GetFlowGraphMaxQueueLength() int32 {
+	return atomic.LoadInt32(&p.FlowGraphMaxQueueLength)
+}
+
 func (p *ParamTable) initFlowGraphMaxParallelism() {
 	p.FlowGraphMaxParallelism = p.ParseInt32("queryNode.dataSync.flowGraph.maxParallelism")
 }
@@ -213,6 +233,20 @@ func (p *ParamTable) initSearchResultReceiveBufSize() {
 	p.SearchResultReceiveBufSize = p.ParseInt64("queryNode.msgStream.searchResult.recvBufSize")
 }
 
+// search:
+func (p *ParamTable) initSearchBruteForceThreshold() {
+	p.SearchBruteForceThreshold = p.ParseInt64("queryNode.search.bruteForceThreshold")
+}
+
+// memory:
+func (p *ParamTable) initMemoryWatermark() {
+	watermark := p.ParseFloat("queryNode.memory.watermark")
+	if watermark <= 0 || watermark > 1 {
+		panic(fmt.Errorf("queryNode.memory.watermark should be in (0, 1], but got %f", watermark))
+	}
+	p.MemoryWatermark = watermark
+}
+
 func (p *ParamTable) initEtcdEndpoints() {
 	endpoints, err := p.Load("_EtcdEndpoints")
 	if err != nil {
@@ -279,3 +313,18 @@ func (p *ParamTable) initLogCfg() {
 		p.Log.File.Filename = ""
 	}
 }
+
+// initRefreshers makes the params pick up the configs changed in etcd
+func (p *ParamTable) initRefreshers() {
+	p.RegisterRefresher("queryNode.dataSync.flowGraph.maxQueueLength", func(value string) error {
+		length, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		if length <= 0 {
+			return fmt.Errorf("flow graph max queue length should be positive, but got %d", length)
+		}
+		atomic.StoreInt32(&p.FlowGraphMaxQueueLength, int32(length))
+		return nil
+	})
+}
@@ -103,3 +103,13 @@ func TestParamTable_metaRootPath(t *testing.T) {
 	path := Params.MetaRootPath
 	fmt.Println(path)
 }
+
+func TestParamTable_memoryWatermark(t *testing.T) {
+	watermark := Params.MemoryWatermark
+	assert.Equal(t, 0.9, watermark)
+}
+
+func TestParamTable_searchBruteForceThreshold(t *testing.T) {
+	threshold := Params.SearchBruteForceThreshold
+	assert.Equal(t, int64(4096), threshold)
+}
@@ -18,19 +18,24 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
 	"sync"
+	"time"
 	"unsafe"
 
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -46,6 +51,39 @@ type queryMsg interface {
 	TravelTs() Timestamp
 }
 
+type timeRecorderCtxKey struct{}
+
+// withTimeRecorder keeps the time recorder of a query message in its context, so that the
+// time which the message waits for tSafe is counted even if it is put into the unsolved buffer
+func withTimeRecorder(ctx context.Context, tr *timerecord.TimeRecorder) context.Context {
+	return context.WithValue(ctx, timeRecorderCtxKey{}, tr)
+}
+
+// timeRecorderOf returns the time recorder kept by withTimeRecorder, a new one is returned if not found
+func timeRecorderOf(msg queryMsg) *timerecord.TimeRecorder {
+	if ctx := msg.TraceCtx(); ctx != nil {
+		if tr, ok := ctx.Value(timeRecorderCtxKey{}).(*timerecord.TimeRecorder); ok {
+			return tr
+		}
+	}
+	return timerecord.NewTimeRecorder(fmt.Sprintf("queryMsg %d", msg.ID()))
+}
+
+// stageCostsOf converts the stages recorded on this query node to the ones returned to the proxy
+func stageCostsOf(tr *timerecord.TimeRecorder) []*commonpb.StageCost {
+	stages := tr.StageCosts()
+	costs := make([]*commonpb.StageCost, 0, len(stages))
+	for _, stage := range stages {
+		costs = append(costs, &commonpb.StageCost{
+			Role:   typeutil.QueryNodeRole,
+			NodeID: Params.QueryNodeID,
+			Stage:  stage.Stage,
+			CostUs: stage.Cost.Microseconds(),
+		})
+	}
+	return costs
+}
+
 type queryCollection struct {
 	releaseCtx context.Context
 	cancel     context.CancelFunc
@@ -71,6 +109,8 @@ type queryCollection struct {
 
 type ResultEntityIds []UniqueID
 
+const waitQueryableInterval = 10 * time.Millisecond
+
 func newQueryCollection(releaseCtx context.Context,
 	cancel context.CancelFunc,
 	collectionID UniqueID,
@@ -123,6 +163,9 @@ func (q *queryCollection) close() {
 	if q.queryResultMsgStream != nil {
 		q.queryResultMsgStream.Close()
 	}
+	metrics.QueryNodeUnsolvedMsgLen.DeleteLabelValues(
+		strconv.FormatInt(Params.QueryNodeID, 10),
+		strconv.FormatInt(q.collectionID, 10))
 }
 
 func (q *queryCollection) register() {
@@ -152,6 +195,7 @@ func (q *queryCollection) addToUnsolvedMsg(msg queryMsg) {
 	q.unsolvedMsgMu.Lock()
 	defer q.unsolvedMsgMu.Unlock()
 	q.unsolvedMsg = append(q.unsolvedMsg, msg)
+	q.setUnsolvedMsgLenMetric()
 }
 
 func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
@@ -159,9 +203,17 @@ func (q *queryCollection) popAllUnsolvedMsg() []queryMsg {
 	defer q.unsolvedMsgMu.Unlock()
 	tmp := q.unsolvedMsg
 	q.unsolvedMsg = q.unsolvedMsg[:0]
+	q.setUnsolvedMsgLenMetric()
 	return tmp
 }
 
+// setUnsolvedMsgLenMetric must be called with unsolvedMsgMu held
+func (q *queryCollection) setUnsolvedMsgLenMetric() {
+	metrics.QueryNodeUnsolvedMsgLen.WithLabelValues(
+		strconv.FormatInt(Params.QueryNodeID, 10),
+		strconv.FormatInt(q.collectionID, 10)).Set(float64(len(q.unsolvedMsg)))
+}
+
 func (q *queryCollection) waitNewTSafe() Timestamp {
 	// block until any vChannel updating tSafe
 	_, _, recvOK := reflect.Select(q.watcherSelectCase)
@@ -203,6 +255,32 @@ func (q *queryCollection) setServiceableTime(t Timestamp) {
 	}
 }
 
+// waitQueryable blocks until the serviceable time reaches the guarantee timestamp of msg,
+// it is used by the requests which are sent to the query node directly
+func (q *queryCollection) waitQueryable(ctx context.Context, msg queryMsg) error {
+	collection, err := q.historical.replica.getCollectionByID(q.collectionID)
+	if err != nil {
+		return err
+	}
+	guaranteeTs := msg.GuaranteeTs()
+	if guaranteeTs >= collection.getReleaseTime() {
+		return fmt.Errorf("collection has been released, msgID = %d, collectionID = %d", msg.ID(), q.collectionID)
+	}
+
+	ticker := time.NewTicker(waitQueryableInterval)
+	defer ticker.Stop()
+	for q.getServiceableTime() < guaranteeTs {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for serviceable time failed, msgID = %d, %w", msg.ID(), ctx.Err())
+		case <-q.releaseCtx.Done():
+			return fmt.Errorf("query collection %d has been closed", q.collectionID)
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
 func (q *queryCollection) consumeQuery() {
 	for {
 		select {
@@ -303,8 +381,8 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 	}
 
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-	msg.SetTraceCtx(ctx)
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("receiveQueryMsg %d", msg.ID()))
+	msg.SetTraceCtx(withTimeRecorder(ctx, tr))
 
 	// check if collection has been released
 	collection, err := q.historical.replica.getCollectionByID(collectionID)
@@ -352,17 +430,14 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 			zap.String("msgType", msgTypeStr),
 		)
 		q.addToUnsolvedMsg(msg)
-		sp.LogFields(
-			oplog.String("send to unsolved buffer", "send to unsolved buffer"),
-			oplog.Object("guarantee ts", gt),
-			oplog.Object("serviceTime", st),
-			oplog.Float64("delta seconds", float64(guaranteeTs-serviceTime)/(1000.0*1000.0*1000.0)),
-		)
-		sp.Finish()
+		sp.AddEvent("send to unsolved buffer", oteltrace.WithAttributes(
+			attribute.String("guarantee ts", gt.String()),
+			attribute.String("serviceTime", st.String()),
+			attribute.Float64("delta seconds", float64(guaranteeTs-serviceTime)/(1000.0*1000.0*1000.0)),
+		))
+		sp.End()
 		return
 	}
-	tr.Record("get searchable time done")
-
 	log.Debug("doing query in receiveQueryMsg...",
 		zap.Int64("collectionID", collectionID),
 		zap.Int64("msgID", msg.ID()),
@@ -399,7 +474,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) {
 		zap.String("msgType", msgTypeStr),
 	)
 	tr.Elapse("all done")
-	sp.Finish()
+	sp.End()
 }
 
 func (q *queryCollection) doUnsolvedQueryMsg() {
@@ -484,7 +559,7 @@ func (q *queryCollection) doUnsolvedQueryMsg() {
 						)
 					}
 				}
-				sp.Finish()
+				sp.End()
 				log.Debug("do query done in doUnsolvedMsg",
 					zap.Int64("collectionID", q.collectionID),
 					zap.Int64("msgID", m.ID()),
@@ -524,9 +599,11 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	blobOffset += 8
 	var ids []int64
 	var scores []float32
+	var groupByValues []int64
 	for _, hit := range hits {
 		ids = append(ids, hit.IDs...)
 		scores = append(scores, hit.Scores...)
+		groupByValues = append(groupByValues, hit.GroupByValues...)
 	}
 
 	finalResult := &schemapb.SearchResultData{
@@ -537,9 +614,10 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			},
 		},
-		Scores:     scores,
-		TopK:       int64(topK),
-		NumQueries: int64(numQueries),
+		Scores:        scores,
+		TopK:          int64(topK),
+		NumQueries:    int64(numQueries),
+		GroupByValues: groupByValues,
 	}
 
 	for _, fieldID := range fieldIDs {
@@ -764,6 +842,62 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 			blobOffset += blobLen
+		case schemapb.DataType_JSON:
+			blobLen, err := typeutil.GetJSONMaxLength(fieldMeta)
+			if err != nil {
+				return nil, err
+			}
+			var colData [][]byte
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					dataBlob := row[blobOffset : blobOffset+blobLen]
+					data := typeutil.DecodeJSONSlot(dataBlob)
+					colData = append(colData, append([]byte(nil), data...))
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_JsonData{
+							JsonData: &schemapb.JSONArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset += blobLen
+		case schemapb.DataType_Array:
+			blobLen, err := typeutil.GetJSONMaxLength(fieldMeta)
+			if err != nil {
+				return nil, err
+			}
+			var colData []*schemapb.ScalarField
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					dataBlob := row[blobOffset : blobOffset+blobLen]
+					data, err := typeutil.DecodeArray(typeutil.DecodeJSONSlot(dataBlob), fieldMeta.ElementType)
+					if err != nil {
+						return nil, err
+					}
+					colData = append(colData, data)
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_ArrayData{
+							ArrayData: &schemapb.ArrayArray{
+								Data:        colData,
+								ElementType: fieldMeta.ElementType,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset += blobLen
 		default:
 			return nil, fmt.Errorf("unsupported data type %s", schemapb.DataType_name[int32(fieldMeta.DataType)])
 		}
@@ -777,62 +911,121 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 func (q *queryCollection) search(msg queryMsg) error {
 	searchMsg := msg.(*msgstream.SearchMsg)
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
-	defer sp.Finish()
+	defer sp.End()
 	searchMsg.SetTraceCtx(ctx)
+
+	results, err := q.doSearch(searchMsg, nil)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		resultChannelInt := 0
+		searchResultMsg := &msgstream.SearchResultMsg{
+			BaseMsg:       msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+			SearchResults: *result,
+		}
+		log.Ctx(ctx).Debug("QueryNode SearchResultMsg",
+			zap.Any("collectionID", searchMsg.CollectionID),
+			zap.Any("msgID", searchMsg.ID()),
+			zap.Any("vChannels", result.ChannelIDsSearched),
+			zap.Any("sealedSegmentSearched", result.SealedSegmentIDsSearched),
+		)
+		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// annotateSearchPlan fills the estimated selectivity of the predicates and the brute force threshold
+// into the serialized plan, segcore picks between the index and brute force with them
+func (q *queryCollection) annotateSearchPlan(searchMsg *msgstream.SearchMsg) ([]byte, error) {
+	if Params.SearchBruteForceThreshold <= 0 {
+		return searchMsg.SerializedExprPlan, nil
+	}
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(searchMsg.SerializedExprPlan, planNode); err != nil {
+		return nil, err
+	}
+	vectorAnns := planNode.GetVectorAnns()
+	if vectorAnns == nil || vectorAnns.GetQueryInfo() == nil {
+		return searchMsg.SerializedExprPlan, nil
+	}
+	if vectorAnns.GetPredicates() != nil {
+		segments := q.historical.getSegmentsOnService(searchMsg.CollectionID, searchMsg.PartitionIDs)
+		vectorAnns.QueryInfo.EstimatedSelectivity = estimateSelectivity(vectorAnns.GetPredicates(), segments)
+	}
+	vectorAnns.QueryInfo.BruteForceThreshold = Params.SearchBruteForceThreshold
+	return proto.Marshal(planNode)
+}
+
+// doSearch searches the sealed segments and the growing segments of vChannels, every
+// vChannel of the collection is searched if vChannels is empty
+func (q *queryCollection) doSearch(searchMsg *msgstream.SearchMsg, vChannels []Channel) ([]*internalpb.SearchResults, error) {
+	sp, _ := trace.StartSpanFromContext(searchMsg.TraceCtx())
+	defer sp.End()
+	// all the ways a search waits for the tsafe end here, so the stage is recorded once
+	tr := timeRecorderOf(searchMsg)
+	tr.RecordStage("waitTSafe")
 	searchTimestamp := searchMsg.BeginTs()
 	travelTimestamp := searchMsg.TravelTimestamp
 
 	collectionID := searchMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	schema, err := typeutil.CreateSchemaHelper(collection.schema)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var plan *SearchPlan
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
-		expr := searchMsg.SerializedExprPlan
+		expr, err := q.annotateSearchPlan(searchMsg)
+		if err != nil {
+			return nil, err
+		}
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		dsl := searchMsg.Dsl
 		plan, err = createSearchPlan(collection, dsl)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	topK := plan.getTopK()
 	if topK == 0 {
-		return fmt.Errorf("limit must be greater than 0")
+		return nil, fmt.Errorf("limit must be greater than 0")
 	}
 	if topK >= 16385 {
-		return fmt.Errorf("limit %d is too large", topK)
+		return nil, fmt.Errorf("limit %d is too large", topK)
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	queryNum := searchReq.getNumOfQuery()
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
 
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
-		sp.LogFields(oplog.String("statistical time", "stats start"),
-			oplog.Object("nq", queryNum),
-			oplog.Object("expr", searchMsg.SerializedExprPlan))
+		sp.AddEvent("stats start", oteltrace.WithAttributes(
+			attribute.Int64("nq", queryNum),
+			attribute.Int("expr size", len(searchMsg.SerializedExprPlan))))
 	} else {
-		sp.LogFields(oplog.String("statistical time", "stats start"),
-			oplog.Object("nq", queryNum),
-			oplog.Object("dsl", searchMsg.Dsl))
+		sp.AddEvent("stats start", oteltrace.WithAttributes(
+			attribute.Int64("nq", queryNum),
+			attribute.String("dsl", searchMsg.Dsl)))
 	}
 
-	tr := timerecord.NewTimeRecorder(fmt.Sprintf("search %d(nq=%d, k=%d)", searchMsg.CollectionID, queryNum, topK))
+	log.Debug("search", zap.Int64("msgID", searchMsg.ID()), zap.Int64("collectionID", searchMsg.CollectionID),
+		zap.Int64("nq", queryNum), zap.Int64("topK", topK))
 
 	// get global sealed segments
 	var globalSealedSegments []UniqueID
@@ -850,31 +1043,34 @@ func (q *queryCollection) search(msg queryMsg) error {
 	hisSearchResults, hisSegmentResults, err1 := q.historical.search(searchRequests, collectionID, searchMsg.PartitionIDs, plan, travelTimestamp)
 	if err1 != nil {
 		log.Warn(err1.Error())
-		return err1
+		return nil, err1
 	}
 	searchResults = append(searchResults, hisSearchResults...)
 	matchedSegments = append(matchedSegments, hisSegmentResults...)
 	for _, seg := range hisSegmentResults {
 		sealedSegmentSearched = append(sealedSegmentSearched, seg.segmentID)
 	}
-	tr.Record("historical search done")
+	tr.RecordStage("searchSealed")
 
 	// streaming search
+	if len(vChannels) == 0 {
+		vChannels = collection.getVChannels()
+	}
 	var err2 error
-	for _, channel := range collection.getVChannels() {
+	for _, channel := range vChannels {
 		var strSearchResults []*SearchResult
 		var strSegmentResults []*Segment
 		strSearchResults, strSegmentResults, err2 = q.streaming.search(searchRequests, collectionID, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err2 != nil {
 			log.Warn(err2.Error())
-			return err2
+			return nil, err2
 		}
 		searchResults = append(searchResults, strSearchResults...)
 		matchedSegments = append(matchedSegments, strSegmentResults...)
 	}
-	tr.Record("streaming search done")
+	tr.RecordStage("searchGrowing")
 
-	sp.LogFields(oplog.String("statistical time", "segment search end"))
+	sp.AddEvent("segment search end")
 	if len(searchResults) <= 0 {
 		for _, group := range searchRequests {
 			nq := group.getNumOfQuery()
@@ -883,7 +1079,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 			for i := 0; i < int(nq); i++ {
 				bs, err := proto.Marshal(hit)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				nilHits[i] = bs
 			}
@@ -894,48 +1090,41 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 			transformed, err := translateHits(schema, searchMsg.OutputFieldsId, nilHits)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			byteBlobs, err := proto.Marshal(transformed)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			resultChannelInt := 0
-			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-				SearchResults: internalpb.SearchResults{
-					Base: &commonpb.MsgBase{
-						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
-					},
-					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
-					Hits:                     nilHits,
-					SlicedBlob:               byteBlobs,
-					SlicedOffset:             1,
-					SlicedNumCount:           1,
-					MetricType:               plan.getMetricType(),
-					SealedSegmentIDsSearched: sealedSegmentSearched,
-					ChannelIDsSearched:       collection.getVChannels(),
-					GlobalSealedSegmentIDs:   globalSealedSegments,
+			result := &internalpb.SearchResults{
+				Base: &commonpb.MsgBase{
+					MsgType:   commonpb.MsgType_SearchResult,
+					MsgID:     searchMsg.Base.MsgID,
+					Timestamp: searchTimestamp,
+					SourceID:  searchMsg.Base.SourceID,
 				},
+				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				ResultChannelID:          searchMsg.ResultChannelID,
+				Hits:                     nilHits,
+				SlicedBlob:               byteBlobs,
+				SlicedOffset:             1,
+				SlicedNumCount:           1,
+				MetricType:               plan.getMetricType(),
+				SealedSegmentIDsSearched: sealedSegmentSearched,
+				ChannelIDsSearched:       vChannels,
+				GlobalSealedSegmentIDs:   globalSealedSegments,
+				StageCosts:               stageCostsOf(tr),
 			}
-			log.Debug("QueryNode Empty SearchResultMsg",
+			observeSearchLatency(tr)
+			log.Debug("QueryNode Empty SearchResults",
 				zap.Any("collectionID", collection.ID()),
 				zap.Any("msgID", searchMsg.ID()),
-				zap.Any("vChannels", collection.getVChannels()),
+				zap.Any("vChannels", vChannels),
 				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
 			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-			if err != nil {
-				return err
-			}
-			tr.Record("publish empty search result done")
 			tr.Elapse("all done")
-			return nil
+			return []*internalpb.SearchResults{result}, nil
 		}
 	}
 
@@ -945,44 +1134,45 @@ func (q *queryCollection) search(msg queryMsg) error {
 	if numSegment == 1 {
 		inReduced[0] = true
 		err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
-		sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
+		sp.AddEvent("fillTargetEntry end")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		marshaledHits, err = reorganizeSingleSearchResult(plan, searchRequests, searchResults[0])
-		sp.LogFields(oplog.String("statistical time", "reorganizeSingleSearchResult end"))
+		sp.AddEvent("reorganizeSingleSearchResult end")
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		err = reduceSearchResults(searchResults, numSegment, inReduced)
-		sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
+		sp.AddEvent("reduceSearchResults end")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		err = fillTargetEntry(plan, searchResults, matchedSegments, inReduced)
-		sp.LogFields(oplog.String("statistical time", "fillTargetEntry end"))
+		sp.AddEvent("fillTargetEntry end")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		marshaledHits, err = reorganizeSearchResults(plan, searchRequests, searchResults, numSegment, inReduced)
-		sp.LogFields(oplog.String("statistical time", "reorganizeSearchResults end"))
+		sp.AddEvent("reorganizeSearchResults end")
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	hitsBlob, err := marshaledHits.getHitsBlob()
-	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
+	sp.AddEvent("getHitsBlob end")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tr.Record("reduce result done")
+	tr.RecordStage("reduce")
 
+	results := make([]*internalpb.SearchResults, 0, len(searchRequests))
 	var offset int64 = 0
 	for index := range searchRequests {
 		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		hits := make([][]byte, len(hitBlobSizePeerQuery))
 		for i, len := range hitBlobSizePeerQuery {
@@ -1004,41 +1194,31 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		byteBlobs, err := proto.Marshal(transformed)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		resultChannelInt := 0
-		searchResultMsg := &msgstream.SearchResultMsg{
-			BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-			SearchResults: internalpb.SearchResults{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_SearchResult,
-					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
-					SourceID:  searchMsg.Base.SourceID,
-				},
-				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-				ResultChannelID:          searchMsg.ResultChannelID,
-				Hits:                     hits,
-				SlicedBlob:               byteBlobs,
-				SlicedOffset:             1,
-				SlicedNumCount:           1,
-				MetricType:               plan.getMetricType(),
-				SealedSegmentIDsSearched: sealedSegmentSearched,
-				ChannelIDsSearched:       collection.getVChannels(),
-				GlobalSealedSegmentIDs:   globalSealedSegments,
+		results = append(results, &internalpb.SearchResults{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_SearchResult,
+				MsgID:     searchMsg.Base.MsgID,
+				Timestamp: searchTimestamp,
+				SourceID:  searchMsg.Base.SourceID,
 			},
-		}
-		log.Debug("QueryNode SearchResultMsg",
-			zap.Any("collectionID", collection.ID()),
-			zap.Any("msgID", searchMsg.ID()),
-			zap.Any("vChannels", collection.getVChannels()),
-			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-		)
+			Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			ResultChannelID:          searchMsg.ResultChannelID,
+			Hits:                     hits,
+			SlicedBlob:               byteBlobs,
+			SlicedOffset:             1,
+			SlicedNumCount:           1,
+			MetricType:               plan.getMetricType(),
+			SealedSegmentIDsSearched: sealedSegmentSearched,
+			ChannelIDsSearched:       vChannels,
+			GlobalSealedSegmentIDs:   globalSealedSegments,
+		})
 
 		// For debugging, please don't delete.
 		//fmt.Println("==================== search result ======================")
@@ -1051,21 +1231,23 @@ func (q *queryCollection) search(msg queryMsg) error {
 		//	fmt.Println(testHits.IDs)
 		//	fmt.Println(testHits.Scores)
 		//}
-		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-		if err != nil {
-			return err
-		}
-		tr.Record("publish search result")
 	}
 
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
+	tr.RecordStage("translateHits")
+	stageCosts := stageCostsOf(tr)
+	for _, result := range results {
+		result.StageCosts = stageCosts
+	}
+	observeSearchLatency(tr)
+
+	sp.AddEvent("before free c++ memory")
 	deleteSearchResults(searchResults)
 	deleteMarshaledHits(marshaledHits)
-	sp.LogFields(oplog.String("statistical time", "stats done"))
+	sp.AddEvent("stats done")
 	plan.delete()
 	searchReq.delete()
 	tr.Elapse("all done")
-	return nil
+	return results, nil
 }
 
 func (q *queryCollection) fillVectorFieldsData(segment *Segment, result *segcorepb.RetrieveResults) error {
@@ -1155,14 +1337,50 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	// retrieveProtoBlob, err := proto.Marshal(&retrieveMsg.RetrieveRequest)
 	retrieveMsg := msg.(*msgstream.RetrieveMsg)
 	sp, ctx := trace.StartSpanFromContext(retrieveMsg.TraceCtx())
-	defer sp.Finish()
+	defer sp.End()
 	retrieveMsg.SetTraceCtx(ctx)
+
+	result, err := q.doRetrieve(retrieveMsg, nil)
+	if err != nil {
+		return err
+	}
+
+	resultChannelInt := 0
+	retrieveResultMsg := &msgstream.RetrieveResultMsg{
+		BaseMsg:         msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		RetrieveResults: *result,
+	}
+
+	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
+	if err != nil {
+		return err
+	}
+	log.Debug("QueryNode publish RetrieveResultMsg",
+		zap.Any("vChannels", result.ChannelIDsRetrieved),
+		zap.Any("collectionID", retrieveMsg.CollectionID),
+		zap.Any("sealedSegmentRetrieved", result.SealedSegmentIDsRetrieved),
+	)
+	return nil
+}
+
+// doRetrieve retrieves from the sealed segments and the growing segments of vChannels, every
+// vChannel of the collection is retrieved if vChannels is empty
+func (q *queryCollection) doRetrieve(retrieveMsg *msgstream.RetrieveMsg, vChannels []Channel) (*internalpb.RetrieveResults, error) {
 	timestamp := retrieveMsg.RetrieveRequest.TravelTimestamp
 
 	collectionID := retrieveMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var channelFilter map[Channel]struct{}
+	if len(vChannels) > 0 {
+		channelFilter = make(map[Channel]struct{})
+		for _, channel := range vChannels {
+			channelFilter[channel] = struct{}{}
+		}
+	} else {
+		vChannels = collection.getVChannels()
 	}
 
 	req := &segcorepb.RetrieveRequest{
@@ -1172,7 +1390,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	plan, err := createRetrievePlan(collection, req, timestamp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer plan.delete()
 
@@ -1187,7 +1405,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		partitionIDsInHistoricalCol, err1 := q.historical.replica.getPartitionIDs(collectionID)
 		partitionIDsInStreamingCol, err2 := q.streaming.replica.getPartitionIDs(collectionID)
 		if err1 != nil && err2 != nil {
-			return err2
+			return nil, err2
 		}
 		partitionIDsInHistorical = partitionIDsInHistoricalCol
 		partitionIDsInStreaming = partitionIDsInStreamingCol
@@ -1203,49 +1421,62 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 				partitionIDsInStreaming = append(partitionIDsInStreaming, id)
 			}
 			if err1 != nil && err2 != nil {
-				return err2
+				return nil, err2
 			}
 		}
 	}
+	// sealed segments whose pk bloom filters rule out every requested primary key are skipped
+	intIDs, filterByPk := retrieveMsg.Ids.GetIdField().(*schemapb.IDs_IntId)
 	sealedSegmentRetrieved := make([]UniqueID, 0)
+	skippedSegments := 0
 	var mergeList []*segcorepb.RetrieveResults
 	for _, partitionID := range partitionIDsInHistorical {
 		segmentIDs, err := q.historical.replica.getSegmentIDs(partitionID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, segmentID := range segmentIDs {
 			segment, err := q.historical.replica.getSegmentByID(segmentID)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if filterByPk && len(segment.filterPks(intIDs.IntId.GetData())) == 0 {
+				sealedSegmentRetrieved = append(sealedSegmentRetrieved, segmentID)
+				skippedSegments++
+				continue
 			}
 			result, err := segment.getEntityByIds(plan)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			if err = q.fillVectorFieldsData(segment, result); err != nil {
-				return err
+				return nil, err
 			}
 			mergeList = append(mergeList, result)
 			sealedSegmentRetrieved = append(sealedSegmentRetrieved, segmentID)
 		}
 	}
-	tr.Record("historical retrieve done")
+	tr.Record(fmt.Sprintf("historical retrieve done, %d segments skipped by pk bloom filters", skippedSegments))
 
 	for _, partitionID := range partitionIDsInStreaming {
 		segmentIDs, err := q.streaming.replica.getSegmentIDs(partitionID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, segmentID := range segmentIDs {
 			segment, err := q.streaming.replica.getSegmentByID(segmentID)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if channelFilter != nil {
+				if _, ok := channelFilter[segment.vChannelID]; !ok {
+					continue
+				}
 			}
 			result, err := segment.getEntityByIds(plan)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			mergeList = append(mergeList, result)
 		}
@@ -1254,40 +1485,25 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	result, err := mergeRetrieveResults(mergeList)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tr.Record("merge result done")
 
-	resultChannelInt := 0
-	retrieveResultMsg := &msgstream.RetrieveResultMsg{
-		BaseMsg: msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-		RetrieveResults: internalpb.RetrieveResults{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_RetrieveResult,
-				MsgID:    retrieveMsg.Base.MsgID,
-				SourceID: retrieveMsg.Base.SourceID,
-			},
-			Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-			Ids:                       result.Ids,
-			FieldsData:                result.FieldsData,
-			ResultChannelID:           retrieveMsg.ResultChannelID,
-			SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
-			ChannelIDsRetrieved:       collection.getVChannels(),
-			GlobalSealedSegmentIDs:    globalSealedSegments,
-		},
-	}
-
-	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
-	if err != nil {
-		return err
-	}
-	log.Debug("QueryNode publish RetrieveResultMsg",
-		zap.Any("vChannels", collection.getVChannels()),
-		zap.Any("collectionID", collection.ID()),
-		zap.Any("sealedSegmentRetrieved", sealedSegmentRetrieved),
-	)
 	tr.Elapse("all done")
-	return nil
+	return &internalpb.RetrieveResults{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RetrieveResult,
+			MsgID:    retrieveMsg.Base.MsgID,
+			SourceID: retrieveMsg.Base.SourceID,
+		},
+		Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:                       result.Ids,
+		FieldsData:                result.FieldsData,
+		ResultChannelID:           retrieveMsg.ResultChannelID,
+		SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
+		ChannelIDsRetrieved:       vChannels,
+		GlobalSealedSegmentIDs:    globalSealedSegments,
+	}, nil
 }
 
 func mergeRetrieveResults(dataArr []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
@@ -1326,7 +1542,7 @@ func mergeRetrieveResults(dataArr []*segcorepb.RetrieveResults) (*segcorepb.Retr
 
 func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID UniqueID) error {
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-	defer span.Finish()
+	defer span.End()
 	msg.SetTraceCtx(ctx)
 	msgPack := msgstream.MsgPack{}
 	msgPack.Msgs = append(msgPack.Msgs, msg)
@@ -1341,7 +1557,7 @@ func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID U
 func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg string) error {
 	msgType := msg.Type()
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-	defer span.Finish()
+	defer span.End()
 	msg.SetTraceCtx(ctx)
 	msgPack := msgstream.MsgPack{}
 
@@ -27,6 +27,7 @@ import "C"
 import (
 	"context"
 	"errors"
+	"path"
 	"strconv"
 	"sync/atomic"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -99,6 +101,11 @@ func (node *QueryNode) Register() error {
 	return nil
 }
 
+// EtcdClient returns the etcd client of the query node session, nil before it registers
+func (node *QueryNode) EtcdClient() *clientv3.Client {
+	return node.session.EtcdClient()
+}
+
 func (node *QueryNode) Init() error {
 	//ctx := context.Background()
 	connectEtcdFn := func() error {
@@ -118,6 +125,12 @@ func (node *QueryNode) Init() error {
 	}
 	log.Debug("queryNode try to connect etcd success")
 
+	configRoot := path.Join(Params.MetaRootPath, paramtable.ConfigSubPath)
+	if err := Params.WatchEtcdConfig(node.queryNodeLoopCtx, Params.EtcdEndpoints, configRoot); err != nil {
+		log.Debug("queryNode watch etcd config failed", zap.Error(err))
+		return err
+	}
+
 	node.historical = newHistorical(node.queryNodeLoopCtx,
 		node.rootCoord,
 		node.indexCoord,
@@ -161,6 +174,7 @@ func (node *QueryNode) Start() error {
 
 	// start services
 	go node.historical.start()
+	go node.metricsLoop()
 	node.UpdateStateCode(internalpb.StateCode_Healthy)
 	return nil
 }
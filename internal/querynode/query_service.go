@@ -14,6 +14,8 @@ package querynode
 import "C"
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -30,7 +32,8 @@ type queryService struct {
 	historical *historical
 	streaming  *streaming
 
-	queryCollections map[UniqueID]*queryCollection
+	queryCollectionMu sync.Mutex // guards queryCollections
+	queryCollections  map[UniqueID]*queryCollection
 
 	factory msgstream.Factory
 
@@ -60,11 +63,10 @@ func newQueryService(ctx context.Context,
 		BucketName:        Params.MinioBucketName,
 	}
 
-	client, err := miniokv.NewMinIOKV(ctx, option)
+	rcm, err := storage.NewObjectStorageChunkManager(ctx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
 		panic(err)
 	}
-	rcm := storage.NewMinioChunkManager(client)
 
 	return &queryService{
 		ctx:    queryServiceCtx,
@@ -84,14 +86,20 @@ func newQueryService(ctx context.Context,
 
 func (q *queryService) close() {
 	log.Debug("search service closed")
-	for collectionID := range q.queryCollections {
-		q.stopQueryCollection(collectionID)
+	q.queryCollectionMu.Lock()
+	for _, sc := range q.queryCollections {
+		sc.close()
+		sc.cancel()
 	}
 	q.queryCollections = make(map[UniqueID]*queryCollection)
+	q.queryCollectionMu.Unlock()
 	q.cancel()
 }
 
 func (q *queryService) addQueryCollection(collectionID UniqueID) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
+
 	if _, ok := q.queryCollections[collectionID]; ok {
 		log.Warn("query collection already exists", zap.Any("collectionID", collectionID))
 		return
@@ -110,11 +118,28 @@ func (q *queryService) addQueryCollection(collectionID UniqueID) {
 }
 
 func (q *queryService) hasQueryCollection(collectionID UniqueID) bool {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
+
 	_, ok := q.queryCollections[collectionID]
 	return ok
 }
 
+func (q *queryService) getQueryCollection(collectionID UniqueID) (*queryCollection, error) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
+
+	qc, ok := q.queryCollections[collectionID]
+	if !ok {
+		return nil, fmt.Errorf("query collection %d doesn't exist", collectionID)
+	}
+	return qc, nil
+}
+
 func (q *queryService) stopQueryCollection(collectionID UniqueID) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
+
 	sc, ok := q.queryCollections[collectionID]
 	if !ok {
 		log.Warn("stopQueryCollection failed, collection doesn't exist", zap.Int64("collectionID", collectionID))
@@ -36,6 +36,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/dablooms"
 )
 
 type segmentType int32
@@ -88,6 +90,12 @@ type Segment struct {
 
 	vectorFieldMutex sync.RWMutex // guards vectorFieldInfos
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
+
+	fieldStatsMu sync.RWMutex // guards fieldStats
+	fieldStats   map[UniqueID]*storage.Int64Stats
+
+	pkFilterMu sync.RWMutex // guards pkFilters
+	pkFilters  []*dablooms.ScalingBloom
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -162,6 +170,51 @@ func (s *Segment) getVectorFieldInfo(fieldID UniqueID) (*VectorFieldInfo, error)
 	return nil, errors.New("Invalid fieldID " + strconv.Itoa(int(fieldID)))
 }
 
+func (s *Segment) setFieldStats(fieldID UniqueID, stats *storage.Int64Stats) {
+	s.fieldStatsMu.Lock()
+	defer s.fieldStatsMu.Unlock()
+	s.fieldStats[fieldID] = stats
+}
+
+// getFieldStats returns the min/max stats of the integer field, nil if the field has no stats
+func (s *Segment) getFieldStats(fieldID UniqueID) *storage.Int64Stats {
+	s.fieldStatsMu.RLock()
+	defer s.fieldStatsMu.RUnlock()
+	return s.fieldStats[fieldID]
+}
+
+// setPkFilters sets the primary key bloom filters of the segment, one per stats binlog,
+// the filters are destroyed along with the segment
+func (s *Segment) setPkFilters(filters []*dablooms.ScalingBloom) {
+	s.pkFilterMu.Lock()
+	defer s.pkFilterMu.Unlock()
+	for _, filter := range s.pkFilters {
+		filter.Destroy()
+	}
+	s.pkFilters = filters
+}
+
+// filterPks returns the primary keys which may be in the segment, all of pks are returned
+// if the segment has no primary key bloom filter
+func (s *Segment) filterPks(pks []int64) []int64 {
+	s.pkFilterMu.RLock()
+	defer s.pkFilterMu.RUnlock()
+	if len(s.pkFilters) == 0 {
+		return pks
+	}
+	result := make([]int64, 0, len(pks))
+	for _, pk := range pks {
+		key := storage.PkBloomKey(pk)
+		for _, filter := range s.pkFilters {
+			if filter.Check(key) {
+				result = append(result, pk)
+				break
+			}
+		}
+	}
+	return result
+}
+
 func newSegment(collection *Collection, segmentID int64, partitionID UniqueID, collectionID UniqueID, vChannelID Channel, segType segmentType, onService bool) *Segment {
 	/*
 		CSegmentInterface
@@ -193,6 +246,7 @@ func newSegment(collection *Collection, segmentID int64, partitionID UniqueID, c
 		onService:        onService,
 		indexInfos:       make(map[int64]*indexInfo),
 		vectorFieldInfos: make(map[UniqueID]*VectorFieldInfo),
+		fieldStats:       make(map[UniqueID]*storage.Int64Stats),
 	}
 
 	return segment
@@ -208,6 +262,7 @@ func deleteSegment(segment *Segment) {
 	cPtr := segment.segmentPtr
 	C.DeleteSegment(cPtr)
 	segment.segmentPtr = nil
+	segment.setPkFilters(nil)
 
 	log.Debug("delete segment", zap.Int64("segmentID", segment.ID()))
 
@@ -28,7 +28,9 @@ import (
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/dablooms"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -140,31 +142,58 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 		}
 	}
 
-	indexedFieldIDs := make([]int64, 0)
-	for _, vecFieldID := range vectorFieldIDs {
-		err = loader.indexLoader.setIndexInfo(collectionID, segment, vecFieldID)
-		if err != nil {
-			log.Warn(err.Error())
-			continue
+	// raw data of scalar fields are still loaded for output fields even if they are indexed
+	scalarFieldIDs, err := loader.historicalReplica.getScalarFieldIDsByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	// the index info of all the fields are got at once for the segment
+	fieldIDs := append(append([]int64{}, vectorFieldIDs...), scalarFieldIDs...)
+	indexedFieldIDs, err := loader.indexLoader.setIndexInfos(collectionID, segment, fieldIDs)
+	if err != nil {
+		// the raw data is loaded instead
+		log.Warn("failed to get the index info of segment", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+		indexedFieldIDs = nil
+	}
+	indexedVecFieldIDs := make([]int64, 0)
+	indexedScalarFieldIDs := make([]int64, 0)
+	for _, fieldID := range indexedFieldIDs {
+		if funcutil.SliceContain(vectorFieldIDs, fieldID) {
+			indexedVecFieldIDs = append(indexedVecFieldIDs, fieldID)
+		} else {
+			indexedScalarFieldIDs = append(indexedScalarFieldIDs, fieldID)
 		}
-		indexedFieldIDs = append(indexedFieldIDs, vecFieldID)
 	}
 
 	// we don't need to load raw data for indexed vector field
-	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedFieldIDs)
+	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedVecFieldIDs)
 
 	log.Debug("loading insert...")
 	err = loader.loadSegmentFieldsData(segment, fieldBinlogs)
 	if err != nil {
 		return err
 	}
-	for _, id := range indexedFieldIDs {
+	err = loader.loadSegmentPkFilters(collectionID, segment, segmentLoadInfo.Statslogs)
+	if err != nil {
+		// segments without pk bloom filters are checked for every primary key
+		log.Warn("failed to load primary key bloom filters",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.Error(err))
+	}
+	for _, id := range indexedVecFieldIDs {
 		log.Debug("loading index...")
 		err = loader.indexLoader.loadIndex(segment, id)
 		if err != nil {
 			return err
 		}
 	}
+	for _, id := range indexedScalarFieldIDs {
+		log.Debug("loading scalar index...")
+		err = loader.indexLoader.loadIndex(segment, id)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -269,6 +298,18 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		case *storage.BinaryVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+		case *storage.JSONFieldData:
+			numRows = fieldData.NumRows
+			data, err = loader.encodeJSONFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
+		case *storage.ArrayFieldData:
+			numRows = fieldData.NumRows
+			data, err = loader.encodeJSONFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
 		default:
 			return errors.New("unexpected field data type")
 		}
@@ -284,11 +325,95 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 			// TODO: return or continue?
 			return err
 		}
+		if stats := int64StatsOf(value); stats != nil {
+			segment.setFieldStats(fieldID, stats)
+		}
 	}
 
 	return nil
 }
 
+// encodeJSONFieldData packs json values, or arrays encoded as json, into the fixed-width slots segcore stores them in
+func (loader *segmentLoader) encodeJSONFieldData(collectionID UniqueID, fieldID int64, values [][]byte) ([]byte, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(collection.schema)
+	if err != nil {
+		return nil, err
+	}
+	field, err := schemaHelper.GetFieldFromID(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetJSONMaxLength(field)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, maxLength*len(values))
+	for _, value := range values {
+		slot, err := typeutil.EncodeJSONSlot(value, maxLength)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, slot...)
+	}
+	return data, nil
+}
+
+// loadSegmentPkFilters loads the bloom filters written in the stats binlogs of the primary key field
+func (loader *segmentLoader) loadSegmentPkFilters(collectionID UniqueID, segment *Segment, statslogs []*datapb.FieldBinlog) error {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return err
+	}
+	pkFieldID := UniqueID(-1)
+	for _, field := range collection.Schema().Fields {
+		if field.IsPrimaryKey {
+			pkFieldID = field.FieldID
+			break
+		}
+	}
+
+	filters := make([]*dablooms.ScalingBloom, 0)
+	destroyFilters := func() {
+		for _, filter := range filters {
+			filter.Destroy()
+		}
+	}
+	for _, fieldStatslog := range statslogs {
+		if fieldStatslog.FieldID != pkFieldID {
+			continue
+		}
+		for _, path := range fieldStatslog.Binlogs {
+			value, err := loader.minioKV.Load(path)
+			if err != nil {
+				destroyFilters()
+				return err
+			}
+			reader := &storage.StatsReader{}
+			reader.SetBuffer([]byte(value))
+			stats := reader.GetInt64Stats()
+			filter, err := stats.PkFilter()
+			if err != nil {
+				destroyFilters()
+				return err
+			}
+			if filter == nil {
+				// stats written without a bloom filter, the segment may contain any key
+				destroyFilters()
+				return nil
+			}
+			filters = append(filters, filter)
+		}
+	}
+	if len(filters) > 0 {
+		segment.setPkFilters(filters)
+	}
+	return nil
+}
+
 func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, etcdKV *etcdkv.EtcdKV) *segmentLoader {
 	option := &minioKV.Option{
 		Address:           Params.MinioEndPoint,
@@ -299,7 +424,7 @@ func newSegmentLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord
 		BucketName:        Params.MinioBucketName,
 	}
 
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	client, err := storage.NewObjectStorageKV(ctx, Params.StorageType, Params.LocalStoragePath, option)
 	if err != nil {
 		panic(err)
 	}
@@ -25,6 +25,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/dablooms"
 )
 
 //-------------------------------------------------------------------------------------- constructor and destructor
@@ -617,3 +619,29 @@ func TestSegment_ConcurrentOperation(t *testing.T) {
 	wg.Wait()
 	deleteCollection(collection)
 }
+
+func TestSegment_filterPks(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+
+	segment := newSegment(collection, UniqueID(0), defaultPartitionID, collectionID, "", segmentTypeSealed, true)
+	pks := []int64{1, 2, 3, 100, 200}
+	// no pk bloom filter, every key may be in the segment
+	assert.Equal(t, pks, segment.filterPks(pks))
+
+	filter1 := dablooms.NewScalingBloom(2, storage.MaxBloomFalsePositive)
+	filter1.Add(storage.PkBloomKey(1), 0)
+	filter1.Add(storage.PkBloomKey(2), 1)
+	filter2 := dablooms.NewScalingBloom(1, storage.MaxBloomFalsePositive)
+	filter2.Add(storage.PkBloomKey(3), 0)
+	segment.setPkFilters([]*dablooms.ScalingBloom{filter1, filter2})
+
+	filtered := segment.filterPks(pks)
+	assert.Subset(t, filtered, []int64{1, 2, 3})
+	assert.Subset(t, pks, filtered)
+	assert.Empty(t, segment.filterPks([]int64{}))
+
+	deleteSegment(segment)
+	deleteCollection(collection)
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// unknownSelectivity is used for the predicates the min/max stats can't tell anything about
+const unknownSelectivity = 1.0
+
+// int64StatsOf computes the min/max stats of the integer field data, nil is returned for other types
+func int64StatsOf(fieldData storage.FieldData) *storage.Int64Stats {
+	var values []int64
+	switch data := fieldData.(type) {
+	case *storage.Int8FieldData:
+		for _, v := range data.Data {
+			values = append(values, int64(v))
+		}
+	case *storage.Int16FieldData:
+		for _, v := range data.Data {
+			values = append(values, int64(v))
+		}
+	case *storage.Int32FieldData:
+		for _, v := range data.Data {
+			values = append(values, int64(v))
+		}
+	case *storage.Int64FieldData:
+		values = data.Data
+	default:
+		return nil
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	stats := &storage.Int64Stats{Max: values[0], Min: values[0]}
+	for _, v := range values[1:] {
+		if v > stats.Max {
+			stats.Max = v
+		}
+		if v < stats.Min {
+			stats.Min = v
+		}
+	}
+	return stats
+}
+
+// estimateSelectivity estimates the fraction of rows passing expr over the segments, every segment
+// is weighted by its row count and 0 is returned if the segments have no rows
+func estimateSelectivity(expr *planpb.Expr, segments []*Segment) float64 {
+	var numRows, numPassed float64
+	for _, segment := range segments {
+		rowCount := float64(segment.getRowCount())
+		if rowCount <= 0 {
+			continue
+		}
+		numRows += rowCount
+		numPassed += rowCount * estimateSegmentSelectivity(expr, segment.getFieldStats)
+	}
+	if numRows == 0 {
+		return 0
+	}
+	return numPassed / numRows
+}
+
+// estimateSegmentSelectivity estimates the fraction of rows passing expr in a single segment,
+// the values of a field are assumed to be evenly distributed between its min and max
+func estimateSegmentSelectivity(expr *planpb.Expr, statsOf func(fieldID UniqueID) *storage.Int64Stats) float64 {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		stats := statsOf(e.TermExpr.GetColumnInfo().GetFieldId())
+		if stats == nil {
+			return unknownSelectivity
+		}
+		matched := make(map[int64]struct{})
+		for _, value := range e.TermExpr.GetValues() {
+			v, ok := value.GetVal().(*planpb.GenericValue_Int64Val)
+			if !ok {
+				return unknownSelectivity
+			}
+			if v.Int64Val >= stats.Min && v.Int64Val <= stats.Max {
+				matched[v.Int64Val] = struct{}{}
+			}
+		}
+		return rangeSelectivity(stats, float64(len(matched)))
+	case *planpb.Expr_UnaryRangeExpr:
+		stats := statsOf(e.UnaryRangeExpr.GetColumnInfo().GetFieldId())
+		v, ok := e.UnaryRangeExpr.GetValue().GetVal().(*planpb.GenericValue_Int64Val)
+		if stats == nil || !ok {
+			return unknownSelectivity
+		}
+		return unaryRangeSelectivity(stats, e.UnaryRangeExpr.GetOp(), v.Int64Val)
+	case *planpb.Expr_BinaryRangeExpr:
+		stats := statsOf(e.BinaryRangeExpr.GetColumnInfo().GetFieldId())
+		lower, ok1 := e.BinaryRangeExpr.GetLowerValue().GetVal().(*planpb.GenericValue_Int64Val)
+		upper, ok2 := e.BinaryRangeExpr.GetUpperValue().GetVal().(*planpb.GenericValue_Int64Val)
+		if stats == nil || !ok1 || !ok2 {
+			return unknownSelectivity
+		}
+		low, high := float64(lower.Int64Val), float64(upper.Int64Val)
+		if !e.BinaryRangeExpr.GetLowerInclusive() {
+			low++
+		}
+		if !e.BinaryRangeExpr.GetUpperInclusive() {
+			high--
+		}
+		low = math.Max(low, float64(stats.Min))
+		high = math.Min(high, float64(stats.Max))
+		return rangeSelectivity(stats, high-low+1)
+	case *planpb.Expr_UnaryExpr:
+		child := estimateSegmentSelectivity(e.UnaryExpr.GetChild(), statsOf)
+		if e.UnaryExpr.GetOp() == planpb.UnaryExpr_Not {
+			return 1 - child
+		}
+		return child
+	case *planpb.Expr_BinaryExpr:
+		left := estimateSegmentSelectivity(e.BinaryExpr.GetLeft(), statsOf)
+		right := estimateSegmentSelectivity(e.BinaryExpr.GetRight(), statsOf)
+		// the predicates are assumed to be independent
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return left * right
+		case planpb.BinaryExpr_LogicalOr:
+			return left + right - left*right
+		}
+	}
+	return unknownSelectivity
+}
+
+func unaryRangeSelectivity(stats *storage.Int64Stats, op planpb.OpType, value int64) float64 {
+	v, min, max := float64(value), float64(stats.Min), float64(stats.Max)
+	switch op {
+	case planpb.OpType_Equal:
+		return equalSelectivity(stats, value)
+	case planpb.OpType_NotEqual:
+		return 1 - equalSelectivity(stats, value)
+	case planpb.OpType_GreaterThan:
+		return rangeSelectivity(stats, max-math.Max(v, min-1))
+	case planpb.OpType_GreaterEqual:
+		return rangeSelectivity(stats, max-math.Max(v, min)+1)
+	case planpb.OpType_LessThan:
+		return rangeSelectivity(stats, math.Min(v, max+1)-min)
+	case planpb.OpType_LessEqual:
+		return rangeSelectivity(stats, math.Min(v, max)-min+1)
+	}
+	return unknownSelectivity
+}
+
+func equalSelectivity(stats *storage.Int64Stats, value int64) float64 {
+	if value < stats.Min || value > stats.Max {
+		return 0
+	}
+	return rangeSelectivity(stats, 1)
+}
+
+// rangeSelectivity returns the fraction of the distinct values between min and max covered by count values
+func rangeSelectivity(stats *storage.Int64Stats, count float64) float64 {
+	total := float64(stats.Max) - float64(stats.Min) + 1
+	return math.Max(0, math.Min(1, count/total))
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestSelectivity_int64StatsOf(t *testing.T) {
+	stats := int64StatsOf(&storage.Int32FieldData{Data: []int32{5, -3, 8, 0}})
+	assert.Equal(t, &storage.Int64Stats{Max: 8, Min: -3}, stats)
+
+	stats = int64StatsOf(&storage.Int64FieldData{Data: []int64{7}})
+	assert.Equal(t, &storage.Int64Stats{Max: 7, Min: 7}, stats)
+
+	assert.Nil(t, int64StatsOf(&storage.Int64FieldData{}))
+	assert.Nil(t, int64StatsOf(&storage.FloatFieldData{Data: []float32{1.0}}))
+}
+
+func TestSelectivity_estimateSegmentSelectivity(t *testing.T) {
+	const fieldID = UniqueID(100)
+	statsOf := func(id UniqueID) *storage.Int64Stats {
+		if id == fieldID {
+			return &storage.Int64Stats{Min: 0, Max: 99}
+		}
+		return nil
+	}
+	column := func(id UniqueID) *planpb.ColumnInfo {
+		return &planpb.ColumnInfo{FieldId: id}
+	}
+	int64Value := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	unaryRange := func(id UniqueID, op planpb.OpType, v int64) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: column(id),
+			Op:         op,
+			Value:      int64Value(v),
+		}}}
+	}
+
+	t.Run("unary range", func(t *testing.T) {
+		assert.InDelta(t, 0.01, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_Equal, 5), statsOf), 1e-9)
+		assert.InDelta(t, 0, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_Equal, 500), statsOf), 1e-9)
+		assert.InDelta(t, 0.99, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_NotEqual, 5), statsOf), 1e-9)
+		assert.InDelta(t, 0.5, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_GreaterEqual, 50), statsOf), 1e-9)
+		assert.InDelta(t, 0.49, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_GreaterThan, 50), statsOf), 1e-9)
+		assert.InDelta(t, 0.5, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_LessThan, 50), statsOf), 1e-9)
+		assert.InDelta(t, 0.51, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_LessEqual, 50), statsOf), 1e-9)
+		assert.InDelta(t, 1, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_LessThan, 1000), statsOf), 1e-9)
+		assert.InDelta(t, 0, estimateSegmentSelectivity(unaryRange(fieldID, planpb.OpType_LessThan, -10), statsOf), 1e-9)
+	})
+
+	t.Run("binary range", func(t *testing.T) {
+		expr := &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     column(fieldID),
+			LowerInclusive: true,
+			UpperInclusive: false,
+			LowerValue:     int64Value(90),
+			UpperValue:     int64Value(200),
+		}}}
+		assert.InDelta(t, 0.1, estimateSegmentSelectivity(expr, statsOf), 1e-9)
+	})
+
+	t.Run("term", func(t *testing.T) {
+		expr := &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: column(fieldID),
+			Values:     []*planpb.GenericValue{int64Value(1), int64Value(2), int64Value(2), int64Value(1000)},
+		}}}
+		assert.InDelta(t, 0.02, estimateSegmentSelectivity(expr, statsOf), 1e-9)
+	})
+
+	t.Run("logical", func(t *testing.T) {
+		and := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    planpb.BinaryExpr_LogicalAnd,
+			Left:  unaryRange(fieldID, planpb.OpType_LessThan, 50),
+			Right: unaryRange(fieldID, planpb.OpType_LessThan, 10),
+		}}}
+		assert.InDelta(t, 0.05, estimateSegmentSelectivity(and, statsOf), 1e-9)
+
+		or := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    planpb.BinaryExpr_LogicalOr,
+			Left:  unaryRange(fieldID, planpb.OpType_LessThan, 50),
+			Right: unaryRange(fieldID, planpb.OpType_LessThan, 10),
+		}}}
+		assert.InDelta(t, 0.55, estimateSegmentSelectivity(or, statsOf), 1e-9)
+
+		not := &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Op:    planpb.UnaryExpr_Not,
+			Child: unaryRange(fieldID, planpb.OpType_LessThan, 10),
+		}}}
+		assert.InDelta(t, 0.9, estimateSegmentSelectivity(not, statsOf), 1e-9)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Equal(t, unknownSelectivity, estimateSegmentSelectivity(unaryRange(fieldID+1, planpb.OpType_Equal, 5), statsOf))
+
+		float := &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: column(fieldID),
+			Op:         planpb.OpType_Equal,
+			Value:      &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 1.5}},
+		}}}
+		assert.Equal(t, unknownSelectivity, estimateSegmentSelectivity(float, statsOf))
+
+		compare := &planpb.Expr{Expr: &planpb.Expr_CompareExpr{CompareExpr: &planpb.CompareExpr{
+			LeftColumnInfo:  column(fieldID),
+			RightColumnInfo: column(fieldID + 1),
+			Op:              planpb.OpType_Equal,
+		}}}
+		assert.Equal(t, unknownSelectivity, estimateSegmentSelectivity(compare, statsOf))
+	})
+}
@@ -338,7 +338,11 @@ func (l *loadSegmentsTask) PreExecute(ctx context.Context) error {
 func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 	// TODO: support db
 	log.Debug("query node load segment", zap.String("loadSegmentRequest", fmt.Sprintln(l.req)))
-	var err error
+	err := checkLoadMemory(l.req)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
 
 	// init meta
 	for _, info := range l.req.Infos {
@@ -14,11 +14,13 @@ package querynode
 import (
 	"context"
 	"errors"
+	"strconv"
 	"sync"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 )
 
 // TSafeReplicaInterface is the interface wrapper of tSafeReplica
@@ -28,6 +30,7 @@ type TSafeReplicaInterface interface {
 	addTSafe(vChannel Channel)
 	removeTSafe(vChannel Channel)
 	registerTSafeWatcher(vChannel Channel, watcher *tSafeWatcher)
+	getTSafeChannels() []Channel
 }
 
 type tSafeReplica struct {
@@ -91,6 +94,7 @@ func (t *tSafeReplica) removeTSafe(vChannel Channel) {
 	)
 	safer.close()
 	delete(t.tSafes, vChannel)
+	metrics.QueryNodeTSafeLag.DeleteLabelValues(strconv.FormatInt(Params.QueryNodeID, 10), vChannel)
 }
 
 func (t *tSafeReplica) registerTSafeWatcher(vChannel Channel, watcher *tSafeWatcher) {
@@ -104,6 +108,16 @@ func (t *tSafeReplica) registerTSafeWatcher(vChannel Channel, watcher *tSafeWatc
 	safer.registerTSafeWatcher(watcher)
 }
 
+func (t *tSafeReplica) getTSafeChannels() []Channel {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	channels := make([]Channel, 0, len(t.tSafes))
+	for vChannel := range t.tSafes {
+		channels = append(channels, vChannel)
+	}
+	return channels
+}
+
 func newTSafeReplica() TSafeReplicaInterface {
 	var replica TSafeReplicaInterface = &tSafeReplica{
 		tSafes: make(map[string]tSafer),
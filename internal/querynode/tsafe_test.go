@@ -31,3 +31,14 @@ func TestTSafe_GetAndSet(t *testing.T) {
 
 	tSafe.set(UniqueID(1), Timestamp(1000))
 }
+
+func TestTSafeReplica_getTSafeChannels(t *testing.T) {
+	replica := newTSafeReplica()
+	replica.addTSafe("TestTSafe-channel-0")
+	replica.addTSafe("TestTSafe-channel-1")
+	assert.ElementsMatch(t, []Channel{"TestTSafe-channel-0", "TestTSafe-channel-1"}, replica.getTSafeChannels())
+
+	replica.removeTSafe("TestTSafe-channel-0")
+	assert.ElementsMatch(t, []Channel{"TestTSafe-channel-1"}, replica.getTSafeChannels())
+	replica.removeTSafe("TestTSafe-channel-1")
+}
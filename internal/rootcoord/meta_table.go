@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -804,6 +805,24 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 				return seg, nil
 			}
 		}
+	} else if idxName == "" { // return the index on the field, whatever its name is
+		var found *pb.SegmentIndexInfo
+		for idxID, seg := range segIdxMap {
+			idxMeta, ok := mt.indexID2Meta[idxID]
+			if !ok || seg.FieldID != filedID {
+				continue
+			}
+			if idxMeta.IndexName == Params.DefaultIndexName {
+				return seg, nil
+			}
+			if found == nil || seg.IndexID < found.IndexID {
+				seg := seg
+				found = &seg
+			}
+		}
+		if found != nil {
+			return *found, nil
+		}
 	} else {
 		for idxID, seg := range segIdxMap {
 			idxMeta, ok := mt.indexID2Meta[idxID]
@@ -821,6 +840,26 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 	return pb.SegmentIndexInfo{}, fmt.Errorf("can't find index name = %s on segment = %d, with filed id = %d", idxName, segID, filedID)
 }
 
+// GetSegmentIndexInfos returns the indexes on all the fields of the segment, ordered by field id and index id
+func (mt *metaTable) GetSegmentIndexInfos(segID typeutil.UniqueID) []pb.SegmentIndexInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	infos := make([]pb.SegmentIndexInfo, 0, len(mt.segID2IndexMeta[segID]))
+	for idxID, seg := range mt.segID2IndexMeta[segID] {
+		if _, ok := mt.indexID2Meta[idxID]; ok {
+			infos = append(infos, seg)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].FieldID != infos[j].FieldID {
+			return infos[i].FieldID < infos[j].FieldID
+		}
+		return infos[i].IndexID < infos[j].IndexID
+	})
+	return infos
+}
+
 func (mt *metaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -896,8 +935,12 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 		return nil, fieldSchema, err
 	}
 
+	// index names are unique per field, indexes on other fields may share the same name
 	var dupIdx typeutil.UniqueID = 0
 	for _, f := range collMeta.FieldIndexes {
+		if f.FiledID != fieldSchema.FieldID {
+			continue
+		}
 		if info, ok := mt.indexID2Meta[f.IndexID]; ok {
 			if info.IndexName == idxInfo.IndexName {
 				dupIdx = info.IndexID
@@ -816,6 +816,20 @@ func TestMetaTable(t *testing.T) {
 		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find index name = %s on segment = %d, with filed id = 11", idxInfo[0].IndexName, segIdxInfo.SegmentID))
+
+		// the index on the field is found whatever its name is
+		idx, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, segIdxInfo.FieldID, "")
+		assert.Nil(t, err)
+		assert.Equal(t, segIdxInfo.IndexID, idx.IndexID)
+		_, err = mt.GetSegmentIndexInfoByID(segIdxInfo.SegmentID, 11, "")
+		assert.NotNil(t, err)
+
+		infos := mt.GetSegmentIndexInfos(segIdxInfo.SegmentID)
+		assert.Equal(t, 1, len(infos))
+		assert.Equal(t, segIdxInfo.FieldID, infos[0].FieldID)
+		assert.Equal(t, segIdxInfo.IndexID, infos[0].IndexID)
+		assert.Equal(t, segIdxInfo.BuildID, infos[0].BuildID)
+		assert.Equal(t, 0, len(mt.GetSegmentIndexInfos(segID2)))
 	})
 
 	t.Run("get field schema failed", func(t *testing.T) {
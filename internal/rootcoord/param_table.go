@@ -13,6 +13,7 @@ package rootcoord
 
 import (
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -49,6 +50,8 @@ type ParamTable struct {
 	Log log.Config
 
 	RoleName string
+
+	EnableActiveStandby bool
 }
 
 func (p *ParamTable) Init() {
@@ -80,6 +83,7 @@ func (p *ParamTable) Init() {
 
 		p.initLogCfg()
 		p.initRoleName()
+		p.initEnableActiveStandby()
 	})
 }
 
@@ -216,3 +220,14 @@ func (p *ParamTable) initLogCfg() {
 func (p *ParamTable) initRoleName() {
 	p.RoleName = "RootCoord"
 }
+
+func (p *ParamTable) initEnableActiveStandby() {
+	enable, err := p.Load("rootCoord.enableActiveStandby")
+	if err != nil {
+		panic(err)
+	}
+	p.EnableActiveStandby, err = strconv.ParseBool(enable)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -58,4 +58,6 @@ func TestParamTable(t *testing.T) {
 
 	assert.NotZero(t, Params.TimeTickInterval)
 	t.Logf("master timetickerInterval = %d", Params.TimeTickInterval)
+
+	assert.False(t, Params.EnableActiveStandby)
 }
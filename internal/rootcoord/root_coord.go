@@ -717,6 +717,7 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 			IndexParams: idxInfo.IndexParams,
 			IndexID:     idxInfo.IndexID,
 			IndexName:   idxInfo.IndexName,
+			FieldID:     field.FieldID,
 		})
 		if err != nil {
 			retID = 0
@@ -839,7 +840,7 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 // BuildIndex will check row num and call build index service
 func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo, isFlush bool) (typeutil.UniqueID, error) {
 	sp, ctx := trace.StartSpanFromContext(ctx)
-	defer sp.Finish()
+	defer sp.End()
 	if c.MetaTable.IsSegmentIndexed(segID, field, idxInfo.IndexParams) {
 		return 0, nil
 	}
@@ -872,13 +873,37 @@ func (c *Core) Register() error {
 	if c.session == nil {
 		return fmt.Errorf("session is nil, maybe the etcd client connection fails")
 	}
+	c.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	c.sessCloseCh = c.session.Init(typeutil.RootCoordRole, Params.Address, true)
 	return nil
 }
 
+// EtcdClient returns the etcd client of the RootCoord session, nil before it registers
+func (c *Core) EtcdClient() *clientv3.Client {
+	return c.session.EtcdClient()
+}
+
+// activeStandBy blocks a standby RootCoord until the active one is down, then only moves the
+// state to Initializing. Init calls it before loading anything, so the meta and tso are loaded
+// by the rest of Init once this RootCoord is active, and it starts from the latest state.
+func (c *Core) activeStandBy() error {
+	c.UpdateStateCode(internalpb.StateCode_StandBy)
+	log.Debug("RootCoord enter standby mode", zap.Int64("node id", c.session.ServerID))
+	return c.session.ProcessActiveStandBy(func() error {
+		log.Debug("RootCoord switch from standby to active", zap.Int64("node id", c.session.ServerID))
+		c.UpdateStateCode(internalpb.StateCode_Initializing)
+		return nil
+	})
+}
+
 func (c *Core) Init() error {
 	var initError error = nil
 	c.initOnce.Do(func() {
+		if Params.EnableActiveStandby {
+			if initError = c.activeStandBy(); initError != nil {
+				return
+			}
+		}
 		connectEtcdFn := func() error {
 			if c.etcdCli, initError = clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints, DialTimeout: 5 * time.Second}); initError != nil {
 				return initError
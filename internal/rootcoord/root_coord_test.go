@@ -763,6 +763,21 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 		t.Logf("index id = %d", rsp.IndexID)
+		// the indexes on all the fields are described at once
+		assert.Equal(t, 1, len(rsp.FieldIndexes))
+		assert.Equal(t, int64(100), rsp.FieldIndexes[0].FieldID)
+		assert.Equal(t, rsp.IndexID, rsp.FieldIndexes[0].IndexID)
+
+		req.FieldID = 100
+		fieldRsp, err := core.DescribeSegment(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, fieldRsp.Status.ErrorCode)
+		assert.Equal(t, rsp.IndexID, fieldRsp.IndexID)
+
+		req.FieldID = 101
+		fieldRsp, err = core.DescribeSegment(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, fieldRsp.Status.ErrorCode)
 	})
 
 	t.Run("describe index", func(t *testing.T) {
@@ -784,6 +799,12 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, 1, len(rsp.IndexDescriptions))
 		assert.Equal(t, Params.DefaultIndexName, rsp.IndexDescriptions[0].IndexName)
 		assert.Equal(t, "vector", rsp.IndexDescriptions[0].FieldName)
+
+		req.FieldName = "no field"
+		rsp, err = core.DescribeIndex(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IndexNotExist, rsp.Status.ErrorCode)
+		assert.Equal(t, 0, len(rsp.IndexDescriptions))
 	})
 
 	t.Run("describe index not exist", func(t *testing.T) {
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
@@ -610,11 +611,30 @@ func (t *DescribeSegmentReqTask) Execute(ctx context.Context) error {
 	if !exist {
 		return fmt.Errorf("segment id %d not belong to collection id %d", t.Req.SegmentID, t.Req.CollectionID)
 	}
-	//TODO, get filed_id and index_name from request
-	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, -1, "")
+	// the indexes on all the fields are returned, so the segment is described once for all its indexes
+	for _, info := range t.core.MetaTable.GetSegmentIndexInfos(t.Req.SegmentID) {
+		t.Rsp.FieldIndexes = append(t.Rsp.FieldIndexes, &milvuspb.SegmentFieldIndex{
+			FieldID:     info.FieldID,
+			IndexID:     info.IndexID,
+			BuildID:     info.BuildID,
+			EnableIndex: info.EnableIndex,
+		})
+	}
+
+	//TODO, get index_name from request
+	fieldID := int64(-1)
+	if t.Req.FieldID != 0 {
+		fieldID = t.Req.FieldID
+	}
+	// the index on the field is found whatever its name is
+	segIdxInfo, err := t.core.MetaTable.GetSegmentIndexInfoByID(t.Req.SegmentID, fieldID, "")
 	log.Debug("RootCoord DescribeSegmentReqTask, MetaTable.GetSegmentIndexInfoByID", zap.Any("SegmentID", t.Req.SegmentID),
 		zap.Any("segIdxInfo", segIdxInfo), zap.Error(err))
 	if err != nil {
+		// a segment only indexed with custom index names has no default index
+		if fieldID == -1 && len(t.Rsp.FieldIndexes) > 0 {
+			return nil
+		}
 		return err
 	}
 	t.Rsp.IndexID = segIdxInfo.IndexID
@@ -705,7 +725,16 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 	if field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
-		return fmt.Errorf("field name = %s, data type = %s", t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)])
+		indexType := ""
+		for _, kv := range t.Req.ExtraParams {
+			if kv.Key == "index_type" {
+				indexType = kv.Value
+				break
+			}
+		}
+		if !indexparamcheck.CheckScalarIndexType(indexType, field.DataType) {
+			return fmt.Errorf("field name = %s, data type = %s, index type = %s", t.Req.FieldName, schemapb.DataType_name[int32(field.DataType)], indexType)
+		}
 	}
 
 	for _, segID := range segIDs {
@@ -756,6 +785,10 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 			log.Warn("get field schema by index id failed", zap.String("collection name", t.Req.CollectionName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 			continue
 		}
+		// each vector field has its own index, filter them by field name if required
+		if t.Req.FieldName != "" && f.Name != t.Req.FieldName {
+			continue
+		}
 		desc := &milvuspb.IndexDescription{
 			IndexName: i.IndexName,
 			Params:    i.IndexParams,
@@ -780,11 +813,24 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
 	}
+	// indexes on different fields may share the same name
+	fieldInfo := make([]etcdpb.IndexInfo, 0, len(info))
+	for _, i := range info {
+		f, err := GetFieldSchemaByIndexID(&coll, typeutil.UniqueID(i.IndexID))
+		if err != nil {
+			log.Warn("get field schema by index id failed", zap.String("collection name", t.Req.CollectionName), zap.String("index name", t.Req.IndexName), zap.Error(err))
+			continue
+		}
+		if f.Name == t.Req.FieldName {
+			fieldInfo = append(fieldInfo, i)
+		}
+	}
+	info = fieldInfo
 	if len(info) == 0 {
 		return nil
 	}
@@ -0,0 +1,366 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// ExportFormatJSON exports one json object per payload row
+	ExportFormatJSON = "json"
+	// ExportFormatCSV exports one csv record per payload row, with a header record
+	ExportFormatCSV = "csv"
+)
+
+// binlogRow is a payload row of a binlog event, it is the unit of export
+type binlogRow struct {
+	Event int         `json:"event"`
+	Type  string      `json:"type"`
+	Row   int         `json:"row"`
+	Value interface{} `json:"value"`
+}
+
+// ExportBinlog writes the payload of every event in the binlog to w in the given format,
+// vectors are exported as arrays, binary vectors as hex strings and JSON and array values as they are
+func ExportBinlog(data []byte, format string, w io.Writer) error {
+	var write func(row *binlogRow) error
+	var flush func() error
+	switch format {
+	case ExportFormatJSON:
+		encoder := json.NewEncoder(w)
+		write = func(row *binlogRow) error {
+			return encoder.Encode(row)
+		}
+		flush = func() error { return nil }
+	case ExportFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"event", "type", "row", "value"}); err != nil {
+			return err
+		}
+		write = func(row *binlogRow) error {
+			value, err := csvValue(row.Value)
+			if err != nil {
+				return err
+			}
+			return writer.Write([]string{strconv.Itoa(row.Event), row.Type, strconv.Itoa(row.Row), value})
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	default:
+		return fmt.Errorf("unsupported export format %s", format)
+	}
+
+	r, err := NewBinlogReader(data)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	dataType := r.descriptorEvent.descriptorEventData.PayloadDataType
+	for eventNum := 0; ; eventNum++ {
+		event, err := r.NextEventReader()
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			break
+		}
+		values, err := readPayloadValues(event.TypeCode, dataType, event.PayloadReaderInterface)
+		if err != nil {
+			return err
+		}
+		for i, value := range values {
+			row := &binlogRow{
+				Event: eventNum,
+				Type:  event.TypeCode.String(),
+				Row:   i,
+				Value: value,
+			}
+			if err := write(row); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+func csvValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.RawMessage:
+		return string(v), nil
+	case []float32:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// readPayloadValues returns the payload of an event row by row, the payload of ddl events
+// is decoded into the text format of the ddl request
+func readPayloadValues(eventType EventTypeCode, dataType schemapb.DataType, reader PayloadReaderInterface) ([]interface{}, error) {
+	var values []interface{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		val, err := reader.GetBoolFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int8:
+		val, err := reader.GetInt8FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int16:
+		val, err := reader.GetInt16FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int32:
+		val, err := reader.GetInt32FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Int64:
+		val, err := reader.GetInt64FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Float:
+		val, err := reader.GetFloatFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_Double:
+		val, err := reader.GetDoubleFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range val {
+			values = append(values, v)
+		}
+	case schemapb.DataType_String:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneStringFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			if eventType == InsertEventType || eventType == DeleteEventType {
+				values = append(values, val)
+				continue
+			}
+			ddl, err := ddlRequestText(eventType, []byte(val))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, ddl)
+		}
+	case schemapb.DataType_JSON, schemapb.DataType_Array:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneJSONFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, json.RawMessage(val))
+		}
+	case schemapb.DataType_BinaryVector:
+		val, dim, err := reader.GetBinaryVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		dim = dim / 8
+		for i := 0; i+dim <= len(val); i += dim {
+			values = append(values, hex.EncodeToString(val[i:i+dim]))
+		}
+	case schemapb.DataType_FloatVector:
+		val, dim, err := reader.GetFloatVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i+dim <= len(val); i += dim {
+			values = append(values, val[i:i+dim])
+		}
+	default:
+		return nil, errors.New("undefined data type")
+	}
+	return values, nil
+}
+
+func ddlRequestText(eventType EventTypeCode, data []byte) (string, error) {
+	var req proto.Message
+	switch eventType {
+	case CreateCollectionEventType:
+		req = &internalpb.CreateCollectionRequest{}
+	case DropCollectionEventType:
+		req = &internalpb.DropCollectionRequest{}
+	case CreatePartitionEventType:
+		req = &internalpb.CreatePartitionRequest{}
+	case DropPartitionEventType:
+		req = &internalpb.DropPartitionRequest{}
+	default:
+		return "", fmt.Errorf("undefined ddl event type %d", eventType)
+	}
+	if err := proto.Unmarshal(data, req); err != nil {
+		return "", err
+	}
+	return proto.CompactTextString(req), nil
+}
+
+// VerifyBinlog checks that the event headers of the binlog are chained correctly and that
+// the timestamp ranges of events are valid and inside the range of the descriptor event.
+// If segment is not nil, the ids and timestamps are also checked against the segment meta
+// of datacoord. Every problem found is returned, an empty result means the binlog is sound.
+func VerifyBinlog(data []byte, segment *datapb.SegmentInfo) []error {
+	var errs []error
+	r, err := NewBinlogReader(data)
+	if err != nil {
+		return append(errs, err)
+	}
+	defer r.Close()
+
+	header := r.descriptorEvent.descriptorEventHeader
+	desc := r.descriptorEvent.descriptorEventData
+	if header.TypeCode != DescriptorEventType {
+		errs = append(errs, fmt.Errorf("descriptor event type code is %s", header.TypeCode.String()))
+	}
+	offset := int32(len(data) - r.buffer.Len())
+	if header.NextPosition != offset {
+		errs = append(errs, fmt.Errorf("descriptor event next position is %d, but the first event is at %d", header.NextPosition, offset))
+	}
+	if _, ok := schemapb.DataType_name[int32(desc.PayloadDataType)]; !ok {
+		errs = append(errs, fmt.Errorf("undefined payload data type %d", desc.PayloadDataType))
+	}
+	errs = append(errs, verifyTimestampRange("descriptor event", desc.StartTimestamp, desc.EndTimestamp)...)
+
+	if segment != nil {
+		if desc.CollectionID != segment.CollectionID || desc.PartitionID != segment.PartitionID || desc.SegmentID != segment.ID {
+			errs = append(errs, fmt.Errorf("binlog belongs to collection %d partition %d segment %d, but segment meta is of collection %d partition %d segment %d",
+				desc.CollectionID, desc.PartitionID, desc.SegmentID, segment.CollectionID, segment.PartitionID, segment.ID))
+		}
+		if start := segment.GetStartPosition().GetTimestamp(); start != 0 && desc.StartTimestamp < start {
+			errs = append(errs, fmt.Errorf("descriptor event start timestamp %s is before segment start position %s",
+				formatTimestamp(desc.StartTimestamp), formatTimestamp(start)))
+		}
+		if segment.State == commonpb.SegmentState_Flushed && segment.GetDmlPosition() != nil && desc.EndTimestamp > segment.GetDmlPosition().GetTimestamp() {
+			errs = append(errs, fmt.Errorf("descriptor event end timestamp %s is after segment dml position %s",
+				formatTimestamp(desc.EndTimestamp), formatTimestamp(segment.GetDmlPosition().GetTimestamp())))
+		}
+	}
+
+	for eventNum := 0; ; eventNum++ {
+		offset = int32(len(data) - r.buffer.Len())
+		event, err := r.NextEventReader()
+		if err != nil {
+			return append(errs, fmt.Errorf("event %d at %d cannot be read: %w", eventNum, offset, err))
+		}
+		if event == nil {
+			break
+		}
+		name := fmt.Sprintf("event %d", eventNum)
+		if event.NextPosition != offset+event.EventLength {
+			errs = append(errs, fmt.Errorf("%s at %d has length %d but next position %d", name, offset, event.EventLength, event.NextPosition))
+		}
+		if int(event.NextPosition) > len(data) {
+			errs = append(errs, fmt.Errorf("%s next position %d is beyond the file size %d", name, event.NextPosition, len(data)))
+		}
+		var start, end uint64
+		switch evd := event.eventData.(type) {
+		case *insertEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		case *deleteEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		case *createCollectionEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		case *dropCollectionEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		case *createPartitionEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		case *dropPartitionEventData:
+			start, end = evd.StartTimestamp, evd.EndTimestamp
+		default:
+			errs = append(errs, fmt.Errorf("%s has unknown type code %d", name, event.TypeCode))
+			continue
+		}
+		errs = append(errs, verifyTimestampRange(name, start, end)...)
+		if start < desc.StartTimestamp || end > desc.EndTimestamp {
+			errs = append(errs, fmt.Errorf("%s timestamp range [%s, %s] is out of the descriptor event range [%s, %s]", name,
+				formatTimestamp(start), formatTimestamp(end), formatTimestamp(desc.StartTimestamp), formatTimestamp(desc.EndTimestamp)))
+		}
+		if _, err := event.GetPayloadLengthFromReader(); err != nil {
+			errs = append(errs, fmt.Errorf("%s payload cannot be read: %w", name, err))
+		}
+	}
+	return errs
+}
+
+func verifyTimestampRange(name string, start, end uint64) []error {
+	var errs []error
+	if start == 0 || end == 0 {
+		errs = append(errs, fmt.Errorf("%s timestamp range [%d, %d] is not set", name, start, end))
+	}
+	if start > end {
+		errs = append(errs, fmt.Errorf("%s start timestamp %s is after end timestamp %s", name, formatTimestamp(start), formatTimestamp(end)))
+	}
+	return errs
+}
+
+func formatTimestamp(ts uint64) string {
+	physical, logical := tsoutil.ParseTS(ts)
+	return fmt.Sprintf("%v(%d)", physical, logical)
+}
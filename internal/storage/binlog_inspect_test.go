@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestFloatVectorBinlog(t *testing.T, start, end uint64) []byte {
+	w := NewInsertBinlogWriter(schemapb.DataType_FloatVector, 10, 20, 30, 40)
+	e, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e.AddFloatVectorToPayload([]float32{1, 2, 3, 4}, 2)
+	assert.Nil(t, err)
+	e.SetEventTimestamp(start, end)
+	w.SetEventTimeStamp(start, end)
+
+	err = w.Close()
+	assert.Nil(t, err)
+	buf, err := w.GetBuffer()
+	assert.Nil(t, err)
+	return buf
+}
+
+func TestExportBinlog(t *testing.T) {
+	curTS := time.Now().UnixNano() / int64(time.Millisecond)
+	start := tsoutil.ComposeTS(curTS, 0)
+	end := tsoutil.ComposeTS(curTS+1000, 0)
+	data := newTestFloatVectorBinlog(t, start, end)
+
+	var buf bytes.Buffer
+	err := ExportBinlog(data, ExportFormatJSON, &buf)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	row := struct {
+		Event int       `json:"event"`
+		Type  string    `json:"type"`
+		Row   int       `json:"row"`
+		Value []float32 `json:"value"`
+	}{}
+	err = json.Unmarshal([]byte(lines[1]), &row)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, row.Event)
+	assert.Equal(t, InsertEventType.String(), row.Type)
+	assert.Equal(t, 1, row.Row)
+	assert.Equal(t, []float32{3, 4}, row.Value)
+
+	buf.Reset()
+	err = ExportBinlog(data, ExportFormatCSV, &buf)
+	assert.Nil(t, err)
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, 3, len(lines))
+	assert.Equal(t, "event,type,row,value", lines[0])
+	assert.Equal(t, "0,InsertEvent,0,\"[1,2]\"", lines[1])
+
+	err = ExportBinlog(data, "xml", &buf)
+	assert.NotNil(t, err)
+}
+
+func TestExportDDLBinlog(t *testing.T) {
+	w := NewDDLBinlogWriter(schemapb.DataType_String, 10)
+	e, err := w.NextCreateCollectionEventWriter()
+	assert.Nil(t, err)
+	req := &internalpb.CreateCollectionRequest{CollectionName: "test", DbName: "default"}
+	reqBytes, err := proto.Marshal(req)
+	assert.Nil(t, err)
+	err = e.AddOneStringToPayload(string(reqBytes))
+	assert.Nil(t, err)
+	e.SetEventTimestamp(100, 200)
+	w.SetEventTimeStamp(100, 200)
+	err = w.Close()
+	assert.Nil(t, err)
+	data, err := w.GetBuffer()
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	err = ExportBinlog(data, ExportFormatJSON, &buf)
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(buf.String(), "collectionName"))
+}
+
+func TestVerifyBinlog(t *testing.T) {
+	curTS := time.Now().UnixNano() / int64(time.Millisecond)
+	start := tsoutil.ComposeTS(curTS, 0)
+	end := tsoutil.ComposeTS(curTS+1000, 0)
+	data := newTestFloatVectorBinlog(t, start, end)
+
+	errs := VerifyBinlog(data, nil)
+	assert.Empty(t, errs)
+
+	segment := &datapb.SegmentInfo{
+		ID:            30,
+		CollectionID:  10,
+		PartitionID:   20,
+		State:         commonpb.SegmentState_Flushed,
+		StartPosition: &internalpb.MsgPosition{Timestamp: start},
+		DmlPosition:   &internalpb.MsgPosition{Timestamp: end},
+	}
+	errs = VerifyBinlog(data, segment)
+	assert.Empty(t, errs)
+
+	segment.ID = 31
+	segment.DmlPosition.Timestamp = start
+	errs = VerifyBinlog(data, segment)
+	assert.Equal(t, 2, len(errs))
+
+	// event range is out of the descriptor range
+	w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, 30, 40)
+	e, err := w.NextInsertEventWriter()
+	assert.Nil(t, err)
+	err = e.AddDataToPayload([]int64{1, 2, 3})
+	assert.Nil(t, err)
+	e.SetEventTimestamp(start, end+1)
+	w.SetEventTimeStamp(start, end)
+	err = w.Close()
+	assert.Nil(t, err)
+	data, err = w.GetBuffer()
+	assert.Nil(t, err)
+	errs = VerifyBinlog(data, nil)
+	assert.Equal(t, 1, len(errs))
+
+	// truncated file
+	errs = VerifyBinlog(data[:len(data)-8], nil)
+	assert.NotEmpty(t, errs)
+
+	errs = VerifyBinlog([]byte{1, 2, 3, 4}, nil)
+	assert.Equal(t, 1, len(errs))
+}
@@ -20,6 +20,8 @@ enum ColumnType : int {
   FLOAT = 10,
   DOUBLE = 11,
   STRING = 20,
+  ARRAY = 22,
+  JSON = 23,
   VECTOR_BINARY = 100,
   VECTOR_FLOAT = 101
 };
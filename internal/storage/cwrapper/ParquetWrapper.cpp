@@ -77,6 +77,20 @@ CPayloadWriter NewPayloadWriter(int columnType) {
       p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
       break;
     }
+    // json documents are utf8 text, written and read by the string functions
+    case ColumnType::JSON : {
+      p->columnType = ColumnType::JSON;
+      p->builder = std::make_shared<arrow::StringBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
+      break;
+    }
+    // arrays are encoded as json arrays
+    case ColumnType::ARRAY : {
+      p->columnType = ColumnType::ARRAY;
+      p->builder = std::make_shared<arrow::StringBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
+      break;
+    }
     case ColumnType::VECTOR_BINARY : {
       p->columnType = ColumnType::VECTOR_BINARY;
       p->dimension = wrapper::EMPTY_DIMENSION;
@@ -376,6 +390,8 @@ CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_siz
     case ColumnType::FLOAT :
     case ColumnType::DOUBLE :
     case ColumnType::STRING :
+    case ColumnType::ARRAY :
+    case ColumnType::JSON :
     case ColumnType::VECTOR_BINARY :
     case ColumnType::VECTOR_FLOAT : {
       break;
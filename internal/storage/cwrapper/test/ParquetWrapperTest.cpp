@@ -209,6 +209,42 @@ TEST(wrapper, stringarray) {
   ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
 }
 
+TEST(wrapper, jsonarray) {
+  auto payload = NewPayloadWriter(ColumnType::JSON);
+  std::string v0 = R"({"color":"red","size":3})";
+  std::string v1 = R"([1,2,3])";
+  auto st = AddOneStringToPayload(payload, (char *) v0.data(), v0.size());
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddOneStringToPayload(payload, (char *) v1.data(), v1.size());
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+
+  st = FinishPayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  auto cb = GetPayloadBufferFromWriter(payload);
+  ASSERT_GT(cb.length, 0);
+  ASSERT_NE(cb.data, nullptr);
+  auto nums = GetPayloadLengthFromWriter(payload);
+  ASSERT_EQ(nums, 2);
+
+  auto reader = NewPayloadReader(ColumnType::JSON, (uint8_t *) cb.data, cb.length);
+  ASSERT_NE(reader, nullptr);
+  int length = GetPayloadLengthFromReader(reader);
+  ASSERT_EQ(length, 2);
+  char *r0, *r1;
+  int s0, s1;
+  st = GetOneStringFromPayload(reader, 0, &r0, &s0);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(std::string(r0, s0), v0);
+  st = GetOneStringFromPayload(reader, 1, &r1, &s1);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(std::string(r1, s1), v1);
+
+  st = ReleasePayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = ReleasePayloadReader(reader);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+}
+
 TEST(wrapper, binary_vector) {
   auto payload = NewPayloadWriter(ColumnType::VECTOR_BINARY);
   uint8_t data[] = {0xF1, 0xF2, 0xF3, 0xF4, 0xF5, 0xF6, 0xF7, 0xF8};
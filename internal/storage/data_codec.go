@@ -103,6 +103,16 @@ type StringFieldData struct {
 	NumRows []int64
 	Data    []string
 }
+type JSONFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+}
+
+// ArrayFieldData keeps every row as an encoded json array
+type ArrayFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+}
 type BinaryVectorFieldData struct {
 	NumRows []int64
 	Data    []byte
@@ -196,6 +206,20 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 					return nil, nil, err
 				}
 			}
+		case schemapb.DataType_JSON:
+			for _, singleJSON := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+		case schemapb.DataType_Array:
+			for _, singleArray := range singleData.(*ArrayFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleArray)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 		case schemapb.DataType_FloatVector:
@@ -227,7 +251,11 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		statsWriter := &StatsWriter{}
 		switch field.DataType {
 		case schemapb.DataType_Int64:
-			err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
+			if field.IsPrimaryKey {
+				err = statsWriter.StatsPrimaryKey(singleData.(*Int64FieldData).Data)
+			} else {
+				err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
+			}
 		}
 		if err != nil {
 			return nil, nil, err
@@ -416,6 +444,44 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 					stringFieldData.Data = append(stringFieldData.Data, singleString)
 				}
 				resultData.Data[fieldID] = stringFieldData
+			case schemapb.DataType_JSON:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &JSONFieldData{}
+				}
+				jsonFieldData := resultData.Data[fieldID].(*JSONFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					singleJSON, err := eventReader.GetOneJSONFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					jsonFieldData.Data = append(jsonFieldData.Data, singleJSON)
+				}
+				resultData.Data[fieldID] = jsonFieldData
+			case schemapb.DataType_Array:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &ArrayFieldData{}
+				}
+				arrayFieldData := resultData.Data[fieldID].(*ArrayFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				arrayFieldData.NumRows = append(arrayFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					singleArray, err := eventReader.GetOneJSONFromPayload(i)
+					if err != nil {
+						return InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					arrayFieldData.Data = append(arrayFieldData.Data, singleArray)
+				}
+				resultData.Data[fieldID] = arrayFieldData
 			case schemapb.DataType_BinaryVector:
 				if resultData.Data[fieldID] == nil {
 					resultData.Data[fieldID] = &BinaryVectorFieldData{}
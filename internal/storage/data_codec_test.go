@@ -38,6 +38,8 @@ const (
 	StringField       = 107
 	BinaryVectorField = 108
 	FloatVectorField  = 109
+	JSONField         = 110
+	ArrayField        = 111
 )
 
 func TestInsertCodec(t *testing.T) {
@@ -135,6 +137,21 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      JSONField,
+					Name:         "field_json",
+					IsPrimaryKey: false,
+					Description:  "json",
+					DataType:     schemapb.DataType_JSON,
+				},
+				{
+					FieldID:      ArrayField,
+					Name:         "field_array",
+					IsPrimaryKey: false,
+					Description:  "array",
+					DataType:     schemapb.DataType_Array,
+					ElementType:  schemapb.DataType_Int64,
+				},
 			},
 		},
 	}
@@ -191,6 +208,14 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"size":3}`), []byte(`{"size":4}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`[3]`), []byte(`[4,5]`)},
+			},
 		},
 	}
 
@@ -246,6 +271,14 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"size":1}`), []byte(`{"color":"red"}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`[1]`), []byte(`[]`)},
+			},
 		},
 	}
 	Blobs1, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData1)
@@ -277,6 +310,8 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[JSONField].(*JSONFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[ArrayField].(*ArrayFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -289,6 +324,8 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{[]byte(`{"size":1}`), []byte(`{"color":"red"}`), []byte(`{"size":3}`), []byte(`{"size":4}`)}, resultData.Data[JSONField].(*JSONFieldData).Data)
+	assert.Equal(t, [][]byte{[]byte(`[1]`), []byte(`[]`), []byte(`[3]`), []byte(`[4,5]`)}, resultData.Data[ArrayField].(*ArrayFieldData).Data)
 	assert.Nil(t, insertCodec.Close())
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
@@ -75,6 +75,12 @@ func (ds *DataSorter) Swap(i, j int) {
 		case schemapb.DataType_String:
 			data := singleData.(*StringFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_JSON:
+			data := singleData.(*JSONFieldData).Data
+			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_Array:
+			data := singleData.(*ArrayFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_BinaryVector:
 			data := singleData.(*BinaryVectorFieldData).Data
 			dim := singleData.(*BinaryVectorFieldData).Dim
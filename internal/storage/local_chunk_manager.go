@@ -16,12 +16,20 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"golang.org/x/exp/mmap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 )
 
+var _ ChunkManager = (*LocalChunkManager)(nil)
+var _ kv.BaseKV = (*LocalChunkManager)(nil)
+
+// LocalChunkManager stores every key as a file under localPath, so it could be used
+// both as the local cache of remote files and as the object storage of standalone mode
 type LocalChunkManager struct {
 	localPath string
 }
@@ -96,3 +104,132 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 
 	return at.ReadAt(p, off)
 }
+
+// Load reads the content of key as a string, it implements kv.BaseKV
+func (lcm *LocalChunkManager) Load(key string) (string, error) {
+	content, err := lcm.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// MultiLoad reads the contents of keys, the first error is returned if any key fails
+func (lcm *LocalChunkManager) MultiLoad(keys []string) ([]string, error) {
+	var resultErr error
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, err := lcm.Load(key)
+		if err != nil && resultErr == nil {
+			resultErr = err
+		}
+		values = append(values, value)
+	}
+	return values, resultErr
+}
+
+// LoadWithPrefix reads all the files whose key starts with prefix
+func (lcm *LocalChunkManager) LoadWithPrefix(prefix string) ([]string, []string, error) {
+	keys, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := lcm.MultiLoad(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// ListWithPrefix returns the keys of all the files whose key starts with prefix, in lexical order
+func (lcm *LocalChunkManager) ListWithPrefix(prefix string) ([]string, error) {
+	root := path.Join(lcm.localPath, prefix)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		root = path.Dir(root)
+	}
+	keys := make([]string, 0)
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(lcm.localPath, filePath)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Save writes value into the file of key, it implements kv.BaseKV
+func (lcm *LocalChunkManager) Save(key, value string) error {
+	return lcm.Write(key, []byte(value))
+}
+
+// MultiSave writes all the key-value pairs, the first error is returned if any key fails
+func (lcm *LocalChunkManager) MultiSave(kvs map[string]string) error {
+	var resultErr error
+	for key, value := range kvs {
+		if err := lcm.Save(key, value); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// MultiWrite writes all the contents, the first error is returned if any key fails
+func (lcm *LocalChunkManager) MultiWrite(contents map[string][]byte) error {
+	var resultErr error
+	for key, content := range contents {
+		if err := lcm.Write(key, content); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// Remove deletes the file of key, removing a key not existed is not an error
+func (lcm *LocalChunkManager) Remove(key string) error {
+	err := os.Remove(path.Join(lcm.localPath, key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes the files of keys, the first error is returned if any key fails
+func (lcm *LocalChunkManager) MultiRemove(keys []string) error {
+	var resultErr error
+	for _, key := range keys {
+		if err := lcm.Remove(key); err != nil && resultErr == nil {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
+
+// RemoveWithPrefix deletes all the files whose key starts with prefix
+func (lcm *LocalChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return lcm.MultiRemove(keys)
+}
+
+func (lcm *LocalChunkManager) Close() {
+
+}
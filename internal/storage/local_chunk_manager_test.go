@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalChunkManager_KV(t *testing.T) {
+	localPath, err := ioutil.TempDir("", "local_chunk_manager")
+	assert.Nil(t, err)
+	defer os.RemoveAll(localPath)
+
+	lcm := NewLocalChunkManager(localPath)
+
+	err = lcm.Save("abc", "123")
+	assert.Nil(t, err)
+	err = lcm.MultiSave(map[string]string{
+		"a/b/c": "1",
+		"a/b/d": "2",
+		"a/e":   "3",
+		"b/f":   "4",
+	})
+	assert.Nil(t, err)
+
+	value, err := lcm.Load("abc")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", value)
+
+	values, err := lcm.MultiLoad([]string{"a/b/c", "b/f"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "4"}, values)
+
+	_, err = lcm.MultiLoad([]string{"a/b/c", "not_exist"})
+	assert.NotNil(t, err)
+
+	keys, values, err := lcm.LoadWithPrefix("a/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/b/c", "a/b/d", "a/e"}, keys)
+	assert.Equal(t, []string{"1", "2", "3"}, values)
+
+	keys, err = lcm.ListWithPrefix("a")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/b/c", "a/b/d", "a/e", "abc"}, keys)
+
+	keys, err = lcm.ListWithPrefix("a/b/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a/b/c", "a/b/d"}, keys)
+
+	keys, err = lcm.ListWithPrefix("not_exist/")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(keys))
+
+	err = lcm.Remove("abc")
+	assert.Nil(t, err)
+	assert.False(t, lcm.Exist("abc"))
+	err = lcm.Remove("abc")
+	assert.Nil(t, err)
+
+	err = lcm.MultiRemove([]string{"a/e", "b/f"})
+	assert.Nil(t, err)
+	assert.False(t, lcm.Exist("a/e"))
+	assert.False(t, lcm.Exist("b/f"))
+
+	err = lcm.RemoveWithPrefix("a/b")
+	assert.Nil(t, err)
+	keys, err = lcm.ListWithPrefix("")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	err = lcm.MultiWrite(map[string][]byte{"x/y": []byte("z")})
+	assert.Nil(t, err)
+	content, err := lcm.Read("x/y")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("z"), content)
+
+	lcm.Close()
+}
+
+func TestNewObjectStorageKV(t *testing.T) {
+	localPath, err := ioutil.TempDir("", "object_storage")
+	assert.Nil(t, err)
+	defer os.RemoveAll(localPath)
+
+	ctx := context.Background()
+	storageKV, err := NewObjectStorageKV(ctx, LocalStorage, localPath, nil)
+	assert.Nil(t, err)
+	err = storageKV.Save("key", "value")
+	assert.Nil(t, err)
+
+	cm, err := NewObjectStorageChunkManager(ctx, LocalStorage, localPath, nil)
+	assert.Nil(t, err)
+	content, err := cm.Read("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), content)
+
+	_, err = NewObjectStorageKV(ctx, "unknown", localPath, nil)
+	assert.NotNil(t, err)
+	_, err = NewObjectStorageChunkManager(ctx, "unknown", localPath, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// MinIOStorage keeps the binlogs and index files in MinIO
+	MinIOStorage = "minio"
+	// LocalStorage keeps the binlogs and index files on the local disk, it is for standalone only
+	LocalStorage = "local"
+)
+
+// NewObjectStorageKV creates the kv of binlogs and index files according to storageType,
+// option is used by MinIO and localPath is the root path of the local storage
+func NewObjectStorageKV(ctx context.Context, storageType string, localPath string, option *miniokv.Option) (kv.BaseKV, error) {
+	switch storageType {
+	case MinIOStorage, "":
+		return miniokv.NewMinIOKV(ctx, option)
+	case LocalStorage:
+		log.Debug("use local storage", zap.String("path", localPath))
+		return NewLocalChunkManager(localPath), nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", storageType)
+	}
+}
+
+// NewObjectStorageChunkManager creates the ChunkManager of binlogs and index files according to storageType
+func NewObjectStorageChunkManager(ctx context.Context, storageType string, localPath string, option *miniokv.Option) (ChunkManager, error) {
+	switch storageType {
+	case MinIOStorage, "":
+		client, err := miniokv.NewMinIOKV(ctx, option)
+		if err != nil {
+			return nil, err
+		}
+		return NewMinioChunkManager(client), nil
+	case LocalStorage:
+		log.Debug("use local storage", zap.String("path", localPath))
+		return NewLocalChunkManager(localPath), nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", storageType)
+	}
+}
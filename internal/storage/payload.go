@@ -37,6 +37,7 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -56,6 +57,7 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetOneStringFromPayload(idx int) (string, error)
+	GetOneJSONFromPayload(idx int) ([]byte, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
 	GetPayloadLengthFromReader() (int, error)
@@ -133,6 +135,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_JSON, schemapb.DataType_Array:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -318,6 +326,28 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return nil
 }
 
+// JSON documents are kept as utf8 text in the payload, so are arrays encoded as JSON arrays
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	if length == 0 {
+		return errors.New("can't add empty JSON into payload")
+	}
+
+	cmsg := (*C.char)(C.CBytes(msg))
+	clength := C.int(length)
+	defer C.free(unsafe.Pointer(cmsg))
+
+	st := C.AddOneStringToPayload(w.payloadWriterPtr, cmsg, clength)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
 // dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -433,6 +463,9 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 		case schemapb.DataType_String:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_JSON, schemapb.DataType_Array:
+			val, err := r.GetOneJSONFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -641,6 +674,25 @@ func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+func (r *PayloadReader) GetOneJSONFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_JSON && r.colType != schemapb.DataType_Array {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cStr *C.char
+	var cSize C.int
+
+	st := C.GetOneStringFromPayload(r.payloadReaderPtr, C.int(idx), &cStr, &cSize)
+
+	errCode := commonpb.ErrorCode(st.error_code)
+	if errCode != commonpb.ErrorCode_Success {
+		msg := C.GoString(st.error_msg)
+		defer C.free(unsafe.Pointer(st.error_msg))
+		return nil, errors.New(msg)
+	}
+	return C.GoBytes(unsafe.Pointer(cStr), cSize), nil
+}
+
 // ,dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
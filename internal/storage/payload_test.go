@@ -345,6 +345,49 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("TestAddOneJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_JSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"color":"red","size":3}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`[1,2,3]`))
+		assert.Nil(t, err)
+		err = w.AddOneJSONToPayload(nil)
+		assert.NotNil(t, err)
+		err = w.AddDataToPayload("hello")
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_JSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		json0, err := r.GetOneJSONFromPayload(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"color":"red","size":3}`), json0)
+
+		ijson1, _, err := r.GetDataFromPayload(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`[1,2,3]`), ijson1.([]byte))
+
+		_, err = r.GetOneStringFromPayload(0)
+		assert.NotNil(t, err)
+
+		err = r.ReleasePayloadReader()
+		assert.Nil(t, err)
+		err = w.ReleasePayloadWriter()
+		assert.Nil(t, err)
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
 		require.Nil(t, err)
@@ -54,7 +54,12 @@ func printBinlogFile(filename string) error {
 
 	fmt.Printf("buf size = %d\n", len(b))
 
-	r, err := NewBinlogReader(b)
+	return PrintBinlog(b)
+}
+
+// PrintBinlog prints the headers and payload of every event in the binlog
+func PrintBinlog(data []byte) error {
+	r, err := NewBinlogReader(data)
 	if err != nil {
 		return err
 	}
@@ -261,6 +266,18 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Printf("\t\t%d : %s\n", i, val)
 		}
+	case schemapb.DataType_JSON, schemapb.DataType_Array:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneJSONFromPayload(i)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d : %s\n", i, val)
+		}
 	case schemapb.DataType_BinaryVector:
 		val, dim, err := reader.GetBinaryVectorFromPayload()
 		if err != nil {
@@ -13,11 +13,34 @@ package storage
 
 import (
 	"encoding/json"
+
+	"github.com/milvus-io/milvus/internal/util/dablooms"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// MaxBloomFalsePositive is the false positive rate the primary key bloom filters are built for
+const MaxBloomFalsePositive float64 = 0.005
+
 type Int64Stats struct {
 	Max int64 `json:"max"`
 	Min int64 `json:"min"`
+	// BF is the serialized bloom filter of the primary keys, only set for the primary key field
+	BF         []byte `json:"bf,omitempty"`
+	BFCapacity uint64 `json:"bf_capacity,omitempty"`
+}
+
+// PkFilter restores the primary key bloom filter of the stats, it returns nil if the stats carry none.
+// The caller owns the returned filter and must Destroy it.
+func (stats *Int64Stats) PkFilter() (*dablooms.ScalingBloom, error) {
+	if len(stats.BF) == 0 {
+		return nil, nil
+	}
+	return dablooms.NewScalingBloomFromBytes(stats.BFCapacity, MaxBloomFalsePositive, stats.BF)
+}
+
+// PkBloomKey converts a primary key to the key stored in the primary key bloom filters
+func PkBloomKey(pk int64) []byte {
+	return typeutil.Int64ToBytes(pk)
 }
 
 type StatsWriter struct {
@@ -47,6 +70,39 @@ func (sw *StatsWriter) StatsInt64(msgs []int64) error {
 	return nil
 }
 
+// StatsPrimaryKey writes the min and max of the primary keys together with a bloom filter holding all of them
+func (sw *StatsWriter) StatsPrimaryKey(pks []int64) error {
+	if len(pks) < 1 {
+		return nil
+	}
+
+	stats := &Int64Stats{
+		Max:        pks[0],
+		Min:        pks[0],
+		BFCapacity: uint64(len(pks)),
+	}
+	bf := dablooms.NewScalingBloom(stats.BFCapacity, MaxBloomFalsePositive)
+	defer bf.Destroy()
+	for i, pk := range pks {
+		if pk > stats.Max {
+			stats.Max = pk
+		}
+		if pk < stats.Min {
+			stats.Min = pk
+		}
+		bf.Add(PkBloomKey(pk), int64(i))
+	}
+	stats.BF = bf.Bytes()
+
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -35,3 +35,34 @@ func TestStatsInt64(t *testing.T) {
 	}
 	assert.Equal(t, stats, expectedStats)
 }
+
+func TestStatsPrimaryKey(t *testing.T) {
+	pks := []int64{7, 3, 9, 1, 5}
+	sw := &StatsWriter{}
+	err := sw.StatsPrimaryKey(pks)
+	assert.NoError(t, err)
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats := sr.GetInt64Stats()
+	assert.Equal(t, int64(9), stats.Max)
+	assert.Equal(t, int64(1), stats.Min)
+	assert.Equal(t, uint64(len(pks)), stats.BFCapacity)
+
+	bf, err := stats.PkFilter()
+	assert.NoError(t, err)
+	assert.NotNil(t, bf)
+	defer bf.Destroy()
+	for _, pk := range pks {
+		assert.True(t, bf.Check(PkBloomKey(pk)))
+	}
+
+	sw = &StatsWriter{}
+	err = sw.StatsInt64([]int64{1, 2})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats = sr.GetInt64Stats()
+	bf, err = stats.PkFilter()
+	assert.NoError(t, err)
+	assert.Nil(t, bf)
+}
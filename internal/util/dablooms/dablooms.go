@@ -16,11 +16,13 @@ package dablooms
 
 #cgo LDFLAGS: -L${SRCDIR}/cwrapper/output -ldablooms -lstdc++ -lm
 #include <stdlib.h>
+#include <string.h>
 #include <dablooms.h>
 */
 import "C"
 
 import (
+	"errors"
 	"unsafe"
 )
 
@@ -35,6 +37,31 @@ func NewScalingBloom(capacity uint64, errorRate float64) *ScalingBloom {
 	return sb
 }
 
+// NewScalingBloomFromBytes restores a scaling bloom filter from the bytes returned by Bytes,
+// capacity and errorRate must be the same as the ones the filter was created with
+func NewScalingBloomFromBytes(capacity uint64, errorRate float64, data []byte) (*ScalingBloom, error) {
+	if len(data) < int(C.sizeof_scaling_bloom_header_t) {
+		return nil, errors.New("dablooms: bloom filter data is too short")
+	}
+	bitmap := C.new_bitmap(C.size_t(len(data)))
+	if bitmap == nil {
+		return nil, errors.New("dablooms: failed to allocate bitmap")
+	}
+	C.memcpy(unsafe.Pointer(bitmap.array), unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	cfilter := C.new_scaling_bloom_from_bitmap(C.uint(capacity), C.double(errorRate), bitmap)
+	if cfilter == nil {
+		// the bitmap has been released along with the half built filter
+		return nil, errors.New("dablooms: bloom filter data does not match capacity and error rate")
+	}
+	return &ScalingBloom{cfilter: cfilter}, nil
+}
+
+// Bytes returns a copy of the filter's counters which can be persisted and
+// restored by NewScalingBloomFromBytes
+func (sb *ScalingBloom) Bytes() []byte {
+	return C.GoBytes(unsafe.Pointer(sb.cfilter.bitmap.array), C.int(sb.cfilter.bitmap.bytes))
+}
+
 func (sb *ScalingBloom) Destroy() {
 	C.free_scaling_bloom(sb.cfilter)
 }
@@ -89,3 +89,29 @@ func TestDablooms_Correctness(t *testing.T) {
 	// False negatives means that there should
 	assert.False(t, results.FalseNegatives > 0)
 }
+
+func TestDablooms_Bytes(t *testing.T) {
+	sb := NewScalingBloom(1000, ErrorRate)
+	for i := 0; i < 3000; i++ {
+		sb.Add([]byte(strconv.Itoa(i)), int64(i))
+	}
+	data := sb.Bytes()
+	sb.Destroy()
+
+	restored, err := NewScalingBloomFromBytes(1000, ErrorRate, data)
+	assert.Nil(t, err)
+	defer restored.Destroy()
+	for i := 0; i < 3000; i++ {
+		assert.True(t, restored.Check([]byte(strconv.Itoa(i))))
+	}
+	assert.Equal(t, data, restored.Bytes())
+
+	restored.Add([]byte("3000"), 3000)
+	assert.True(t, restored.Check([]byte("3000")))
+
+	_, err = NewScalingBloomFromBytes(1000, ErrorRate, data[:4])
+	assert.NotNil(t, err)
+
+	_, err = NewScalingBloomFromBytes(10000, ErrorRate, data)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package embedetcd
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/embed"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// StartTimeout is how long Start waits for the embedded etcd to be ready
+var StartTimeout = 60 * time.Second
+
+// PeerEndpoint is where the embedded etcd listens for peers, nothing connects to it
+// since the embedded etcd is a single member cluster
+var PeerEndpoint = "localhost:2380"
+
+// Server is an etcd server running inside the milvus process, it is used by
+// standalone deployments so that no external etcd is needed
+type Server struct {
+	etcd *embed.Etcd
+}
+
+// Start launches an etcd server which keeps its data in dataDir and serves clients
+// on endpoints, the endpoints are host:port pairs just like etcd.endpoints in milvus.yaml
+func Start(dataDir string, endpoints []string) (*Server, error) {
+	cfg := embed.NewConfig()
+	cfg.Dir = dataDir
+	cfg.Logger = "zap"
+	cfg.LogLevel = "warn"
+	if len(endpoints) > 0 {
+		urls, err := parseEndpoints(endpoints)
+		if err != nil {
+			return nil, err
+		}
+		cfg.LCUrls = urls
+		cfg.ACUrls = urls
+	}
+	peerURLs, err := parseEndpoints([]string{PeerEndpoint})
+	if err != nil {
+		return nil, err
+	}
+	cfg.LPUrls = peerURLs
+	cfg.APUrls = peerURLs
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-e.Server.ReadyNotify():
+		log.Debug("embedded etcd is ready", zap.String("dataDir", dataDir), zap.Strings("endpoints", endpoints))
+	case <-time.After(StartTimeout):
+		e.Server.Stop()
+		e.Close()
+		return nil, errors.New("embedded etcd took too long to start")
+	}
+	return &Server{etcd: e}, nil
+}
+
+// Stop shuts the embedded etcd down, the data is kept in the data directory
+func (s *Server) Stop() {
+	s.etcd.Close()
+	log.Debug("embedded etcd stopped")
+}
+
+// Endpoints returns the client urls the embedded etcd serves on
+func (s *Server) Endpoints() []string {
+	endpoints := make([]string, 0, len(s.etcd.Clients))
+	for _, l := range s.etcd.Clients {
+		endpoints = append(endpoints, l.Addr().String())
+	}
+	return endpoints
+}
+
+func parseEndpoints(endpoints []string) ([]url.URL, error) {
+	urls := make([]url.URL, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, *u)
+	}
+	return urls, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package embedetcd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestEmbedEtcd(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "embed_etcd")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dataDir)
+
+	PeerEndpoint = fmt.Sprintf("localhost:%d", funcutil.GetAvailablePort())
+	endpoints := []string{fmt.Sprintf("localhost:%d", funcutil.GetAvailablePort())}
+	server, err := Start(dataDir, endpoints)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(server.Endpoints()))
+
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints, DialTimeout: 5 * time.Second})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = cli.Put(ctx, "embed", "etcd")
+	assert.Nil(t, err)
+	cli.Close()
+	server.Stop()
+
+	// data is kept after restart
+	server, err = Start(dataDir, endpoints)
+	require.NoError(t, err)
+	defer server.Stop()
+
+	cli, err = clientv3.New(clientv3.Config{Endpoints: endpoints, DialTimeout: 5 * time.Second})
+	assert.Nil(t, err)
+	defer cli.Close()
+	resp, err := cli.Get(ctx, "embed")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Kvs))
+	assert.Equal(t, "etcd", string(resp.Kvs[0].Value))
+}
+
+func TestParseEndpoints(t *testing.T) {
+	urls, err := parseEndpoints([]string{"localhost:2379", "https://127.0.0.1:2381"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(urls))
+	assert.Equal(t, "http", urls[0].Scheme)
+	assert.Equal(t, "localhost:2379", urls[0].Host)
+	assert.Equal(t, "https", urls[1].Scheme)
+
+	_, err = parseEndpoints([]string{"http://local host:2379"})
+	assert.NotNil(t, err)
+}
@@ -14,8 +14,7 @@ package flowgraph
 import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/opentracing/opentracing-go"
-	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type InputNode struct {
@@ -50,10 +49,10 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	if msgPack == nil {
 		return nil
 	}
-	var spans []opentracing.Span
+	var spans []trace.Span
 	for _, msg := range msgPack.Msgs {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
-		sp.LogFields(oplog.String("input_node name", inNode.Name()))
+		sp.SetAttributes(attribute.String("input_node name", inNode.Name()))
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
@@ -67,7 +66,7 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	}
 
 	for _, span := range spans {
-		span.Finish()
+		span.End()
 	}
 
 	return []Msg{msgStreamMsg}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	memInfoPath       = "/proc/meminfo"
+	procStatusPath    = "/proc/self/status"
+	cgroupV2LimitPath = "/sys/fs/cgroup/memory.max"
+	cgroupV1LimitPath = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
+)
+
+// GetMemoryCount returns the memory in bytes the process is allowed to use, the cgroup limit is
+// honored if it is lower than the physical memory, 0 is returned if neither can be read
+func GetMemoryCount() uint64 {
+	total := readKilobytes(memInfoPath, "MemTotal")
+	limit := readCgroupLimit()
+	if limit > 0 && (total == 0 || limit < total) {
+		return limit
+	}
+	return total
+}
+
+// GetUsedMemoryCount returns the resident memory of the process in bytes, the memory obtained by
+// the go runtime is returned if it can't be read from proc
+func GetUsedMemoryCount() uint64 {
+	if rss := readKilobytes(procStatusPath, "VmRSS"); rss > 0 {
+		return rss
+	}
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.Sys
+}
+
+// readKilobytes reads the "key: value kB" line from the proc file in bytes
+func readKilobytes(path string, key string) uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	return parseKilobytes(f, key)
+}
+
+func parseKilobytes(r io.Reader, key string) uint64 {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != key+":" {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0
+		}
+		return value * 1024
+	}
+	return 0
+}
+
+func readCgroupLimit() uint64 {
+	for _, path := range []string{cgroupV2LimitPath, cgroupV1LimitPath} {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		return parseCgroupLimit(string(content))
+	}
+	return 0
+}
+
+// parseCgroupLimit parses the memory limit of cgroup, "max" means no limit
+func parseCgroupLimit(content string) uint64 {
+	content = strings.TrimSpace(content)
+	if content == "max" {
+		return 0
+	}
+	limit, err := strconv.ParseUint(content, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return limit
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMemoryCount(t *testing.T) {
+	assert.Greater(t, GetMemoryCount(), uint64(0))
+}
+
+func TestGetUsedMemoryCount(t *testing.T) {
+	assert.Greater(t, GetUsedMemoryCount(), uint64(0))
+}
+
+func TestParseKilobytes(t *testing.T) {
+	content := "MemTotal:       16318220 kB\nMemFree:         1039472 kB\n"
+	assert.Equal(t, uint64(16318220*1024), parseKilobytes(strings.NewReader(content), "MemTotal"))
+	assert.Equal(t, uint64(1039472*1024), parseKilobytes(strings.NewReader(content), "MemFree"))
+	assert.Equal(t, uint64(0), parseKilobytes(strings.NewReader(content), "VmRSS"))
+	assert.Equal(t, uint64(0), parseKilobytes(strings.NewReader("VmRSS: abc kB"), "VmRSS"))
+}
+
+func TestParseCgroupLimit(t *testing.T) {
+	assert.Equal(t, uint64(1073741824), parseCgroupLimit("1073741824\n"))
+	assert.Equal(t, uint64(0), parseCgroupLimit("max\n"))
+	assert.Equal(t, uint64(0), parseCgroupLimit(""))
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.etcd.io/etcd/clientv3"
+)
+
+// EtcdClient holds the etcd client of a component for the health checks, it is set once the
+// component registers its session, so the probes reuse the client instead of dialing etcd
+type EtcdClient struct {
+	cli atomic.Value
+}
+
+// Set stores the etcd client of the component
+func (c *EtcdClient) Set(cli *clientv3.Client) {
+	if cli != nil {
+		c.cli.Store(cli)
+	}
+}
+
+// Get returns the etcd client of the component, nil before it is set
+func (c *EtcdClient) Get() *clientv3.Client {
+	cli, _ := c.cli.Load().(*clientv3.Client)
+	return cli
+}
+
+// EtcdDependency returns the dependency which checks whether etcd is reachable through the client of the component
+func EtcdDependency(cli *EtcdClient) Dependency {
+	return Dependency{
+		Name: "etcd",
+		Check: func(ctx context.Context) error {
+			etcdCli := cli.Get()
+			if etcdCli == nil {
+				return errors.New("etcd client is not initialized")
+			}
+			// any response, including an empty one, means etcd works
+			_, err := etcdCli.Get(ctx, "health")
+			return err
+		},
+	}
+}
+
+// MinioDependency returns the dependency which checks whether the bucket on MinIO is accessible
+func MinioDependency(address, accessKeyID, secretAccessKey string, useSSL bool, bucketName string) Dependency {
+	return Dependency{
+		Name: "minio",
+		Check: func(ctx context.Context) error {
+			cli, err := minio.New(address, &minio.Options{
+				Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+				Secure: useSSL,
+			})
+			if err != nil {
+				return err
+			}
+			exists, err := cli.BucketExists(ctx, bucketName)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("bucket %s not existed", bucketName)
+			}
+			return nil
+		},
+	}
+}
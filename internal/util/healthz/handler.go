@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	// LivenessRoute is the http route of the liveness probe
+	LivenessRoute = "/healthz"
+
+	// ReadinessRoute is the http route of the readiness probe
+	ReadinessRoute = "/readyz"
+)
+
+// componentHealth is the health of a component in the responses of the probes
+type componentHealth struct {
+	Role   string `json:"role"`
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// healthResponse is the body of the responses of the probes
+type healthResponse struct {
+	Healthy    bool              `json:"healthy"`
+	Components []componentHealth `json:"components"`
+}
+
+// LivenessHandler returns the http handler of the liveness probe, it responds 200 if all
+// the components in this process are running, or 503 if any of them is abnormal
+func LivenessHandler() http.Handler {
+	return probeHandler((*Checker).live)
+}
+
+// ReadinessHandler returns the http handler of the readiness probe, it responds 200 if all
+// the components in this process are healthy and their dependencies are reachable, or 503 otherwise
+func ReadinessHandler() http.Handler {
+	return probeHandler((*Checker).ready)
+}
+
+func probeHandler(probe func(c *Checker, ctx context.Context, code internalpb.StateCode) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := healthResponse{
+			Healthy:    true,
+			Components: make([]componentHealth, 0),
+		}
+		for _, c := range registeredCheckers() {
+			health := componentHealth{Role: c.Role()}
+			code, err := c.StateCode(r.Context())
+			health.State = code.String()
+			if err == nil {
+				err = probe(c, r.Context(), code)
+			}
+			if err != nil {
+				resp.Healthy = false
+				health.Reason = err.Error()
+			}
+			resp.Components = append(resp.Components, health)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if resp.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
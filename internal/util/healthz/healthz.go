@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const (
+	// checkTimeout is the timeout of checking a component or a dependency
+	checkTimeout = 3 * time.Second
+
+	// watchInterval is the interval to check the health of a component for the watchers
+	watchInterval = time.Second
+)
+
+// Indicator reports the state of a component, every grpc server of the components implements it
+type Indicator interface {
+	GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
+}
+
+// Dependency is an external service a component relies on, such as etcd, MinIO and the message queue
+type Dependency struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Checker checks the health of a component and its dependencies, it implements the
+// standard grpc health checking service, and is registered to serve /healthz and /readyz
+type Checker struct {
+	role         string
+	indicator    Indicator
+	dependencies []Dependency
+}
+
+// NewChecker returns the checker of a component
+func NewChecker(role string, indicator Indicator, dependencies ...Dependency) *Checker {
+	return &Checker{
+		role:         role,
+		indicator:    indicator,
+		dependencies: dependencies,
+	}
+}
+
+// Role returns the role of the checked component
+func (c *Checker) Role() string {
+	return c.role
+}
+
+// StateCode returns the state code of the component
+func (c *Checker) StateCode(ctx context.Context) (internalpb.StateCode, error) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	states, err := c.indicator.GetComponentStates(ctx, &internalpb.GetComponentStatesRequest{})
+	if err != nil {
+		return internalpb.StateCode_Abnormal, err
+	}
+	if states.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return internalpb.StateCode_Abnormal, errors.New(states.GetStatus().GetReason())
+	}
+	return states.GetState().GetStateCode(), nil
+}
+
+// Live returns nil if the component is running, no matter whether it is able to serve
+func (c *Checker) Live(ctx context.Context) error {
+	code, err := c.StateCode(ctx)
+	if err != nil {
+		return err
+	}
+	return c.live(ctx, code)
+}
+
+// Ready returns nil if the component is healthy and all its dependencies are reachable
+func (c *Checker) Ready(ctx context.Context) error {
+	code, err := c.StateCode(ctx)
+	if err != nil {
+		return err
+	}
+	return c.ready(ctx, code)
+}
+
+func (c *Checker) live(ctx context.Context, code internalpb.StateCode) error {
+	if code == internalpb.StateCode_Abnormal {
+		return fmt.Errorf("%s is %s", c.role, code.String())
+	}
+	return nil
+}
+
+func (c *Checker) ready(ctx context.Context, code internalpb.StateCode) error {
+	if code != internalpb.StateCode_Healthy {
+		return fmt.Errorf("%s is %s", c.role, code.String())
+	}
+	for _, dep := range c.dependencies {
+		depCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := dep.Check(depCtx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("%s is unreachable: %w", dep.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Checker) servingStatus(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if err := c.Ready(ctx); err != nil {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// Check implements grpc_health_v1.HealthServer, the component is serving if it is ready
+func (c *Checker) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	return &grpc_health_v1.HealthCheckResponse{Status: c.servingStatus(ctx)}, nil
+}
+
+// Watch implements grpc_health_v1.HealthServer, it sends the serving status at first and whenever it changes
+func (c *Checker) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	lastStatus := grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	for {
+		status := c.servingStatus(stream.Context())
+		if status != lastStatus {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: status}); err != nil {
+				return err
+			}
+			lastStatus = status
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+var registry = struct {
+	mu       sync.RWMutex
+	checkers []*Checker
+}{}
+
+// Register registers the checker of a component to the /healthz and /readyz handlers
+func Register(c *Checker) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.checkers = append(registry.checkers, c)
+}
+
+// Unregister removes the checker from the /healthz and /readyz handlers
+func Unregister(c *Checker) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for i, checker := range registry.checkers {
+		if checker == c {
+			registry.checkers = append(registry.checkers[:i], registry.checkers[i+1:]...)
+			return
+		}
+	}
+}
+
+func registeredCheckers() []*Checker {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	checkers := make([]*Checker, len(registry.checkers))
+	copy(checkers, registry.checkers)
+	return checkers
+}
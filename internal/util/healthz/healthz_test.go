@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/clientv3"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/embedetcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+type mockIndicator struct {
+	code internalpb.StateCode
+	err  error
+}
+
+func (m *mockIndicator) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &internalpb.ComponentStates{
+		State:  &internalpb.ComponentInfo{StateCode: m.code},
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil
+}
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	indicator := &mockIndicator{code: internalpb.StateCode_Healthy}
+	var depErr error
+	c := NewChecker("test", indicator, Dependency{
+		Name: "dep",
+		Check: func(ctx context.Context) error {
+			return depErr
+		},
+	})
+
+	assert.NoError(t, c.Live(ctx))
+	assert.NoError(t, c.Ready(ctx))
+	resp, err := c.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+
+	depErr = errors.New("mock error")
+	assert.NoError(t, c.Live(ctx))
+	assert.Error(t, c.Ready(ctx))
+	resp, err = c.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	depErr = nil
+	indicator.code = internalpb.StateCode_Initializing
+	assert.NoError(t, c.Live(ctx))
+	assert.Error(t, c.Ready(ctx))
+
+	indicator.code = internalpb.StateCode_Abnormal
+	assert.Error(t, c.Live(ctx))
+	assert.Error(t, c.Ready(ctx))
+
+	indicator.err = errors.New("mock error")
+	assert.Error(t, c.Live(ctx))
+	assert.Error(t, c.Ready(ctx))
+}
+
+func TestEtcdDependency(t *testing.T) {
+	ctx := context.Background()
+	var cli EtcdClient
+	dep := EtcdDependency(&cli)
+	// not registered yet
+	assert.Error(t, dep.Check(ctx))
+
+	dataDir, err := ioutil.TempDir("", "healthz_etcd")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+	embedetcd.PeerEndpoint = fmt.Sprintf("localhost:%d", funcutil.GetAvailablePort())
+	server, err := embedetcd.Start(dataDir, []string{fmt.Sprintf("localhost:%d", funcutil.GetAvailablePort())})
+	require.NoError(t, err)
+	defer server.Stop()
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: server.Endpoints(), DialTimeout: checkTimeout})
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+	cli.Set(etcdCli)
+	assert.Equal(t, etcdCli, cli.Get())
+	assert.NoError(t, dep.Check(ctx))
+}
+
+func TestHandler(t *testing.T) {
+	indicator := &mockIndicator{code: internalpb.StateCode_Initializing}
+	c := NewChecker("test", indicator)
+	Register(c)
+	defer Unregister(c)
+
+	probe := func(handler http.Handler) (int, healthResponse) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		var resp healthResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w.Code, resp
+	}
+
+	code, resp := probe(LivenessHandler())
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, resp.Healthy)
+	assert.Equal(t, 1, len(resp.Components))
+	assert.Equal(t, "test", resp.Components[0].Role)
+	assert.Equal(t, internalpb.StateCode_Initializing.String(), resp.Components[0].State)
+
+	code, resp = probe(ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, resp.Healthy)
+	assert.NotEmpty(t, resp.Components[0].Reason)
+
+	indicator.code = internalpb.StateCode_Healthy
+	code, _ = probe(ReadinessHandler())
+	assert.Equal(t, http.StatusOK, code)
+
+	Unregister(c)
+	code, resp = probe(ReadinessHandler())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0, len(resp.Components))
+}
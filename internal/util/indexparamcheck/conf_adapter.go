@@ -457,3 +457,17 @@ func (adapter *NGTONNGConfAdapter) CheckTrain(params map[string]string) bool {
 func newNGTONNGConfAdapter() *NGTONNGConfAdapter {
 	return &NGTONNGConfAdapter{}
 }
+
+// ScalarConfAdapter checks the params of sorted and inverted indexes on scalar fields,
+// which need neither a metric type nor any build parameter.
+type ScalarConfAdapter struct {
+}
+
+func (adapter *ScalarConfAdapter) CheckTrain(params map[string]string) bool {
+	_, ok := params[Metric]
+	return !ok
+}
+
+func newScalarConfAdapter() *ScalarConfAdapter {
+	return &ScalarConfAdapter{}
+}
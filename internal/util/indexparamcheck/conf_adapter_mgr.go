@@ -55,6 +55,9 @@ func (mgr *ConfAdapterMgrImpl) registerConfAdapter() {
 	mgr.adapters[IndexRHNSWSQ] = newRHNSWSQConfAdapter()
 	mgr.adapters[IndexNGTPANNG] = newNGTPANNGConfAdapter()
 	mgr.adapters[IndexNGTONNG] = newNGTONNGConfAdapter()
+
+	mgr.adapters[IndexSorted] = newScalarConfAdapter()
+	mgr.adapters[IndexInverted] = newScalarConfAdapter()
 }
 
 func newConfAdapterMgrImpl() *ConfAdapterMgrImpl {
@@ -117,6 +117,18 @@ func TestGetConfAdapterMgrInstance(t *testing.T) {
 	assert.NotEqual(t, nil, adapter)
 	_, ok = adapter.(*NGTONNGConfAdapter)
 	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexSorted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
+
+	adapter, err = adapterMgr.GetAdapter(IndexInverted)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, adapter)
+	_, ok = adapter.(*ScalarConfAdapter)
+	assert.Equal(t, true, ok)
 }
 
 func TestConfAdapterMgrImpl_GetAdapter(t *testing.T) {
@@ -336,3 +336,24 @@ func TestNGTONNGConfAdapter_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func TestScalarConfAdapter_CheckTrain(t *testing.T) {
+	validParams := map[string]string{}
+	invalidParams := map[string]string{
+		Metric: L2,
+	}
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{validParams, true},
+		{invalidParams, false},
+	}
+
+	adapter := newScalarConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("ScalarConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+}
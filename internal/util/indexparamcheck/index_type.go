@@ -11,6 +11,8 @@
 
 package indexparamcheck
 
+import "github.com/milvus-io/milvus/internal/proto/schemapb"
+
 type IndexType = string
 
 const (
@@ -29,4 +31,40 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+
+	IndexSorted   IndexType = "SORTED"
+	IndexInverted IndexType = "INVERTED"
 )
+
+// IsScalarIndexType returns true if indexType is built on scalar fields instead of vector fields
+func IsScalarIndexType(indexType IndexType) bool {
+	return indexType == IndexSorted || indexType == IndexInverted
+}
+
+// CheckScalarIndexType returns true if a scalar index of indexType can be built on a field of dataType.
+// Sorted indexes support all numeric fields, inverted indexes only fields with discrete values.
+func CheckScalarIndexType(indexType IndexType, dataType schemapb.DataType) bool {
+	switch indexType {
+	case IndexSorted:
+		switch dataType {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+			schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+			return true
+		}
+	case IndexInverted:
+		switch dataType {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+			schemapb.DataType_Int64:
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultScalarIndexType returns the index type used when no index type is specified for a scalar field
+func DefaultScalarIndexType(dataType schemapb.DataType) IndexType {
+	if dataType == schemapb.DataType_Bool {
+		return IndexInverted
+	}
+	return IndexSorted
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestIsScalarIndexType(t *testing.T) {
+	assert.True(t, IsScalarIndexType(IndexSorted))
+	assert.True(t, IsScalarIndexType(IndexInverted))
+	assert.False(t, IsScalarIndexType(IndexFaissIvfFlat))
+	assert.False(t, IsScalarIndexType(IndexHNSW))
+}
+
+func TestCheckScalarIndexType(t *testing.T) {
+	assert.True(t, CheckScalarIndexType(IndexSorted, schemapb.DataType_Int64))
+	assert.True(t, CheckScalarIndexType(IndexSorted, schemapb.DataType_Double))
+	assert.True(t, CheckScalarIndexType(IndexInverted, schemapb.DataType_Bool))
+	assert.True(t, CheckScalarIndexType(IndexInverted, schemapb.DataType_Int32))
+	assert.False(t, CheckScalarIndexType(IndexInverted, schemapb.DataType_Float))
+	assert.False(t, CheckScalarIndexType(IndexSorted, schemapb.DataType_FloatVector))
+	assert.False(t, CheckScalarIndexType(IndexFaissIvfFlat, schemapb.DataType_Int64))
+}
+
+func TestDefaultScalarIndexType(t *testing.T) {
+	assert.Equal(t, IndexInverted, DefaultScalarIndexType(schemapb.DataType_Bool))
+	assert.Equal(t, IndexSorted, DefaultScalarIndexType(schemapb.DataType_Int64))
+	assert.Equal(t, IndexSorted, DefaultScalarIndexType(schemapb.DataType_Float))
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// DefaultKafkaPartition is the only partition of every topic, messages of a channel must keep their order
+const DefaultKafkaPartition int32 = 0
+
+// KafkaReplicationFactor is the replication factor of the topics created by kafkaClient
+var KafkaReplicationFactor int16 = 1
+
+type kafkaClient struct {
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+}
+
+var kc *kafkaClient
+var kafkaOnce sync.Once
+
+func newKafkaConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_0_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewManualPartitioner
+	config.Consumer.Return.Errors = true
+	return config
+}
+
+func GetKafkaClientInstance(brokers []string) (*kafkaClient, error) {
+	kafkaOnce.Do(func() {
+		c, err := NewKafkaClient(brokers)
+		if err != nil {
+			log.Error("Set kafka client failed, error", zap.Error(err))
+			return
+		}
+		kc = c
+	})
+	if kc == nil {
+		return nil, errors.New("kafka client is not initialized")
+	}
+	return kc, nil
+}
+
+func NewKafkaClient(brokers []string) (*kafkaClient, error) {
+	c, err := sarama.NewClient(brokers, newKafkaConfig())
+	if err != nil {
+		return nil, err
+	}
+	admin, err := sarama.NewClusterAdminFromClient(c)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return &kafkaClient{client: c, admin: admin}, nil
+}
+
+// createTopic creates a topic with a single partition, ignore if the topic exists
+func (kc *kafkaClient) createTopic(topic string) error {
+	err := kc.admin.CreateTopic(topic, &sarama.TopicDetail{
+		NumPartitions:     1,
+		ReplicationFactor: KafkaReplicationFactor,
+	}, false)
+	var topicErr *sarama.TopicError
+	if errors.As(err, &topicErr) && topicErr.Err == sarama.ErrTopicAlreadyExists {
+		return nil
+	}
+	return err
+}
+
+func (kc *kafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	err := kc.createTopic(options.Topic)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := sarama.NewSyncProducerFromClient(kc.client)
+	if err != nil {
+		return nil, err
+	}
+	producer := &kafkaProducer{p: pp, topic: options.Topic}
+	return producer, nil
+}
+
+func (kc *kafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	err := kc.createTopic(options.Topic)
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := newKafkaConsumer(kc.client, options)
+	if err != nil {
+		return nil, err
+	}
+	return consumer, nil
+}
+
+func (kc *kafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{messageID: sarama.OffsetOldest}
+}
+
+func (kc *kafkaClient) StringToMsgID(id string) (MessageID, error) {
+	offset, err := StringToKafkaMsgID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) Close() {
+	_ = kc.admin.Close()
+	_ = kc.client.Close()
+}
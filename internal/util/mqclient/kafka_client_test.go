@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockKafkaBroker(t *testing.T, topic string, group string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader(topic, DefaultKafkaPartition, broker.BrokerID()),
+		"CreateTopicsRequest": sarama.NewMockCreateTopicsResponse(t),
+		"ProduceRequest":      sarama.NewMockProduceResponse(t).SetVersion(3),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, group, broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset(group, topic, DefaultKafkaPartition, -1, "", sarama.ErrNoError),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).SetVersion(1).
+			SetOffset(topic, DefaultKafkaPartition, sarama.OffsetOldest, 0).
+			SetOffset(topic, DefaultKafkaPartition, sarama.OffsetNewest, 3),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(7).
+			SetMessage(topic, DefaultKafkaPartition, 0, sarama.StringEncoder("msg_0")).
+			SetMessage(topic, DefaultKafkaPartition, 1, sarama.StringEncoder("msg_1")).
+			SetMessage(topic, DefaultKafkaPartition, 2, sarama.StringEncoder("msg_2")).
+			SetHighWaterMark(topic, DefaultKafkaPartition, 3),
+	})
+	return broker
+}
+
+func TestKafkaClient_CreateProducer(t *testing.T) {
+	topic := "test_kafka_CreateProducer"
+	broker := newMockKafkaBroker(t, topic, "")
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()})
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	assert.NotNil(t, producer)
+	defer producer.Close()
+
+	msg := &ProducerMessage{
+		Payload:    []byte("kafka_message"),
+		Properties: map[string]string{"key": "value"},
+	}
+	err = producer.Send(context.Background(), msg)
+	assert.Nil(t, err)
+}
+
+func TestKafkaClient_Subscribe(t *testing.T) {
+	topic := "test_kafka_Subscribe"
+	group := "test_kafka_group"
+	broker := newMockKafkaBroker(t, topic, group)
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()})
+	assert.Nil(t, err)
+	defer client.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            group,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, consumer)
+	defer consumer.Close()
+	assert.Equal(t, group, consumer.Subscription())
+
+	// No committed offset, consume from the earliest message
+	select {
+	case msg := <-consumer.Chan():
+		assert.Equal(t, "msg_0", string(msg.Payload()))
+		assert.Equal(t, topic, msg.Topic())
+		assert.Equal(t, SerializeKafkaID(0), msg.ID().Serialize())
+		consumer.Ack(msg)
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "consume timeout")
+	}
+
+	// Seek makes the message with the id be the next one
+	msgID, err := client.BytesToMsgID(SerializeKafkaID(2))
+	assert.Nil(t, err)
+	err = consumer.Seek(msgID)
+	assert.Nil(t, err)
+	select {
+	case msg := <-consumer.Chan():
+		assert.Equal(t, "msg_2", string(msg.Payload()))
+		assert.Equal(t, SerializeKafkaID(2), msg.ID().Serialize())
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "consume timeout")
+	}
+
+	// Seek returns the error of restarting the consumption
+	msgID, err = client.BytesToMsgID(SerializeKafkaID(10))
+	assert.Nil(t, err)
+	err = consumer.Seek(msgID)
+	assert.NotNil(t, err)
+}
+
+func TestKafkaClient_MsgID(t *testing.T) {
+	client := &kafkaClient{}
+
+	msgID, err := client.StringToMsgID("100")
+	assert.Nil(t, err)
+	assert.Equal(t, SerializeKafkaID(100), msgID.Serialize())
+
+	_, err = client.StringToMsgID("not_a_number")
+	assert.NotNil(t, err)
+
+	msgID, err = client.BytesToMsgID(SerializeKafkaID(200))
+	assert.Nil(t, err)
+	offset, err := DeserializeKafkaID(msgID.Serialize())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), offset)
+
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.NotNil(t, err)
+
+	earliest, err := DeserializeKafkaID(client.EarliestMessageID().Serialize())
+	assert.Nil(t, err)
+	assert.Equal(t, sarama.OffsetOldest, earliest)
+}
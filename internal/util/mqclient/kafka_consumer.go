@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// kafkaConsumer reads the single partition of a topic, the consumed position of
+// the subscription is committed to kafka as the offset of the consumer group
+type kafkaConsumer struct {
+	consumer      sarama.Consumer
+	offsetManager sarama.OffsetManager
+	pom           sarama.PartitionOffsetManager
+	topic         string
+	groupID       string
+	initialOffset int64
+
+	mu         sync.Mutex
+	pc         sarama.PartitionConsumer
+	msgChannel chan ConsumerMessage
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+	closeOnce  sync.Once
+}
+
+func newKafkaConsumer(client sarama.Client, options ConsumerOptions) (*kafkaConsumer, error) {
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+	offsetManager, err := sarama.NewOffsetManagerFromClient(options.SubscriptionName, client)
+	if err != nil {
+		_ = consumer.Close()
+		return nil, err
+	}
+	pom, err := offsetManager.ManagePartition(options.Topic, DefaultKafkaPartition)
+	if err != nil {
+		_ = offsetManager.Close()
+		_ = consumer.Close()
+		return nil, err
+	}
+
+	initialOffset := sarama.OffsetNewest
+	if options.SubscriptionInitialPosition == SubscriptionPositionEarliest {
+		initialOffset = sarama.OffsetOldest
+	}
+	kc := &kafkaConsumer{
+		consumer:      consumer,
+		offsetManager: offsetManager,
+		pom:           pom,
+		topic:         options.Topic,
+		groupID:       options.SubscriptionName,
+		initialOffset: initialOffset,
+		msgChannel:    make(chan ConsumerMessage),
+	}
+	// consuming starts here rather than in Chan(), so that a failure is returned by Subscribe
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	if err := kc.startConsume(kc.committedOffset()); err != nil {
+		_ = pom.Close()
+		_ = offsetManager.Close()
+		_ = consumer.Close()
+		return nil, err
+	}
+	return kc, nil
+}
+
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.groupID
+}
+
+func (kc *kafkaConsumer) Chan() <-chan ConsumerMessage {
+	return kc.msgChannel
+}
+
+// committedOffset returns the next offset of the consumer group, or the initial
+// position if the group has never committed on the topic
+func (kc *kafkaConsumer) committedOffset() int64 {
+	offset, _ := kc.pom.NextOffset()
+	if offset < 0 {
+		return kc.initialOffset
+	}
+	return offset
+}
+
+// startConsume must be called with mu held
+func (kc *kafkaConsumer) startConsume(offset int64) error {
+	pc, err := kc.consumer.ConsumePartition(kc.topic, DefaultKafkaPartition, offset)
+	if err != nil {
+		return err
+	}
+	kc.pc = pc
+	kc.stopCh = make(chan struct{})
+
+	stopCh := kc.stopCh
+	msgChannel := kc.msgChannel
+	kc.wg.Add(1)
+	go func() {
+		defer kc.wg.Done()
+		for {
+			select {
+			case msg, ok := <-pc.Messages():
+				if !ok {
+					return
+				}
+				select {
+				case msgChannel <- &kafkaMessage{msg: msg}:
+				case <-stopCh:
+					return
+				}
+			case err, ok := <-pc.Errors():
+				if !ok {
+					return
+				}
+				log.Warn("kafka consumer error", zap.String("topic", kc.topic), zap.Error(err))
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// stopConsume must be called with mu held
+func (kc *kafkaConsumer) stopConsume() {
+	if kc.pc == nil {
+		return
+	}
+	close(kc.stopCh)
+	kc.wg.Wait()
+	if err := kc.pc.Close(); err != nil {
+		log.Warn("kafka partition consumer close failed", zap.String("topic", kc.topic), zap.Error(err))
+	}
+	kc.pc = nil
+}
+
+// Seek makes the message with the id be the next one received from Chan(),
+// nothing is received any more if an error is returned
+func (kc *kafkaConsumer) Seek(id MessageID) error {
+	offset := id.(*kafkaID).messageID
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	kc.stopConsume()
+	return kc.startConsume(offset)
+}
+
+func (kc *kafkaConsumer) Ack(message ConsumerMessage) {
+	km := message.(*kafkaMessage)
+	kc.pom.MarkOffset(km.msg.Offset+1, "")
+}
+
+func (kc *kafkaConsumer) Close() {
+	kc.closeOnce.Do(func() {
+		kc.mu.Lock()
+		defer kc.mu.Unlock()
+		kc.stopConsume()
+		if err := kc.pom.Close(); err != nil {
+			log.Warn("kafka partition offset manager close failed", zap.String("topic", kc.topic), zap.Error(err))
+		}
+		if err := kc.offsetManager.Close(); err != nil {
+			log.Warn("kafka offset manager close failed", zap.String("topic", kc.topic), zap.Error(err))
+		}
+		if err := kc.consumer.Close(); err != nil {
+			log.Warn("kafka consumer close failed", zap.String("topic", kc.topic), zap.Error(err))
+		}
+		close(kc.msgChannel)
+	})
+}
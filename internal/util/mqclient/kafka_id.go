@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+type kafkaID struct {
+	messageID int64
+}
+
+func (kid *kafkaID) Serialize() []byte {
+	return SerializeKafkaID(kid.messageID)
+}
+
+func SerializeKafkaID(offset int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func DeserializeKafkaID(messageID []byte) (int64, error) {
+	if len(messageID) != 8 {
+		return 0, errors.New("invalid kafka message id length")
+	}
+	return int64(binary.LittleEndian.Uint64(messageID)), nil
+}
+
+func KafkaMsgIDToString(offset int64) string {
+	return strconv.FormatInt(offset, 10)
+}
+
+func StringToKafkaMsgID(msgString string) (int64, error) {
+	return strconv.ParseInt(msgString, 10, 64)
+}
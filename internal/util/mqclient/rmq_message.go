@@ -24,7 +24,7 @@ func (rm *rmqMessage) Topic() string {
 }
 
 func (rm *rmqMessage) Properties() map[string]string {
-	return nil
+	return rm.msg.Properties
 }
 
 func (rm *rmqMessage) Payload() []byte {
@@ -26,7 +26,7 @@ func (rp *rmqProducer) Topic() string {
 }
 
 func (rp *rmqProducer) Send(ctx context.Context, message *ProducerMessage) error {
-	pm := &rocksmq.ProducerMessage{Payload: message.Payload}
+	pm := &rocksmq.ProducerMessage{Payload: message.Payload, Properties: message.Properties}
 	return rp.p.Send(pm)
 }
 
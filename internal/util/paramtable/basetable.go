@@ -18,6 +18,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 
@@ -40,6 +41,9 @@ type Base interface {
 
 type BaseTable struct {
 	params *memkv.MemoryKV
+
+	sourceOnce sync.Once
+	source     *etcdConfigSource
 }
 
 func (gp *BaseTable) Init() {
@@ -119,6 +123,16 @@ func (gp *BaseTable) tryloadFromEnv() {
 		panic(err)
 	}
 
+	kafkaBrokerList := os.Getenv("KAFKA_BROKER_LIST")
+	if kafkaBrokerList == "" {
+		// kafka is optional, an empty broker list keeps using pulsar
+		kafkaBrokerList, _ = gp.Load("kafka.brokerList")
+	}
+	err = gp.Save("_KafkaBrokerList", kafkaBrokerList)
+	if err != nil {
+		panic(err)
+	}
+
 	rocksmqPath := os.Getenv("ROCKSMQ_PATH")
 	if rocksmqPath == "" {
 		path, err := gp.Load("rocksmq.path")
@@ -128,3 +128,25 @@ func TestBaseTable_ParseIntWithErr(t *testing.T) {
 	_, err = baseParams.ParseIntWithErr(key2)
 	assert.NotNil(t, err)
 }
+
+func TestParamTable_EtcdCfg(t *testing.T) {
+	Params.Init()
+	assert.False(t, Params.UseEmbedEtcd)
+	assert.NotEqual(t, "", Params.EtcdDataDir)
+	assert.NotEmpty(t, Params.EtcdEndpoints)
+}
+
+func TestStorageConfig_Init(t *testing.T) {
+	var sc StorageConfig
+	sc.InitStorageConfig(&baseParams)
+	assert.Equal(t, "minio", sc.StorageType)
+	assert.NotEmpty(t, sc.LocalStoragePath)
+
+	err := baseParams.Save("storage.type", "local")
+	assert.Nil(t, err)
+	sc.InitStorageConfig(&baseParams)
+	assert.Equal(t, "local", sc.StorageType)
+
+	err = baseParams.Save("storage.type", "minio")
+	assert.Nil(t, err)
+}
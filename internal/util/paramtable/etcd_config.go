@@ -0,0 +1,215 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+	"go.uber.org/zap"
+)
+
+// ConfigSubPath is the path under the meta root where the dynamic configs are saved,
+// each config is saved with its lower case key, such as `config/proxy.maxtasknum`
+const ConfigSubPath = "config"
+
+// RefreshFunc is called when a refreshable parameter is updated from etcd,
+// it returns an error if the value is invalid, then the update is dropped
+type RefreshFunc func(value string) error
+
+// etcdConfigSource keeps the params updated with the configs saved in etcd
+type etcdConfigSource struct {
+	mu         sync.Mutex
+	refreshers map[string][]RefreshFunc
+	// values before being overridden by etcd, restored when the config is removed from etcd
+	defaults map[string]string
+
+	etcdCli    *clientv3.Client
+	configRoot string
+}
+
+func (gp *BaseTable) configSource() *etcdConfigSource {
+	gp.sourceOnce.Do(func() {
+		gp.source = &etcdConfigSource{
+			refreshers: make(map[string][]RefreshFunc),
+			defaults:   make(map[string]string),
+		}
+	})
+	return gp.source
+}
+
+// RegisterRefresher makes the parameter of key refreshable, refresh is called
+// with the new value every time the config of key is changed in etcd
+func (gp *BaseTable) RegisterRefresher(key string, refresh RefreshFunc) {
+	source := gp.configSource()
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	key = strings.ToLower(key)
+	source.refreshers[key] = append(source.refreshers[key], refresh)
+}
+
+// WatchEtcdConfig loads the configs saved under configRoot in etcd, which override
+// the values from yaml and env, then keeps watching them until ctx is done
+func (gp *BaseTable) WatchEtcdConfig(ctx context.Context, etcdEndpoints []string, configRoot string) error {
+	source := gp.configSource()
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints, DialTimeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	source.mu.Lock()
+	source.etcdCli = etcdCli
+	source.configRoot = configRoot
+	source.mu.Unlock()
+
+	prefix := configRoot + "/"
+	resp, err := etcdCli.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		gp.updateConfig(strings.TrimPrefix(string(kv.Key), prefix), string(kv.Value))
+	}
+
+	rch := etcdCli.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+	go func() {
+		defer etcdCli.Close()
+		for wresp := range rch {
+			if err := wresp.Err(); err != nil {
+				log.Warn("watch etcd config failed", zap.String("configRoot", configRoot), zap.Error(err))
+				return
+			}
+			for _, ev := range wresp.Events {
+				key := strings.TrimPrefix(string(ev.Kv.Key), prefix)
+				switch ev.Type {
+				case mvccpb.PUT:
+					gp.updateConfig(key, string(ev.Kv.Value))
+				case mvccpb.DELETE:
+					gp.resetConfig(key)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// SaveEtcdConfig saves the config into etcd, every component watching the configs
+// will update it, an empty value removes the config so that the default value is used
+func (gp *BaseTable) SaveEtcdConfig(ctx context.Context, key, value string) error {
+	etcdCli, configRoot, err := gp.configEtcd()
+	if err != nil {
+		return err
+	}
+	key = path.Join(configRoot, strings.ToLower(key))
+	if value == "" {
+		_, err = etcdCli.Delete(ctx, key)
+		return err
+	}
+	_, err = etcdCli.Put(ctx, key, value)
+	return err
+}
+
+// LoadEtcdConfigs returns the configs saved in etcd whose key has the prefix
+func (gp *BaseTable) LoadEtcdConfigs(ctx context.Context, keyPrefix string) (map[string]string, error) {
+	etcdCli, configRoot, err := gp.configEtcd()
+	if err != nil {
+		return nil, err
+	}
+	prefix := configRoot + "/"
+	resp, err := etcdCli.Get(ctx, prefix+strings.ToLower(keyPrefix), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	configs := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		configs[strings.TrimPrefix(string(kv.Key), prefix)] = string(kv.Value)
+	}
+	return configs, nil
+}
+
+func (gp *BaseTable) configEtcd() (*clientv3.Client, string, error) {
+	source := gp.configSource()
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if source.etcdCli == nil {
+		return nil, "", errors.New("etcd config is not watched")
+	}
+	return source.etcdCli, source.configRoot, nil
+}
+
+func (gp *BaseTable) updateConfig(key, value string) {
+	source := gp.configSource()
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	key = strings.ToLower(key)
+	old, err := gp.Load(key)
+	if err != nil {
+		log.Warn("load config failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if err := source.refresh(key, value); err != nil {
+		log.Warn("refresh config failed", zap.String("key", key), zap.String("value", value), zap.Error(err))
+		return
+	}
+	if _, ok := source.defaults[key]; !ok {
+		source.defaults[key] = old
+	}
+	if err := gp.Save(key, value); err != nil {
+		log.Warn("save config failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	log.Debug("config updated", zap.String("key", key), zap.String("old", old), zap.String("new", value))
+}
+
+func (gp *BaseTable) resetConfig(key string) {
+	source := gp.configSource()
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	key = strings.ToLower(key)
+	value, ok := source.defaults[key]
+	if !ok {
+		return
+	}
+	if err := source.refresh(key, value); err != nil {
+		log.Warn("refresh config failed", zap.String("key", key), zap.String("value", value), zap.Error(err))
+		return
+	}
+	delete(source.defaults, key)
+	var err error
+	if value == "" {
+		err = gp.Remove(key)
+	} else {
+		err = gp.Save(key, value)
+	}
+	if err != nil {
+		log.Warn("reset config failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	log.Debug("config reset", zap.String("key", key), zap.String("value", value))
+}
+
+// refresh calls the refreshers of key, the caller must hold mu
+func (source *etcdConfigSource) refresh(key, value string) error {
+	for _, refresh := range source.refreshers[key] {
+		if err := refresh(value); err != nil {
+			return fmt.Errorf("invalid value %s of config %s: %w", value, key, err)
+		}
+	}
+	return nil
+}
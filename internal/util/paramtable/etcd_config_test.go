@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/clientv3"
+)
+
+func TestBaseTable_WatchEtcdConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	params := BaseTable{}
+	params.Init()
+	endpoints, err := params.Load("_EtcdEndpoints")
+	assert.Nil(t, err)
+	etcdEndpoints := strings.Split(endpoints, ",")
+	configRoot := fmt.Sprintf("/test/%d/%s", rand.Int(), ConfigSubPath)
+
+	etcdCli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints})
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	defer etcdCli.Delete(context.Background(), configRoot, clientv3.WithPrefix())
+
+	// config saved before watching overrides the yaml value
+	_, err = etcdCli.Put(ctx, configRoot+"/proxy.maxnamelength", "128")
+	assert.Nil(t, err)
+
+	var refreshed int64
+	params.RegisterRefresher("proxy.maxTaskNum", func(value string) error {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if v <= 0 {
+			return errors.New("maxTaskNum should be positive")
+		}
+		refreshed = v
+		return nil
+	})
+	err = params.Save("proxy.maxTaskNum", "1024")
+	assert.Nil(t, err)
+
+	err = params.WatchEtcdConfig(ctx, etcdEndpoints, configRoot)
+	assert.Nil(t, err)
+	value, err := params.Load("proxy.maxNameLength")
+	assert.Nil(t, err)
+	assert.Equal(t, "128", value)
+
+	err = params.SaveEtcdConfig(ctx, "proxy.maxTaskNum", "2048")
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		value, _ := params.Load("proxy.maxTaskNum")
+		return value == "2048"
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int64(2048), refreshed)
+
+	configs, err := params.LoadEtcdConfigs(ctx, "proxy.")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"proxy.maxnamelength": "128", "proxy.maxtasknum": "2048"}, configs)
+
+	// invalid values are dropped by the refresher
+	err = params.SaveEtcdConfig(ctx, "proxy.maxTaskNum", "-1")
+	assert.Nil(t, err)
+	err = params.SaveEtcdConfig(ctx, "proxy.timeTickInterval", "100")
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		value, _ := params.Load("proxy.timeTickInterval")
+		return value == "100"
+	}, 5*time.Second, 50*time.Millisecond)
+	value, err = params.Load("proxy.maxTaskNum")
+	assert.Nil(t, err)
+	assert.Equal(t, "2048", value)
+
+	// removing the config restores the default value
+	err = params.SaveEtcdConfig(ctx, "proxy.maxTaskNum", "")
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		value, _ := params.Load("proxy.maxTaskNum")
+		return value == "1024"
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int64(1024), refreshed)
+}
+
+func TestBaseTable_EtcdConfigNotWatched(t *testing.T) {
+	params := BaseTable{}
+	params.Init()
+	err := params.SaveEtcdConfig(context.Background(), "proxy.maxTaskNum", "10")
+	assert.NotNil(t, err)
+	_, err = params.LoadEtcdConfigs(context.Background(), "")
+	assert.NotNil(t, err)
+}
@@ -12,6 +12,8 @@
 package paramtable
 
 import (
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -24,6 +26,10 @@ type ParamTable struct {
 	BaseTable
 
 	LogConfig *log.Config
+
+	UseEmbedEtcd  bool
+	EtcdDataDir   string
+	EtcdEndpoints []string
 }
 
 func (p *ParamTable) Init() {
@@ -31,6 +37,7 @@ func (p *ParamTable) Init() {
 		p.BaseTable.Init()
 
 		p.initLogCfg()
+		p.initEtcdCfg()
 	})
 }
 
@@ -54,3 +61,23 @@ func (p *ParamTable) initLogCfg() {
 		panic(err)
 	}
 }
+
+func (p *ParamTable) initEtcdCfg() {
+	useEmbedEtcd, err := p.Load("etcd.use.embed")
+	if err != nil {
+		panic(err)
+	}
+	p.UseEmbedEtcd, err = strconv.ParseBool(useEmbedEtcd)
+	if err != nil {
+		panic(err)
+	}
+	p.EtcdDataDir, err = p.Load("etcd.data.dir")
+	if err != nil {
+		panic(err)
+	}
+	endpoints, err := p.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+	p.EtcdEndpoints = strings.Split(endpoints, ",")
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package paramtable
+
+// StorageConfig is the storage of binlogs and index files, embedded in the param tables
+// of the components reading or writing them
+type StorageConfig struct {
+	// StorageType is minio or local
+	StorageType string
+	// LocalStoragePath is the root path of the files when StorageType is local
+	LocalStoragePath string
+}
+
+// InitStorageConfig loads the storage configs from the base table
+func (sc *StorageConfig) InitStorageConfig(gp *BaseTable) {
+	storageType, err := gp.Load("storage.type")
+	if err != nil {
+		panic(err)
+	}
+	sc.StorageType = storageType
+
+	localPath, err := gp.Load("localStorage.path")
+	if err != nil {
+		panic(err)
+	}
+	sc.LocalStoragePath = localPath
+}
@@ -132,9 +132,10 @@ func consume(ctx context.Context, consumer *consumer) {
 				}
 
 				consumer.messageCh <- ConsumerMessage{
-					MsgID:   msg[0].MsgID,
-					Payload: msg[0].Payload,
-					Topic:   consumer.Topic(),
+					MsgID:      msg[0].MsgID,
+					Payload:    msg[0].Payload,
+					Properties: msg[0].Properties,
+					Topic:      consumer.Topic(),
 				}
 			}
 		}
@@ -43,9 +43,10 @@ type ConsumerOptions struct {
 
 type ConsumerMessage struct {
 	Consumer
-	MsgID   UniqueID
-	Topic   string
-	Payload []byte
+	MsgID      UniqueID
+	Topic      string
+	Payload    []byte
+	Properties map[string]string
 }
 
 type Consumer interface {
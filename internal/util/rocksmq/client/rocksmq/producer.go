@@ -16,7 +16,8 @@ type ProducerOptions struct {
 }
 
 type ProducerMessage struct {
-	Payload []byte
+	Payload    []byte
+	Properties map[string]string
 }
 
 type Producer interface {
@@ -43,7 +43,8 @@ func (p *producer) Topic() string {
 func (p *producer) Send(message *ProducerMessage) error {
 	return p.c.server.Produce(p.topic, []server.ProducerMessage{
 		{
-			Payload: message.Payload,
+			Payload:    message.Payload,
+			Properties: message.Properties,
 		},
 	})
 }
@@ -13,9 +13,11 @@ package rocksmq
 
 import (
 	"os"
+	"strconv"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 
 	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 )
@@ -49,6 +51,8 @@ func InitRocksMQ(rocksdbName string) error {
 		idAllocator := allocator.NewGlobalIDAllocator("rmq_id", rocksdbKV)
 		_ = idAllocator.Initialize()
 
+		initRetentionParams()
+
 		Rmq, err = NewRocksMQ(rocksdbName, idAllocator)
 		if err != nil {
 			panic(err)
@@ -58,7 +62,24 @@ func InitRocksMQ(rocksdbName string) error {
 }
 
 func CloseRocksMQ() {
-	if Rmq != nil && Rmq.store != nil {
-		Rmq.store.Close()
+	if Rmq != nil {
+		Rmq.Close()
+	}
+}
+
+// initRetentionParams overrides the default retention policy with milvus.yaml
+func initRetentionParams() {
+	params := paramtable.BaseTable{}
+	params.Init()
+
+	if value, err := params.Load("rocksmq.retentionTimeInMinutes"); err == nil {
+		if minutes, err := strconv.ParseInt(value, 10, 64); err == nil {
+			RocksmqRetentionTimeInMinutes = minutes
+		}
+	}
+	if value, err := params.Load("rocksmq.retentionSizeInMB"); err == nil {
+		if size, err := strconv.ParseInt(value, 10, 64); err == nil {
+			RocksmqRetentionSizeInMB = size
+		}
 	}
 }
@@ -12,7 +12,8 @@
 package rocksmq
 
 type ProducerMessage struct {
-	Payload []byte
+	Payload    []byte
+	Properties map[string]string
 }
 
 type Consumer struct {
@@ -22,8 +23,9 @@ type Consumer struct {
 }
 
 type ConsumerMessage struct {
-	MsgID   UniqueID
-	Payload []byte
+	MsgID      UniqueID
+	Payload    []byte
+	Properties map[string]string
 }
 
 type RocksMQ interface {
@@ -12,18 +12,22 @@
 package rocksmq
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 )
 
 type UniqueID = typeutil.UniqueID
@@ -32,6 +36,12 @@ const (
 	DefaultMessageID        = "-1"
 	FixedChannelNameLen     = 320
 	RocksDBLRUCacheCapacity = 3 << 30
+
+	// MetaKVSuffix is appended to the rocksmq path to name the RocksDB which
+	// persists topic ranges, consumer group positions and retention pages
+	MetaKVSuffix = "_meta_kv"
+
+	propertiesSeparator = "#"
 )
 
 /**
@@ -63,13 +73,45 @@ func combKey(channelName string, id UniqueID) (string, error) {
 	return fixName + "/" + strconv.FormatInt(id, 10), nil
 }
 
+// propertiesKey is the key of the message properties, the separator differs from the one
+// of combKey so that the properties are out of the prefix which the messages are iterated by
+func propertiesKey(channelName string, id UniqueID) (string, error) {
+	fixName, err := fixChannelName(channelName)
+	if err != nil {
+		return "", err
+	}
+
+	return fixName + propertiesSeparator + strconv.FormatInt(id, 10), nil
+}
+
+func beginIDKey(topicName string) string {
+	return topicName + "/begin_id"
+}
+
+func endIDKey(topicName string) string {
+	return topicName + "/end_id"
+}
+
+func currentIDKey(topicName, groupName string) string {
+	return groupName + "/" + topicName + "/current_id"
+}
+
 type rocksmq struct {
 	store       *gorocksdb.DB
-	kv          kv.BaseKV
+	kv          kv.TxnKV
 	idAllocator allocator.GIDAllocator
 	channelMu   sync.Map
 
 	consumers sync.Map
+
+	// groupMu protects consumerGroups, which maps topic name to the set of
+	// consumer group names created on it, registered consumer or not
+	groupMu        sync.Mutex
+	consumerGroups map[string]map[string]struct{}
+
+	// topicRetention maps topic name to *topicRetention, guarded by the topic's channelMu
+	topicRetention sync.Map
+	retentionInfo  *retentionInfo
 }
 
 func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, error) {
@@ -85,30 +127,149 @@ func NewRocksMQ(name string, idAllocator allocator.GIDAllocator) (*rocksmq, erro
 		return nil, err
 	}
 
-	mkv := memkv.NewMemoryKV()
+	metaKV, err := rocksdbkv.NewRocksdbKV(name + MetaKVSuffix)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	rmq := &rocksmq{
-		store:       db,
-		kv:          mkv,
-		idAllocator: idAllocator,
+		store:          db,
+		kv:             metaKV,
+		idAllocator:    idAllocator,
+		consumerGroups: make(map[string]map[string]struct{}),
 	}
 	rmq.channelMu = sync.Map{}
 	rmq.consumers = sync.Map{}
+	rmq.topicRetention = sync.Map{}
+
+	err = rmq.loadMeta()
+	if err != nil {
+		rmq.store.Close()
+		metaKV.Close()
+		return nil, err
+	}
+
+	rmq.retentionInfo = newRetentionInfo(rmq)
+	rmq.retentionInfo.startRetentionInfo()
 	return rmq, nil
 }
 
+// loadMeta rebuilds the in-memory topic locks, consumer group sets and
+// retention pages from the persisted meta kv, so that a restarted rocksmq
+// continues from where every consumer group stopped
+func (rmq *rocksmq) loadMeta() error {
+	keys, values, err := rmq.kv.LoadWithPrefix("")
+	if err != nil {
+		return err
+	}
+	endIDs := make(map[string]UniqueID)
+	for i, key := range keys {
+		switch {
+		case strings.HasSuffix(key, "/begin_id"):
+			topicName := strings.TrimSuffix(key, "/begin_id")
+			rmq.channelMu.LoadOrStore(topicName, new(sync.Mutex))
+			rmq.topicRetention.LoadOrStore(topicName, newTopicRetention())
+		case strings.HasSuffix(key, "/end_id"):
+			endID, err := strconv.ParseInt(values[i], 10, 64)
+			if err != nil {
+				return err
+			}
+			endIDs[strings.TrimSuffix(key, "/end_id")] = endID
+		case strings.HasSuffix(key, "/current_id"):
+			groupTopic := strings.TrimSuffix(key, "/current_id")
+			index := strings.Index(groupTopic, "/")
+			if index < 0 {
+				continue
+			}
+			rmq.addConsumerGroup(groupTopic[index+1:], groupTopic[:index])
+		case strings.HasPrefix(key, pageInfoTitle):
+			topicName, page, err := parsePageInfo(key, values[i])
+			if err != nil {
+				return err
+			}
+			val, _ := rmq.topicRetention.LoadOrStore(topicName, newTopicRetention())
+			val.(*topicRetention).addPage(page)
+		}
+	}
+	now := time.Now().Unix()
+	rmq.topicRetention.Range(func(key, value interface{}) bool {
+		tr := value.(*topicRetention)
+		tr.sortPages()
+		if endID, ok := endIDs[key.(string)]; ok {
+			tr.restoreOpenPage(endID-1, now)
+		}
+		return true
+	})
+	log.Debug("RocksMQ: meta loaded", zap.Int("keys", len(keys)))
+	return nil
+}
+
+// Close stops the retention goroutine and releases the underlying RocksDBs
+func (rmq *rocksmq) Close() {
+	if rmq.retentionInfo != nil {
+		rmq.retentionInfo.close()
+	}
+	if rmq.store != nil {
+		rmq.store.Close()
+	}
+	if rmq.kv != nil {
+		rmq.kv.Close()
+	}
+}
+
+func (rmq *rocksmq) addConsumerGroup(topicName, groupName string) {
+	rmq.groupMu.Lock()
+	defer rmq.groupMu.Unlock()
+	groups, ok := rmq.consumerGroups[topicName]
+	if !ok {
+		groups = make(map[string]struct{})
+		rmq.consumerGroups[topicName] = groups
+	}
+	groups[groupName] = struct{}{}
+}
+
+func (rmq *rocksmq) removeConsumerGroup(topicName, groupName string) {
+	rmq.groupMu.Lock()
+	defer rmq.groupMu.Unlock()
+	if groups, ok := rmq.consumerGroups[topicName]; ok {
+		delete(groups, groupName)
+		if len(groups) == 0 {
+			delete(rmq.consumerGroups, topicName)
+		}
+	}
+}
+
+func (rmq *rocksmq) removeConsumerGroups(topicName string) {
+	rmq.groupMu.Lock()
+	defer rmq.groupMu.Unlock()
+	delete(rmq.consumerGroups, topicName)
+}
+
+func (rmq *rocksmq) getConsumerGroups(topicName string) []string {
+	rmq.groupMu.Lock()
+	defer rmq.groupMu.Unlock()
+	groups := make([]string, 0, len(rmq.consumerGroups[topicName]))
+	for groupName := range rmq.consumerGroups[topicName] {
+		groups = append(groups, groupName)
+	}
+	return groups
+}
+
 func (rmq *rocksmq) checkKeyExist(key string) bool {
 	val, _ := rmq.kv.Load(key)
 	return val != ""
 }
 
 func (rmq *rocksmq) CreateTopic(topicName string) error {
-	beginKey := topicName + "/begin_id"
-	endKey := topicName + "/end_id"
+	beginKey := beginIDKey(topicName)
+	endKey := endIDKey(topicName)
 
 	// Check if topic exist
 	if rmq.checkKeyExist(beginKey) || rmq.checkKeyExist(endKey) {
 		log.Debug("RocksMQ: " + beginKey + " or " + endKey + " existed.")
+		rmq.channelMu.LoadOrStore(topicName, new(sync.Mutex))
+		rmq.topicRetention.LoadOrStore(topicName, newTopicRetention())
 		return nil
 	}
 
@@ -124,34 +285,55 @@ func (rmq *rocksmq) CreateTopic(topicName string) error {
 		return err
 	}
 	rmq.channelMu.Store(topicName, new(sync.Mutex))
+	rmq.topicRetention.Store(topicName, newTopicRetention())
 
 	return nil
 }
 
 func (rmq *rocksmq) DestroyTopic(topicName string) error {
-	beginKey := topicName + "/begin_id"
-	endKey := topicName + "/end_id"
+	beginKey := beginIDKey(topicName)
+	endKey := endIDKey(topicName)
+
+	if ll, ok := rmq.channelMu.Load(topicName); ok {
+		lock := ll.(*sync.Mutex)
+		lock.Lock()
+		defer lock.Unlock()
+	}
 
-	err := rmq.kv.Remove(beginKey)
+	removals := []string{beginKey, endKey}
+	// loadMeta would register the consumer groups again after a restart if their positions were kept
+	for _, groupName := range rmq.getConsumerGroups(topicName) {
+		removals = append(removals, currentIDKey(topicName, groupName))
+	}
+	if val, ok := rmq.topicRetention.Load(topicName); ok {
+		for _, page := range val.(*topicRetention).pages {
+			removals = append(removals, pageInfoKey(topicName, page.endID))
+		}
+	}
+	err := rmq.kv.MultiRemove(removals)
 	if err != nil {
-		log.Debug("RocksMQ: remove " + beginKey + " failed.")
+		log.Debug("RocksMQ: remove " + beginKey + " and " + endKey + " failed.")
 		return err
 	}
 
-	err = rmq.kv.Remove(endKey)
+	// Messages of a destroyed topic can never be consumed again
+	err = rmq.deleteMessages(topicName, -1)
 	if err != nil {
-		log.Debug("RocksMQ: remove " + endKey + " failed.")
+		log.Debug("RocksMQ: delete messages of " + topicName + " failed.")
 		return err
 	}
 
+	rmq.topicRetention.Delete(topicName)
 	rmq.consumers.Delete(topicName)
+	rmq.removeConsumerGroups(topicName)
+	rmq.channelMu.Delete(topicName)
 	log.Debug("DestroyTopic: " + topicName)
 
 	return nil
 }
 
 func (rmq *rocksmq) ExistConsumerGroup(topicName, groupName string) (bool, *Consumer) {
-	key := groupName + "/" + topicName + "/current_id"
+	key := currentIDKey(topicName, groupName)
 	if rmq.checkKeyExist(key) {
 		if vals, ok := rmq.consumers.Load(topicName); ok {
 			for _, v := range vals.([]*Consumer) {
@@ -165,9 +347,11 @@ func (rmq *rocksmq) ExistConsumerGroup(topicName, groupName string) (bool, *Cons
 }
 
 func (rmq *rocksmq) CreateConsumerGroup(topicName, groupName string) error {
-	key := groupName + "/" + topicName + "/current_id"
+	key := currentIDKey(topicName, groupName)
 	if rmq.checkKeyExist(key) {
+		// The group survived a restart, keep consuming from its persisted position
 		log.Debug("RocksMQ: " + key + " existed.")
+		rmq.addConsumerGroup(topicName, groupName)
 		return nil
 	}
 	err := rmq.kv.Save(key, DefaultMessageID)
@@ -175,6 +359,7 @@ func (rmq *rocksmq) CreateConsumerGroup(topicName, groupName string) error {
 		log.Debug("RocksMQ: save " + key + " failed.")
 		return err
 	}
+	rmq.addConsumerGroup(topicName, groupName)
 
 	return nil
 }
@@ -197,13 +382,14 @@ func (rmq *rocksmq) RegisterConsumer(consumer *Consumer) {
 }
 
 func (rmq *rocksmq) DestroyConsumerGroup(topicName, groupName string) error {
-	key := groupName + "/" + topicName + "/current_id"
+	key := currentIDKey(topicName, groupName)
 
 	err := rmq.kv.Remove(key)
 	if err != nil {
 		log.Debug("RocksMQ: remove " + key + " failed.")
 		return err
 	}
+	rmq.removeConsumerGroup(topicName, groupName)
 	if vals, ok := rmq.consumers.Load(topicName); ok {
 		consumers := vals.([]*Consumer)
 		for index, v := range consumers {
@@ -248,6 +434,7 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 
 	/* Step I: Insert data to store system */
 	batch := gorocksdb.NewWriteBatch()
+	var msgSize int64
 	for i := 0; i < msgLen && idStart+UniqueID(i) < idEnd; i++ {
 		key, err := combKey(topicName, idStart+UniqueID(i))
 		if err != nil {
@@ -256,6 +443,22 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 		}
 
 		batch.Put([]byte(key), messages[i].Payload)
+		msgSize += int64(len(messages[i].Payload))
+
+		if len(messages[i].Properties) > 0 {
+			pKey, err := propertiesKey(topicName, idStart+UniqueID(i))
+			if err != nil {
+				batch.Destroy()
+				return err
+			}
+			properties, err := json.Marshal(messages[i].Properties)
+			if err != nil {
+				batch.Destroy()
+				return err
+			}
+			batch.Put([]byte(pKey), properties)
+			msgSize += int64(len(properties))
+		}
 	}
 
 	err = rmq.store.Write(gorocksdb.NewDefaultWriteOptions(), batch)
@@ -266,7 +469,7 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 	}
 
 	/* Step II: Update meta data to kv system */
-	kvChannelBeginID := topicName + "/begin_id"
+	kvChannelBeginID := beginIDKey(topicName)
 	beginIDValue, err := rmq.kv.Load(kvChannelBeginID)
 	if err != nil {
 		log.Debug("RocksMQ: load " + kvChannelBeginID + " failed")
@@ -280,14 +483,25 @@ func (rmq *rocksmq) Produce(topicName string, messages []ProducerMessage) error
 		kvValues[kvChannelBeginID] = strconv.FormatInt(idStart, 10)
 	}
 
-	kvChannelEndID := topicName + "/end_id"
+	kvChannelEndID := endIDKey(topicName)
 	kvValues[kvChannelEndID] = strconv.FormatInt(idEnd, 10)
 
+	/* Step III: Account the messages for retention, persist the page once it is full */
+	val, _ := rmq.topicRetention.LoadOrStore(topicName, newTopicRetention())
+	tr := val.(*topicRetention)
+	page, full := tr.appendMessages(idEnd-1, msgSize, time.Now().Unix())
+	if full {
+		kvValues[pageInfoKey(topicName, page.endID)] = page.value()
+	}
+
 	err = rmq.kv.MultiSave(kvValues)
 	if err != nil {
 		log.Debug("RocksMQ: multisave failed")
 		return err
 	}
+	if full {
+		tr.addPage(page)
+	}
 
 	if vals, ok := rmq.consumers.Load(topicName); ok {
 		for _, v := range vals.([]*Consumer) {
@@ -314,7 +528,7 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 	lock.Lock()
 	defer lock.Unlock()
 
-	metaKey := groupName + "/" + topicName + "/current_id"
+	metaKey := currentIDKey(topicName, groupName)
 	currentID, err := rmq.kv.Load(metaKey)
 	if err != nil {
 		log.Debug("RocksMQ: load " + metaKey + " failed")
@@ -336,15 +550,19 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 	}
 	dataKey := fixChanName + "/" + currentID
 
-	// msgID is DefaultMessageID means this is the first consume operation
-	// currentID may be not valid if the deprecated values has been removed, when
-	// we move currentID to first location.
+	// msgID is DefaultMessageID means this is the first consume operation.
+	// The message at currentID may have been removed by retention, in which case
+	// Seek already stands on the first message not consumed yet.
 	// Note that we assume currentId is always correct and not larger than the latest endID.
-	if iter.Seek([]byte(dataKey)); currentID != DefaultMessageID && iter.Valid() {
-		iter.Next()
-	} else {
+	if currentID == DefaultMessageID {
 		newKey := fixChanName + "/"
 		iter.Seek([]byte(newKey))
+	} else if iter.Seek([]byte(dataKey)); iter.Valid() {
+		key := iter.Key()
+		if string(key.Data()) == dataKey {
+			iter.Next()
+		}
+		key.Free()
 	}
 
 	offset := 0
@@ -357,9 +575,15 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 			log.Debug("RocksMQ: parse int " + string(key.Data())[FixedChannelNameLen+1:] + " failed")
 			return nil, err
 		}
+		properties, err := rmq.loadProperties(readOpts, topicName, msgID)
+		if err != nil {
+			log.Debug("RocksMQ: load properties of " + strconv.FormatInt(msgID, 10) + " failed")
+			return nil, err
+		}
 		msg := ConsumerMessage{
-			MsgID:   msgID,
-			Payload: val.Data(),
+			MsgID:      msgID,
+			Payload:    val.Data(),
+			Properties: properties,
 		}
 		consumerMessage = append(consumerMessage, msg)
 		key.Free()
@@ -386,9 +610,30 @@ func (rmq *rocksmq) Consume(topicName string, groupName string, n int) ([]Consum
 	return consumerMessage, nil
 }
 
+// loadProperties returns nil if the message is produced without properties
+func (rmq *rocksmq) loadProperties(readOpts *gorocksdb.ReadOptions, topicName string, msgID UniqueID) (map[string]string, error) {
+	pKey, err := propertiesKey(topicName, msgID)
+	if err != nil {
+		return nil, err
+	}
+	val, err := rmq.store.Get(readOpts, []byte(pKey))
+	if err != nil {
+		return nil, err
+	}
+	defer val.Free()
+	if val.Size() == 0 {
+		return nil, nil
+	}
+	properties := make(map[string]string)
+	if err := json.Unmarshal(val.Data(), &properties); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
 func (rmq *rocksmq) Seek(topicName string, groupName string, msgID UniqueID) error {
 	/* Step I: Check if key exists */
-	key := groupName + "/" + topicName + "/current_id"
+	key := currentIDKey(topicName, groupName)
 	if !rmq.checkKeyExist(key) {
 		log.Debug("RocksMQ: channel " + key + " not exists")
 		return fmt.Errorf("ConsumerGroup %s, channel %s not exists", groupName, topicName)
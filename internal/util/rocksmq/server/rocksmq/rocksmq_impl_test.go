@@ -23,7 +23,9 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/stretchr/testify/assert"
+	"github.com/tecbot/gorocksdb"
 	"go.etcd.io/etcd/clientv3"
 )
 
@@ -54,6 +56,7 @@ func TestRocksMQ(t *testing.T) {
 	name := "/tmp/rocksmq"
 	_ = os.RemoveAll(name)
 	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -107,6 +110,7 @@ func TestRocksMQ_Loop(t *testing.T) {
 	name := "/tmp/rocksmq_1"
 	_ = os.RemoveAll(name)
 	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -170,6 +174,7 @@ func TestRocksMQ_Goroutines(t *testing.T) {
 
 	name := "/tmp/rocksmq_2"
 	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -237,6 +242,7 @@ func TestRocksMQ_Throughout(t *testing.T) {
 
 	name := "/tmp/rocksmq_3"
 	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -287,6 +293,7 @@ func TestRocksMQ_MultiChan(t *testing.T) {
 
 	name := "/tmp/rocksmq_multichan"
 	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
 	rmq, err := NewRocksMQ(name, idAllocator)
 	assert.Nil(t, err)
 
@@ -321,3 +328,231 @@ func TestRocksMQ_MultiChan(t *testing.T) {
 	assert.Equal(t, len(cMsgs), 1)
 	assert.Equal(t, string(cMsgs[0].Payload), "for_chann1_"+strconv.Itoa(0))
 }
+
+func newRocksdbIDAllocator(t *testing.T, name string) (*rocksdbkv.RocksdbKV, allocator.GIDAllocator) {
+	kvName := name + "_kv"
+	_ = os.RemoveAll(kvName)
+	rocksdbKV, err := rocksdbkv.NewRocksdbKV(kvName)
+	assert.Nil(t, err)
+	idAllocator := allocator.NewGlobalIDAllocator("rmq_id", rocksdbKV)
+	_ = idAllocator.Initialize()
+	return rocksdbKV, idAllocator
+}
+
+func TestRocksMQ_PersistConsumerPosition(t *testing.T) {
+	name := "/tmp/rocksmq_persist"
+	_ = os.RemoveAll(name)
+	_ = os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name + "_kv")
+	kv, idAllocator := newRocksdbIDAllocator(t, name)
+	defer kv.Close()
+
+	rmq, err := NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+
+	channelName := "channel_persist"
+	groupName := "group_persist"
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName)
+	assert.Nil(t, err)
+
+	loopNum := 10
+	for i := 0; i < loopNum; i++ {
+		pMsg := ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+		err = rmq.Produce(channelName, []ProducerMessage{pMsg})
+		assert.Nil(t, err)
+	}
+	cMsgs, err := rmq.Consume(channelName, groupName, loopNum/2)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	rmq.Close()
+
+	// Reopen, the consumer group continues from its persisted position
+	rmq, err = NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName)
+	assert.Nil(t, err)
+	cMsgs, err = rmq.Consume(channelName, groupName, loopNum)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	assert.Equal(t, "message_"+strconv.Itoa(loopNum/2), string(cMsgs[0].Payload))
+
+	pMsg := ProducerMessage{Payload: []byte("message_after_reopen")}
+	err = rmq.Produce(channelName, []ProducerMessage{pMsg})
+	assert.Nil(t, err)
+	cMsgs, err = rmq.Consume(channelName, groupName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cMsgs))
+	assert.Equal(t, "message_after_reopen", string(cMsgs[0].Payload))
+}
+
+func TestRocksMQ_DestroyTopicPersisted(t *testing.T) {
+	name := "/tmp/rocksmq_destroy"
+	_ = os.RemoveAll(name)
+	_ = os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name + "_kv")
+	kv, idAllocator := newRocksdbIDAllocator(t, name)
+	defer kv.Close()
+
+	rmq, err := NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+
+	channelName := "channel_destroy"
+	groupName := "group_destroy"
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName)
+	assert.Nil(t, err)
+	err = rmq.Produce(channelName, []ProducerMessage{{Payload: []byte("message")}})
+	assert.Nil(t, err)
+
+	err = rmq.DestroyTopic(channelName)
+	assert.Nil(t, err)
+	assert.False(t, rmq.checkKeyExist(currentIDKey(channelName, groupName)))
+	assert.Empty(t, rmq.getConsumerGroups(channelName))
+	_, ok := rmq.channelMu.Load(channelName)
+	assert.False(t, ok)
+	rmq.Close()
+
+	// Reopen, nothing of the destroyed topic is loaded again
+	rmq, err = NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+	assert.Empty(t, rmq.getConsumerGroups(channelName))
+	_, ok = rmq.channelMu.Load(channelName)
+	assert.False(t, ok)
+}
+
+func TestRocksMQ_Retention(t *testing.T) {
+	name := "/tmp/rocksmq_retention"
+	_ = os.RemoveAll(name)
+	_ = os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name + "_kv")
+	kv, idAllocator := newRocksdbIDAllocator(t, name)
+	defer kv.Close()
+
+	oldRetentionTime := RocksmqRetentionTimeInMinutes
+	oldPageSize := RocksmqPageSize
+	RocksmqRetentionTimeInMinutes = 0
+	RocksmqPageSize = 1
+	defer func() {
+		RocksmqRetentionTimeInMinutes = oldRetentionTime
+		RocksmqPageSize = oldPageSize
+	}()
+
+	rmq, err := NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	channelName := "channel_retention"
+	groupName0 := "group_retention_0"
+	groupName1 := "group_retention_1"
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName0)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName1)
+	assert.Nil(t, err)
+
+	loopNum := 10
+	for i := 0; i < loopNum; i++ {
+		pMsg := ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+		err = rmq.Produce(channelName, []ProducerMessage{pMsg})
+		assert.Nil(t, err)
+	}
+
+	// Nothing is acknowledged by group1 yet, so nothing is removed
+	cMsgs, err := rmq.Consume(channelName, groupName0, loopNum/2)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	err = rmq.expiredCleanUp(channelName)
+	assert.Nil(t, err)
+
+	err = rmq.CreateConsumerGroup(channelName, groupName0+"_replay")
+	assert.Nil(t, err)
+	cMsgs, err = rmq.Consume(channelName, groupName0+"_replay", loopNum)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum, len(cMsgs))
+	err = rmq.DestroyConsumerGroup(channelName, groupName0+"_replay")
+	assert.Nil(t, err)
+
+	// Both groups acknowledged the first half, which is removed
+	cMsgs, err = rmq.Consume(channelName, groupName1, loopNum/2)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	err = rmq.expiredCleanUp(channelName)
+	assert.Nil(t, err)
+
+	err = rmq.CreateConsumerGroup(channelName, groupName0+"_replay")
+	assert.Nil(t, err)
+	cMsgs, err = rmq.Consume(channelName, groupName0+"_replay", loopNum)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	assert.Equal(t, "message_"+strconv.Itoa(loopNum/2), string(cMsgs[0].Payload))
+	err = rmq.DestroyConsumerGroup(channelName, groupName0+"_replay")
+	assert.Nil(t, err)
+
+	// Existing groups continue without losing any message
+	cMsgs, err = rmq.Consume(channelName, groupName1, loopNum)
+	assert.Nil(t, err)
+	assert.Equal(t, loopNum/2, len(cMsgs))
+	assert.Equal(t, "message_"+strconv.Itoa(loopNum/2), string(cMsgs[0].Payload))
+}
+
+func TestRocksMQ_Properties(t *testing.T) {
+	name := "/tmp/rocksmq_properties"
+	_ = os.RemoveAll(name)
+	_ = os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name)
+	defer os.RemoveAll(name + MetaKVSuffix)
+	defer os.RemoveAll(name + "_kv")
+	kv, idAllocator := newRocksdbIDAllocator(t, name)
+	defer kv.Close()
+
+	rmq, err := NewRocksMQ(name, idAllocator)
+	assert.Nil(t, err)
+	defer rmq.Close()
+
+	channelName := "channel_properties"
+	groupName := "group_properties"
+	err = rmq.CreateTopic(channelName)
+	assert.Nil(t, err)
+	err = rmq.CreateConsumerGroup(channelName, groupName)
+	assert.Nil(t, err)
+
+	pMsgs := []ProducerMessage{
+		{Payload: []byte("a_message"), Properties: map[string]string{"traceparent": "a"}},
+		{Payload: []byte("b_message")},
+	}
+	err = rmq.Produce(channelName, pMsgs)
+	assert.Nil(t, err)
+
+	cMsgs, err := rmq.Consume(channelName, groupName, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cMsgs))
+	assert.Equal(t, map[string]string{"traceparent": "a"}, cMsgs[0].Properties)
+	assert.Nil(t, cMsgs[1].Properties)
+
+	// The properties are removed along with the messages
+	err = rmq.deleteMessages(channelName, -1)
+	assert.Nil(t, err)
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	properties, err := rmq.loadProperties(readOpts, channelName, cMsgs[0].MsgID)
+	assert.Nil(t, err)
+	assert.Nil(t, properties)
+
+	err = rmq.DestroyTopic(channelName)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,367 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package rocksmq
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/tecbot/gorocksdb"
+	"go.uber.org/zap"
+)
+
+const (
+	pageInfoTitle = "page_info/"
+	MB            = 1024 * 1024
+)
+
+var (
+	// RocksmqRetentionTimeInMinutes is how long acknowledged messages are kept, negative means forever
+	RocksmqRetentionTimeInMinutes int64 = 10080
+	// RocksmqRetentionSizeInMB is the size above which acknowledged messages of a topic are removed, negative means unlimited
+	RocksmqRetentionSizeInMB int64 = 8192
+	// RocksmqPageSize is the size of the message page, the unit in which messages are removed
+	RocksmqPageSize int64 = 64 * MB
+	// RocksmqPageTimeInSeconds is the longest time a page keeps accepting messages before it is closed
+	RocksmqPageTimeInSeconds int64 = 600
+	// TickerTimeInSeconds is the interval at which the retention goroutine checks every topic
+	TickerTimeInSeconds int64 = 60
+)
+
+func pageInfoKey(topicName string, endID UniqueID) string {
+	return pageInfoTitle + topicName + "/" + strconv.FormatInt(endID, 10)
+}
+
+// pageInfo records a closed range of messages, which contains every message
+// whose id is not larger than endID and larger than the endID of the previous page
+type pageInfo struct {
+	endID UniqueID
+	ts    int64
+	size  int64
+}
+
+func (p pageInfo) value() string {
+	return strconv.FormatInt(p.ts, 10) + "," + strconv.FormatInt(p.size, 10)
+}
+
+func parsePageInfo(key, value string) (string, pageInfo, error) {
+	info := strings.TrimPrefix(key, pageInfoTitle)
+	index := strings.LastIndex(info, "/")
+	if index < 0 {
+		return "", pageInfo{}, fmt.Errorf("invalid page info key %s", key)
+	}
+	endID, err := strconv.ParseInt(info[index+1:], 10, 64)
+	if err != nil {
+		return "", pageInfo{}, err
+	}
+	fields := strings.Split(value, ",")
+	if len(fields) != 2 {
+		return "", pageInfo{}, fmt.Errorf("invalid page info value %s of key %s", value, key)
+	}
+	ts, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return "", pageInfo{}, err
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return "", pageInfo{}, err
+	}
+	return info[:index], pageInfo{endID: endID, ts: ts, size: size}, nil
+}
+
+// topicRetention keeps the pages of a topic, it is protected by the topic's channelMu
+type topicRetention struct {
+	pages     []pageInfo
+	totalSize int64
+
+	// messages produced after the last closed page
+	lastID      UniqueID
+	pageSize    int64
+	pageBeginTs int64
+}
+
+func newTopicRetention() *topicRetention {
+	return &topicRetention{
+		pages:  make([]pageInfo, 0),
+		lastID: -1,
+	}
+}
+
+func (tr *topicRetention) addPage(page pageInfo) {
+	tr.pages = append(tr.pages, page)
+	tr.totalSize += page.size
+	if page.endID > tr.lastID {
+		tr.lastID = page.endID
+	}
+}
+
+func (tr *topicRetention) sortPages() {
+	sort.Slice(tr.pages, func(i, j int) bool {
+		return tr.pages[i].endID < tr.pages[j].endID
+	})
+}
+
+// restoreOpenPage makes the messages behind the last persisted page, whose
+// sizes were lost with the restart, be closed into a page by retention later
+func (tr *topicRetention) restoreOpenPage(lastID UniqueID, now int64) {
+	if lastID > tr.lastID {
+		tr.lastID = lastID
+		tr.pageBeginTs = now
+	}
+}
+
+// appendMessages accounts the produced messages into the open page, and returns
+// the page if it becomes full, the caller should persist it and add it back
+func (tr *topicRetention) appendMessages(lastID UniqueID, size int64, now int64) (pageInfo, bool) {
+	if tr.pageBeginTs == 0 {
+		tr.pageBeginTs = now
+	}
+	tr.lastID = lastID
+	tr.pageSize += size
+	if tr.pageSize < RocksmqPageSize && now-tr.pageBeginTs < RocksmqPageTimeInSeconds {
+		return pageInfo{}, false
+	}
+	return tr.closePage(now), true
+}
+
+// closeStalePage closes the open page if no message was produced for a while
+func (tr *topicRetention) closeStalePage(now int64) (pageInfo, bool) {
+	if tr.pageBeginTs == 0 || now-tr.pageBeginTs < RocksmqPageTimeInSeconds {
+		return pageInfo{}, false
+	}
+	return tr.closePage(now), true
+}
+
+func (tr *topicRetention) closePage(now int64) pageInfo {
+	page := pageInfo{endID: tr.lastID, ts: now, size: tr.pageSize}
+	tr.pageSize = 0
+	tr.pageBeginTs = 0
+	return page
+}
+
+// expiredPages returns how many leading pages could be removed, a page is removed
+// only when all of its messages are acknowledged, and it is either older than the
+// retention time or the topic exceeds the retention size
+func (tr *topicRetention) expiredPages(ackedID UniqueID, now int64) int {
+	remaining := tr.totalSize + tr.pageSize
+	count := 0
+	for _, page := range tr.pages {
+		if page.endID > ackedID {
+			break
+		}
+		expired := RocksmqRetentionTimeInMinutes >= 0 && now-page.ts >= RocksmqRetentionTimeInMinutes*60
+		oversize := RocksmqRetentionSizeInMB >= 0 && remaining > RocksmqRetentionSizeInMB*MB
+		if !expired && !oversize {
+			break
+		}
+		remaining -= page.size
+		count++
+	}
+	return count
+}
+
+func (tr *topicRetention) removePages(count int) {
+	for _, page := range tr.pages[:count] {
+		tr.totalSize -= page.size
+	}
+	tr.pages = tr.pages[count:]
+}
+
+type retentionInfo struct {
+	rmq *rocksmq
+
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newRetentionInfo(rmq *rocksmq) *retentionInfo {
+	return &retentionInfo{
+		rmq:     rmq,
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (ri *retentionInfo) startRetentionInfo() {
+	ri.closeWg.Add(1)
+	go ri.retention()
+}
+
+func (ri *retentionInfo) retention() {
+	defer ri.closeWg.Done()
+	log.Debug("RocksMQ: retention goroutine start")
+	ticker := time.NewTicker(time.Duration(TickerTimeInSeconds) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ri.closeCh:
+			log.Debug("RocksMQ: retention goroutine quit")
+			return
+		case <-ticker.C:
+			ri.rmq.channelMu.Range(func(key, value interface{}) bool {
+				topicName := key.(string)
+				if err := ri.rmq.expiredCleanUp(topicName); err != nil {
+					log.Warn("RocksMQ: retention clean up failed", zap.String("topic", topicName), zap.Error(err))
+				}
+				return true
+			})
+		}
+	}
+}
+
+func (ri *retentionInfo) close() {
+	ri.closeOnce.Do(func() {
+		close(ri.closeCh)
+		ri.closeWg.Wait()
+	})
+}
+
+// getAckedID returns the largest message id consumed by every consumer group of the topic,
+// false is returned when there is no consumer group or some group has not consumed anything
+func (rmq *rocksmq) getAckedID(topicName string) (UniqueID, bool) {
+	groups := rmq.getConsumerGroups(topicName)
+	if len(groups) == 0 {
+		return 0, false
+	}
+	var ackedID UniqueID = -1
+	for _, groupName := range groups {
+		currentID, err := rmq.kv.Load(currentIDKey(topicName, groupName))
+		if err != nil || currentID == "" || currentID == DefaultMessageID {
+			return 0, false
+		}
+		id, err := strconv.ParseInt(currentID, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		if ackedID == -1 || id < ackedID {
+			ackedID = id
+		}
+	}
+	return ackedID, true
+}
+
+// expiredCleanUp removes the acknowledged messages of the topic which exceed
+// the retention time or size, page by page
+func (rmq *rocksmq) expiredCleanUp(topicName string) error {
+	ll, ok := rmq.channelMu.Load(topicName)
+	if !ok {
+		return fmt.Errorf("topic name = %s not exist", topicName)
+	}
+	lock, ok := ll.(*sync.Mutex)
+	if !ok {
+		return fmt.Errorf("get mutex failed, topic name = %s", topicName)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	val, ok := rmq.topicRetention.Load(topicName)
+	if !ok {
+		return nil
+	}
+	tr := val.(*topicRetention)
+	now := time.Now().Unix()
+
+	if page, ok := tr.closeStalePage(now); ok {
+		err := rmq.kv.Save(pageInfoKey(topicName, page.endID), page.value())
+		if err != nil {
+			return err
+		}
+		tr.addPage(page)
+	}
+
+	ackedID, ok := rmq.getAckedID(topicName)
+	if !ok {
+		return nil
+	}
+	count := tr.expiredPages(ackedID, now)
+	if count == 0 {
+		return nil
+	}
+
+	lastPage := tr.pages[count-1]
+	err := rmq.deleteMessages(topicName, lastPage.endID)
+	if err != nil {
+		return err
+	}
+
+	removals := make([]string, 0, count)
+	for _, page := range tr.pages[:count] {
+		removals = append(removals, pageInfoKey(topicName, page.endID))
+	}
+	saves := map[string]string{
+		beginIDKey(topicName): strconv.FormatInt(lastPage.endID+1, 10),
+	}
+	err = rmq.kv.MultiSaveAndRemove(saves, removals)
+	if err != nil {
+		return err
+	}
+	tr.removePages(count)
+
+	log.Debug("RocksMQ: expired messages removed", zap.String("topic", topicName),
+		zap.Int("pages", count), zap.Int64("endID", lastPage.endID))
+	return nil
+}
+
+// deleteMessages removes the messages of the topic whose id is not larger than endID,
+// all messages of the topic are removed if endID is negative
+func (rmq *rocksmq) deleteMessages(topicName string, endID UniqueID) error {
+	fixChanName, err := fixChannelName(topicName)
+	if err != nil {
+		return err
+	}
+
+	batch := gorocksdb.NewWriteBatch()
+	defer batch.Destroy()
+	// the properties are removed along with their messages
+	for _, prefix := range []string{fixChanName + "/", fixChanName + propertiesSeparator} {
+		if err := rmq.deleteWithPrefix(batch, prefix, endID); err != nil {
+			return err
+		}
+	}
+
+	writeOpts := gorocksdb.NewDefaultWriteOptions()
+	defer writeOpts.Destroy()
+	return rmq.store.Write(writeOpts, batch)
+}
+
+// deleteWithPrefix adds the keys of the prefix whose id is not larger than endID to the batch
+func (rmq *rocksmq) deleteWithPrefix(batch *gorocksdb.WriteBatch, prefix string, endID UniqueID) error {
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetPrefixSameAsStart(true)
+	iter := rmq.store.NewIterator(readOpts)
+	defer iter.Close()
+
+	for iter.Seek([]byte(prefix)); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if endID >= 0 {
+			msgID, err := strconv.ParseInt(string(key.Data())[FixedChannelNameLen+1:], 10, 64)
+			if err != nil {
+				key.Free()
+				return err
+			}
+			if msgID > endID {
+				key.Free()
+				break
+			}
+		}
+		batch.Delete(key.Data())
+		key.Free()
+	}
+	return iter.Err()
+}
@@ -9,10 +9,10 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
 	"go.uber.org/zap"
 )
 
@@ -45,6 +45,8 @@ type Session struct {
 	leaseID  clientv3.LeaseID
 	cancel   context.CancelFunc
 	metaRoot string
+
+	enableActiveStandBy bool
 }
 
 // NewSession is a helper to build Session object.
@@ -81,6 +83,22 @@ func NewSession(ctx context.Context, metaRoot string, etcdEndpoints []string) *S
 	return session
 }
 
+// SetEnableActiveStandBy makes an exclusive server able to start more than one instance,
+// only the active one registers the session key, the others wait in ProcessActiveStandBy.
+// It must be called before Init.
+func (s *Session) SetEnableActiveStandBy(enable bool) {
+	s.enableActiveStandBy = enable
+}
+
+// EtcdClient returns the etcd client of the session, it lives as long as the server
+// and is shared by the health checks of the server. A nil session has no client.
+func (s *Session) EtcdClient() *clientv3.Client {
+	if s == nil {
+		return nil
+	}
+	return s.etcdCli
+}
+
 // Init will initialize base struct of the Session, including ServerName, ServerID,
 // Address, Exclusive. ServerID is obtained in getServerID.
 // Finally it will process keepAliveResponse to keep alive with etcd.
@@ -183,24 +201,27 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 			return err
 		}
 
-		key := s.ServerName
-		if !s.Exclusive {
-			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
-		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				0)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
+		// in active-standby mode the key is registered once the server becomes active
+		if !s.Exclusive || !s.enableActiveStandBy {
+			key := s.ServerName
+			if !s.Exclusive {
+				key = key + "-" + strconv.FormatInt(s.ServerID, 10)
+			}
+			txnResp, err := s.etcdCli.Txn(s.ctx).If(
+				clientv3.Compare(
+					clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
+					"=",
+					0)).
+				Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
 
-		if err != nil {
-			fmt.Printf("compare and swap error %s\n. maybe the key has registered", err)
-			return err
-		}
+			if err != nil {
+				fmt.Printf("compare and swap error %s\n. maybe the key has registered", err)
+				return err
+			}
 
-		if !txnResp.Succeeded {
-			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+			if !txnResp.Succeeded {
+				return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+			}
 		}
 
 		ch, err = s.etcdCli.KeepAlive(s.ctx, resp.ID)
@@ -218,6 +239,70 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 	return ch, nil
 }
 
+// ProcessActiveStandBy blocks until the server becomes the active one of its exclusive
+// service. It tries to register the session key with its own lease, and if the key is
+// held by another server, it watches the key and tries again once the key is deleted,
+// which happens when the active server stops or its lease expires.
+// activateFunc is called after the key is registered.
+func (s *Session) ProcessActiveStandBy(activateFunc func() error) error {
+	if !s.Exclusive || !s.enableActiveStandBy {
+		return fmt.Errorf("session %s is not in active-standby mode", s.ServerName)
+	}
+	sessionJSON, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	key := path.Join(s.metaRoot, DefaultServiceRoot, s.ServerName)
+	for {
+		txnResp, err := s.etcdCli.Txn(s.ctx).If(
+			clientv3.Compare(clientv3.Version(key), "=", 0)).
+			Then(clientv3.OpPut(key, string(sessionJSON), clientv3.WithLease(s.leaseID))).
+			Else(clientv3.OpGet(key)).Commit()
+		if err != nil {
+			return err
+		}
+		if txnResp.Succeeded {
+			break
+		}
+		revision := txnResp.Header.Revision
+		log.Debug("Session standby, waiting for the active server to quit",
+			zap.String("ServerName", s.ServerName), zap.Int64("ServerID", s.ServerID))
+		if err := s.waitKeyDeleted(key, revision+1); err != nil {
+			return err
+		}
+	}
+	log.Debug("Session becomes active", zap.String("ServerName", s.ServerName), zap.Int64("ServerID", s.ServerID))
+	if activateFunc != nil {
+		return activateFunc()
+	}
+	return nil
+}
+
+// waitKeyDeleted watches key from revision until a delete event is received
+func (s *Session) waitKeyDeleted(key string, revision int64) error {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	rch := s.etcdCli.Watch(ctx, key, clientv3.WithRev(revision))
+	for {
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case wresp, ok := <-rch:
+			if !ok {
+				return errors.New("session watch channel closed")
+			}
+			if err := wresp.Err(); err != nil {
+				return err
+			}
+			for _, ev := range wresp.Events {
+				if ev.Type == mvccpb.DELETE {
+					return nil
+				}
+			}
+		}
+	}
+}
+
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
 func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
@@ -333,3 +418,29 @@ func (s *Session) WatchServices(prefix string, revision int64) (eventChannel <-c
 	}()
 	return eventCh
 }
+
+// WatchActiveServer calls onChange with the address of every server of the role registering
+// its session after the call, which happens when a standby server takes over the active one.
+// It blocks until ctx is done, so the clients of the coordinators run it in a goroutine.
+func (s *Session) WatchActiveServer(ctx context.Context, role string, onChange func(address string)) {
+	_, revision, err := s.GetSessions(role)
+	if err != nil {
+		log.Debug("WatchActiveServer GetSessions failed", zap.String("role", role), zap.Error(err))
+		return
+	}
+	eventCh := s.WatchServices(role, revision+1)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+			if event.EventType != SessionAddEvent || event.Session.ServerName != role {
+				continue
+			}
+			onChange(event.Session.Address)
+		}
+	}
+}
@@ -171,3 +171,133 @@ func TestUpdateSessions(t *testing.T) {
 	assert.Equal(t, addEventLen, 10)
 	assert.Equal(t, delEventLen, 10)
 }
+
+func TestActiveStandBy(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	cli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints})
+	assert.Nil(t, err)
+	etcdKV := etcdkv.NewEtcdKV(cli, "")
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+	_, err = cli.Delete(ctx, metaRoot, clientv3.WithPrefix())
+	assert.Nil(t, err)
+
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s1 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s1.SetEnableActiveStandBy(true)
+	s1.Init("standbytest", "addr1", true)
+	sessions, _, err := s1.GetSessions("standbytest")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(sessions))
+
+	activated1 := false
+	err = s1.ProcessActiveStandBy(func() error {
+		activated1 = true
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, activated1)
+
+	s2 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s2.SetEnableActiveStandBy(true)
+	s2.Init("standbytest", "addr2", true)
+	activated2 := make(chan struct{})
+	go func() {
+		err := s2.ProcessActiveStandBy(func() error {
+			close(activated2)
+			return nil
+		})
+		assert.Nil(t, err)
+	}()
+
+	select {
+	case <-activated2:
+		t.Fatal("standby session should not be activated while the active one is alive")
+	case <-time.After(500 * time.Millisecond):
+	}
+	sessions, _, err = s1.GetSessions("standbytest")
+	assert.Nil(t, err)
+	assert.Equal(t, "addr1", sessions["standbytest"].Address)
+
+	// the active session key is removed with its lease
+	_, err = cli.Revoke(ctx, s1.leaseID)
+	assert.Nil(t, err)
+	select {
+	case <-activated2:
+	case <-time.After(10 * time.Second):
+		t.Fatal("standby session is not activated")
+	}
+	sessions, _, err = s2.GetSessions("standbytest")
+	assert.Nil(t, err)
+	assert.Equal(t, "addr2", sessions["standbytest"].Address)
+
+	s3 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s3.Init("standbytest3", "addr3", true)
+	assert.NotNil(t, s3.ProcessActiveStandBy(nil))
+}
+
+func TestWatchActiveServer(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	cli, err := clientv3.New(clientv3.Config{Endpoints: etcdEndpoints})
+	assert.Nil(t, err)
+	etcdKV := etcdkv.NewEtcdKV(cli, "")
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	s1 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s1.Init("watchtest", "addr1", true)
+
+	watcher := NewSession(ctx, metaRoot, etcdEndpoints)
+	assert.Equal(t, cli.Endpoints(), watcher.EtcdClient().Endpoints())
+	watchCtx, cancel := context.WithCancel(ctx)
+	addrCh := make(chan string, 10)
+	done := make(chan struct{})
+	go func() {
+		watcher.WatchActiveServer(watchCtx, "watchtest", func(address string) {
+			addrCh <- address
+		})
+		close(done)
+	}()
+	// wait for the watch to start, the session registered before is not reported
+	time.Sleep(100 * time.Millisecond)
+
+	// other roles are ignored
+	s2 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s2.Init("watchtest2", "addr2", true)
+	_, err = cli.Revoke(ctx, s1.leaseID)
+	assert.Nil(t, err)
+	s3 := NewSession(ctx, metaRoot, etcdEndpoints)
+	s3.Init("watchtest", "addr3", true)
+	select {
+	case addr := <-addrCh:
+		assert.Equal(t, "addr3", addr)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the new active server is not reported")
+	}
+	assert.Equal(t, 0, len(addrCh))
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("WatchActiveServer does not return after the context is done")
+	}
+}
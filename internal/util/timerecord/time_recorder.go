@@ -22,6 +22,13 @@ type TimeRecorder struct {
 	header string
 	start  time.Time
 	last   time.Time
+	stages []StageCost
+}
+
+// StageCost is the time span of a stage recorded by RecordStage
+type StageCost struct {
+	Stage string
+	Cost  time.Duration
 }
 
 // NewTimeRecorder create a new TimeRecorder
@@ -42,6 +49,23 @@ func (tr *TimeRecorder) Record(msg string) time.Duration {
 	return span
 }
 
+// RecordStage works as Record, the time span is also kept as the cost of the stage
+func (tr *TimeRecorder) RecordStage(stage string) time.Duration {
+	span := tr.Record(stage)
+	tr.stages = append(tr.stages, StageCost{Stage: stage, Cost: span})
+	return span
+}
+
+// StageCosts returns the stages recorded by RecordStage in order
+func (tr *TimeRecorder) StageCosts() []StageCost {
+	return tr.stages
+}
+
+// ElapseSpan calculates the time span from the beginning of this TimeRecorder without logging
+func (tr *TimeRecorder) ElapseSpan() time.Duration {
+	return time.Since(tr.start)
+}
+
 // Elapse calculates the time span from the beginning of this TimeRecorder
 func (tr *TimeRecorder) Elapse(msg string) time.Duration {
 	curr := time.Now()
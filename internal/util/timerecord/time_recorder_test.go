@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package timerecord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeRecorder_RecordStage(t *testing.T) {
+	tr := NewTimeRecorder("test")
+	assert.Empty(t, tr.StageCosts())
+
+	time.Sleep(10 * time.Millisecond)
+	tr.RecordStage("first")
+	tr.Record("not a stage")
+	tr.RecordStage("second")
+
+	stages := tr.StageCosts()
+	assert.Equal(t, 2, len(stages))
+	assert.Equal(t, "first", stages[0].Stage)
+	assert.Equal(t, "second", stages[1].Stage)
+	assert.GreaterOrEqual(t, int64(stages[0].Cost), int64(10*time.Millisecond))
+	assert.GreaterOrEqual(t, int64(tr.ElapseSpan()), int64(stages[0].Cost+stages[1].Cost))
+}
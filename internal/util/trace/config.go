@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package trace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// ExporterNoop disables the export of spans, the span context is still propagated
+	ExporterNoop = "noop"
+	// ExporterOTLP exports the spans to an OTLP/HTTP endpoint, such as the OpenTelemetry collector or jaeger
+	ExporterOTLP = "otlp"
+
+	defaultOTLPEndpoint = "localhost:4318"
+)
+
+type config struct {
+	exporter       string
+	sampleFraction float64
+	otlpEndpoint   string
+	otlpInsecure   bool
+}
+
+// loadConfig reads the trace section of milvus.yaml
+func loadConfig() *config {
+	params := paramtable.BaseTable{}
+	params.Init()
+
+	cfg := &config{
+		exporter:       ExporterNoop,
+		sampleFraction: 1,
+		otlpEndpoint:   defaultOTLPEndpoint,
+		otlpInsecure:   true,
+	}
+	if value, err := params.Load("trace.exporter"); err == nil && value != "" {
+		cfg.exporter = strings.ToLower(value)
+	}
+	if value, err := params.Load("trace.sampleFraction"); err == nil {
+		if fraction, err := strconv.ParseFloat(value, 64); err == nil {
+			cfg.sampleFraction = fraction
+		}
+	}
+	if value, err := params.Load("trace.otlp.endpoint"); err == nil && value != "" {
+		cfg.otlpEndpoint = value
+	}
+	if value, err := params.Load("trace.otlp.insecure"); err == nil {
+		if insecure, err := strconv.ParseBool(value); err == nil {
+			cfg.otlpInsecure = insecure
+		}
+	}
+	return cfg
+}
+
+// newExporter returns nil if the export is disabled
+func newExporter(cfg *config) (sdktrace.SpanExporter, error) {
+	switch cfg.exporter {
+	case ExporterNoop:
+		return nil, nil
+	case ExporterOTLP:
+		return newOTLPExporter(cfg.otlpEndpoint, cfg.otlpInsecure)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %s", cfg.exporter)
+	}
+}
@@ -14,16 +14,16 @@ package trace
 import (
 	"context"
 
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
-	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-type InterceptorSuite struct {
-	ClientOpts []grpc.DialOption
-	ServerOpts []grpc.ServerOption
-}
-
 var (
 	filterFunc = func(ctx context.Context, fullMethodName string) bool {
 		if fullMethodName == `/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick` ||
@@ -34,11 +34,124 @@ var (
 	}
 )
 
-func GetInterceptorOpts() []grpc_opentracing.Option {
-	tracer := opentracing.GlobalTracer()
-	opts := []grpc_opentracing.Option{
-		grpc_opentracing.WithTracer(tracer),
-		grpc_opentracing.WithFilterFunc(filterFunc),
+// metadataCarrier adapts the grpc metadata to propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	values := metadata.MD(mc).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (mc metadataCarrier) Set(key, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for k := range mc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func rpcAttributes(fullMethodName string) trace.SpanStartOption {
+	return trace.WithAttributes(
+		semconv.RPCSystemKey.String("grpc"),
+		attribute.String("rpc.method", fullMethodName))
+}
+
+func endRPCSpan(span Span, err error) {
+	if err != nil {
+		s, _ := status.FromError(err)
+		span.SetStatus(codes.Error, s.Message())
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", s.Code().String()))
+	}
+	span.End()
+}
+
+// startServerSpan continues the trace carried by the metadata of the incoming request
+func startServerSpan(ctx context.Context, fullMethodName string) (context.Context, Span) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	return tracer().Start(ctx, fullMethodName, trace.WithSpanKind(trace.SpanKindServer), rpcAttributes(fullMethodName))
+}
+
+// startClientSpan writes the trace into the metadata of the outgoing request
+func startClientSpan(ctx context.Context, fullMethodName string) (context.Context, Span) {
+	ctx, span := tracer().Start(ctx, fullMethodName, trace.WithSpanKind(trace.SpanKindClient), rpcAttributes(fullMethodName))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// UnaryServerInterceptor starts a span for every unary request, whose parent is the span of the client
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !filterFunc(ctx, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endRPCSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor starts a span for every stream, which ends with the stream
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !filterFunc(ss.Context(), info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		err := handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx})
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientInterceptor starts a span for every unary request, and propagates it to the server
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !filterFunc(ctx, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx, span := startClientSpan(ctx, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor propagates the span to the server, the span only covers the creation of the stream
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !filterFunc(ctx, method) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		ctx, span := startClientSpan(ctx, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		endRPCSpan(span, err)
+		return cs, err
 	}
-	return opts
 }
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package trace
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	otlpTracesPath = "/v1/traces"
+	otlpTimeout    = 10 * time.Second
+)
+
+// newOTLPExporter returns the OTLP exporter of OpenTelemetry sending the spans over OTLP/HTTP.
+// The client of otlptracehttp is not used since it depends on a newer grpc than the one etcd works with.
+func newOTLPExporter(endpoint string, insecure bool) (sdktrace.SpanExporter, error) {
+	return otlptrace.New(context.Background(), newOTLPHTTPClient(endpoint, insecure))
+}
+
+// otlpHTTPClient posts the spans converted by the otlptrace exporter in the protobuf encoding,
+// TracesData is sent as it has the same wire format as ExportTraceServiceRequest
+type otlpHTTPClient struct {
+	url    string
+	client *http.Client
+}
+
+func newOTLPHTTPClient(endpoint string, insecure bool) *otlpHTTPClient {
+	scheme := "https://"
+	if insecure {
+		scheme = "http://"
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = scheme + endpoint
+	}
+	return &otlpHTTPClient{
+		url:    strings.TrimSuffix(endpoint, "/") + otlpTracesPath,
+		client: &http.Client{Timeout: otlpTimeout},
+	}
+}
+
+func (c *otlpHTTPClient) Start(ctx context.Context) error {
+	return nil
+}
+
+func (c *otlpHTTPClient) Stop(ctx context.Context) error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *otlpHTTPClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	body, err := proto.Marshal(&tracepb.TracesData{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("export spans to %s failed, status: %s, body: %s", c.url, resp.Status, string(msg))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
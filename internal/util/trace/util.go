@@ -17,45 +17,78 @@ import (
 	"io"
 	"runtime"
 	"strings"
-
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/log"
-	"github.com/uber/jaeger-client-go"
-	"github.com/uber/jaeger-client-go/config"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
+// Span is the span of OpenTelemetry, it is ended by End
+type Span = trace.Span
+
+// tracerName is the name of the instrumentation which creates the spans of milvus
+const tracerName = "github.com/milvus-io/milvus"
+
+var initialized int32
+
+func init() {
+	// the spans and baggage are propagated even if tracing is not initialized
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{}))
+}
+
+// tracerProviderCloser shuts down the tracer provider, the ended spans are flushed
+type tracerProviderCloser struct {
+	tp *sdktrace.TracerProvider
+}
+
+func (c *tracerProviderCloser) Close() error {
+	return c.tp.Shutdown(context.Background())
+}
+
+// InitTracing sets the global tracer provider of OpenTelemetry, the exporter and the
+// sampler are configured by the trace section of milvus.yaml. Only the first call takes
+// effect when several components run in one process, nil is returned by the others.
 func InitTracing(serviceName string) io.Closer {
-	if opentracing.IsGlobalTracerRegistered() {
+	if !atomic.CompareAndSwapInt32(&initialized, 0, 1) {
 		return nil
 	}
-	var cfg *config.Configuration
-	var err error
-	if true {
-		cfg, err = config.FromEnv()
-		if err != nil {
-			log.Error(err)
-			return nil
-		}
-		cfg.ServiceName = serviceName
-	} else {
-		cfg = &config.Configuration{
-			ServiceName: serviceName,
-			Sampler: &config.SamplerConfig{
-				Type:  "const",
-				Param: 1,
-			},
-		}
-	}
-	tracer, closer, err := cfg.NewTracer()
+	cfg := loadConfig()
+	exporter, err := newExporter(cfg)
 	if err != nil {
-		log.Error(err)
+		log.Error("failed to create trace exporter", zap.String("exporter", cfg.exporter), zap.Error(err))
 		return nil
 	}
-	opentracing.SetGlobalTracer(tracer)
-	return closer
+	res := sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.sampleFraction))),
+	}
+	// without an exporter the spans still get their trace ids to be propagated and logged, they are just not exported
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	log.Debug("tracing initialized", zap.String("service", serviceName), zap.String("exporter", cfg.exporter),
+		zap.Float64("sampleFraction", cfg.sampleFraction))
+	return &tracerProviderCloser{tp: tp}
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
 }
 
-func StartSpanFromContext(ctx context.Context, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContext(ctx context.Context, opts ...trace.SpanStartOption) (Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), ctx
 	}
@@ -63,8 +96,8 @@ func StartSpanFromContext(ctx context.Context, opts ...opentracing.StartSpanOpti
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, "unknown", opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := tracer().Start(ctx, "unknown", opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	fn := runtime.FuncForPC(pcs[0])
@@ -73,18 +106,13 @@ func StartSpanFromContext(ctx context.Context, opts ...opentracing.StartSpanOpti
 		name = name[lastSlash+1:]
 	}
 
-	if parent := opentracing.SpanFromContext(ctx); parent != nil {
-		opts = append(opts, opentracing.ChildOf(parent.Context()))
-	}
-	span := opentracing.StartSpan(name, opts...)
-
 	file, line := fn.FileLine(pcs[0])
-	span.LogFields(log.String("filename", file), log.Int("line", line))
-
-	return span, opentracing.ContextWithSpan(ctx, span)
+	opts = append(opts, trace.WithAttributes(attribute.String("filename", file), attribute.Int("line", line)))
+	ctx, span := tracer().Start(ctx, name, opts...)
+	return span, ctx
 }
 
-func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...opentracing.StartSpanOption) (opentracing.Span, context.Context) {
+func StartSpanFromContextWithOperationName(ctx context.Context, operationName string, opts ...trace.SpanStartOption) (Span, context.Context) {
 	if ctx == nil {
 		return NoopSpan(), ctx
 	}
@@ -92,82 +120,85 @@ func StartSpanFromContextWithOperationName(ctx context.Context, operationName st
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span, ctx := opentracing.StartSpanFromContext(ctx, operationName, opts...)
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		ctx, span := tracer().Start(ctx, operationName, opts...)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return span, ctx
 	}
 	file, line := runtime.FuncForPC(pcs[0]).FileLine(pcs[0])
 
-	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
-		opts = append(opts, opentracing.ChildOf(parentSpan.Context()))
-	}
-	span := opentracing.StartSpan(operationName, opts...)
-	ctx = opentracing.ContextWithSpan(ctx, span)
-
-	span.LogFields(log.String("filename", file), log.Int("line", line))
-
+	opts = append(opts, trace.WithAttributes(attribute.String("filename", file), attribute.Int("line", line)))
+	ctx, span := tracer().Start(ctx, operationName, opts...)
 	return span, ctx
 }
 
-func LogError(span opentracing.Span, err error) error {
+func LogError(span Span, err error) error {
 	if err == nil {
 		return nil
 	}
+	span.SetStatus(codes.Error, err.Error())
 
 	// Get caller frame.
 	var pcs [1]uintptr
 	n := runtime.Callers(2, pcs[:])
 	if n < 1 {
-		span.LogFields(log.Error(err))
-		span.LogFields(log.Error(errors.New("runtime.Callers failed")))
+		span.RecordError(err)
+		span.RecordError(errors.New("runtime.Callers failed"))
 		return err
 	}
 
 	file, line := runtime.FuncForPC(pcs[0]).FileLine(pcs[0])
-	span.LogFields(log.String("filename", file), log.Int("line", line), log.Error(err))
+	span.RecordError(err, trace.WithAttributes(attribute.String("filename", file), attribute.Int("line", line)))
 
 	return err
 }
 
-func InfoFromSpan(span opentracing.Span) (traceID string, sampled bool, found bool) {
-	if spanContext, ok := span.Context().(jaeger.SpanContext); ok {
-		traceID = spanContext.TraceID().String()
-		sampled = spanContext.IsSampled()
-		return traceID, sampled, true
+func InfoFromSpan(span Span) (traceID string, sampled bool, found bool) {
+	spanContext := span.SpanContext()
+	if spanContext.HasTraceID() {
+		return spanContext.TraceID().String(), spanContext.IsSampled(), true
 	}
 	return "", false, false
 }
 
 func InfoFromContext(ctx context.Context) (traceID string, sampled bool, found bool) {
-	if span := opentracing.SpanFromContext(ctx); span != nil {
-		return InfoFromSpan(span)
-	}
-	return "", false, false
+	return InfoFromSpan(trace.SpanFromContext(ctx))
 }
 
-func InjectContextToPulsarMsgProperties(sc opentracing.SpanContext, properties map[string]string) {
-	tracer := opentracing.GlobalTracer()
-	tracer.Inject(sc, opentracing.TextMap, PropertiesReaderWriter{properties})
+// InjectContextToMsgProperties writes the span context and the baggage of ctx into the
+// properties of a message, so that the consumers could continue the trace
+func InjectContextToMsgProperties(ctx context.Context, properties map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, PropertiesReaderWriter{properties})
 }
 
+// ExtractContextFromMsgProperties returns a copy of ctx with the span context and the
+// baggage written into the properties by InjectContextToMsgProperties
+func ExtractContextFromMsgProperties(ctx context.Context, properties map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, PropertiesReaderWriter{properties})
+}
+
+// PropertiesReaderWriter adapts the message properties to propagation.TextMapCarrier
 type PropertiesReaderWriter struct {
 	PpMap map[string]string
 }
 
+func (ppRW PropertiesReaderWriter) Get(key string) string {
+	return ppRW.PpMap[strings.ToLower(key)]
+}
+
 func (ppRW PropertiesReaderWriter) Set(key, val string) {
 	key = strings.ToLower(key)
 	ppRW.PpMap[key] = val
 }
 
-func (ppRW PropertiesReaderWriter) ForeachKey(handler func(key, val string) error) error {
-	for k, val := range ppRW.PpMap {
-		if err := handler(k, val); err != nil {
-			return err
-		}
+func (ppRW PropertiesReaderWriter) Keys() []string {
+	keys := make([]string, 0, len(ppRW.PpMap))
+	for k := range ppRW.PpMap {
+		keys = append(keys, k)
 	}
-	return nil
+	return keys
 }
 
-func NoopSpan() opentracing.Span {
-	return opentracing.NoopTracer{}.StartSpan("Default-span")
+// NoopSpan returns a span which records nothing
+func NoopSpan() Span {
+	return trace.SpanFromContext(context.Background())
 }
@@ -13,12 +13,22 @@ package trace
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
-	"errors"
-
-	oplog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 type simpleStruct struct {
@@ -29,7 +39,9 @@ type simpleStruct struct {
 func TestTracing(t *testing.T) {
 	//Already Init in each framework, this can be ignored in debug
 	closer := InitTracing("test")
-	defer closer.Close()
+	if closer != nil {
+		defer closer.Close()
+	}
 
 	// context normally can be propagated through func params
 	ctx := context.Background()
@@ -37,16 +49,18 @@ func TestTracing(t *testing.T) {
 	//start span
 	//default use function name for operation name
 	sp, ctx := StartSpanFromContext(ctx)
-	sp.SetTag("tag1", "tag1")
+	// the noop exporter still samples the spans, they have trace ids to be logged
+	assert.True(t, sp.SpanContext().IsValid())
+	sp.SetAttributes(attribute.String("tag1", "tag1"))
 	// use self-defined operation name for span
 	// sp, ctx := StartSpanFromContextWithOperationName(ctx, "self-defined name")
-	defer sp.Finish()
+	defer sp.End()
 
 	ss := &simpleStruct{
 		name:  "name",
 		value: "value",
 	}
-	sp.LogFields(oplog.String("key", "value"), oplog.Object("key", ss))
+	sp.AddEvent("event", trace.WithAttributes(attribute.String("key", "value"), attribute.String("struct", fmt.Sprint(ss))))
 
 	err := caller(ctx)
 
@@ -59,9 +73,9 @@ func TestTracing(t *testing.T) {
 func caller(ctx context.Context) error {
 	for i := 0; i < 2; i++ {
 		// if span starts in a loop, defer is not allowed.
-		// manually call span.Finish() if error occurs or one loop ends
+		// manually call span.End() if error occurs or one loop ends
 		sp, _ := StartSpanFromContextWithOperationName(ctx, fmt.Sprintf("test:%d", i))
-		sp.SetTag(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i))
+		sp.SetAttributes(attribute.String(fmt.Sprintf("tags:%d", i), fmt.Sprintf("tags:%d", i)))
 
 		var err error
 		if i == 1 {
@@ -69,11 +83,83 @@ func caller(ctx context.Context) error {
 		}
 
 		if err != nil {
-			sp.Finish()
+			sp.End()
 			return LogError(sp, err)
 		}
 
-		sp.Finish()
+		sp.End()
 	}
 	return nil
 }
+
+func TestMsgPropertiesPropagation(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	defer tp.Shutdown(context.Background())
+
+	ctx, sp := tp.Tracer("test").Start(context.Background(), "producer")
+	member, err := baggage.NewMember("key", "value")
+	assert.Nil(t, err)
+	bag, err := baggage.New(member)
+	assert.Nil(t, err)
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+
+	properties := map[string]string{}
+	InjectContextToMsgProperties(ctx, properties)
+	sp.End()
+	assert.NotEmpty(t, properties)
+
+	consumerCtx := ExtractContextFromMsgProperties(context.Background(), properties)
+	sc := trace.SpanContextFromContext(consumerCtx)
+	assert.True(t, sc.IsRemote())
+	assert.Equal(t, sp.SpanContext().TraceID(), sc.TraceID())
+	assert.Equal(t, sp.SpanContext().SpanID(), sc.SpanID())
+	assert.Equal(t, "value", baggage.FromContext(consumerCtx).Member("key").Value())
+
+	traceID, _, found := InfoFromContext(consumerCtx)
+	assert.True(t, found)
+	assert.Equal(t, sp.SpanContext().TraceID().String(), traceID)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var req tracepb.TracesData
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlpTracesPath, r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Nil(t, proto.Unmarshal(body, &req))
+	}))
+	defer server.Close()
+
+	exporter, err := newOTLPExporter(server.URL, true)
+	assert.Nil(t, err)
+	res := sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String("test"))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter), sdktrace.WithResource(res))
+	defer tp.Shutdown(context.Background())
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, child := tp.Tracer("test").Start(ctx, "child", trace.WithAttributes(
+		attribute.Int64("ID", 1), attribute.StringSlice("channels", []string{"ch1", "ch2"})))
+	LogError(child, errors.New("child failed"))
+	child.End()
+
+	assert.Equal(t, 1, len(req.ResourceSpans))
+	assert.Equal(t, string(semconv.ServiceNameKey), req.ResourceSpans[0].Resource.Attributes[0].Key)
+	assert.Equal(t, 1, len(req.ResourceSpans[0].ScopeSpans))
+	assert.Equal(t, "test", req.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, "child", spans[0].Name)
+	traceID, spanID := parent.SpanContext().TraceID(), parent.SpanContext().SpanID()
+	assert.Equal(t, traceID[:], spans[0].TraceId)
+	assert.Equal(t, spanID[:], spans[0].ParentSpanId)
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, spans[0].Status.Code)
+	assert.Equal(t, "child failed", spans[0].Status.Message)
+	assert.Equal(t, 1, len(spans[0].Events))
+	assert.Equal(t, int64(1), spans[0].Attributes[0].Value.GetIntValue())
+	assert.Equal(t, 2, len(spans[0].Attributes[1].Value.GetArrayValue().Values))
+	parent.End()
+
+	server.Close()
+	assert.NotNil(t, exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{parent.(sdktrace.ReadOnlySpan)}))
+}
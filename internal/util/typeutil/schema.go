@@ -12,8 +12,11 @@
 package typeutil
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
@@ -33,6 +36,12 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 8
 		case schemapb.DataType_String:
 			res += 125 // todo find a better way to estimate string type
+		case schemapb.DataType_JSON, schemapb.DataType_Array:
+			maxLength, err := GetJSONMaxLength(fs)
+			if err != nil {
+				return -1, err
+			}
+			res += maxLength
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -61,17 +70,18 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 }
 
 type SchemaHelper struct {
-	schema           *schemapb.CollectionSchema
-	nameOffset       map[string]int
-	idOffset         map[int64]int
-	primaryKeyOffset int
+	schema             *schemapb.CollectionSchema
+	nameOffset         map[string]int
+	idOffset           map[int64]int
+	primaryKeyOffset   int
+	dynamicFieldOffset int
 }
 
 func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error) {
 	if schema == nil {
 		return nil, errors.New("schema is nil")
 	}
-	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1}
+	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1, dynamicFieldOffset: -1}
 	for offset, field := range schema.Fields {
 		if _, ok := schemaHelper.nameOffset[field.Name]; ok {
 			return nil, errors.New("duplicated fieldName: " + field.Name)
@@ -87,6 +97,12 @@ func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error
 			}
 			schemaHelper.primaryKeyOffset = offset
 		}
+		if field.IsDynamic {
+			if schemaHelper.dynamicFieldOffset != -1 {
+				return nil, errors.New("dynamic field is not unique")
+			}
+			schemaHelper.dynamicFieldOffset = offset
+		}
 	}
 	return &schemaHelper, nil
 }
@@ -98,6 +114,14 @@ func (helper *SchemaHelper) GetPrimaryKeyField() (*schemapb.FieldSchema, error)
 	return helper.schema.Fields[helper.primaryKeyOffset], nil
 }
 
+// GetDynamicField returns the hidden json field keeping the fields not in the schema
+func (helper *SchemaHelper) GetDynamicField() (*schemapb.FieldSchema, error) {
+	if helper.dynamicFieldOffset == -1 || !helper.schema.EnableDynamicField {
+		return nil, fmt.Errorf("dynamic field is not enabled in schema")
+	}
+	return helper.schema.Fields[helper.dynamicFieldOffset], nil
+}
+
 func (helper *SchemaHelper) GetFieldFromName(fieldName string) (*schemapb.FieldSchema, error) {
 	offset, ok := helper.nameOffset[fieldName]
 	if !ok {
@@ -134,6 +158,161 @@ func (helper *SchemaHelper) GetVectorDimFromID(filedID int64) (int, error) {
 	return 0, fmt.Errorf("fieldID(%d) not has dim", filedID)
 }
 
+// GetJSONMaxLength returns the max length in bytes of the values of a JSON field,
+// or of the JSON encoding of the values of an array field
+func GetJSONMaxLength(field *schemapb.FieldSchema) (int, error) {
+	if field.DataType != schemapb.DataType_JSON && field.DataType != schemapb.DataType_Array {
+		return 0, fmt.Errorf("field type = %s not has max_length", schemapb.DataType_name[int32(field.DataType)])
+	}
+	for _, kv := range field.TypeParams {
+		if kv.Key == "max_length" {
+			maxLength, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			if maxLength <= 0 {
+				return 0, fmt.Errorf("invalid max_length %d of field %s", maxLength, field.Name)
+			}
+			return maxLength, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s not has max_length", field.Name)
+}
+
+// EncodeJSONSlot pads a JSON value with NULs to the fixed width slot of maxLength bytes
+// it takes in row based data, JSON text never contains NUL so the padding is unambiguous
+func EncodeJSONSlot(value []byte, maxLength int) ([]byte, error) {
+	if len(value) > maxLength {
+		return nil, fmt.Errorf("the length of JSON value %d exceeds max_length %d", len(value), maxLength)
+	}
+	slot := make([]byte, maxLength)
+	copy(slot, value)
+	return slot, nil
+}
+
+// DecodeJSONSlot strips the NUL padding of a JSON slot
+func DecodeJSONSlot(slot []byte) []byte {
+	if end := bytes.IndexByte(slot, 0); end != -1 {
+		return slot[:end]
+	}
+	return slot
+}
+
+// GetArrayMaxCapacity returns the max number of elements of the values of an array field
+func GetArrayMaxCapacity(field *schemapb.FieldSchema) (int, error) {
+	if field.DataType != schemapb.DataType_Array {
+		return 0, fmt.Errorf("field type = %s not has max_capacity", schemapb.DataType_name[int32(field.DataType)])
+	}
+	for _, kv := range field.TypeParams {
+		if kv.Key == "max_capacity" {
+			maxCapacity, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			if maxCapacity <= 0 {
+				return 0, fmt.Errorf("invalid max_capacity %d of field %s", maxCapacity, field.Name)
+			}
+			return maxCapacity, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s not has max_capacity", field.Name)
+}
+
+// IsArrayElementType returns whether dataType can be the element type of an array field
+func IsArrayElementType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_String:
+		return true
+	default:
+		return IsIntergerType(dataType) || IsFloatingType(dataType)
+	}
+}
+
+// getArrayElements returns the elements of an array value, which must be of elementType
+func getArrayElements(value *schemapb.ScalarField, elementType schemapb.DataType) (interface{}, error) {
+	var elements interface{}
+	var ok bool
+	switch elementType {
+	case schemapb.DataType_Bool:
+		elements, ok = value.GetBoolData().GetData(), value.GetBoolData() != nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		elements, ok = value.GetIntData().GetData(), value.GetIntData() != nil
+	case schemapb.DataType_Int64:
+		elements, ok = value.GetLongData().GetData(), value.GetLongData() != nil
+	case schemapb.DataType_Float:
+		elements, ok = value.GetFloatData().GetData(), value.GetFloatData() != nil
+	case schemapb.DataType_Double:
+		elements, ok = value.GetDoubleData().GetData(), value.GetDoubleData() != nil
+	case schemapb.DataType_String:
+		elements, ok = value.GetStringData().GetData(), value.GetStringData() != nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", elementType.String())
+	}
+	if !ok {
+		return nil, fmt.Errorf("elements of array don't match element type %s", elementType.String())
+	}
+	return elements, nil
+}
+
+// GetArrayLength returns the number of elements of an array value
+func GetArrayLength(value *schemapb.ScalarField, elementType schemapb.DataType) (int, error) {
+	elements, err := getArrayElements(value, elementType)
+	if err != nil {
+		return 0, err
+	}
+	return reflect.ValueOf(elements).Len(), nil
+}
+
+// EncodeArray encodes the elements of an array value as a JSON array, which is how arrays are stored
+func EncodeArray(value *schemapb.ScalarField, elementType schemapb.DataType) ([]byte, error) {
+	elements, err := getArrayElements(value, elementType)
+	if err != nil {
+		return nil, err
+	}
+	if reflect.ValueOf(elements).Len() == 0 {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(elements)
+}
+
+// DecodeArray decodes a JSON array encoded by EncodeArray back into the elements of elementType
+func DecodeArray(data []byte, elementType schemapb.DataType) (*schemapb.ScalarField, error) {
+	value := &schemapb.ScalarField{}
+	var err error
+	switch elementType {
+	case schemapb.DataType_Bool:
+		elements := make([]bool, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: elements}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		elements := make([]int32, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: elements}}
+	case schemapb.DataType_Int64:
+		elements := make([]int64, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: elements}}
+	case schemapb.DataType_Float:
+		elements := make([]float32, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: elements}}
+	case schemapb.DataType_Double:
+		elements := make([]float64, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: elements}}
+	case schemapb.DataType_String:
+		elements := make([]string, 0)
+		err = json.Unmarshal(data, &elements)
+		value.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: elements}}
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", elementType.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
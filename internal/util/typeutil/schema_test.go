@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetJSONMaxLength(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "meta",
+		DataType:   schemapb.DataType_JSON,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}},
+	}
+	maxLength, err := GetJSONMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, 64, maxLength)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "id", DataType: schemapb.DataType_Int64},
+			field,
+		},
+	}
+	size, err := EstimateSizePerRecord(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, 72, size)
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "0"}}
+	_, err = GetJSONMaxLength(field)
+	assert.NotNil(t, err)
+
+	field.TypeParams = nil
+	_, err = GetJSONMaxLength(field)
+	assert.NotNil(t, err)
+
+	_, err = GetJSONMaxLength(&schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64})
+	assert.NotNil(t, err)
+}
+
+func TestJSONSlot(t *testing.T) {
+	value := []byte(`{"color":"red"}`)
+	slot, err := EncodeJSONSlot(value, 32)
+	assert.Nil(t, err)
+	assert.Equal(t, 32, len(slot))
+	assert.Equal(t, value, DecodeJSONSlot(slot))
+
+	slot, err = EncodeJSONSlot(value, len(value))
+	assert.Nil(t, err)
+	assert.Equal(t, value, DecodeJSONSlot(slot))
+
+	_, err = EncodeJSONSlot(value, len(value)-1)
+	assert.NotNil(t, err)
+}
+
+func TestGetArrayMaxCapacity(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "tags",
+		DataType:   schemapb.DataType_Array,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_capacity", Value: "16"}},
+	}
+	maxCapacity, err := GetArrayMaxCapacity(field)
+	assert.Nil(t, err)
+	assert.Equal(t, 16, maxCapacity)
+
+	field.TypeParams[0].Value = "-1"
+	_, err = GetArrayMaxCapacity(field)
+	assert.NotNil(t, err)
+
+	field.TypeParams = nil
+	_, err = GetArrayMaxCapacity(field)
+	assert.NotNil(t, err)
+
+	field.DataType = schemapb.DataType_JSON
+	_, err = GetArrayMaxCapacity(field)
+	assert.NotNil(t, err)
+}
+
+func TestArrayEncoding(t *testing.T) {
+	values := []struct {
+		elementType schemapb.DataType
+		value       *schemapb.ScalarField
+		encoded     string
+	}{
+		{
+			schemapb.DataType_Bool,
+			&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: []bool{true, false}}}},
+			`[true,false]`,
+		},
+		{
+			schemapb.DataType_Int16,
+			&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{1, -2}}}},
+			`[1,-2]`,
+		},
+		{
+			schemapb.DataType_Int64,
+			&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{}}}},
+			`[]`,
+		},
+		{
+			schemapb.DataType_Double,
+			&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{1.5}}}},
+			`[1.5]`,
+		},
+		{
+			schemapb.DataType_String,
+			&schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b\""}}}},
+			`["a","b\""]`,
+		},
+	}
+	for _, v := range values {
+		encoded, err := EncodeArray(v.value, v.elementType)
+		assert.Nil(t, err)
+		assert.Equal(t, v.encoded, string(encoded))
+
+		length, err := GetArrayLength(v.value, v.elementType)
+		assert.Nil(t, err)
+		decoded, err := DecodeArray(encoded, v.elementType)
+		assert.Nil(t, err)
+		decodedLength, err := GetArrayLength(decoded, v.elementType)
+		assert.Nil(t, err)
+		assert.Equal(t, length, decodedLength)
+		reencoded, err := EncodeArray(decoded, v.elementType)
+		assert.Nil(t, err)
+		assert.Equal(t, encoded, reencoded)
+	}
+
+	_, err := EncodeArray(values[0].value, schemapb.DataType_Int64)
+	assert.NotNil(t, err)
+	_, err = DecodeArray([]byte(`["a"]`), schemapb.DataType_Int64)
+	assert.NotNil(t, err)
+	_, err = DecodeArray([]byte(`[1]`), schemapb.DataType_FloatVector)
+	assert.NotNil(t, err)
+
+	assert.True(t, IsArrayElementType(schemapb.DataType_String))
+	assert.True(t, IsArrayElementType(schemapb.DataType_Int8))
+	assert.False(t, IsArrayElementType(schemapb.DataType_JSON))
+	assert.False(t, IsArrayElementType(schemapb.DataType_FloatVector))
+}
+
+func TestSchemaHelper_GetDynamicField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "testColl",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	helper, err := CreateSchemaHelper(schema)
+	assert.Nil(t, err)
+	_, err = helper.GetDynamicField()
+	assert.NotNil(t, err)
+
+	schema.EnableDynamicField = true
+	helper, err = CreateSchemaHelper(schema)
+	assert.Nil(t, err)
+	field, err := helper.GetDynamicField()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), field.FieldID)
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "$meta2", DataType: schemapb.DataType_JSON, IsDynamic: true})
+	_, err = CreateSchemaHelper(schema)
+	assert.NotNil(t, err)
+}